@@ -1,7 +1,9 @@
 package main
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
 	"strconv"
 	"time"
@@ -9,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/urfave/cli/v2"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -24,6 +27,11 @@ var (
 		Aliases: []string{"dns"},
 		Usage:   "if true, will not store the run internally - cannot be run in conjunction with dnr",
 	}
+	exportFormatsFlag = &cli.StringSliceFlag{
+		Name:    "exportformats",
+		Aliases: []string{"x"},
+		Usage:   fmt.Sprintf("exports the results to the server's report output path in the formats provided. eg '%v,%v'", config.ExportJSON, config.ExportCSV),
+	}
 )
 
 var executeStrategyFromFileCommand = &cli.Command{
@@ -54,6 +62,7 @@ var executeStrategyFromFileCommand = &cli.Command{
 			Aliases: []string{"i"},
 			Usage:   "override the strategy file's candle interval, in seconds. eg 60 = 1 minute",
 		},
+		exportFormatsFlag,
 	},
 }
 
@@ -139,6 +148,7 @@ func executeStrategyFromFile(c *cli.Context) error {
 			StartTimeOverride:   timestamppb.New(s),
 			EndTimeOverride:     timestamppb.New(e),
 			IntervalOverride:    uint64(overrideDuration),
+			ExportFormats:       c.StringSlice("exportformats"),
 		},
 	)
 
@@ -406,6 +416,7 @@ var executeStrategyFromConfigCommand = &cli.Command{
 	Flags: []cli.Flag{
 		doNotRunFlag,
 		doNotStoreFlag,
+		exportFormatsFlag,
 	},
 }
 
@@ -572,6 +583,7 @@ func executeStrategyFromConfig(c *cli.Context) error {
 			UseSimultaneousSignalProcessing: defaultConfig.StrategySettings.SimultaneousSignalProcessing,
 			DisableUsdTracking:              defaultConfig.StrategySettings.DisableUSDTracking,
 			CustomSettings:                  customSettings,
+			Seed:                            defaultConfig.StrategySettings.Seed,
 		},
 		FundingSettings: &btrpc.FundingSettings{
 			UseExchangeLevelFunding: defaultConfig.FundingSettings.UseExchangeLevelFunding,
@@ -618,9 +630,545 @@ func executeStrategyFromConfig(c *cli.Context) error {
 			Config:              cfg,
 			DoNotRunImmediately: dnr,
 			DoNotStore:          dns,
+			ExportFormats:       c.StringSlice("exportformats"),
+		},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var listRunsCommand = &cli.Command{
+	Name:   "listruns",
+	Usage:  "lists completed runs stored in the server's run history, newest first",
+	Action: listRuns,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "strategy",
+			Aliases: []string{"s"},
+			Usage:   "only list runs of the strategy name",
+		},
+		&cli.StringFlag{
+			Name:    "nickname",
+			Aliases: []string{"n"},
+			Usage:   "only list runs with the strategy config nickname",
+		},
+		&cli.StringFlag{
+			Name:    "confighash",
+			Aliases: []string{"c"},
+			Usage:   "only list runs which used the exact same strategy config",
+		},
+		&cli.StringFlag{
+			Name:    "from",
+			Aliases: []string{"f"},
+			Usage:   fmt.Sprintf("only list runs completed after this time using your local time. eg '%v'", time.Now().Truncate(time.Hour).AddDate(0, -1, 0).Format(common.SimpleTimeFormat)),
+		},
+		&cli.StringFlag{
+			Name:    "to",
+			Aliases: []string{"t"},
+			Usage:   fmt.Sprintf("only list runs completed before this time using your local time. eg '%v'", time.Now().Truncate(time.Hour).Format(common.SimpleTimeFormat)),
+		},
+		&cli.Uint64Flag{
+			Name:    "limit",
+			Aliases: []string{"l"},
+			Usage:   "the maximum number of runs to list",
+		},
+	},
+}
+
+func listRuns(c *cli.Context) error {
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	request := &btrpc.ListRunsRequest{
+		Strategy:   c.String("strategy"),
+		Nickname:   c.String("nickname"),
+		ConfigHash: c.String("confighash"),
+		Limit:      c.Uint64("limit"),
+	}
+	if c.IsSet("from") {
+		var from time.Time
+		from, err = time.ParseInLocation(common.SimpleTimeFormat, c.String("from"), time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for from: %v", err)
+		}
+		request.CreatedFrom = timestamppb.New(from)
+	}
+	if c.IsSet("to") {
+		var to time.Time
+		to, err = time.ParseInLocation(common.SimpleTimeFormat, c.String("to"), time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for to: %v", err)
+		}
+		request.CreatedTo = timestamppb.New(to)
+	}
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ListRuns(c.Context, request)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getRunCommand = &cli.Command{
+	Name:      "getrun",
+	Usage:     "gets a completed run from the server's run history",
+	ArgsUsage: "<id>",
+	Action:    getRun,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the id of the run",
+		},
+		&cli.BoolFlag{
+			Name:    "includereport",
+			Aliases: []string{"r"},
+			Usage:   "if true, will include the run's exported results",
+		},
+		&cli.StringFlag{
+			Name:    "output",
+			Aliases: []string{"o"},
+			Usage:   "saves the run's exported results as JSON to the filepath provided instead of printing them",
+		},
+	},
+}
+
+func getRun(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	output := c.String("output")
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.GetRun(
+		c.Context,
+		&btrpc.GetRunRequest{
+			Id:            id,
+			IncludeReport: c.Bool("includereport") || output != "",
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if output != "" {
+		err = file.Write(output, []byte(result.Report))
+		if err != nil {
+			return err
+		}
+		result.Report = ""
+		fmt.Printf("Saved run %v results to %v\n", id, output)
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var compareRunsCommand = &cli.Command{
+	Name:      "compareruns",
+	Usage:     "compares the results of completed runs from the server's run history",
+	ArgsUsage: "<ids>",
+	Action:    compareRuns,
+	Flags: []cli.Flag{
+		&cli.StringSliceFlag{
+			Name:  "ids",
+			Usage: "the ids of the runs to compare. eg 'id1,id2'",
+		},
+	},
+}
+
+func compareRuns(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	var ids []string
+	if c.IsSet("ids") {
+		ids = c.StringSlice("ids")
+	} else {
+		ids = c.Args().Slice()
+	}
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.CompareRuns(
+		c.Context,
+		&btrpc.CompareRunsRequest{
+			Ids: ids,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var streamTaskProgressCommand = &cli.Command{
+	Name:      "streamtaskprogress",
+	Usage:     "displays the progress of a strategy task until it has finished",
+	ArgsUsage: "<id>",
+	Action:    streamTaskProgress,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the id of the strategy task",
+		},
+		&cli.BoolFlag{
+			Name:    "events",
+			Aliases: []string{"e"},
+			Usage:   "if true, will output each signal and fill generated by the strategy task",
+		},
+	},
+}
+
+func streamTaskProgress(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	// the stream lasts as long as the task runs, so it is not
+	// limited by the request timeout
+	streamCtx := c.Context
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	stream, err := client.StreamTaskProgress(
+		streamCtx,
+		&btrpc.StreamTaskProgressRequest{
+			Id:            id,
+			IncludeEvents: c.Bool("events"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	var resp *btrpc.StreamTaskProgressResponse
+	for {
+		resp, err = stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for i := range resp.Events {
+			fmt.Print(clearLine)
+			fmt.Printf("%v %v %v %v %v-%v %v amount: %v price: %v fee: %v %v\n",
+				resp.Events[i].Time,
+				resp.Events[i].Type,
+				resp.Events[i].Exchange,
+				resp.Events[i].Asset,
+				resp.Events[i].Base,
+				resp.Events[i].Quote,
+				resp.Events[i].Direction,
+				resp.Events[i].Amount,
+				resp.Events[i].Price,
+				resp.Events[i].Fee,
+				resp.Events[i].Reason)
+		}
+		fmt.Print(clearLine + formatProgress(resp))
+		if resp.Finished {
+			fmt.Println()
+			jsonOutput(resp.Holdings)
+			fmt.Println()
+			return nil
+		}
+	}
+}
+
+var debugTaskCommand = &cli.Command{
+	Name:      "debugtask",
+	Usage:     "pauses, steps or resumes an offline strategy task",
+	ArgsUsage: "<id> <action>",
+	Action:    debugTask,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the id of the strategy task",
+		},
+		&cli.StringFlag{
+			Name:    "action",
+			Aliases: []string{"a"},
+			Usage:   "'pause' pauses before the next event, 'step' handles events then pauses, 'resume' runs until a breakpoint is met",
+		},
+		&cli.Uint64Flag{
+			Name:    "steps",
+			Aliases: []string{"s"},
+			Usage:   "the amount of events to handle when stepping",
+			Value:   1,
+		},
+	},
+}
+
+func debugTask(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	var action string
+	if c.IsSet("action") {
+		action = c.String("action")
+	} else {
+		action = c.Args().Get(1)
+	}
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.DebugTask(
+		c.Context,
+		&btrpc.DebugTaskRequest{
+			Id:     id,
+			Action: action,
+			Steps:  c.Uint64("steps"),
 		},
 	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var setTaskBreakpointCommand = &cli.Command{
+	Name:      "settaskbreakpoint",
+	Usage:     "adds a breakpoint which pauses an offline strategy task when its condition is met",
+	ArgsUsage: "<id> <type>",
+	Action:    setTaskBreakpoint,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the id of the strategy task",
+		},
+		&cli.StringFlag{
+			Name:    "type",
+			Aliases: []string{"t"},
+			Usage:   "the breakpoint type: 'time', 'event', 'event-type' or 'drawdown'",
+		},
+		&cli.StringFlag{
+			Name:  "time",
+			Usage: fmt.Sprintf("for 'time' breakpoints, pauses at the first event at or after this time using your local time. eg '%v'", time.Now().Truncate(time.Hour).AddDate(0, -1, 0).Format(common.SimpleTimeFormat)),
+		},
+		&cli.Uint64Flag{
+			Name:  "event",
+			Usage: "for 'event' breakpoints, pauses before this numbered event is handled",
+		},
+		&cli.StringFlag{
+			Name:  "eventtype",
+			Usage: "for 'event-type' breakpoints, pauses before every 'data', 'signal', 'order' or 'fill' event",
+		},
+		&cli.Float64Flag{
+			Name:  "drawdown",
+			Usage: "for 'drawdown' breakpoints, pauses when the value of all holdings falls further than this percentage from its highest value",
+		},
+	},
+}
+
+func setTaskBreakpoint(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
 
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	request := &btrpc.SetTaskBreakpointRequest{
+		Id:              id,
+		EventNumber:     c.Uint64("event"),
+		EventType:       c.String("eventtype"),
+		DrawdownPercent: c.Float64("drawdown"),
+	}
+	if c.IsSet("type") {
+		request.Type = c.String("type")
+	} else {
+		request.Type = c.Args().Get(1)
+	}
+	if c.IsSet("time") {
+		var t time.Time
+		t, err = time.ParseInLocation(common.SimpleTimeFormat, c.String("time"), time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for time: %v", err)
+		}
+		request.Time = timestamppb.New(t)
+	}
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.SetTaskBreakpoint(c.Context, request)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var clearTaskBreakpointsCommand = &cli.Command{
+	Name:      "cleartaskbreakpoints",
+	Usage:     "removes a breakpoint from a strategy task, or all breakpoints if no breakpoint id is set",
+	ArgsUsage: "<id> <breakpointid>",
+	Action:    clearTaskBreakpoints,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the id of the strategy task",
+		},
+		&cli.Uint64Flag{
+			Name:    "breakpointid",
+			Aliases: []string{"b"},
+			Usage:   "the id of the breakpoint to remove",
+		},
+	},
+}
+
+func clearTaskBreakpoints(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+	var breakpointID uint64
+	if c.IsSet("breakpointid") {
+		breakpointID = c.Uint64("breakpointid")
+	} else if c.Args().Get(1) != "" {
+		breakpointID, err = strconv.ParseUint(c.Args().Get(1), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid breakpoint id: %v", err)
+		}
+	}
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.ClearTaskBreakpoints(
+		c.Context,
+		&btrpc.ClearTaskBreakpointsRequest{
+			Id:           id,
+			BreakpointId: breakpointID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var inspectTaskCommand = &cli.Command{
+	Name:      "inspecttask",
+	Usage:     "displays the event queue, data history, holdings, funding and pending orders of a paused strategy task",
+	ArgsUsage: "<id>",
+	Action:    inspectTask,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "id",
+			Usage: "the id of the strategy task",
+		},
+		&cli.Int64Flag{
+			Name:  "history",
+			Usage: "the amount of processed data events to display for each currency",
+			Value: 25,
+		},
+	},
+}
+
+func inspectTask(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	client := btrpc.NewBacktesterServiceClient(conn)
+	result, err := client.InspectTask(
+		c.Context,
+		&btrpc.InspectTaskRequest{
+			Id:           id,
+			HistoryLimit: c.Int64("history"),
+		},
+	)
 	if err != nil {
 		return err
 	}
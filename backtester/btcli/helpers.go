@@ -3,7 +3,9 @@ package main
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
 	"google.golang.org/grpc"
 )
 
@@ -15,3 +17,33 @@ func closeConn(conn *grpc.ClientConn, cancel context.CancelFunc) {
 		cancel()
 	}
 }
+
+// progressBarWidth is the amount of characters used to draw a progress bar
+const progressBarWidth = 30
+
+// clearLine returns the cursor to the start of the line and erases it
+// so that progress can be redrawn in place
+const clearLine = "\r\033[2K"
+
+// formatProgress renders a single line summary of a task's progress.
+// Live tasks have no end, so no progress bar is drawn for them
+func formatProgress(resp *btrpc.StreamTaskProgressResponse) string {
+	var sb strings.Builder
+	if resp.Task != nil && !resp.Task.LiveTesting {
+		filled := int(resp.ProgressPercentage / 100 * progressBarWidth)
+		if filled > progressBarWidth {
+			filled = progressBarWidth
+		}
+		sb.WriteString("[" + strings.Repeat("#", filled) + strings.Repeat("-", progressBarWidth-filled) + "] ")
+		sb.WriteString(fmt.Sprintf("%.2f%% ", resp.ProgressPercentage))
+	}
+	if resp.CurrentTime != "" {
+		sb.WriteString(resp.CurrentTime + " ")
+	}
+	sb.WriteString(fmt.Sprintf("data: %v signals: %v orders: %v fills: %v",
+		resp.DataEventsProcessed,
+		resp.SignalEventsProcessed,
+		resp.OrderEventsProcessed,
+		resp.FillEventsProcessed))
+	return sb.String()
+}
@@ -119,6 +119,14 @@ func main() {
 		stopAllTasksCommand,
 		clearTaskCommand,
 		clearAllTasksCommand,
+		listRunsCommand,
+		getRunCommand,
+		compareRunsCommand,
+		streamTaskProgressCommand,
+		debugTaskCommand,
+		setTaskBreakpointCommand,
+		clearTaskBreakpointsCommand,
+		inspectTaskCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
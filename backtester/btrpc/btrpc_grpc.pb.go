@@ -31,6 +31,14 @@ type BacktesterServiceClient interface {
 	StopAllTasks(ctx context.Context, in *StopAllTasksRequest, opts ...grpc.CallOption) (*StopAllTasksResponse, error)
 	ClearTask(ctx context.Context, in *ClearTaskRequest, opts ...grpc.CallOption) (*ClearTaskResponse, error)
 	ClearAllTasks(ctx context.Context, in *ClearAllTasksRequest, opts ...grpc.CallOption) (*ClearAllTasksResponse, error)
+	ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error)
+	GetRun(ctx context.Context, in *GetRunRequest, opts ...grpc.CallOption) (*GetRunResponse, error)
+	CompareRuns(ctx context.Context, in *CompareRunsRequest, opts ...grpc.CallOption) (*CompareRunsResponse, error)
+	StreamTaskProgress(ctx context.Context, in *StreamTaskProgressRequest, opts ...grpc.CallOption) (BacktesterService_StreamTaskProgressClient, error)
+	DebugTask(ctx context.Context, in *DebugTaskRequest, opts ...grpc.CallOption) (*DebugTaskResponse, error)
+	SetTaskBreakpoint(ctx context.Context, in *SetTaskBreakpointRequest, opts ...grpc.CallOption) (*SetTaskBreakpointResponse, error)
+	ClearTaskBreakpoints(ctx context.Context, in *ClearTaskBreakpointsRequest, opts ...grpc.CallOption) (*ClearTaskBreakpointsResponse, error)
+	InspectTask(ctx context.Context, in *InspectTaskRequest, opts ...grpc.CallOption) (*InspectTaskResponse, error)
 }
 
 type backtesterServiceClient struct {
@@ -122,6 +130,101 @@ func (c *backtesterServiceClient) ClearAllTasks(ctx context.Context, in *ClearAl
 	return out, nil
 }
 
+func (c *backtesterServiceClient) ListRuns(ctx context.Context, in *ListRunsRequest, opts ...grpc.CallOption) (*ListRunsResponse, error) {
+	out := new(ListRunsResponse)
+	err := c.cc.Invoke(ctx, "/btrpc.BacktesterService/ListRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtesterServiceClient) GetRun(ctx context.Context, in *GetRunRequest, opts ...grpc.CallOption) (*GetRunResponse, error) {
+	out := new(GetRunResponse)
+	err := c.cc.Invoke(ctx, "/btrpc.BacktesterService/GetRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtesterServiceClient) CompareRuns(ctx context.Context, in *CompareRunsRequest, opts ...grpc.CallOption) (*CompareRunsResponse, error) {
+	out := new(CompareRunsResponse)
+	err := c.cc.Invoke(ctx, "/btrpc.BacktesterService/CompareRuns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtesterServiceClient) StreamTaskProgress(ctx context.Context, in *StreamTaskProgressRequest, opts ...grpc.CallOption) (BacktesterService_StreamTaskProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BacktesterService_ServiceDesc.Streams[0], "/btrpc.BacktesterService/StreamTaskProgress", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &backtesterServiceStreamTaskProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BacktesterService_StreamTaskProgressClient interface {
+	Recv() (*StreamTaskProgressResponse, error)
+	grpc.ClientStream
+}
+
+type backtesterServiceStreamTaskProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *backtesterServiceStreamTaskProgressClient) Recv() (*StreamTaskProgressResponse, error) {
+	m := new(StreamTaskProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *backtesterServiceClient) DebugTask(ctx context.Context, in *DebugTaskRequest, opts ...grpc.CallOption) (*DebugTaskResponse, error) {
+	out := new(DebugTaskResponse)
+	err := c.cc.Invoke(ctx, "/btrpc.BacktesterService/DebugTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtesterServiceClient) SetTaskBreakpoint(ctx context.Context, in *SetTaskBreakpointRequest, opts ...grpc.CallOption) (*SetTaskBreakpointResponse, error) {
+	out := new(SetTaskBreakpointResponse)
+	err := c.cc.Invoke(ctx, "/btrpc.BacktesterService/SetTaskBreakpoint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtesterServiceClient) ClearTaskBreakpoints(ctx context.Context, in *ClearTaskBreakpointsRequest, opts ...grpc.CallOption) (*ClearTaskBreakpointsResponse, error) {
+	out := new(ClearTaskBreakpointsResponse)
+	err := c.cc.Invoke(ctx, "/btrpc.BacktesterService/ClearTaskBreakpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backtesterServiceClient) InspectTask(ctx context.Context, in *InspectTaskRequest, opts ...grpc.CallOption) (*InspectTaskResponse, error) {
+	out := new(InspectTaskResponse)
+	err := c.cc.Invoke(ctx, "/btrpc.BacktesterService/InspectTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BacktesterServiceServer is the server API for BacktesterService service.
 // All implementations must embed UnimplementedBacktesterServiceServer
 // for forward compatibility
@@ -135,6 +238,14 @@ type BacktesterServiceServer interface {
 	StopAllTasks(context.Context, *StopAllTasksRequest) (*StopAllTasksResponse, error)
 	ClearTask(context.Context, *ClearTaskRequest) (*ClearTaskResponse, error)
 	ClearAllTasks(context.Context, *ClearAllTasksRequest) (*ClearAllTasksResponse, error)
+	ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error)
+	GetRun(context.Context, *GetRunRequest) (*GetRunResponse, error)
+	CompareRuns(context.Context, *CompareRunsRequest) (*CompareRunsResponse, error)
+	StreamTaskProgress(*StreamTaskProgressRequest, BacktesterService_StreamTaskProgressServer) error
+	DebugTask(context.Context, *DebugTaskRequest) (*DebugTaskResponse, error)
+	SetTaskBreakpoint(context.Context, *SetTaskBreakpointRequest) (*SetTaskBreakpointResponse, error)
+	ClearTaskBreakpoints(context.Context, *ClearTaskBreakpointsRequest) (*ClearTaskBreakpointsResponse, error)
+	InspectTask(context.Context, *InspectTaskRequest) (*InspectTaskResponse, error)
 	mustEmbedUnimplementedBacktesterServiceServer()
 }
 
@@ -169,6 +280,30 @@ func (UnimplementedBacktesterServiceServer) ClearTask(context.Context, *ClearTas
 func (UnimplementedBacktesterServiceServer) ClearAllTasks(context.Context, *ClearAllTasksRequest) (*ClearAllTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClearAllTasks not implemented")
 }
+func (UnimplementedBacktesterServiceServer) ListRuns(context.Context, *ListRunsRequest) (*ListRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuns not implemented")
+}
+func (UnimplementedBacktesterServiceServer) GetRun(context.Context, *GetRunRequest) (*GetRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRun not implemented")
+}
+func (UnimplementedBacktesterServiceServer) CompareRuns(context.Context, *CompareRunsRequest) (*CompareRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareRuns not implemented")
+}
+func (UnimplementedBacktesterServiceServer) StreamTaskProgress(*StreamTaskProgressRequest, BacktesterService_StreamTaskProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTaskProgress not implemented")
+}
+func (UnimplementedBacktesterServiceServer) DebugTask(context.Context, *DebugTaskRequest) (*DebugTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugTask not implemented")
+}
+func (UnimplementedBacktesterServiceServer) SetTaskBreakpoint(context.Context, *SetTaskBreakpointRequest) (*SetTaskBreakpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaskBreakpoint not implemented")
+}
+func (UnimplementedBacktesterServiceServer) ClearTaskBreakpoints(context.Context, *ClearTaskBreakpointsRequest) (*ClearTaskBreakpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearTaskBreakpoints not implemented")
+}
+func (UnimplementedBacktesterServiceServer) InspectTask(context.Context, *InspectTaskRequest) (*InspectTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InspectTask not implemented")
+}
 func (UnimplementedBacktesterServiceServer) mustEmbedUnimplementedBacktesterServiceServer() {}
 
 // UnsafeBacktesterServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -344,6 +479,153 @@ func _BacktesterService_ClearAllTasks_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BacktesterService_ListRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).ListRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/btrpc.BacktesterService/ListRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).ListRuns(ctx, req.(*ListRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktesterService_GetRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).GetRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/btrpc.BacktesterService/GetRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).GetRun(ctx, req.(*GetRunRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktesterService_CompareRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).CompareRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/btrpc.BacktesterService/CompareRuns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).CompareRuns(ctx, req.(*CompareRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktesterService_StreamTaskProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTaskProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BacktesterServiceServer).StreamTaskProgress(m, &backtesterServiceStreamTaskProgressServer{stream})
+}
+
+type BacktesterService_StreamTaskProgressServer interface {
+	Send(*StreamTaskProgressResponse) error
+	grpc.ServerStream
+}
+
+type backtesterServiceStreamTaskProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *backtesterServiceStreamTaskProgressServer) Send(m *StreamTaskProgressResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BacktesterService_DebugTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).DebugTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/btrpc.BacktesterService/DebugTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).DebugTask(ctx, req.(*DebugTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktesterService_SetTaskBreakpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaskBreakpointRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).SetTaskBreakpoint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/btrpc.BacktesterService/SetTaskBreakpoint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).SetTaskBreakpoint(ctx, req.(*SetTaskBreakpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktesterService_ClearTaskBreakpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearTaskBreakpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).ClearTaskBreakpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/btrpc.BacktesterService/ClearTaskBreakpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).ClearTaskBreakpoints(ctx, req.(*ClearTaskBreakpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BacktesterService_InspectTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InspectTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BacktesterServiceServer).InspectTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/btrpc.BacktesterService/InspectTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BacktesterServiceServer).InspectTask(ctx, req.(*InspectTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BacktesterService_ServiceDesc is the grpc.ServiceDesc for BacktesterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -387,7 +669,41 @@ var BacktesterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ClearAllTasks",
 			Handler:    _BacktesterService_ClearAllTasks_Handler,
 		},
+		{
+			MethodName: "ListRuns",
+			Handler:    _BacktesterService_ListRuns_Handler,
+		},
+		{
+			MethodName: "GetRun",
+			Handler:    _BacktesterService_GetRun_Handler,
+		},
+		{
+			MethodName: "CompareRuns",
+			Handler:    _BacktesterService_CompareRuns_Handler,
+		},
+		{
+			MethodName: "DebugTask",
+			Handler:    _BacktesterService_DebugTask_Handler,
+		},
+		{
+			MethodName: "SetTaskBreakpoint",
+			Handler:    _BacktesterService_SetTaskBreakpoint_Handler,
+		},
+		{
+			MethodName: "ClearTaskBreakpoints",
+			Handler:    _BacktesterService_ClearTaskBreakpoints_Handler,
+		},
+		{
+			MethodName: "InspectTask",
+			Handler:    _BacktesterService_InspectTask_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTaskProgress",
+			Handler:       _BacktesterService_StreamTaskProgress_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "btrpc.proto",
 }
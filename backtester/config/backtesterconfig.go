@@ -5,10 +5,15 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 )
 
 // ReadBacktesterConfigFromPath will take a config from a path
@@ -41,6 +46,15 @@ func GenerateDefaultConfig() (*BacktesterConfig, error) {
 			TemplatePath:   filepath.Join(wd, "report", "tpl.gohtml"),
 			OutputPath:     filepath.Join(wd, "results"),
 		},
+		RunHistory: RunHistory{
+			Path: filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "database"),
+			Config: database.Config{
+				Driver: database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{
+					Database: database.DefaultSQLiteDatabase,
+				},
+			},
+		},
 		GRPC: GRPC{
 			Username: "rpcuser",
 			Password: "helloImTheDefaultPassword",
@@ -70,3 +84,20 @@ func GenerateDefaultConfig() (*BacktesterConfig, error) {
 		StopAllTasksOnClose: true,
 	}, nil
 }
+
+// ValidateExportFormats ensures the export formats are supported and not repeated
+func (r *Report) ValidateExportFormats() error {
+	for i := range r.ExportFormats {
+		switch strings.ToLower(r.ExportFormats[i]) {
+		case ExportJSON, ExportCSV:
+		default:
+			return fmt.Errorf("%w '%v'", errUnsupportedExportFormat, r.ExportFormats[i])
+		}
+		for j := i + 1; j < len(r.ExportFormats); j++ {
+			if strings.EqualFold(r.ExportFormats[i], r.ExportFormats[j]) {
+				return fmt.Errorf("%w '%v' is duplicated", errUnsupportedExportFormat, r.ExportFormats[i])
+			}
+		}
+	}
+	return nil
+}
@@ -1,14 +1,24 @@
 package config
 
 import (
+	"errors"
 	"path/filepath"
 	"runtime"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database"
 )
 
+// Export formats supported by the report
+const (
+	ExportJSON = "json"
+	ExportCSV  = "csv"
+)
+
+var errUnsupportedExportFormat = errors.New("unsupported export format")
+
 var (
 	// DefaultBTDir is the default backtester config directory
 	DefaultBTDir = filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "backtester")
@@ -24,6 +34,7 @@ type BacktesterConfig struct {
 	StopAllTasksOnClose bool           `json:"stop-all-tasks-on-close"`
 	PluginPath          string         `json:"plugin-path"`
 	Report              Report         `json:"report"`
+	RunHistory          RunHistory     `json:"run-history"`
 	GRPC                GRPC           `json:"grpc"`
 	UseCMDColours       bool           `json:"use-cmd-colours"`
 	Colours             common.Colours `json:"cmd-colours"`
@@ -35,6 +46,18 @@ type Report struct {
 	TemplatePath   string `json:"template-path"`
 	OutputPath     string `json:"output-path"`
 	DarkMode       bool   `json:"dark-mode"`
+	// ExportFormats sets which machine-readable formats the results
+	// are exported as, alongside the report
+	ExportFormats []string `json:"export-formats,omitempty"`
+}
+
+// RunHistory contains the settings for storing completed backtest runs
+// and their results to a database so they can be listed and compared later
+type RunHistory struct {
+	Enabled bool `json:"enabled"`
+	// Path is the directory of the sqlite database
+	Path   string          `json:"path"`
+	Config database.Config `json:"config"`
 }
 
 // GRPC holds the GRPC configuration
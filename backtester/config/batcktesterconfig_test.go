@@ -47,3 +47,30 @@ func TestGenerateDefaultConfig(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", cfg.PrintLogo, true)
 	}
 }
+
+func TestValidateExportFormats(t *testing.T) {
+	t.Parallel()
+	r := &Report{}
+	err := r.ValidateExportFormats()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+
+	r.ExportFormats = []string{ExportJSON, "CSV"}
+	err = r.ValidateExportFormats()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+
+	r.ExportFormats = []string{ExportJSON, "xml"}
+	err = r.ValidateExportFormats()
+	if !errors.Is(err, errUnsupportedExportFormat) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedExportFormat)
+	}
+
+	r.ExportFormats = []string{ExportCSV, "csv"}
+	err = r.ValidateExportFormats()
+	if !errors.Is(err, errUnsupportedExportFormat) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedExportFormat)
+	}
+}
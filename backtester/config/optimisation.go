@@ -0,0 +1,216 @@
+package config
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func (c *Config) validateOptimisationSettings() error {
+	if c.OptimisationSettings == nil {
+		return nil
+	}
+	if c.DataSettings.LiveData != nil {
+		return fmt.Errorf("%w optimisation cannot be run against live data", errFeatureIncompatible)
+	}
+	err := c.OptimisationSettings.validate()
+	if err != nil {
+		return err
+	}
+	if c.OptimisationSettings.WalkForward == nil {
+		return nil
+	}
+	_, err = c.GenerateWalkForwardWindows()
+	return err
+}
+
+// GenerateWalkForwardWindows splits the API or database date range into
+// rolling windows. Each window starts one out-of-sample length after the
+// previous window, so out-of-sample ranges follow each other without overlap.
+// Any remaining time too short for a full window is not used
+func (c *Config) GenerateWalkForwardWindows() ([]WalkForwardWindow, error) {
+	if c.OptimisationSettings == nil || c.OptimisationSettings.WalkForward == nil {
+		return nil, fmt.Errorf("%w walk-forward settings", gctcommon.ErrNilPointer)
+	}
+	wf := c.OptimisationSettings.WalkForward
+	if wf.InSamplePeriods <= 0 || wf.OutOfSamplePeriods <= 0 {
+		return nil, fmt.Errorf("%w in-sample and out-of-sample periods must be greater than zero", errInvalidWalkForwardPeriods)
+	}
+	var start, end time.Time
+	switch {
+	case c.DataSettings.APIData != nil:
+		start, end = c.DataSettings.APIData.StartDate, c.DataSettings.APIData.EndDate
+		if c.DataSettings.APIData.InclusiveEndDate {
+			end = end.Add(c.DataSettings.Interval.Duration())
+		}
+	case c.DataSettings.DatabaseData != nil:
+		start, end = c.DataSettings.DatabaseData.StartDate, c.DataSettings.DatabaseData.EndDate
+		if c.DataSettings.DatabaseData.InclusiveEndDate {
+			end = end.Add(c.DataSettings.Interval.Duration())
+		}
+	default:
+		return nil, fmt.Errorf("%w walk-forward analysis requires API or database data", errFeatureIncompatible)
+	}
+	interval := c.DataSettings.Interval.Duration()
+	if interval <= 0 {
+		return nil, fmt.Errorf("%w %v", kline.ErrInvalidInterval, c.DataSettings.Interval)
+	}
+	inSample := interval * time.Duration(wf.InSamplePeriods)
+	outOfSample := interval * time.Duration(wf.OutOfSamplePeriods)
+	var resp []WalkForwardWindow
+	for windowStart := start; !windowStart.Add(inSample + outOfSample).After(end); windowStart = windowStart.Add(outOfSample) {
+		resp = append(resp, WalkForwardWindow{
+			InSampleStart:    windowStart,
+			InSampleEnd:      windowStart.Add(inSample),
+			OutOfSampleStart: windowStart.Add(inSample),
+			OutOfSampleEnd:   windowStart.Add(inSample + outOfSample),
+		})
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%w %v to %v cannot fit %v in-sample and %v out-of-sample periods", errNotEnoughWalkForwardData, start, end, wf.InSamplePeriods, wf.OutOfSamplePeriods)
+	}
+	return resp, nil
+}
+
+// validate ensures the optimisation settings can generate runs
+// which can be ranked
+func (o *OptimisationSettings) validate() error {
+	switch o.Method {
+	case GridSearch:
+	case RandomSearch:
+		if o.RandomIterations <= 0 {
+			return fmt.Errorf("%w random-iterations must be greater than zero", errInvalidOptimisationParameter)
+		}
+		if o.RandomIterations > maximumOptimisationRuns {
+			return fmt.Errorf("%w %v random iterations exceeds the maximum of %v", errTooManyOptimisationRuns, o.RandomIterations, maximumOptimisationRuns)
+		}
+	default:
+		return fmt.Errorf("%w '%v', supported methods are %v and %v", errUnsupportedOptimisationMethod, o.Method, GridSearch, RandomSearch)
+	}
+	if !isSupportedMetric(o.Metric) {
+		return fmt.Errorf("%w '%v', supported metrics are %v", errUnsupportedOptimisationMetric, o.Metric, strings.Join(supportedOptimisationMetrics, ", "))
+	}
+	if o.MaximumConcurrentTasks < 0 {
+		return fmt.Errorf("%w maximum-concurrent-tasks cannot be negative", errInvalidOptimisationParameter)
+	}
+	if len(o.Parameters) == 0 {
+		return errOptimisationParametersUnset
+	}
+	names := make(map[string]bool, len(o.Parameters))
+	for i := range o.Parameters {
+		if o.Parameters[i].Name == "" {
+			return fmt.Errorf("%w name unset", errInvalidOptimisationParameter)
+		}
+		if names[o.Parameters[i].Name] {
+			return fmt.Errorf("%w '%v' is duplicated", errInvalidOptimisationParameter, o.Parameters[i].Name)
+		}
+		names[o.Parameters[i].Name] = true
+		if len(o.Parameters[i].Values) > 0 {
+			continue
+		}
+		if !o.Parameters[i].Step.IsPositive() {
+			return fmt.Errorf("%w '%v' requires values or a step greater than zero", errInvalidOptimisationParameter, o.Parameters[i].Name)
+		}
+		if o.Parameters[i].Maximum.LessThan(o.Parameters[i].Minimum) {
+			return fmt.Errorf("%w '%v' maximum is less than minimum", errInvalidOptimisationParameter, o.Parameters[i].Name)
+		}
+	}
+	if o.Method == GridSearch {
+		runs := o.countRuns()
+		if runs > maximumOptimisationRuns {
+			return fmt.Errorf("%w %v grid runs exceeds the maximum of %v", errTooManyOptimisationRuns, runs, maximumOptimisationRuns)
+		}
+	}
+	return nil
+}
+
+// GenerateParameterSets returns the parameter values for every run of the
+// optimisation. Grid searches return every combination of values, random
+// searches return a random sample of combinations without repeats
+func (o *OptimisationSettings) GenerateParameterSets() ([]map[string]interface{}, error) {
+	err := o.validate()
+	if err != nil {
+		return nil, err
+	}
+	runs := o.countRuns()
+	var indexes []int64
+	if o.Method == RandomSearch && o.RandomIterations < runs {
+		seed := o.RandomSeed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		r := rand.New(rand.NewSource(seed)) //nolint:gosec // not used for security purposes
+		picked := make(map[int64]bool, o.RandomIterations)
+		for int64(len(indexes)) < o.RandomIterations {
+			idx := r.Int63n(runs)
+			if picked[idx] {
+				continue
+			}
+			picked[idx] = true
+			indexes = append(indexes, idx)
+		}
+	} else {
+		indexes = make([]int64, runs)
+		for i := range indexes {
+			indexes[i] = int64(i)
+		}
+	}
+	resp := make([]map[string]interface{}, len(indexes))
+	for i := range indexes {
+		set := make(map[string]interface{}, len(o.Parameters))
+		// decode the index into a value position for each parameter,
+		// with the last parameter changing most frequently
+		idx := indexes[i]
+		for j := len(o.Parameters) - 1; j >= 0; j-- {
+			l := o.Parameters[j].count()
+			set[o.Parameters[j].Name] = o.Parameters[j].valueAt(idx % l)
+			idx /= l
+		}
+		resp[i] = set
+	}
+	return resp, nil
+}
+
+// countRuns returns the amount of parameter combinations. The amount is
+// capped to prevent overflows when randomly sampling very large ranges
+func (o *OptimisationSettings) countRuns() int64 {
+	runs := int64(1)
+	for i := range o.Parameters {
+		c := o.Parameters[i].count()
+		if runs > maximumCombinations/c {
+			return maximumCombinations
+		}
+		runs *= c
+	}
+	return runs
+}
+
+func (p *OptimisationParameter) count() int64 {
+	if len(p.Values) > 0 {
+		return int64(len(p.Values))
+	}
+	return p.Maximum.Sub(p.Minimum).Div(p.Step).IntPart() + 1
+}
+
+// valueAt returns the parameter value at the index in the format
+// JSON custom settings are decoded as
+func (p *OptimisationParameter) valueAt(i int64) interface{} {
+	if len(p.Values) > 0 {
+		return p.Values[i]
+	}
+	return p.Minimum.Add(p.Step.Mul(decimal.NewFromInt(i))).InexactFloat64()
+}
+
+func isSupportedMetric(metric string) bool {
+	for i := range supportedOptimisationMetrics {
+		if supportedOptimisationMetrics[i] == metric {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,234 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func TestValidateOptimisationSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateOptimisationSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.OptimisationSettings = &OptimisationSettings{}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errUnsupportedOptimisationMethod) {
+		t.Errorf("received %v expected %v", err, errUnsupportedOptimisationMethod)
+	}
+
+	c.OptimisationSettings.Method = RandomSearch
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidOptimisationParameter) {
+		t.Errorf("received %v expected %v", err, errInvalidOptimisationParameter)
+	}
+
+	c.OptimisationSettings.RandomIterations = maximumOptimisationRuns + 1
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errTooManyOptimisationRuns) {
+		t.Errorf("received %v expected %v", err, errTooManyOptimisationRuns)
+	}
+
+	c.OptimisationSettings.RandomIterations = 5
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errUnsupportedOptimisationMetric) {
+		t.Errorf("received %v expected %v", err, errUnsupportedOptimisationMetric)
+	}
+
+	c.OptimisationSettings.Metric = SortinoRatio
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errOptimisationParametersUnset) {
+		t.Errorf("received %v expected %v", err, errOptimisationParametersUnset)
+	}
+
+	c.OptimisationSettings.Parameters = []OptimisationParameter{{}}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidOptimisationParameter) {
+		t.Errorf("received %v expected %v", err, errInvalidOptimisationParameter)
+	}
+
+	c.OptimisationSettings.Parameters[0].Name = "rsi-period"
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidOptimisationParameter) {
+		t.Errorf("received %v expected %v", err, errInvalidOptimisationParameter)
+	}
+
+	c.OptimisationSettings.Parameters[0].Step = decimal.NewFromInt(1)
+	c.OptimisationSettings.Parameters[0].Minimum = decimal.NewFromInt(2)
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidOptimisationParameter) {
+		t.Errorf("received %v expected %v", err, errInvalidOptimisationParameter)
+	}
+
+	c.OptimisationSettings.Parameters[0].Maximum = decimal.NewFromInt(20000)
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.OptimisationSettings.Method = GridSearch
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errTooManyOptimisationRuns) {
+		t.Errorf("received %v expected %v", err, errTooManyOptimisationRuns)
+	}
+
+	c.OptimisationSettings.Parameters = append(c.OptimisationSettings.Parameters, OptimisationParameter{
+		Name:   "rsi-period",
+		Values: []interface{}{1.0},
+	})
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errInvalidOptimisationParameter) {
+		t.Errorf("received %v expected %v", err, errInvalidOptimisationParameter)
+	}
+
+	c.OptimisationSettings.Parameters = c.OptimisationSettings.Parameters[:1]
+	c.OptimisationSettings.Parameters[0].Maximum = decimal.NewFromInt(20)
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateOptimisationSettings()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received %v expected %v", err, errFeatureIncompatible)
+	}
+}
+
+func TestGenerateParameterSets(t *testing.T) {
+	t.Parallel()
+	o := &OptimisationSettings{}
+	_, err := o.GenerateParameterSets()
+	if !errors.Is(err, errUnsupportedOptimisationMethod) {
+		t.Errorf("received %v expected %v", err, errUnsupportedOptimisationMethod)
+	}
+
+	o = &OptimisationSettings{
+		Method: GridSearch,
+		Metric: TotalReturn,
+		Parameters: []OptimisationParameter{
+			{
+				Name:    "rsi-period",
+				Minimum: decimal.NewFromInt(7),
+				Maximum: decimal.NewFromInt(21),
+				Step:    decimal.NewFromInt(7),
+			},
+			{
+				Name:   "rsi-low",
+				Values: []interface{}{20.0, 30.0},
+			},
+		},
+	}
+	sets, err := o.GenerateParameterSets()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if len(sets) != 6 {
+		t.Fatalf("received %v expected %v", len(sets), 6)
+	}
+	if sets[0]["rsi-period"] != 7.0 || sets[0]["rsi-low"] != 20.0 {
+		t.Errorf("received %v expected rsi-period 7 and rsi-low 20", sets[0])
+	}
+	if sets[1]["rsi-period"] != 7.0 || sets[1]["rsi-low"] != 30.0 {
+		t.Errorf("received %v expected rsi-period 7 and rsi-low 30", sets[1])
+	}
+	if sets[5]["rsi-period"] != 21.0 || sets[5]["rsi-low"] != 30.0 {
+		t.Errorf("received %v expected rsi-period 21 and rsi-low 30", sets[5])
+	}
+
+	o.Method = RandomSearch
+	o.RandomIterations = 4
+	o.RandomSeed = 1337
+	sets, err = o.GenerateParameterSets()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if len(sets) != 4 {
+		t.Fatalf("received %v expected %v", len(sets), 4)
+	}
+	seen := make(map[string]bool)
+	for i := range sets {
+		key := fmt.Sprint(sets[i]["rsi-period"], sets[i]["rsi-low"])
+		if seen[key] {
+			t.Errorf("received duplicate parameter set %v", key)
+		}
+		seen[key] = true
+	}
+
+	o.RandomIterations = 10
+	sets, err = o.GenerateParameterSets()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if len(sets) != 6 {
+		t.Errorf("received %v expected %v", len(sets), 6)
+	}
+}
+
+func TestGenerateWalkForwardWindows(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	_, err := c.GenerateWalkForwardWindows()
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received %v expected %v", err, gctcommon.ErrNilPointer)
+	}
+
+	c.OptimisationSettings = &OptimisationSettings{WalkForward: &WalkForwardSettings{}}
+	_, err = c.GenerateWalkForwardWindows()
+	if !errors.Is(err, errInvalidWalkForwardPeriods) {
+		t.Errorf("received %v expected %v", err, errInvalidWalkForwardPeriods)
+	}
+
+	c.OptimisationSettings.WalkForward.InSamplePeriods = 4
+	c.OptimisationSettings.WalkForward.OutOfSamplePeriods = 2
+	_, err = c.GenerateWalkForwardWindows()
+	if !errors.Is(err, errFeatureIncompatible) {
+		t.Errorf("received %v expected %v", err, errFeatureIncompatible)
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c.DataSettings.APIData = &APIData{
+		StartDate: start,
+		EndDate:   start.Add(kline.OneDay.Duration() * 9),
+	}
+	_, err = c.GenerateWalkForwardWindows()
+	if !errors.Is(err, kline.ErrInvalidInterval) {
+		t.Errorf("received %v expected %v", err, kline.ErrInvalidInterval)
+	}
+
+	c.DataSettings.Interval = kline.OneDay
+	windows, err := c.GenerateWalkForwardWindows()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	// days 0-6, 2-8 fit, 4-10 does not
+	if len(windows) != 2 {
+		t.Fatalf("received %v expected %v", len(windows), 2)
+	}
+	if !windows[1].InSampleStart.Equal(start.Add(kline.OneDay.Duration()*2)) ||
+		!windows[1].OutOfSampleStart.Equal(start.Add(kline.OneDay.Duration()*6)) ||
+		!windows[1].OutOfSampleEnd.Equal(start.Add(kline.OneDay.Duration()*8)) {
+		t.Errorf("received %+v expected window from day 2 to day 8", windows[1])
+	}
+	if !windows[0].OutOfSampleEnd.Equal(windows[1].OutOfSampleStart) {
+		t.Error("expected out-of-sample ranges to follow each other")
+	}
+
+	c.DataSettings.APIData.InclusiveEndDate = true
+	windows, err = c.GenerateWalkForwardWindows()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if len(windows) != 3 {
+		t.Errorf("received %v expected %v", len(windows), 3)
+	}
+
+	c.OptimisationSettings.WalkForward.InSamplePeriods = 10
+	_, err = c.GenerateWalkForwardWindows()
+	if !errors.Is(err, errNotEnoughWalkForwardData) {
+		t.Errorf("received %v expected %v", err, errNotEnoughWalkForwardData)
+	}
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// maximumOptimisationRuns limits the amount of strategy runs
+// an optimisation can generate
+const maximumOptimisationRuns = 10000
+
+// maximumCombinations caps the amount of parameter combinations
+// a random search samples from
+const maximumCombinations = 1 << 40
+
+var (
+	errOptimisationParametersUnset   = errors.New("no optimisation parameters set")
+	errInvalidOptimisationParameter  = errors.New("invalid optimisation parameter")
+	errUnsupportedOptimisationMethod = errors.New("unsupported optimisation method")
+	errUnsupportedOptimisationMetric = errors.New("unsupported optimisation metric")
+	errTooManyOptimisationRuns       = errors.New("too many optimisation runs")
+	errInvalidWalkForwardPeriods     = errors.New("invalid walk-forward periods")
+	errNotEnoughWalkForwardData      = errors.New("date range too short for a walk-forward window")
+)
+
+// Optimisation search methods
+const (
+	// GridSearch runs every combination of parameter values
+	GridSearch = "grid"
+	// RandomSearch runs a random sample of parameter value combinations
+	RandomSearch = "random"
+)
+
+// Metrics which optimisation runs can be ranked by
+const (
+	SharpeRatio  = "sharpe-ratio"
+	SortinoRatio = "sortino-ratio"
+	CalmarRatio  = "calmar-ratio"
+	TotalReturn  = "total-return"
+	MaxDrawdown  = "max-drawdown"
+)
+
+var supportedOptimisationMetrics = []string{
+	SharpeRatio,
+	SortinoRatio,
+	CalmarRatio,
+	TotalReturn,
+	MaxDrawdown,
+}
+
+// OptimisationSettings runs a strategy against ranges of custom settings
+// and ranks the results by a metric
+type OptimisationSettings struct {
+	Method                 string                  `json:"method"`
+	Metric                 string                  `json:"metric"`
+	Parameters             []OptimisationParameter `json:"parameters"`
+	RandomIterations       int64                   `json:"random-iterations,omitempty"`
+	RandomSeed             int64                   `json:"random-seed,omitempty"`
+	MaximumConcurrentTasks int64                   `json:"maximum-concurrent-tasks,omitempty"`
+	WalkForward            *WalkForwardSettings    `json:"walk-forward,omitempty"`
+}
+
+// WalkForwardSettings splits the date range into rolling windows. Parameters
+// are optimised over each in-sample window and the winners are run over the
+// following out-of-sample window. Periods are measured in candle intervals
+type WalkForwardSettings struct {
+	InSamplePeriods    int64 `json:"in-sample-periods"`
+	OutOfSamplePeriods int64 `json:"out-of-sample-periods"`
+}
+
+// WalkForwardWindow holds the date ranges of a walk-forward window. End
+// dates are exclusive
+type WalkForwardWindow struct {
+	InSampleStart    time.Time
+	InSampleEnd      time.Time
+	OutOfSampleStart time.Time
+	OutOfSampleEnd   time.Time
+}
+
+// OptimisationParameter is a strategy custom setting to optimise. Either
+// a list of values or a range with a step is used
+type OptimisationParameter struct {
+	Name    string          `json:"name"`
+	Values  []interface{}   `json:"values,omitempty"`
+	Minimum decimal.Decimal `json:"minimum"`
+	Maximum decimal.Decimal `json:"maximum"`
+	Step    decimal.Decimal `json:"step"`
+}
@@ -2,17 +2,21 @@ package config
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/size"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -41,7 +45,7 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
-	err = c.validateStrategySettings()
+	err = c.ValidateStrategySettings()
 	if err != nil {
 		return err
 	}
@@ -49,11 +53,35 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = c.validateExitRules()
+	if err != nil {
+		return err
+	}
+	err = c.PortfolioSettings.Sizing.validate()
+	if err != nil {
+		return err
+	}
+	err = c.validateOptimisationSettings()
+	if err != nil {
+		return err
+	}
+	err = c.StatisticSettings.MonteCarlo.validate()
+	if err != nil {
+		return err
+	}
+	err = c.validateStatisticSettings()
+	if err != nil {
+		return err
+	}
+	err = c.validateExecutionSettings()
+	if err != nil {
+		return err
+	}
 	return c.validateMinMaxes()
 }
 
-// validate ensures no one sets bad config values on purpose
-func (m *MinMax) validate() error {
+// Validate ensures no one sets bad config values on purpose
+func (m *MinMax) Validate() error {
 	if m.MaximumSize.IsNegative() {
 		return fmt.Errorf("invalid maximum size %w", errSizeLessThanZero)
 	}
@@ -80,27 +108,298 @@ func (m *MinMax) validate() error {
 
 func (c *Config) validateMinMaxes() (err error) {
 	for i := range c.CurrencySettings {
-		err = c.CurrencySettings[i].BuySide.validate()
+		err = c.CurrencySettings[i].BuySide.Validate()
 		if err != nil {
 			return err
 		}
-		err = c.CurrencySettings[i].SellSide.validate()
+		err = c.CurrencySettings[i].SellSide.Validate()
 		if err != nil {
 			return err
 		}
 	}
-	err = c.PortfolioSettings.BuySide.validate()
+	err = c.PortfolioSettings.BuySide.Validate()
 	if err != nil {
 		return err
 	}
-	err = c.PortfolioSettings.SellSide.validate()
+	err = c.PortfolioSettings.SellSide.Validate()
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (c *Config) validateStrategySettings() error {
+func (c *Config) validateExitRules() error {
+	err := c.PortfolioSettings.ExitRules.validate()
+	if err != nil {
+		return fmt.Errorf("portfolio settings %w", err)
+	}
+	for i := range c.CurrencySettings {
+		err = c.CurrencySettings[i].ExitRules.validate()
+		if err != nil {
+			return fmt.Errorf("%v %v %v-%v %w",
+				c.CurrencySettings[i].ExchangeName,
+				c.CurrencySettings[i].Asset,
+				c.CurrencySettings[i].Base,
+				c.CurrencySettings[i].Quote,
+				err)
+		}
+	}
+	return nil
+}
+
+// validate ensures monte carlo settings can be simulated
+func (m *MonteCarloSettings) validate() error {
+	if m == nil {
+		return nil
+	}
+	if m.Simulations <= 0 || m.Simulations > maximumMonteCarloSimulations {
+		return fmt.Errorf("%w simulations must be between 1 and %v", errInvalidMonteCarloSettings, maximumMonteCarloSimulations)
+	}
+	switch m.Method {
+	case MonteCarloBootstrap, MonteCarloShuffle:
+	default:
+		return fmt.Errorf("%w method '%v', supported methods are %v and %v", errInvalidMonteCarloSettings, m.Method, MonteCarloBootstrap, MonteCarloShuffle)
+	}
+	switch m.Sample {
+	case MonteCarloReturns, MonteCarloTrades:
+	default:
+		return fmt.Errorf("%w sample '%v', supported samples are %v and %v", errInvalidMonteCarloSettings, m.Sample, MonteCarloReturns, MonteCarloTrades)
+	}
+	hundred := decimal.NewFromInt(100)
+	if !m.ConfidenceLevel.IsPositive() || m.ConfidenceLevel.GreaterThanOrEqual(hundred) {
+		return fmt.Errorf("%w confidence-level must be greater than 0 and less than 100", errInvalidMonteCarloSettings)
+	}
+	if !m.RuinThresholdPercent.IsPositive() || m.RuinThresholdPercent.GreaterThan(hundred) {
+		return fmt.Errorf("%w ruin-threshold-percent must be greater than 0 and no more than 100", errInvalidMonteCarloSettings)
+	}
+	if m.SlippagePerturbationPercent.IsNegative() || m.FeePerturbationPercent.IsNegative() {
+		return fmt.Errorf("%w perturbation percentages cannot be negative", errInvalidMonteCarloSettings)
+	}
+	return nil
+}
+
+// validateStatisticSettings ensures the value at risk confidence level is
+// valid and that the benchmark can be loaded
+func (c *Config) validateStatisticSettings() error {
+	if c.StatisticSettings.ValueAtRiskConfidence.IsNegative() ||
+		c.StatisticSettings.ValueAtRiskConfidence.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+		return fmt.Errorf("%w value-at-risk-confidence must be greater than 0 and less than 100", errInvalidStatisticSettings)
+	}
+	b := c.StatisticSettings.Benchmark
+	if b == nil {
+		return nil
+	}
+	switch b.Type {
+	case statistics.BenchmarkPair:
+		for i := range c.CurrencySettings {
+			if strings.EqualFold(c.CurrencySettings[i].ExchangeName, b.ExchangeName) &&
+				c.CurrencySettings[i].Asset == b.Asset &&
+				c.CurrencySettings[i].Base.Equal(b.Base) &&
+				c.CurrencySettings[i].Quote.Equal(b.Quote) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%w benchmark %v %v %v-%v must match a currency setting", errInvalidStatisticSettings, b.ExchangeName, b.Asset, b.Base, b.Quote)
+	case statistics.BenchmarkBasket:
+		return nil
+	case statistics.BenchmarkCSV:
+		if b.CSVPath == "" {
+			return fmt.Errorf("%w benchmark csv-path must be set", errInvalidStatisticSettings)
+		}
+		if !file.Exists(b.CSVPath) {
+			return fmt.Errorf("%w benchmark csv-path %v does not exist", errInvalidStatisticSettings, b.CSVPath)
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w benchmark type '%v', supported types are %v, %v and %v", errInvalidStatisticSettings, b.Type, statistics.BenchmarkPair, statistics.BenchmarkBasket, statistics.BenchmarkCSV)
+	}
+}
+
+// validateExecutionSettings ensures each exchange has at most one set of
+// execution settings and that they are valid
+func (c *Config) validateExecutionSettings() error {
+	exchanges := make(map[string]bool, len(c.ExecutionSettings))
+	for i := range c.ExecutionSettings {
+		name := strings.ToLower(c.ExecutionSettings[i].ExchangeName)
+		if name == "" {
+			return fmt.Errorf("%w exchange name unset", errInvalidExecutionSettings)
+		}
+		if exchanges[name] {
+			return fmt.Errorf("%w duplicate settings for exchange %v", errInvalidExecutionSettings, c.ExecutionSettings[i].ExchangeName)
+		}
+		exchanges[name] = true
+		err := c.ExecutionSettings[i].validate()
+		if err != nil {
+			return fmt.Errorf("%v %w", c.ExecutionSettings[i].ExchangeName, err)
+		}
+	}
+	return nil
+}
+
+// validate ensures latency durations, rejection rates and outage windows
+// are usable
+func (e *ExecutionSettings) validate() error {
+	if e.RejectionRatePercent.IsNegative() || e.RejectionRatePercent.GreaterThan(decimal.NewFromInt(100)) {
+		return fmt.Errorf("%w rejection-rate-percent must be between 0 and 100", errInvalidExecutionSettings)
+	}
+	for i := range e.Outages {
+		if e.Outages[i].Start.IsZero() || !e.Outages[i].Start.Before(e.Outages[i].End) {
+			return fmt.Errorf("%w outage start %v must be before end %v", errInvalidExecutionSettings, e.Outages[i].Start, e.Outages[i].End)
+		}
+	}
+	l := e.Latency
+	if l == nil {
+		return nil
+	}
+	if l.Minimum < 0 || l.Maximum < 0 || l.Mean < 0 || l.StandardDeviation < 0 {
+		return fmt.Errorf("%w latency cannot be negative", errInvalidExecutionSettings)
+	}
+	if l.Maximum > 0 && l.Minimum > l.Maximum {
+		return fmt.Errorf("%w minimum latency %v greater than maximum %v", errInvalidExecutionSettings, l.Minimum, l.Maximum)
+	}
+	switch l.Distribution {
+	case FixedLatency:
+	case UniformLatency:
+		if l.Maximum == 0 {
+			return fmt.Errorf("%w %v latency requires a maximum", errInvalidExecutionSettings, l.Distribution)
+		}
+	case NormalLatency:
+		if l.Mean == 0 {
+			return fmt.Errorf("%w %v latency requires a mean", errInvalidExecutionSettings, l.Distribution)
+		}
+	default:
+		return fmt.Errorf("%w latency distribution '%v', supported distributions are %v, %v and %v", errInvalidExecutionSettings, l.Distribution, FixedLatency, UniformLatency, NormalLatency)
+	}
+	return nil
+}
+
+// validate ensures exit rules are not negative and that ATR rules
+// have a period to calculate the average true range
+func (e *ExitRules) validate() error {
+	if e == nil {
+		return nil
+	}
+	if e.StopLossPercent.IsNegative() ||
+		e.TakeProfitPercent.IsNegative() ||
+		e.TrailingStopPercent.IsNegative() ||
+		e.StopLossATRMultiple.IsNegative() ||
+		e.TakeProfitATRMultiple.IsNegative() ||
+		e.TrailingStopATRMultiple.IsNegative() ||
+		e.ATRPeriod < 0 ||
+		e.MaximumHoldingPeriods < 0 {
+		return fmt.Errorf("%w values cannot be negative", errInvalidExitRules)
+	}
+	if e.StopLossPercent.GreaterThanOrEqual(decimal.NewFromInt(100)) ||
+		e.TrailingStopPercent.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+		return fmt.Errorf("%w stop percentages must be less than 100", errInvalidExitRules)
+	}
+	if e.ATRPeriod == 0 &&
+		(e.StopLossATRMultiple.IsPositive() ||
+			e.TakeProfitATRMultiple.IsPositive() ||
+			e.TrailingStopATRMultiple.IsPositive()) {
+		return fmt.Errorf("%w atr-period required for ATR multiples", errInvalidExitRules)
+	}
+	return nil
+}
+
+// validate ensures spot margin is only used for spot assets and that
+// positions can be liquidated before liabilities exceed assets
+func (m *SpotMargin) validate(a asset.Item) error {
+	if m == nil {
+		return nil
+	}
+	if a != asset.Spot {
+		return fmt.Errorf("%w %v is not a spot asset", errInvalidMarginSettings, a)
+	}
+	one := decimal.NewFromInt(1)
+	if m.MaximumLeverage.LessThanOrEqual(one) {
+		return fmt.Errorf("%w maximum-leverage must be greater than 1", errInvalidMarginSettings)
+	}
+	if m.LiquidationMarginLevel.LessThanOrEqual(one) {
+		return fmt.Errorf("%w liquidation-margin-level must be greater than 1", errInvalidMarginSettings)
+	}
+	// borrowing the maximum allowed results in a margin level of
+	// leverage / (leverage - 1), which must not trigger a liquidation
+	if m.MaximumLeverage.Div(m.MaximumLeverage.Sub(one)).LessThanOrEqual(m.LiquidationMarginLevel) {
+		return fmt.Errorf("%w maximum-leverage %v would be liquidated at liquidation-margin-level %v", errInvalidMarginSettings, m.MaximumLeverage, m.LiquidationMarginLevel)
+	}
+	if m.BaseYearlyBorrowRate.IsNegative() || m.QuoteYearlyBorrowRate.IsNegative() {
+		return fmt.Errorf("%w borrow rates cannot be negative", errInvalidMarginSettings)
+	}
+	return nil
+}
+
+// validate ensures the sizing model is known and has the settings it
+// requires to size orders
+func (s *SizingModel) validate() error {
+	if s == nil {
+		return nil
+	}
+	one := decimal.NewFromInt(1)
+	if s.Fraction.IsNegative() || s.Fraction.GreaterThan(one) ||
+		s.MaximumFraction.IsNegative() || s.MaximumFraction.GreaterThan(one) {
+		return fmt.Errorf("%w fractions must be between 0 and 1", errInvalidSizingModel)
+	}
+	if s.TargetVolatility.IsNegative() ||
+		s.VolatilityPeriod < 0 ||
+		s.KellyWindow < 0 ||
+		s.KellyMinimumTrades < 0 {
+		return fmt.Errorf("%w values cannot be negative", errInvalidSizingModel)
+	}
+	switch s.Model {
+	case size.FixedFractional:
+		if !s.Fraction.IsPositive() {
+			return fmt.Errorf("%w %v requires a fraction", errInvalidSizingModel, s.Model)
+		}
+	case size.VolatilityTarget, size.RiskParity:
+		if s.Model == size.VolatilityTarget && !s.TargetVolatility.IsPositive() {
+			return fmt.Errorf("%w %v requires a target-volatility", errInvalidSizingModel, s.Model)
+		}
+		if s.VolatilityMeasure != size.ATRVolatility && s.VolatilityMeasure != size.RealisedVolatility {
+			return fmt.Errorf("%w volatility-measure '%v' must be '%v' or '%v'", errInvalidSizingModel, s.VolatilityMeasure, size.ATRVolatility, size.RealisedVolatility)
+		}
+		if s.VolatilityPeriod < 2 {
+			return fmt.Errorf("%w %v requires a volatility-period of at least 2", errInvalidSizingModel, s.Model)
+		}
+	case size.Kelly, size.HalfKelly:
+		if !s.Fraction.IsPositive() {
+			return fmt.Errorf("%w %v requires a fraction to use until enough trades have completed", errInvalidSizingModel, s.Model)
+		}
+		if s.KellyWindow > 0 && s.KellyMinimumTrades > s.KellyWindow {
+			return fmt.Errorf("%w kelly-minimum-trades cannot exceed kelly-window", errInvalidSizingModel)
+		}
+	default:
+		return fmt.Errorf("%w '%v'", errInvalidSizingModel, s.Model)
+	}
+	return nil
+}
+
+// GetExitRules returns the exit rules for the currency, falling back to
+// the portfolio settings exit rules when unset
+func (c *Config) GetExitRules(cs *CurrencySettings) ExitRules {
+	if cs != nil && cs.ExitRules != nil {
+		return *cs.ExitRules
+	}
+	if c.PortfolioSettings.ExitRules != nil {
+		return *c.PortfolioSettings.ExitRules
+	}
+	return ExitRules{}
+}
+
+// GetExecutionSettings returns the execution settings for the exchange
+// or nil when the exchange's orders execute without simulated conditions
+func (c *Config) GetExecutionSettings(exchangeName string) *ExecutionSettings {
+	for i := range c.ExecutionSettings {
+		if strings.EqualFold(c.ExecutionSettings[i].ExchangeName, exchangeName) {
+			return &c.ExecutionSettings[i]
+		}
+	}
+	return nil
+}
+
+// ValidateStrategySettings ensures the strategy exists and that its
+// funding settings are consistent and valid
+func (c *Config) ValidateStrategySettings() error {
 	if c.FundingSettings.UseExchangeLevelFunding && !c.StrategySettings.SimultaneousSignalProcessing {
 		return errSimultaneousProcessingRequired
 	}
@@ -112,26 +411,96 @@ func (c *Config) validateStrategySettings() error {
 	}
 	if c.FundingSettings.UseExchangeLevelFunding {
 		for i := range c.FundingSettings.ExchangeLevelFunding {
-			if c.FundingSettings.ExchangeLevelFunding[i].InitialFunds.IsNegative() {
-				return fmt.Errorf("%w for %v %v %v",
-					errBadInitialFunds,
-					c.FundingSettings.ExchangeLevelFunding[i].ExchangeName,
-					c.FundingSettings.ExchangeLevelFunding[i].Asset,
-					c.FundingSettings.ExchangeLevelFunding[i].Currency,
-				)
+			err := c.FundingSettings.ExchangeLevelFunding[i].Validate()
+			if err != nil {
+				return err
 			}
 		}
 	}
 	strats := strategies.GetSupportedStrategies()
 	for i := range strats {
 		if strings.EqualFold(strats[i].Name(), c.StrategySettings.Name) {
-			return nil
+			return c.validateRealOrderStrategy()
 		}
 	}
 
 	return fmt.Errorf("strategty %v %w", c.StrategySettings.Name, base.ErrStrategyNotFound)
 }
 
+// validateRealOrderStrategy ensures a strategy placing real orders is not
+// configured to place resting orders, which are only simulated
+func (c *Config) validateRealOrderStrategy() error {
+	if c.DataSettings.LiveData == nil || !c.DataSettings.LiveData.RealOrders {
+		return nil
+	}
+	strat, err := strategies.LoadStrategyByName(c.StrategySettings.Name, false)
+	if err != nil {
+		return err
+	}
+	placer, ok := strat.(strategies.RestingOrderPlacer)
+	if !ok {
+		return nil
+	}
+	strat.SetDefaults()
+	if c.StrategySettings.CustomSettings != nil {
+		err = strat.SetCustomSettings(c.StrategySettings.CustomSettings)
+		if err != nil && !errors.Is(err, base.ErrCustomSettingsUnsupported) {
+			return err
+		}
+	}
+	if placer.PlacesRestingOrders() {
+		return fmt.Errorf("%w, strategy %v is configured to place resting orders which cannot be placed as real orders", errRestingOrdersWithRealOrders, strat.Name())
+	}
+	return nil
+}
+
+// Validate ensures exchange level funding is not negative and that
+// its transfer settings are valid
+func (e *ExchangeLevelFunding) Validate() error {
+	if e.InitialFunds.IsNegative() {
+		return fmt.Errorf("%w for %v %v %v",
+			errBadInitialFunds,
+			e.ExchangeName,
+			e.Asset,
+			e.Currency,
+		)
+	}
+	return e.validateTransfers()
+}
+
+// validateTransfers ensures transfer fees and delays are not negative and
+// that each chain can be identified
+func (e *ExchangeLevelFunding) validateTransfers() error {
+	if e.TransferFee.IsNegative() || e.TransferDelay < 0 {
+		return fmt.Errorf("%w for %v %v %v, transfer fee and delay cannot be negative",
+			errInvalidTransferSettings,
+			e.ExchangeName,
+			e.Asset,
+			e.Currency)
+	}
+	seen := make(map[string]bool, len(e.TransferChains))
+	for i := range e.TransferChains {
+		chain := strings.ToLower(e.TransferChains[i].Chain)
+		if chain == "" || seen[chain] {
+			return fmt.Errorf("%w for %v %v %v, chain names must be set and unique",
+				errInvalidTransferSettings,
+				e.ExchangeName,
+				e.Asset,
+				e.Currency)
+		}
+		seen[chain] = true
+		if e.TransferChains[i].WithdrawalFee.IsNegative() || e.TransferChains[i].ConfirmationDelay < 0 {
+			return fmt.Errorf("%w for %v %v %v chain %v, withdrawal fee and confirmation delay cannot be negative",
+				errInvalidTransferSettings,
+				e.ExchangeName,
+				e.Asset,
+				e.Currency,
+				e.TransferChains[i].Chain)
+		}
+	}
+	return nil
+}
+
 // validateDate checks whether someone has set a date poorly in their config
 func (c *Config) validateDate() error {
 	if c.DataSettings.DatabaseData != nil {
@@ -154,64 +523,17 @@ func (c *Config) validateCurrencySettings() error {
 	}
 	var hasFutures, hasSlippage bool
 	for i := range c.CurrencySettings {
-		if c.CurrencySettings[i].Asset == asset.PerpetualSwap ||
-			c.CurrencySettings[i].Asset == asset.PerpetualContract {
-			return errPerpetualsUnsupported
+		err := c.ValidateCurrencySetting(&c.CurrencySettings[i])
+		if err != nil {
+			return err
 		}
 		if c.CurrencySettings[i].Asset.IsFutures() {
 			hasFutures = true
-			if c.CurrencySettings[i].Quote.String() == "PERP" || c.CurrencySettings[i].Base.String() == "PI" {
-				return errPerpetualsUnsupported
-			}
-		}
-		if c.CurrencySettings[i].SpotDetails != nil {
-			if c.FundingSettings.UseExchangeLevelFunding {
-				if c.CurrencySettings[i].SpotDetails.InitialQuoteFunds != nil &&
-					c.CurrencySettings[i].SpotDetails.InitialQuoteFunds.GreaterThan(decimal.Zero) {
-					return fmt.Errorf("non-nil quote %w", errBadInitialFunds)
-				}
-				if c.CurrencySettings[i].SpotDetails.InitialBaseFunds != nil &&
-					c.CurrencySettings[i].SpotDetails.InitialBaseFunds.GreaterThan(decimal.Zero) {
-					return fmt.Errorf("non-nil base %w", errBadInitialFunds)
-				}
-			} else {
-				if c.CurrencySettings[i].SpotDetails.InitialQuoteFunds == nil &&
-					c.CurrencySettings[i].SpotDetails.InitialBaseFunds == nil {
-					return fmt.Errorf("nil base and quote %w", errBadInitialFunds)
-				}
-				if c.CurrencySettings[i].SpotDetails.InitialQuoteFunds != nil &&
-					c.CurrencySettings[i].SpotDetails.InitialBaseFunds != nil &&
-					c.CurrencySettings[i].SpotDetails.InitialBaseFunds.IsZero() &&
-					c.CurrencySettings[i].SpotDetails.InitialQuoteFunds.IsZero() {
-					return fmt.Errorf("base or quote funds set to zero %w", errBadInitialFunds)
-				}
-				if c.CurrencySettings[i].SpotDetails.InitialQuoteFunds == nil {
-					c.CurrencySettings[i].SpotDetails.InitialQuoteFunds = &decimal.Zero
-				}
-				if c.CurrencySettings[i].SpotDetails.InitialBaseFunds == nil {
-					c.CurrencySettings[i].SpotDetails.InitialBaseFunds = &decimal.Zero
-				}
-			}
-		}
-		if c.CurrencySettings[i].Base.IsEmpty() {
-			return errUnsetCurrency
-		}
-		if !c.CurrencySettings[i].Asset.IsValid() {
-			return fmt.Errorf("%v %w", c.CurrencySettings[i].Asset, asset.ErrNotSupported)
-		}
-		if c.CurrencySettings[i].ExchangeName == "" {
-			return errUnsetExchange
 		}
 		if !c.CurrencySettings[i].MinimumSlippagePercent.IsZero() ||
 			!c.CurrencySettings[i].MaximumSlippagePercent.IsZero() {
 			hasSlippage = true
 		}
-		if c.CurrencySettings[i].MinimumSlippagePercent.LessThan(decimal.Zero) ||
-			c.CurrencySettings[i].MaximumSlippagePercent.LessThan(decimal.Zero) ||
-			c.CurrencySettings[i].MinimumSlippagePercent.GreaterThan(c.CurrencySettings[i].MaximumSlippagePercent) {
-			return errBadSlippageRates
-		}
-		c.CurrencySettings[i].ExchangeName = strings.ToLower(c.CurrencySettings[i].ExchangeName)
 	}
 	if hasSlippage && hasFutures {
 		return fmt.Errorf("%w futures sizing currently incompatible with slippage", errFeatureIncompatible)
@@ -219,6 +541,168 @@ func (c *Config) validateCurrencySettings() error {
 	return nil
 }
 
+// ValidateCurrencySetting checks a single currency setting against the rest of the config.
+// Unset spot funds are defaulted to zero and the exchange name is lowercased
+func (c *Config) ValidateCurrencySetting(cs *CurrencySettings) error {
+	if cs == nil {
+		return fmt.Errorf("%w currency settings", gctcommon.ErrNilPointer)
+	}
+	err := cs.ValidateSpotFunds(c.FundingSettings.UseExchangeLevelFunding)
+	if err != nil {
+		return err
+	}
+	if cs.SpotDetails != nil && !c.FundingSettings.UseExchangeLevelFunding {
+		if cs.SpotDetails.InitialQuoteFunds == nil {
+			cs.SpotDetails.InitialQuoteFunds = &decimal.Zero
+		}
+		if cs.SpotDetails.InitialBaseFunds == nil {
+			cs.SpotDetails.InitialBaseFunds = &decimal.Zero
+		}
+	}
+	if cs.Base.IsEmpty() {
+		return errUnsetCurrency
+	}
+	if cs.SpotDetails != nil {
+		err = cs.SpotDetails.Margin.validate(cs.Asset)
+		if err != nil {
+			return fmt.Errorf("%w for %v %v %v-%v",
+				err,
+				cs.ExchangeName,
+				cs.Asset,
+				cs.Base,
+				cs.Quote)
+		}
+	}
+	if !cs.Asset.IsValid() {
+		return fmt.Errorf("%v %w", cs.Asset, asset.ErrNotSupported)
+	}
+	if cs.ExchangeName == "" {
+		return errUnsetExchange
+	}
+	err = cs.ValidateSlippage()
+	if err != nil {
+		return err
+	}
+	err = cs.ValidateIntrabarFillAssumption()
+	if err != nil {
+		return err
+	}
+	err = c.validateAdditionalIntervals(cs)
+	if err != nil {
+		return err
+	}
+	err = c.validateFundingRatesCSV(cs)
+	if err != nil {
+		return err
+	}
+	cs.ExchangeName = strings.ToLower(cs.ExchangeName)
+	return nil
+}
+
+// validateFundingRatesCSV ensures funding rates are only loaded from a CSV
+// file for futures run against historical data
+func (c *Config) validateFundingRatesCSV(cs *CurrencySettings) error {
+	if cs.FuturesDetails == nil || cs.FuturesDetails.FundingRatesCSVPath == "" {
+		return nil
+	}
+	if !cs.Asset.IsFutures() || c.DataSettings.LiveData != nil {
+		return fmt.Errorf("%w for %v %v %v-%v",
+			errFundingRatesCSVUnsupported,
+			cs.ExchangeName,
+			cs.Asset,
+			cs.Base,
+			cs.Quote)
+	}
+	return nil
+}
+
+// ValidateSpotFunds ensures spot funds are only set per currency when
+// exchange level funding is disabled, and that some funds are set when it is
+func (cs *CurrencySettings) ValidateSpotFunds(useExchangeLevelFunding bool) error {
+	if cs.SpotDetails == nil {
+		return nil
+	}
+	if useExchangeLevelFunding {
+		if cs.SpotDetails.InitialQuoteFunds != nil &&
+			cs.SpotDetails.InitialQuoteFunds.GreaterThan(decimal.Zero) {
+			return fmt.Errorf("non-nil quote %w", errBadInitialFunds)
+		}
+		if cs.SpotDetails.InitialBaseFunds != nil &&
+			cs.SpotDetails.InitialBaseFunds.GreaterThan(decimal.Zero) {
+			return fmt.Errorf("non-nil base %w", errBadInitialFunds)
+		}
+		return nil
+	}
+	if cs.SpotDetails.InitialQuoteFunds == nil &&
+		cs.SpotDetails.InitialBaseFunds == nil {
+		return fmt.Errorf("nil base and quote %w", errBadInitialFunds)
+	}
+	if cs.SpotDetails.InitialQuoteFunds != nil &&
+		cs.SpotDetails.InitialBaseFunds != nil &&
+		cs.SpotDetails.InitialBaseFunds.IsZero() &&
+		cs.SpotDetails.InitialQuoteFunds.IsZero() {
+		return fmt.Errorf("base or quote funds set to zero %w", errBadInitialFunds)
+	}
+	return nil
+}
+
+// ValidateSlippage ensures slippage rates are not negative and that the
+// minimum does not exceed the maximum
+func (cs *CurrencySettings) ValidateSlippage() error {
+	if cs.MinimumSlippagePercent.LessThan(decimal.Zero) ||
+		cs.MaximumSlippagePercent.LessThan(decimal.Zero) ||
+		cs.MinimumSlippagePercent.GreaterThan(cs.MaximumSlippagePercent) {
+		return errBadSlippageRates
+	}
+	return nil
+}
+
+// ValidateIntrabarFillAssumption ensures the intrabar fill assumption is
+// unset or supported
+func (cs *CurrencySettings) ValidateIntrabarFillAssumption() error {
+	switch strings.ToLower(cs.IntrabarFillAssumption) {
+	case "", PessimisticFills, OptimisticFills:
+		return nil
+	}
+	return fmt.Errorf("%w '%v' for %v %v %v-%v",
+		errInvalidIntrabarFillAssumption,
+		cs.IntrabarFillAssumption,
+		cs.ExchangeName,
+		cs.Asset,
+		cs.Base,
+		cs.Quote)
+}
+
+// validateAdditionalIntervals ensures additional intervals can be
+// resampled from the data settings interval
+func (c *Config) validateAdditionalIntervals(cs *CurrencySettings) error {
+	seen := make(map[kline.Interval]bool, len(cs.AdditionalIntervals))
+	for i := range cs.AdditionalIntervals {
+		interval := cs.AdditionalIntervals[i]
+		if interval <= c.DataSettings.Interval || c.DataSettings.Interval <= 0 || interval%c.DataSettings.Interval != 0 {
+			return fmt.Errorf("%w %v for %v %v %v-%v, must be a larger multiple of the data interval %v",
+				errInvalidAdditionalInterval,
+				interval,
+				cs.ExchangeName,
+				cs.Asset,
+				cs.Base,
+				cs.Quote,
+				c.DataSettings.Interval)
+		}
+		if seen[interval] {
+			return fmt.Errorf("%w %v duplicated for %v %v %v-%v",
+				errInvalidAdditionalInterval,
+				interval,
+				cs.ExchangeName,
+				cs.Asset,
+				cs.Base,
+				cs.Quote)
+		}
+		seen[interval] = true
+	}
+	return nil
+}
+
 // PrintSetting prints relevant settings to the console for easy reading
 func (c *Config) PrintSetting() {
 	log.Infoln(common.Config, common.CMDColours.H1+"------------------Backtester Settings------------------------"+common.CMDColours.Default)
@@ -234,6 +718,7 @@ func (c *Config) PrintSetting() {
 	}
 	log.Infof(common.Config, "Simultaneous Signal Processing: %v", c.StrategySettings.SimultaneousSignalProcessing)
 	log.Infof(common.Config, "USD value tracking: %v", !c.StrategySettings.DisableUSDTracking)
+	log.Infof(common.Config, "Random seed: %v", c.StrategySettings.Seed)
 
 	if c.FundingSettings.UseExchangeLevelFunding && c.StrategySettings.SimultaneousSignalProcessing {
 		log.Infoln(common.Config, common.CMDColours.H2+"------------------Funding Settings---------------------------"+common.CMDColours.Default)
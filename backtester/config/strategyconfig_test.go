@@ -5,11 +5,14 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/size"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/top2bottom2"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
@@ -140,17 +143,24 @@ func TestValidateCurrencySettings(t *testing.T) {
 		t.Errorf("received: %v, expected: %v", err, nil)
 	}
 
-	c.CurrencySettings[0].Asset = asset.PerpetualSwap
+	c.CurrencySettings[0].IntrabarFillAssumption = "lol"
 	err = c.validateCurrencySettings()
-	if !errors.Is(err, errPerpetualsUnsupported) {
-		t.Errorf("received: %v, expected: %v", err, errPerpetualsUnsupported)
+	if !errors.Is(err, errInvalidIntrabarFillAssumption) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidIntrabarFillAssumption)
+	}
+	c.CurrencySettings[0].IntrabarFillAssumption = OptimisticFills
+	err = c.validateCurrencySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
 	}
 
 	c.CurrencySettings[0].Asset = asset.USDTMarginedFutures
 	c.CurrencySettings[0].Quote = currency.NewCode("PERP")
+	c.CurrencySettings[0].SpotDetails = nil
+	c.CurrencySettings[0].FuturesDetails = &FuturesDetails{}
 	err = c.validateCurrencySettings()
-	if !errors.Is(err, errPerpetualsUnsupported) {
-		t.Errorf("received: %v, expected: %v", err, errPerpetualsUnsupported)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
 	}
 
 	c.CurrencySettings[0].MinimumSlippagePercent = decimal.NewFromInt(2)
@@ -311,24 +321,24 @@ func TestValidateMinMaxes(t *testing.T) {
 func TestValidateStrategySettings(t *testing.T) {
 	t.Parallel()
 	c := &Config{}
-	err := c.validateStrategySettings()
+	err := c.ValidateStrategySettings()
 	if !errors.Is(err, base.ErrStrategyNotFound) {
 		t.Errorf("received %v expected %v", err, base.ErrStrategyNotFound)
 	}
 	c.StrategySettings = StrategySettings{Name: dca}
-	err = c.validateStrategySettings()
+	err = c.ValidateStrategySettings()
 	if !errors.Is(err, nil) {
 		t.Errorf("received %v expected %v", err, nil)
 	}
 
 	c.StrategySettings.SimultaneousSignalProcessing = true
-	err = c.validateStrategySettings()
+	err = c.ValidateStrategySettings()
 	if !errors.Is(err, nil) {
 		t.Errorf("received %v expected %v", err, nil)
 	}
 	c.FundingSettings = FundingSettings{}
 	c.FundingSettings.UseExchangeLevelFunding = true
-	err = c.validateStrategySettings()
+	err = c.ValidateStrategySettings()
 	if !errors.Is(err, errExchangeLevelFundingDataRequired) {
 		t.Errorf("received %v expected %v", err, errExchangeLevelFundingDataRequired)
 	}
@@ -337,22 +347,481 @@ func TestValidateStrategySettings(t *testing.T) {
 			InitialFunds: decimal.NewFromInt(-1),
 		},
 	}
-	err = c.validateStrategySettings()
+	err = c.ValidateStrategySettings()
 	if !errors.Is(err, errBadInitialFunds) {
 		t.Errorf("received %v expected %v", err, errBadInitialFunds)
 	}
 
 	c.StrategySettings.SimultaneousSignalProcessing = false
-	err = c.validateStrategySettings()
+	err = c.ValidateStrategySettings()
 	if !errors.Is(err, errSimultaneousProcessingRequired) {
 		t.Errorf("received %v expected %v", err, errSimultaneousProcessingRequired)
 	}
 
 	c.FundingSettings.UseExchangeLevelFunding = false
-	err = c.validateStrategySettings()
+	err = c.ValidateStrategySettings()
 	if !errors.Is(err, errExchangeLevelFundingRequired) {
 		t.Errorf("received %v expected %v", err, errExchangeLevelFundingRequired)
 	}
+
+	// the grid places resting limit orders by default
+	c = &Config{
+		StrategySettings: StrategySettings{Name: "grid"},
+		DataSettings:     DataSettings{LiveData: &LiveData{RealOrders: true}},
+	}
+	err = c.ValidateStrategySettings()
+	if !errors.Is(err, errRestingOrdersWithRealOrders) {
+		t.Errorf("received %v expected %v", err, errRestingOrdersWithRealOrders)
+	}
+	c.StrategySettings.CustomSettings = map[string]interface{}{"order-type": "market"}
+	err = c.ValidateStrategySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	c.DataSettings.LiveData.RealOrders = false
+	c.StrategySettings.CustomSettings = nil
+	err = c.ValidateStrategySettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateMonteCarloSettings(t *testing.T) {
+	t.Parallel()
+	var m *MonteCarloSettings
+	err := m.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	m = &MonteCarloSettings{}
+	err = m.validate()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloSettings)
+	}
+
+	m.Simulations = maximumMonteCarloSimulations + 1
+	err = m.validate()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloSettings)
+	}
+
+	m.Simulations = 1000
+	err = m.validate()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloSettings)
+	}
+
+	m.Method = MonteCarloBootstrap
+	err = m.validate()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloSettings)
+	}
+
+	m.Sample = MonteCarloTrades
+	err = m.validate()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloSettings)
+	}
+
+	m.ConfidenceLevel = decimal.NewFromInt(100)
+	err = m.validate()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloSettings)
+	}
+
+	m.ConfidenceLevel = decimal.NewFromInt(95)
+	err = m.validate()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloSettings)
+	}
+
+	m.RuinThresholdPercent = decimal.NewFromInt(50)
+	m.FeePerturbationPercent = decimal.NewFromInt(-1)
+	err = m.validate()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMonteCarloSettings)
+	}
+
+	m.FeePerturbationPercent = decimal.NewFromInt(20)
+	err = m.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateSizingModel(t *testing.T) {
+	t.Parallel()
+	var s *SizingModel
+	err := s.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	s = &SizingModel{Model: "bad"}
+	err = s.validate()
+	if !errors.Is(err, errInvalidSizingModel) {
+		t.Errorf("received %v expected %v", err, errInvalidSizingModel)
+	}
+
+	s = &SizingModel{Model: size.FixedFractional, Fraction: decimal.NewFromInt(2)}
+	err = s.validate()
+	if !errors.Is(err, errInvalidSizingModel) {
+		t.Errorf("received %v expected %v", err, errInvalidSizingModel)
+	}
+
+	s.Fraction = decimal.NewFromFloat(0.1)
+	err = s.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	s = &SizingModel{Model: size.VolatilityTarget, VolatilityMeasure: size.ATRVolatility, VolatilityPeriod: 14}
+	err = s.validate()
+	if !errors.Is(err, errInvalidSizingModel) {
+		t.Errorf("received %v expected %v", err, errInvalidSizingModel)
+	}
+
+	s.TargetVolatility = decimal.NewFromFloat(0.2)
+	err = s.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	s = &SizingModel{Model: size.RiskParity, VolatilityMeasure: "bad", VolatilityPeriod: 14}
+	err = s.validate()
+	if !errors.Is(err, errInvalidSizingModel) {
+		t.Errorf("received %v expected %v", err, errInvalidSizingModel)
+	}
+
+	s.VolatilityMeasure = size.RealisedVolatility
+	s.VolatilityPeriod = 1
+	err = s.validate()
+	if !errors.Is(err, errInvalidSizingModel) {
+		t.Errorf("received %v expected %v", err, errInvalidSizingModel)
+	}
+
+	s.VolatilityPeriod = 30
+	err = s.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	s = &SizingModel{Model: size.HalfKelly}
+	err = s.validate()
+	if !errors.Is(err, errInvalidSizingModel) {
+		t.Errorf("received %v expected %v", err, errInvalidSizingModel)
+	}
+
+	s.Fraction = decimal.NewFromFloat(0.1)
+	s.KellyWindow = 10
+	s.KellyMinimumTrades = 20
+	err = s.validate()
+	if !errors.Is(err, errInvalidSizingModel) {
+		t.Errorf("received %v expected %v", err, errInvalidSizingModel)
+	}
+
+	s.KellyMinimumTrades = 5
+	err = s.validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	s.KellyWindow = -1
+	err = s.validate()
+	if !errors.Is(err, errInvalidSizingModel) {
+		t.Errorf("received %v expected %v", err, errInvalidSizingModel)
+	}
+}
+
+func TestValidateStatisticSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         currency.BTC,
+				Quote:        currency.USDT,
+			},
+		},
+	}
+	err := c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.StatisticSettings.ValueAtRiskConfidence = decimal.NewFromInt(100)
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errInvalidStatisticSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidStatisticSettings)
+	}
+
+	c.StatisticSettings.ValueAtRiskConfidence = decimal.NewFromInt(99)
+	c.StatisticSettings.Benchmark = &BenchmarkSettings{Type: "bad"}
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errInvalidStatisticSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidStatisticSettings)
+	}
+
+	c.StatisticSettings.Benchmark = &BenchmarkSettings{
+		Type:         statistics.BenchmarkPair,
+		ExchangeName: mainExchange,
+		Asset:        asset.Spot,
+		Base:         currency.ETH,
+		Quote:        currency.USDT,
+	}
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errInvalidStatisticSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidStatisticSettings)
+	}
+
+	c.StatisticSettings.Benchmark.Base = currency.BTC
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.StatisticSettings.Benchmark = &BenchmarkSettings{Type: statistics.BenchmarkBasket}
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.StatisticSettings.Benchmark = &BenchmarkSettings{Type: statistics.BenchmarkCSV}
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errInvalidStatisticSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidStatisticSettings)
+	}
+
+	c.StatisticSettings.Benchmark.CSVPath = filepath.Join(t.TempDir(), "missing.csv")
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, errInvalidStatisticSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidStatisticSettings)
+	}
+
+	c.StatisticSettings.Benchmark.CSVPath = filepath.Join(t.TempDir(), "benchmark.csv")
+	err = os.WriteFile(c.StatisticSettings.Benchmark.CSVPath, []byte("1577836800,100\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.validateStatisticSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+}
+
+func TestValidateExitRules(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateExitRules()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.PortfolioSettings.ExitRules = &ExitRules{StopLossPercent: decimal.NewFromInt(-1)}
+	err = c.validateExitRules()
+	if !errors.Is(err, errInvalidExitRules) {
+		t.Errorf("received %v expected %v", err, errInvalidExitRules)
+	}
+
+	c.PortfolioSettings.ExitRules = &ExitRules{TrailingStopPercent: decimal.NewFromInt(100)}
+	err = c.validateExitRules()
+	if !errors.Is(err, errInvalidExitRules) {
+		t.Errorf("received %v expected %v", err, errInvalidExitRules)
+	}
+
+	c.PortfolioSettings.ExitRules = &ExitRules{StopLossATRMultiple: decimal.NewFromInt(2)}
+	err = c.validateExitRules()
+	if !errors.Is(err, errInvalidExitRules) {
+		t.Errorf("received %v expected %v", err, errInvalidExitRules)
+	}
+
+	c.PortfolioSettings.ExitRules.ATRPeriod = 14
+	err = c.validateExitRules()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.CurrencySettings = []CurrencySettings{
+		{
+			ExitRules: &ExitRules{MaximumHoldingPeriods: -1},
+		},
+	}
+	err = c.validateExitRules()
+	if !errors.Is(err, errInvalidExitRules) {
+		t.Errorf("received %v expected %v", err, errInvalidExitRules)
+	}
+}
+
+func TestGetExitRules(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	cs := &CurrencySettings{}
+	if r := c.GetExitRules(cs); r != (ExitRules{}) {
+		t.Errorf("received %v expected empty exit rules", r)
+	}
+
+	c.PortfolioSettings.ExitRules = &ExitRules{MaximumHoldingPeriods: 5}
+	if r := c.GetExitRules(cs); r.MaximumHoldingPeriods != 5 {
+		t.Errorf("received %v expected %v", r.MaximumHoldingPeriods, 5)
+	}
+
+	cs.ExitRules = &ExitRules{MaximumHoldingPeriods: 2}
+	if r := c.GetExitRules(cs); r.MaximumHoldingPeriods != 2 {
+		t.Errorf("received %v expected %v", r.MaximumHoldingPeriods, 2)
+	}
+}
+
+func TestValidateExecutionSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.validateExecutionSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.ExecutionSettings = []ExecutionSettings{{}}
+	err = c.validateExecutionSettings()
+	if !errors.Is(err, errInvalidExecutionSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidExecutionSettings)
+	}
+
+	c.ExecutionSettings[0].ExchangeName = mainExchange
+	c.ExecutionSettings[0].RejectionRatePercent = decimal.NewFromInt(101)
+	err = c.validateExecutionSettings()
+	if !errors.Is(err, errInvalidExecutionSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidExecutionSettings)
+	}
+
+	c.ExecutionSettings[0].RejectionRatePercent = decimal.NewFromInt(5)
+	tt := time.Now()
+	c.ExecutionSettings[0].Outages = []OutageWindow{{Start: tt, End: tt}}
+	err = c.validateExecutionSettings()
+	if !errors.Is(err, errInvalidExecutionSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidExecutionSettings)
+	}
+
+	c.ExecutionSettings[0].Outages[0].End = tt.Add(time.Hour)
+	c.ExecutionSettings[0].Latency = &LatencySettings{Distribution: "bad"}
+	err = c.validateExecutionSettings()
+	if !errors.Is(err, errInvalidExecutionSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidExecutionSettings)
+	}
+
+	c.ExecutionSettings[0].Latency = &LatencySettings{Distribution: UniformLatency, Minimum: time.Second}
+	err = c.validateExecutionSettings()
+	if !errors.Is(err, errInvalidExecutionSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidExecutionSettings)
+	}
+
+	c.ExecutionSettings[0].Latency.Maximum = time.Millisecond
+	err = c.validateExecutionSettings()
+	if !errors.Is(err, errInvalidExecutionSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidExecutionSettings)
+	}
+
+	c.ExecutionSettings[0].Latency.Maximum = time.Second * 2
+	err = c.validateExecutionSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.ExecutionSettings[0].Latency = &LatencySettings{Distribution: NormalLatency, StandardDeviation: time.Second}
+	err = c.validateExecutionSettings()
+	if !errors.Is(err, errInvalidExecutionSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidExecutionSettings)
+	}
+
+	c.ExecutionSettings[0].Latency.Mean = time.Second
+	err = c.validateExecutionSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.ExecutionSettings = append(c.ExecutionSettings, ExecutionSettings{ExchangeName: strings.ToUpper(mainExchange)})
+	err = c.validateExecutionSettings()
+	if !errors.Is(err, errInvalidExecutionSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidExecutionSettings)
+	}
+}
+
+func TestValidateAdditionalIntervals(t *testing.T) {
+	t.Parallel()
+	c := &Config{DataSettings: DataSettings{Interval: kline.OneHour}}
+	cs := &CurrencySettings{}
+	err := c.validateAdditionalIntervals(cs)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	cs.AdditionalIntervals = []kline.Interval{kline.OneMin}
+	err = c.validateAdditionalIntervals(cs)
+	if !errors.Is(err, errInvalidAdditionalInterval) {
+		t.Errorf("received %v expected %v", err, errInvalidAdditionalInterval)
+	}
+
+	cs.AdditionalIntervals = []kline.Interval{kline.OneHour + kline.ThirtyMin}
+	err = c.validateAdditionalIntervals(cs)
+	if !errors.Is(err, errInvalidAdditionalInterval) {
+		t.Errorf("received %v expected %v", err, errInvalidAdditionalInterval)
+	}
+
+	cs.AdditionalIntervals = []kline.Interval{kline.FourHour, kline.OneDay}
+	err = c.validateAdditionalIntervals(cs)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	cs.AdditionalIntervals = append(cs.AdditionalIntervals, kline.OneDay)
+	err = c.validateAdditionalIntervals(cs)
+	if !errors.Is(err, errInvalidAdditionalInterval) {
+		t.Errorf("received %v expected %v", err, errInvalidAdditionalInterval)
+	}
+}
+
+func TestValidateFundingRatesCSV(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	cs := &CurrencySettings{Asset: asset.Spot}
+	err := c.validateFundingRatesCSV(cs)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	cs.FuturesDetails = &FuturesDetails{FundingRatesCSVPath: "funding.csv"}
+	err = c.validateFundingRatesCSV(cs)
+	if !errors.Is(err, errFundingRatesCSVUnsupported) {
+		t.Errorf("received %v expected %v", err, errFundingRatesCSVUnsupported)
+	}
+
+	cs.Asset = asset.PerpetualSwap
+	err = c.validateFundingRatesCSV(cs)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateFundingRatesCSV(cs)
+	if !errors.Is(err, errFundingRatesCSVUnsupported) {
+		t.Errorf("received %v expected %v", err, errFundingRatesCSVUnsupported)
+	}
+}
+
+func TestGetExecutionSettings(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	if e := c.GetExecutionSettings(mainExchange); e != nil {
+		t.Errorf("received %v expected nil", e)
+	}
+	c.ExecutionSettings = []ExecutionSettings{{ExchangeName: mainExchange, Seed: 1337}}
+	e := c.GetExecutionSettings(strings.ToUpper(mainExchange))
+	if e == nil {
+		t.Fatal("expected execution settings")
+	}
+	if e.Seed != 1337 {
+		t.Errorf("received %v expected %v", e.Seed, 1337)
+	}
 }
 
 func TestPrintSettings(t *testing.T) {
@@ -535,15 +1004,15 @@ func TestGenerateConfigForDCAAPICandles(t *testing.T) {
 	}
 }
 
-func TestGenerateConfigForPluginStrategy(t *testing.T) {
+func TestGenerateConfigForDCAAPICandlesExecutionConditions(t *testing.T) {
 	if !saveConfig {
 		t.Skip()
 	}
 	cfg := Config{
-		Nickname: "ExamplePluginStrategy",
-		Goal:     "To demonstrate that custom strategies can be used",
+		Nickname: "ExampleStrategyDCAAPICandlesExecutionConditions",
+		Goal:     "To demonstrate DCA strategy using API candles with order latency, rejections and an exchange outage",
 		StrategySettings: StrategySettings{
-			Name: "custom-strategy",
+			Name: dca,
 		},
 		CurrencySettings: []CurrencySettings{
 			{
@@ -552,7 +1021,7 @@ func TestGenerateConfigForPluginStrategy(t *testing.T) {
 				Base:         mainCurrencyPair.Base,
 				Quote:        mainCurrencyPair.Quote,
 				SpotDetails: &SpotDetails{
-					InitialQuoteFunds: initialFunds1000000,
+					InitialQuoteFunds: initialFunds100000,
 				},
 				BuySide:  minMax,
 				SellSide: minMax,
@@ -572,13 +1041,29 @@ func TestGenerateConfigForPluginStrategy(t *testing.T) {
 		PortfolioSettings: PortfolioSettings{
 			BuySide:  minMax,
 			SellSide: minMax,
-			Leverage: Leverage{
-				CanUseLeverage: false,
-			},
 		},
 		StatisticSettings: StatisticSettings{
 			RiskFreeRate: decimal.NewFromFloat(0.03),
 		},
+		ExecutionSettings: []ExecutionSettings{
+			{
+				ExchangeName: mainExchange,
+				Latency: &LatencySettings{
+					Distribution:      NormalLatency,
+					Maximum:           time.Hour * 36,
+					Mean:              time.Hour * 12,
+					StandardDeviation: time.Hour * 6,
+				},
+				RejectionRatePercent: decimal.NewFromInt(5),
+				Outages: []OutageWindow{
+					{
+						Start: startDate.AddDate(0, 1, 0),
+						End:   startDate.AddDate(0, 1, 7),
+					},
+				},
+				Seed: 1337,
+			},
+		},
 	}
 	if saveConfig {
 		result, err := json.MarshalIndent(cfg, "", " ")
@@ -589,33 +1074,94 @@ func TestGenerateConfigForPluginStrategy(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		err = os.WriteFile(filepath.Join(p, "strategyexamples", "custom-plugin-strategy.strat"), result, file.DefaultPermissionOctal)
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "dca-api-candles-execution-conditions.strat"), result, file.DefaultPermissionOctal)
 		if err != nil {
 			t.Error(err)
 		}
 	}
 }
 
-func TestGenerateConfigForDCAAPICandlesExchangeLevelFunding(t *testing.T) {
+func TestGenerateConfigForPluginStrategy(t *testing.T) {
 	if !saveConfig {
 		t.Skip()
 	}
 	cfg := Config{
-		Nickname: "ExampleStrategyDCAAPICandlesExchangeLevelFunding",
-		Goal:     "To demonstrate DCA strategy using API candles using a shared pool of funds",
+		Nickname: "ExamplePluginStrategy",
+		Goal:     "To demonstrate that custom strategies can be used",
 		StrategySettings: StrategySettings{
-			Name:                         dca,
-			SimultaneousSignalProcessing: true,
-			DisableUSDTracking:           true,
+			Name: "custom-strategy",
 		},
-		FundingSettings: FundingSettings{
-			UseExchangeLevelFunding: true,
-			ExchangeLevelFunding: []ExchangeLevelFunding{
-				{
-					ExchangeName: mainExchange,
-					Asset:        asset.Spot,
-					Currency:     mainCurrencyPair.Quote,
-					InitialFunds: decimal.NewFromInt(100000),
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds1000000,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay,
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+			Leverage: Leverage{
+				CanUseLeverage: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "custom-plugin-strategy.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForDCAAPICandlesExchangeLevelFunding(t *testing.T) {
+	if !saveConfig {
+		t.Skip()
+	}
+	cfg := Config{
+		Nickname: "ExampleStrategyDCAAPICandlesExchangeLevelFunding",
+		Goal:     "To demonstrate DCA strategy using API candles using a shared pool of funds",
+		StrategySettings: StrategySettings{
+			Name:                         dca,
+			SimultaneousSignalProcessing: true,
+			DisableUSDTracking:           true,
+		},
+		FundingSettings: FundingSettings{
+			UseExchangeLevelFunding: true,
+			ExchangeLevelFunding: []ExchangeLevelFunding{
+				{
+					ExchangeName: mainExchange,
+					Asset:        asset.Spot,
+					Currency:     mainCurrencyPair.Quote,
+					InitialFunds: decimal.NewFromInt(100000),
 				},
 			},
 		},
@@ -840,7 +1386,364 @@ func TestGenerateConfigForDCAAPICandlesSimultaneousProcessing(t *testing.T) {
 			{
 				ExchangeName: mainExchange,
 				Asset:        asset.Spot,
-				Base:         currency.ETH,
+				Base:         currency.ETH,
+				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds100000,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneDay,
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "dca-api-candles-simultaneous-processing.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForDCALiveCandles(t *testing.T) {
+	if !saveConfig {
+		t.Skip()
+	}
+	cfg := Config{
+		Nickname: "ExampleStrategyDCALiveCandles",
+		Goal:     "To demonstrate live trading proof of concept against candle data",
+		StrategySettings: StrategySettings{
+			Name:               dca,
+			DisableUSDTracking: true,
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds100000,
+				},
+				BuySide:  strictMinMax,
+				SellSide: strictMinMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneMin,
+			DataType: common.CandleStr,
+			LiveData: &LiveData{
+				NewEventTimeout:           time.Minute * 2,
+				DataCheckTimer:            time.Second,
+				RealOrders:                false,
+				DataRequestRetryTolerance: 3,
+				DataRequestRetryWaitTime:  time.Millisecond * 500,
+				ExchangeCredentials: []Credentials{
+					{
+						Exchange: mainExchange,
+						Keys: account.Credentials{
+							Key:    "",
+							Secret: "",
+						},
+					},
+				},
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  strictMinMax,
+			SellSide: strictMinMax,
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "dca-candles-live.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForRSIAPICustomSettings(t *testing.T) {
+	if !saveConfig {
+		t.Skip()
+	}
+	cfg := Config{
+		Nickname: "TestGenerateRSICandleAPICustomSettingsStrat",
+		Goal:     "To demonstrate the RSI strategy using API candle data and custom settings",
+		StrategySettings: StrategySettings{
+			Name: "rsi",
+			CustomSettings: map[string]interface{}{
+				"rsi-low":    30.0,
+				"rsi-high":   70.0,
+				"rsi-period": 14,
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds100000,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.ThreeHour,
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate.Add(time.Hour), // Now divisible by 3 hour candle
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "rsi-api-candles.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForRSIAPICandlesOptimisation(t *testing.T) {
+	if !saveConfig {
+		t.Skip()
+	}
+	cfg := Config{
+		Nickname: "TestGenerateRSICandleAPIOptimisationStrat",
+		Goal:     "To demonstrate optimising the RSI strategy custom settings using API candle data",
+		StrategySettings: StrategySettings{
+			Name: "rsi",
+			CustomSettings: map[string]interface{}{
+				"rsi-low":    30.0,
+				"rsi-high":   70.0,
+				"rsi-period": 14,
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds100000,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.ThreeHour,
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate.Add(time.Hour), // Now divisible by 3 hour candle
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+		OptimisationSettings: &OptimisationSettings{
+			Method: GridSearch,
+			Metric: SharpeRatio,
+			Parameters: []OptimisationParameter{
+				{
+					Name:    "rsi-period",
+					Minimum: decimal.NewFromInt(7),
+					Maximum: decimal.NewFromInt(21),
+					Step:    decimal.NewFromInt(7),
+				},
+				{
+					Name:   "rsi-low",
+					Values: []interface{}{20.0, 25.0, 30.0},
+				},
+			},
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "rsi-api-candles-optimisation.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForRSIAPICandlesWalkForward(t *testing.T) {
+	if !saveConfig {
+		t.Skip()
+	}
+	cfg := Config{
+		Nickname: "TestGenerateRSICandleAPIWalkForwardStrat",
+		Goal:     "To demonstrate a walk-forward analysis of the RSI strategy custom settings using API candle data",
+		StrategySettings: StrategySettings{
+			Name: "rsi",
+			CustomSettings: map[string]interface{}{
+				"rsi-low":    30.0,
+				"rsi-high":   70.0,
+				"rsi-period": 14,
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds100000,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.ThreeHour,
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate.Add(time.Hour), // Now divisible by 3 hour candle
+				InclusiveEndDate: false,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{
+			BuySide:  minMax,
+			SellSide: minMax,
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+		OptimisationSettings: &OptimisationSettings{
+			Method: GridSearch,
+			Metric: SharpeRatio,
+			Parameters: []OptimisationParameter{
+				{
+					Name:    "rsi-period",
+					Minimum: decimal.NewFromInt(7),
+					Maximum: decimal.NewFromInt(21),
+					Step:    decimal.NewFromInt(7),
+				},
+				{
+					Name:   "rsi-low",
+					Values: []interface{}{20.0, 25.0, 30.0},
+				},
+			},
+			WalkForward: &WalkForwardSettings{
+				InSamplePeriods:    240,
+				OutOfSamplePeriods: 80,
+			},
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "rsi-api-candles-walk-forward.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfigForGCTScriptAPICandles(t *testing.T) {
+	if !saveConfig {
+		t.Skip()
+	}
+	cfg := Config{
+		Nickname: "ExampleStrategyGCTScriptAPICandles",
+		Goal:     "To demonstrate running a GoCryptoTrader script as a strategy using API candle data",
+		StrategySettings: StrategySettings{
+			Name: "gctscript",
+			CustomSettings: map[string]interface{}{
+				"script":         filepath.Join("config", "strategyexamples", "gctscript-rsi.gct"),
+				"script-timeout": "10s",
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
 				Quote:        mainCurrencyPair.Quote,
 				SpotDetails: &SpotDetails{
 					InitialQuoteFunds: initialFunds100000,
@@ -877,23 +1780,28 @@ func TestGenerateConfigForDCAAPICandlesSimultaneousProcessing(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		err = os.WriteFile(filepath.Join(p, "strategyexamples", "dca-api-candles-simultaneous-processing.strat"), result, file.DefaultPermissionOctal)
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "gctscript-api-candles.strat"), result, file.DefaultPermissionOctal)
 		if err != nil {
 			t.Error(err)
 		}
 	}
 }
 
-func TestGenerateConfigForDCALiveCandles(t *testing.T) {
+func TestGenerateConfigForExternalAPICandles(t *testing.T) {
 	if !saveConfig {
 		t.Skip()
 	}
 	cfg := Config{
-		Nickname: "ExampleStrategyDCALiveCandles",
-		Goal:     "To demonstrate live trading proof of concept against candle data",
+		Nickname: "ExampleStrategyExternalAPICandles",
+		Goal:     "To demonstrate running a strategy in a separate process over gRPC using API candle data",
 		StrategySettings: StrategySettings{
-			Name:               dca,
-			DisableUSDTracking: true,
+			Name: "external",
+			CustomSettings: map[string]interface{}{
+				"address":      "localhost:9055",
+				"timeout":      "10s",
+				"history-size": 14,
+				"threshold":    0.05,
+			},
 		},
 		CurrencySettings: []CurrencySettings{
 			{
@@ -904,35 +1812,24 @@ func TestGenerateConfigForDCALiveCandles(t *testing.T) {
 				SpotDetails: &SpotDetails{
 					InitialQuoteFunds: initialFunds100000,
 				},
-				BuySide:  strictMinMax,
-				SellSide: strictMinMax,
+				BuySide:  minMax,
+				SellSide: minMax,
 				MakerFee: &makerFee,
 				TakerFee: &takerFee,
 			},
 		},
 		DataSettings: DataSettings{
-			Interval: kline.OneMin,
+			Interval: kline.OneDay,
 			DataType: common.CandleStr,
-			LiveData: &LiveData{
-				NewEventTimeout:           time.Minute * 2,
-				DataCheckTimer:            time.Second,
-				RealOrders:                false,
-				DataRequestRetryTolerance: 3,
-				DataRequestRetryWaitTime:  time.Millisecond * 500,
-				ExchangeCredentials: []Credentials{
-					{
-						Exchange: mainExchange,
-						Keys: account.Credentials{
-							Key:    "",
-							Secret: "",
-						},
-					},
-				},
+			APIData: &APIData{
+				StartDate:        startDate,
+				EndDate:          endDate,
+				InclusiveEndDate: false,
 			},
 		},
 		PortfolioSettings: PortfolioSettings{
-			BuySide:  strictMinMax,
-			SellSide: strictMinMax,
+			BuySide:  minMax,
+			SellSide: minMax,
 		},
 		StatisticSettings: StatisticSettings{
 			RiskFreeRate: decimal.NewFromFloat(0.03),
@@ -947,26 +1844,30 @@ func TestGenerateConfigForDCALiveCandles(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		err = os.WriteFile(filepath.Join(p, "strategyexamples", "dca-candles-live.strat"), result, file.DefaultPermissionOctal)
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "external-api-candles.strat"), result, file.DefaultPermissionOctal)
 		if err != nil {
 			t.Error(err)
 		}
 	}
 }
 
-func TestGenerateConfigForRSIAPICustomSettings(t *testing.T) {
+func TestGenerateConfigForGridAPICandles(t *testing.T) {
 	if !saveConfig {
 		t.Skip()
 	}
 	cfg := Config{
-		Nickname: "TestGenerateRSICandleAPICustomSettingsStrat",
-		Goal:     "To demonstrate the RSI strategy using API candle data and custom settings",
+		Nickname: "ExampleStrategyGridAPICandles",
+		Goal:     "To demonstrate a grid strategy using resting limit orders across multiple currencies with simultaneous processing",
 		StrategySettings: StrategySettings{
-			Name: "rsi",
+			Name:                         "grid",
+			SimultaneousSignalProcessing: true,
 			CustomSettings: map[string]interface{}{
-				"rsi-low":    30.0,
-				"rsi-high":   70.0,
-				"rsi-period": 14,
+				"range-percent":    0.15,
+				"levels":           12,
+				"spacing":          "geometric",
+				"order-type":       "limit",
+				"recenter":         true,
+				"stop-out-percent": 0.1,
 			},
 		},
 		CurrencySettings: []CurrencySettings{
@@ -975,6 +1876,19 @@ func TestGenerateConfigForRSIAPICustomSettings(t *testing.T) {
 				Asset:        asset.Spot,
 				Base:         mainCurrencyPair.Base,
 				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds1000000,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         currency.ETH,
+				Quote:        mainCurrencyPair.Quote,
 				SpotDetails: &SpotDetails{
 					InitialQuoteFunds: initialFunds100000,
 				},
@@ -985,11 +1899,11 @@ func TestGenerateConfigForRSIAPICustomSettings(t *testing.T) {
 			},
 		},
 		DataSettings: DataSettings{
-			Interval: kline.ThreeHour,
+			Interval: kline.OneHour,
 			DataType: common.CandleStr,
 			APIData: &APIData{
 				StartDate:        startDate,
-				EndDate:          endDate.Add(time.Hour), // Now divisible by 3 hour candle
+				EndDate:          endDate,
 				InclusiveEndDate: false,
 			},
 		},
@@ -1010,7 +1924,7 @@ func TestGenerateConfigForRSIAPICustomSettings(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		err = os.WriteFile(filepath.Join(p, "strategyexamples", "rsi-api-candles.strat"), result, file.DefaultPermissionOctal)
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "grid-api-candles.strat"), result, file.DefaultPermissionOctal)
 		if err != nil {
 			t.Error(err)
 		}
@@ -1128,6 +2042,61 @@ func TestGenerateConfigForDCACSVTrades(t *testing.T) {
 	}
 }
 
+func TestGenerateConfigForDCACSVOrderbook(t *testing.T) {
+	if !saveConfig {
+		t.Skip()
+	}
+	fp := filepath.Join("..", "testdata", "binance_BTCUSDT_orderbook_2020_11_16.csv")
+	cfg := Config{
+		Nickname: "ExampleStrategyDCACSVOrderbook",
+		Goal:     "To demonstrate the DCA strategy using CSV orderbook data, filling orders by walking the orderbook",
+		StrategySettings: StrategySettings{
+			Name:               dca,
+			DisableUSDTracking: true,
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.Spot,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				SpotDetails: &SpotDetails{
+					InitialQuoteFunds: initialFunds100000,
+				},
+				BuySide:  minMax,
+				SellSide: minMax,
+				MakerFee: &makerFee,
+				TakerFee: &takerFee,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneMin,
+			DataType: common.OrderbookStr,
+			CSVData: &CSVData{
+				FullPath: fp,
+			},
+		},
+		PortfolioSettings: PortfolioSettings{},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "dca-csv-orderbook.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
 func TestGenerateConfigForDCADatabaseCandles(t *testing.T) {
 	if !saveConfig {
 		t.Skip()
@@ -1396,6 +2365,89 @@ func TestGenerateBinanceCashAndCarryStrategy(t *testing.T) {
 	}
 }
 
+func TestGenerateConfigForPairsTrading(t *testing.T) {
+	if !saveConfig {
+		t.Skip()
+	}
+	cfg := Config{
+		Nickname: "ExamplePairsTrading",
+		Goal:     "To demonstrate a market neutral pairs trading strategy, longing and shorting futures contracts when the spread between them deviates",
+		StrategySettings: StrategySettings{
+			Name:                         "pairs-trading",
+			SimultaneousSignalProcessing: true,
+			CustomSettings: map[string]interface{}{
+				"lookback":            30,
+				"entry-z-score":       2,
+				"exit-z-score":        0.5,
+				"minimum-correlation": 0.7,
+				"dependent-pair":      "BTC-USDT",
+				"notional":            10000,
+				"adf-significance":    5,
+				"adf-lags":            1,
+			},
+		},
+		FundingSettings: FundingSettings{
+			UseExchangeLevelFunding: true,
+			ExchangeLevelFunding: []ExchangeLevelFunding{
+				{
+					ExchangeName: mainExchange,
+					Asset:        asset.Spot,
+					Currency:     mainCurrencyPair.Quote,
+					InitialFunds: *initialFunds100000,
+				},
+			},
+		},
+		CurrencySettings: []CurrencySettings{
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.USDTMarginedFutures,
+				Base:         mainCurrencyPair.Base,
+				Quote:        mainCurrencyPair.Quote,
+				MakerFee:     &makerFee,
+				TakerFee:     &takerFee,
+				BuySide:      minMax,
+				SellSide:     minMax,
+			},
+			{
+				ExchangeName: mainExchange,
+				Asset:        asset.USDTMarginedFutures,
+				Base:         currency.ETH,
+				Quote:        mainCurrencyPair.Quote,
+				MakerFee:     &makerFee,
+				TakerFee:     &takerFee,
+				BuySide:      minMax,
+				SellSide:     minMax,
+			},
+		},
+		DataSettings: DataSettings{
+			Interval: kline.OneHour,
+			DataType: common.CandleStr,
+			APIData: &APIData{
+				StartDate:        time.Date(2021, 1, 14, 0, 0, 0, 0, time.UTC),
+				EndDate:          time.Date(2021, 3, 14, 0, 0, 0, 0, time.UTC),
+				InclusiveEndDate: false,
+			},
+		},
+		StatisticSettings: StatisticSettings{
+			RiskFreeRate: decimal.NewFromFloat(0.03),
+		},
+	}
+	if saveConfig {
+		result, err := json.MarshalIndent(cfg, "", " ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := os.Getwd()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = os.WriteFile(filepath.Join(p, "strategyexamples", "pairs-trading-api-candles.strat"), result, file.DefaultPermissionOctal)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
+
 func TestGenerateConfigForLiveCashAndCarry(t *testing.T) {
 	if !saveConfig {
 		t.Skip()
@@ -1483,3 +2535,158 @@ func TestGenerateConfigForLiveCashAndCarry(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSpotMargin(t *testing.T) {
+	t.Parallel()
+	var m *SpotMargin
+	err := m.validate(asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	m = &SpotMargin{
+		MaximumLeverage:        decimal.NewFromInt(3),
+		LiquidationMarginLevel: decimal.NewFromFloat(1.1),
+	}
+	err = m.validate(asset.Futures)
+	if !errors.Is(err, errInvalidMarginSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMarginSettings)
+	}
+
+	err = m.validate(asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	m.BaseYearlyBorrowRate = decimal.NewFromInt(-1)
+	err = m.validate(asset.Spot)
+	if !errors.Is(err, errInvalidMarginSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMarginSettings)
+	}
+
+	m.BaseYearlyBorrowRate = decimal.Zero
+	m.MaximumLeverage = decimal.NewFromInt(1)
+	err = m.validate(asset.Spot)
+	if !errors.Is(err, errInvalidMarginSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMarginSettings)
+	}
+
+	m.MaximumLeverage = decimal.NewFromInt(20)
+	err = m.validate(asset.Spot)
+	if !errors.Is(err, errInvalidMarginSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMarginSettings)
+	}
+
+	m.MaximumLeverage = decimal.NewFromInt(3)
+	m.LiquidationMarginLevel = decimal.NewFromInt(1)
+	err = m.validate(asset.Spot)
+	if !errors.Is(err, errInvalidMarginSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidMarginSettings)
+	}
+}
+
+func TestValidateTransfers(t *testing.T) {
+	t.Parallel()
+	e := &ExchangeLevelFunding{
+		ExchangeName: mainExchange,
+		Asset:        asset.Spot,
+		Currency:     currency.BTC,
+		TransferFee:  decimal.NewFromInt(-1),
+	}
+	err := e.validateTransfers()
+	if !errors.Is(err, errInvalidTransferSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidTransferSettings)
+	}
+
+	e.TransferFee = decimal.Zero
+	e.TransferChains = []TransferChain{
+		{Chain: "bitcoin", WithdrawalFee: decimal.NewFromFloat(0.0005), ConfirmationDelay: time.Hour},
+		{Chain: "lightning"},
+	}
+	err = e.validateTransfers()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+
+	e.TransferChains[1].Chain = "Bitcoin"
+	err = e.validateTransfers()
+	if !errors.Is(err, errInvalidTransferSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidTransferSettings)
+	}
+
+	e.TransferChains[1].Chain = "lightning"
+	e.TransferChains[1].ConfirmationDelay = -time.Second
+	err = e.validateTransfers()
+	if !errors.Is(err, errInvalidTransferSettings) {
+		t.Errorf("received %v expected %v", err, errInvalidTransferSettings)
+	}
+}
+
+func TestValidateExchangeLevelFunding(t *testing.T) {
+	t.Parallel()
+	e := &ExchangeLevelFunding{
+		ExchangeName: mainExchange,
+		Asset:        asset.Spot,
+		Currency:     currency.BTC,
+		InitialFunds: decimal.NewFromInt(-1),
+	}
+	err := e.Validate()
+	if !errors.Is(err, errBadInitialFunds) {
+		t.Errorf("received '%v' expected '%v'", err, errBadInitialFunds)
+	}
+
+	e.InitialFunds = decimal.NewFromInt(1337)
+	e.TransferDelay = -time.Second
+	err = e.Validate()
+	if !errors.Is(err, errInvalidTransferSettings) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidTransferSettings)
+	}
+
+	e.TransferDelay = time.Minute
+	err = e.Validate()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
+func TestValidateCurrencySetting(t *testing.T) {
+	t.Parallel()
+	c := &Config{}
+	err := c.ValidateCurrencySetting(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	leet := decimal.NewFromInt(1337)
+	cs := &CurrencySettings{
+		ExchangeName: "BiNaNcE",
+		Asset:        asset.Spot,
+		Base:         currency.BTC,
+		Quote:        currency.USDT,
+		SpotDetails:  &SpotDetails{InitialQuoteFunds: &leet},
+	}
+	err = c.ValidateCurrencySetting(cs)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if cs.ExchangeName != "binance" {
+		t.Errorf("received '%v' expected '%v'", cs.ExchangeName, "binance")
+	}
+	if cs.SpotDetails.InitialBaseFunds == nil || !cs.SpotDetails.InitialBaseFunds.IsZero() {
+		t.Errorf("received '%v' expected '%v'", cs.SpotDetails.InitialBaseFunds, decimal.Zero)
+	}
+
+	c.FundingSettings.UseExchangeLevelFunding = true
+	err = c.ValidateCurrencySetting(cs)
+	if !errors.Is(err, errBadInitialFunds) {
+		t.Errorf("received '%v' expected '%v'", err, errBadInitialFunds)
+	}
+
+	c.FundingSettings.UseExchangeLevelFunding = false
+	cs.MinimumSlippagePercent = decimal.NewFromInt(100)
+	cs.MaximumSlippagePercent = decimal.NewFromInt(80)
+	err = c.ValidateCurrencySetting(cs)
+	if !errors.Is(err, errBadSlippageRates) {
+		t.Errorf("received '%v' expected '%v'", err, errBadSlippageRates)
+	}
+}
@@ -24,8 +24,56 @@ var (
 	errSizeLessThanZero                 = errors.New("size less than zero")
 	errMaxSizeMinSizeMismatch           = errors.New("maximum size must be greater to minimum size")
 	errMinMaxEqual                      = errors.New("minimum and maximum limits cannot be equal")
-	errPerpetualsUnsupported            = errors.New("perpetual futures not yet supported")
 	errFeatureIncompatible              = errors.New("feature is not compatible")
+	errInvalidIntrabarFillAssumption    = errors.New("invalid intrabar fill assumption")
+	errInvalidExitRules                 = errors.New("invalid exit rules")
+	errInvalidMonteCarloSettings        = errors.New("invalid monte carlo settings")
+	errInvalidExecutionSettings         = errors.New("invalid execution settings")
+	errInvalidAdditionalInterval        = errors.New("invalid additional interval")
+	errInvalidSizingModel               = errors.New("invalid sizing model")
+	errInvalidStatisticSettings         = errors.New("invalid statistic settings")
+	errInvalidMarginSettings            = errors.New("invalid spot margin settings")
+	errInvalidTransferSettings          = errors.New("invalid transfer settings")
+	errRestingOrdersWithRealOrders      = errors.New("resting orders are unsupported when using real orders")
+	errFundingRatesCSVUnsupported       = errors.New("funding rates csv requires a futures asset and historical data")
+)
+
+// maximumMonteCarloSimulations limits the amount of simulations
+// a monte carlo analysis can run
+const maximumMonteCarloSimulations = 100000
+
+const (
+	// PessimisticFills is the default intrabar fill assumption. Limit orders
+	// only fill when a candle trades through their price and stop-limit
+	// orders cannot fill on the candle which triggered them
+	PessimisticFills = "pessimistic"
+	// OptimisticFills fills limit orders when a candle touches their price
+	// and allows stop-limit orders to fill on the candle which triggered them
+	OptimisticFills = "optimistic"
+)
+
+// Monte carlo resampling methods and samples
+const (
+	// MonteCarloBootstrap randomly samples with replacement
+	MonteCarloBootstrap = "bootstrap"
+	// MonteCarloShuffle randomly reorders the sample
+	MonteCarloShuffle = "shuffle"
+	// MonteCarloReturns samples the returns of each candle
+	MonteCarloReturns = "returns"
+	// MonteCarloTrades samples the returns between each trade
+	MonteCarloTrades = "trades"
+)
+
+// Latency distributions used to simulate order latency
+const (
+	// FixedLatency delays every order by the mean latency
+	FixedLatency = "fixed"
+	// UniformLatency delays orders by a random duration between
+	// the minimum and maximum latency
+	UniformLatency = "uniform"
+	// NormalLatency delays orders by a normally distributed duration
+	// around the mean latency, bounded by the minimum and maximum when set
+	NormalLatency = "normal"
 )
 
 // Config defines what is in an individual strategy config
@@ -38,6 +86,12 @@ type Config struct {
 	DataSettings      DataSettings       `json:"data-settings"`
 	PortfolioSettings PortfolioSettings  `json:"portfolio-settings"`
 	StatisticSettings StatisticSettings  `json:"statistic-settings"`
+	// OptimisationSettings runs the strategy against ranges of custom
+	// settings instead of running it once
+	OptimisationSettings *OptimisationSettings `json:"optimisation-settings,omitempty"`
+	// ExecutionSettings simulate real-world order execution
+	// conditions for each exchange
+	ExecutionSettings []ExecutionSettings `json:"execution-settings,omitempty"`
 }
 
 // DataSettings is a container for each type of data retrieval setting.
@@ -69,6 +123,10 @@ type StrategySettings struct {
 	// bool language is opposite to encourage use by default
 	DisableUSDTracking bool                   `json:"disable-usd-tracking"`
 	CustomSettings     map[string]interface{} `json:"custom-settings,omitempty"`
+	// Seed makes slippage and execution randomness reproducible so a run
+	// can be replayed. Zero generates a new seed which is logged on setup and
+	// shown in the report. Optimisation and walk-forward runs share one seed
+	Seed int64 `json:"seed,omitempty"`
 }
 
 // ExchangeLevelFunding allows the portfolio manager to access
@@ -84,21 +142,139 @@ type ExchangeLevelFunding struct {
 	Currency     currency.Code   `json:"currency"`
 	InitialFunds decimal.Decimal `json:"initial-funds"`
 	TransferFee  decimal.Decimal `json:"transfer-fee"`
+	// TransferDelay is how long funds withdrawn from this exchange take to
+	// arrive when transferred without specifying a chain
+	TransferDelay time.Duration `json:"transfer-delay"`
+	// TransferChains sets the withdrawal fee and confirmation delay of
+	// each chain the currency can be withdrawn on
+	TransferChains []TransferChain `json:"transfer-chains,omitempty"`
+}
+
+// TransferChain holds the withdrawal fee and confirmation delay of
+// withdrawing a currency on a specific chain
+type TransferChain struct {
+	Chain             string          `json:"chain"`
+	WithdrawalFee     decimal.Decimal `json:"withdrawal-fee"`
+	ConfirmationDelay time.Duration   `json:"confirmation-delay"`
 }
 
 // StatisticSettings adjusts ratios where
 // proper data is currently lacking
 type StatisticSettings struct {
-	RiskFreeRate decimal.Decimal `json:"risk-free-rate"`
+	RiskFreeRate decimal.Decimal     `json:"risk-free-rate"`
+	MonteCarlo   *MonteCarloSettings `json:"monte-carlo,omitempty"`
+	// ValueAtRiskConfidence is the confidence level percentage used to
+	// calculate value at risk. Defaults to 95
+	ValueAtRiskConfidence decimal.Decimal    `json:"value-at-risk-confidence,omitempty"`
+	Benchmark             *BenchmarkSettings `json:"benchmark,omitempty"`
+}
+
+// BenchmarkSettings determine what the strategy's returns are compared
+// against. A pair benchmark must be one of the currency settings, a basket
+// benchmark uses every currency setting and a csv benchmark loads a file of
+// unix timestamps in seconds and values
+type BenchmarkSettings struct {
+	Type         string        `json:"type"`
+	ExchangeName string        `json:"exchange-name,omitempty"`
+	Asset        asset.Item    `json:"asset,omitempty"`
+	Base         currency.Code `json:"base,omitempty"`
+	Quote        currency.Code `json:"quote,omitempty"`
+	CSVPath      string        `json:"csv-path,omitempty"`
+}
+
+// MonteCarloSettings resamples the returns of a strategy run to measure
+// how much of its performance is down to the order of events
+type MonteCarloSettings struct {
+	Simulations int64  `json:"simulations"`
+	Method      string `json:"method"`
+	Sample      string `json:"sample"`
+	// Seed allows simulations to be repeated. Zero uses a new seed each run
+	Seed int64 `json:"seed,omitempty"`
+	// ConfidenceLevel is the percentage of simulations
+	// covered by the reported confidence intervals
+	ConfidenceLevel decimal.Decimal `json:"confidence-level"`
+	// RuinThresholdPercent is the loss from the starting value
+	// at which a simulation is considered ruined
+	RuinThresholdPercent decimal.Decimal `json:"ruin-threshold-percent"`
+	// SlippagePerturbationPercent and FeePerturbationPercent randomly
+	// scale each trade's slippage and fee costs up or down by up to
+	// the percentage
+	SlippagePerturbationPercent decimal.Decimal `json:"slippage-perturbation-percent"`
+	FeePerturbationPercent      decimal.Decimal `json:"fee-perturbation-percent"`
+}
+
+// ExecutionSettings simulate order latency, random rejections and outages
+// for an exchange. Delayed orders are filled or placed at the first data
+// event after they arrive. Orders which arrive during an outage, or are
+// randomly rejected, fail and have their funds released
+type ExecutionSettings struct {
+	ExchangeName string           `json:"exchange-name"`
+	Latency      *LatencySettings `json:"latency,omitempty"`
+	// RejectionRatePercent is the chance an order is rejected, eg 5 is 5%
+	RejectionRatePercent decimal.Decimal `json:"rejection-rate-percent"`
+	Outages              []OutageWindow  `json:"outages,omitempty"`
+	// Seed allows latency and rejections to be repeated. Zero uses the strategy settings seed
+	Seed int64 `json:"seed,omitempty"`
+}
+
+// LatencySettings determine how long an order takes to reach the exchange
+type LatencySettings struct {
+	Distribution      string        `json:"distribution"`
+	Minimum           time.Duration `json:"minimum"`
+	Maximum           time.Duration `json:"maximum"`
+	Mean              time.Duration `json:"mean"`
+	StandardDeviation time.Duration `json:"standard-deviation"`
+}
+
+// OutageWindow is a period of time where an exchange cannot accept orders
+type OutageWindow struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
 }
 
 // PortfolioSettings act as a global protector for strategies
 // these settings will override ExchangeSettings that go against it
 // and assess the bigger picture
 type PortfolioSettings struct {
-	Leverage Leverage `json:"leverage"`
-	BuySide  MinMax   `json:"buy-side"`
-	SellSide MinMax   `json:"sell-side"`
+	Leverage  Leverage   `json:"leverage"`
+	BuySide   MinMax     `json:"buy-side"`
+	SellSide  MinMax     `json:"sell-side"`
+	ExitRules *ExitRules `json:"exit-rules,omitempty"`
+	// Sizing selects the model used to size orders which open or increase
+	// positions. When unset, orders are sized using all available funds
+	Sizing *SizingModel `json:"sizing,omitempty"`
+}
+
+// SizingModel determines the portion of available funds an order may use
+// before buy-side and sell-side limits are applied. Fractions are decimals,
+// eg 0.1 is 10%. See the size package readme for each model's settings
+type SizingModel struct {
+	Model              string          `json:"model"`
+	Fraction           decimal.Decimal `json:"fraction"`
+	MaximumFraction    decimal.Decimal `json:"maximum-fraction"`
+	TargetVolatility   decimal.Decimal `json:"target-volatility"`
+	VolatilityMeasure  string          `json:"volatility-measure"`
+	VolatilityPeriod   int64           `json:"volatility-period"`
+	KellyWindow        int64           `json:"kelly-window"`
+	KellyMinimumTrades int64           `json:"kelly-minimum-trades"`
+}
+
+// ExitRules are enforced by the portfolio manager against each data event
+// to automatically close open positions. Percentages are whole numbers,
+// eg 5 is 5%. Unset values disable a rule
+type ExitRules struct {
+	StopLossPercent     decimal.Decimal `json:"stop-loss-percent"`
+	TakeProfitPercent   decimal.Decimal `json:"take-profit-percent"`
+	TrailingStopPercent decimal.Decimal `json:"trailing-stop-percent"`
+	// ATRPeriod is the number of candles used to calculate the average
+	// true range for the ATR multiple rules
+	ATRPeriod               int64           `json:"atr-period"`
+	StopLossATRMultiple     decimal.Decimal `json:"stop-loss-atr-multiple"`
+	TakeProfitATRMultiple   decimal.Decimal `json:"take-profit-atr-multiple"`
+	TrailingStopATRMultiple decimal.Decimal `json:"trailing-stop-atr-multiple"`
+	// MaximumHoldingPeriods closes a position after it has been held
+	// for the number of intervals
+	MaximumHoldingPeriods int64 `json:"maximum-holding-periods"`
 }
 
 // Leverage rules are used to allow or limit the use of leverage in orders
@@ -153,6 +329,17 @@ type CurrencySettings struct {
 	CanUseExchangeLimits          bool `json:"use-exchange-order-limits"`
 	ShowExchangeOrderLimitWarning bool `json:"-"`
 	UseExchangePNLCalculation     bool `json:"use-exchange-pnl-calculation"`
+
+	// IntrabarFillAssumption determines how resting limit and stop orders
+	// are filled within a candle. See PessimisticFills and OptimisticFills
+	IntrabarFillAssumption string `json:"intrabar-fill-assumption,omitempty"`
+
+	// ExitRules overrides the portfolio settings exit rules for the currency
+	ExitRules *ExitRules `json:"exit-rules,omitempty"`
+
+	// AdditionalIntervals are resampled from the data settings interval
+	// to allow strategies to assess larger timeframes
+	AdditionalIntervals []kline.Interval `json:"additional-intervals,omitempty"`
 }
 
 // SpotDetails contains funding information that cannot be shared with another
@@ -160,11 +347,31 @@ type CurrencySettings struct {
 type SpotDetails struct {
 	InitialBaseFunds  *decimal.Decimal `json:"initial-base-funds,omitempty"`
 	InitialQuoteFunds *decimal.Decimal `json:"initial-quote-funds,omitempty"`
+	// Margin allows the base or quote currency to be borrowed so
+	// that orders can exceed available funds, including short selling
+	Margin *SpotMargin `json:"margin,omitempty"`
+}
+
+// SpotMargin allows borrowing base or quote currency for a spot pair.
+// Borrowed funds accrue interest every interval until they are repaid and
+// the pair is liquidated when the margin level, being total assets divided
+// by total liabilities, falls below the liquidation margin level
+type SpotMargin struct {
+	MaximumLeverage        decimal.Decimal `json:"maximum-leverage"`
+	LiquidationMarginLevel decimal.Decimal `json:"liquidation-margin-level"`
+	// UseExchangeBorrowRates loads historical borrow rates from the
+	// exchange. The yearly borrow rates are used when no rates are returned
+	UseExchangeBorrowRates bool            `json:"use-exchange-borrow-rates"`
+	BaseYearlyBorrowRate   decimal.Decimal `json:"base-yearly-borrow-rate"`
+	QuoteYearlyBorrowRate  decimal.Decimal `json:"quote-yearly-borrow-rate"`
 }
 
 // FuturesDetails contains data relevant to futures currency pairs
 type FuturesDetails struct {
 	Leverage Leverage `json:"leverage"`
+	// FundingRatesCSVPath loads the historical funding rates of a perpetual
+	// future from a CSV file rather than the exchange API
+	FundingRatesCSVPath string `json:"funding-rates-csv-path,omitempty"`
 }
 
 // APIData defines all fields to configure API based data
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
 	"log"
 	"os"
@@ -17,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -30,6 +32,46 @@ import (
 const (
 	yes = "yes"
 	y   = "y"
+	no  = "no"
+	n   = "n"
+	// stratExtension is the file extension of strategy configs
+	stratExtension = "strat" //nolint:misspell // its shorthand for strategy
+	// maxPairResults limits how many pairs are shown when searching
+	maxPairResults = 20
+)
+
+// Ways to start building a config
+const (
+	createNew    = "Create a new config"
+	fromTemplate = "Start from a template"
+	editExisting = "Edit an existing config"
+)
+
+// Sections of the config and menu actions
+const (
+	strategySection   = "Strategy settings"
+	currencySection   = "Currency settings"
+	portfolioSection  = "Portfolio settings"
+	dataSection       = "Data settings"
+	statisticsSection = "Statistics settings"
+	viewConfig        = "View config"
+	saveConfig        = "Validate and save"
+	quitBuilder       = "Quit without saving"
+)
+
+// Actions which can be taken on a list of settings
+const (
+	addItem    = "Add"
+	editItem   = "Edit"
+	removeItem = "Remove"
+	doneItem   = "Done"
+)
+
+var (
+	errNoTemplates           = errors.New("no strategy config templates found")
+	errNoCurrencySettings    = errors.New("at least one currency setting is required")
+	errPairNotSelected       = errors.New("multiple pairs match, please enter a full pair")
+	errUnsupportedDataSource = errors.New("data source unsupported")
 )
 
 var dataOptions = []string{
@@ -39,714 +81,873 @@ var dataOptions = []string{
 	"Live",
 }
 
+var sections = []string{
+	strategySection,
+	currencySection,
+	portfolioSection,
+	dataSection,
+	statisticsSection,
+}
+
+// builder holds the config being built along with
+// what is required to prompt for each setting
+type builder struct {
+	reader *bufio.Reader
+	meta   *exchangeMetadata
+	cfg    *config.Config
+	// path is set when an existing config is being edited
+	path string
+}
+
 func main() {
+	var examplesPath, editPath string
+	var offline bool
+	flag.StringVar(&examplesPath, "examplespath", filepath.Join("..", "strategyexamples"), "the directory of strategy configs offered as templates")
+	flag.StringVar(&editPath, "editpath", "", "the path of an existing strategy config to edit")
+	flag.BoolVar(&offline, "offline", false, "if true, tradable pairs will not be requested from exchanges")
+	flag.Parse()
+
 	fmt.Print(common.ASCIILogo)
 	fmt.Println("Welcome to the config generator!")
-	reader := bufio.NewReader(os.Stdin)
-	var cfg config.Config
-	var err error
-
-	fmt.Println("-----Strategy Settings-----")
-	// loop in sections, so that if there is an error,
-	// a user only needs to redo that section
-	for {
-		err = parseStrategySettings(&cfg, reader)
-		if err != nil {
-			log.Println(err)
-		} else {
-			break
-		}
+	b := &builder{
+		reader: bufio.NewReader(os.Stdin),
+		meta:   newExchangeMetadata(offline),
 	}
-
-	fmt.Println("-----Exchange Settings-----")
-
-	for {
-		err = parseExchangeSettings(reader, &cfg)
-		if err != nil {
-			log.Println(err)
-		} else {
-			break
-		}
+	isNew := b.load(examplesPath, editPath)
+	if b.run(isNew) {
+		log.Println("Config creation complete!")
 	}
+}
 
-	fmt.Println("-----Portfolio Settings-----")
-	for {
-		err = parsePortfolioSettings(reader, &cfg)
-		if err != nil {
-			log.Println(err)
-		} else {
-			break
+// load sets the config to build and returns whether it is a new config
+func (b *builder) load(examplesPath, editPath string) bool {
+	if editPath != "" {
+		err := b.loadFile(editPath)
+		if err == nil {
+			b.path = editPath
+			return false
 		}
+		log.Println(err)
 	}
-
-	fmt.Println("-----Data Settings-----")
 	for {
-		err = parseDataSettings(&cfg, reader)
-		if err != nil {
-			log.Println(err)
-		} else {
-			break
+		switch askOption(b.reader, "How would you like to start?", []string{createNew, fromTemplate, editExisting}, "") {
+		case createNew:
+			b.cfg = &config.Config{}
+			return true
+		case fromTemplate:
+			templates, err := listTemplates(examplesPath)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			template := askOption(b.reader, "Which template would you like to start from?", templates, "")
+			err = b.loadFile(filepath.Join(examplesPath, template))
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			return false
+		case editExisting:
+			path := askRequiredString(b.reader, "What is the path of the strategy config to edit?", "")
+			err := b.loadFile(path)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			b.path = path
+			return false
 		}
 	}
+}
 
-	fmt.Println("-----Statistics Settings-----")
-	for {
-		err = parseStatisticsSettings(&cfg, reader)
-		if err != nil {
-			log.Println(err)
-		} else {
-			break
-		}
+func (b *builder) loadFile(path string) error {
+	cfg, err := config.ReadStrategyConfigFromFile(path)
+	if err != nil {
+		return err
 	}
+	b.cfg = cfg
+	fmt.Printf("Loaded \"%v\"\n", path)
+	return nil
+}
 
-	var resp []byte
-	resp, err = json.MarshalIndent(cfg, "", " ")
+// listTemplates returns the names of the strategy configs in a directory
+func listTemplates(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
 	if err != nil {
-		log.Fatal(err)
+		return nil, err
 	}
-	fmt.Println("Write strategy config to file? If no, the output will be on screen y/n")
-	yn := quickParse(reader)
-	if yn == y || yn == yes {
-		var fp, wd string
-		extension := "strat" //nolint:misspell // its shorthand for strategy
-		for {
-			wd, err = os.Getwd()
-			if err != nil {
-				log.Fatal(err)
-			}
-			fmt.Printf("Enter output directory. If blank, will default to \"%v\"\n", wd)
-			parsedPath := quickParse(reader)
-			if parsedPath != "" {
-				wd = parsedPath
-			}
+	var resp []string
+	for i := range entries {
+		if !entries[i].IsDir() && filepath.Ext(entries[i].Name()) == "."+stratExtension {
+			resp = append(resp, entries[i].Name())
+		}
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%w in %v", errNoTemplates, dir)
+	}
+	return resp, nil
+}
 
-			fn := cfg.StrategySettings.Name
-			if cfg.Nickname != "" {
-				fn += "-" + cfg.Nickname
-			}
-			fn, err = common.GenerateFileName(fn, extension)
+// run prompts for every section of a new config, then allows any section
+// to be changed until the config is saved. It returns whether it was saved
+func (b *builder) run(isNew bool) bool {
+	if isNew {
+		for i := range sections {
+			b.parseSection(sections[i])
+		}
+	}
+	menu := make([]string, 0, len(sections)+3)
+	menu = append(menu, sections...)
+	menu = append(menu, viewConfig, saveConfig, quitBuilder)
+	for {
+		switch choice := askOption(b.reader, "What would you like to do?", menu, ""); choice {
+		case viewConfig:
+			resp, err := json.MarshalIndent(b.cfg, "", " ")
 			if err != nil {
-				log.Printf("Could not write file, please try again. err: %v", err)
+				log.Println(err)
 				continue
 			}
-			fmt.Printf("Enter output file. If blank, will default to \"%v\"\n", fn)
-			parsedFileName := quickParse(reader)
-			if parsedFileName != "" {
-				fn, err = common.GenerateFileName(parsedFileName, extension)
-				if err != nil {
-					log.Printf("Could not write file, please try again. err: %v", err)
-					continue
-				}
-			}
-			fp = filepath.Join(wd, fn)
-			err = os.WriteFile(fp, resp, file.DefaultPermissionOctal)
+			fmt.Println(string(resp))
+		case saveConfig:
+			err := b.save()
 			if err != nil {
-				log.Printf("Could not write file, please try again. err: %v", err)
+				log.Printf("Config not saved, please fix the following and try again. err: %v", err)
 				continue
 			}
-			break
+			return true
+		case quitBuilder:
+			return false
+		default:
+			b.parseSection(choice)
 		}
-		fmt.Printf("Successfully output strategy to \"%v\"\n", fp)
-	} else {
-		log.Print(string(resp))
 	}
-	log.Println("Config creation complete!")
 }
 
-func parseStatisticsSettings(cfg *config.Config, reader *bufio.Reader) error {
-	fmt.Println("Enter the risk free rate. eg 0.03")
-	rfr, err := strconv.ParseFloat(quickParse(reader), 64)
-	if err != nil {
-		return err
+// parseSection prompts for a section of the config.
+// If there is an error, a user only needs to redo that section
+func (b *builder) parseSection(section string) {
+	fmt.Printf("-----%v-----\n", section)
+	var parse func() error
+	switch section {
+	case strategySection:
+		parse = b.parseStrategySettings
+	case currencySection:
+		parse = b.parseCurrencySettings
+	case portfolioSection:
+		parse = b.parsePortfolioSettings
+	case dataSection:
+		parse = b.parseDataSettings
+	case statisticsSection:
+		parse = b.parseStatisticsSettings
+	default:
+		return
+	}
+	for {
+		err := parse()
+		if err == nil {
+			return
+		}
+		log.Println(err)
 	}
-	cfg.StatisticSettings.RiskFreeRate = decimal.NewFromFloat(rfr)
-	return nil
 }
 
-func parseDataSettings(cfg *config.Config, reader *bufio.Reader) error {
-	var err error
-	fmt.Println("Will you be using \"candle\" or \"trade\" data?")
-	cfg.DataSettings.DataType = quickParse(reader)
-	if cfg.DataSettings.DataType == common.TradeStr {
-		fmt.Println("Trade data will be converted into candles")
-	}
-	fmt.Println("What candle time interval will you use?")
-	cfg.DataSettings.Interval, err = parseKlineInterval(reader)
+// save validates the config then writes it to a file or to the screen
+func (b *builder) save() error {
+	err := b.cfg.Validate()
 	if err != nil {
 		return err
 	}
-
-	fmt.Println("Where will this data be sourced?")
-	var choice string
-	choice, err = parseDataChoice(reader, len(cfg.CurrencySettings) > 1)
+	resp, err := json.MarshalIndent(b.cfg, "", " ")
 	if err != nil {
 		return err
 	}
-	switch choice {
-	case "API":
-		err = parseAPI(reader, cfg)
-	case "Database":
-		err = parseDatabase(reader, cfg)
-	case "CSV":
-		parseCSV(reader, cfg)
-	case "Live":
-		parseLive(reader, cfg)
-	}
-	return err
-}
-
-func parsePortfolioSettings(reader *bufio.Reader, cfg *config.Config) error {
-	var err error
-	fmt.Println("Will there be global portfolio buy-side limits? y/n")
-	yn := quickParse(reader)
-	if yn == y || yn == yes {
-		cfg.PortfolioSettings.BuySide, err = minMaxParse("buy", reader)
+	if b.path != "" {
+		fp := askRequiredString(b.reader, "Enter the file to save to.", b.path)
+		err = os.WriteFile(fp, resp, file.DefaultPermissionOctal)
 		if err != nil {
 			return err
 		}
+		fmt.Printf("Successfully output strategy to \"%v\"\n", fp)
+		return nil
 	}
-	fmt.Println("Will there be global portfolio sell-side limits? y/n")
-	yn = quickParse(reader)
-	if yn == y || yn == yes {
-		cfg.PortfolioSettings.SellSide, err = minMaxParse("sell", reader)
+	if !askYesNo(b.reader, "Write strategy config to file? If no, the output will be on screen", true) {
+		log.Print(string(resp))
+		return nil
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	wd = askRequiredString(b.reader, "Enter output directory.", wd)
+	fn := b.cfg.StrategySettings.Name
+	if b.cfg.Nickname != "" {
+		fn += "-" + b.cfg.Nickname
+	}
+	fn, err = common.GenerateFileName(fn, stratExtension)
+	if err != nil {
+		return err
+	}
+	if parsedFileName := askString(b.reader, "Enter output file.", fn); parsedFileName != fn {
+		fn, err = common.GenerateFileName(parsedFileName, stratExtension)
 		if err != nil {
 			return err
 		}
 	}
+	fp := filepath.Join(wd, fn)
+	err = os.WriteFile(fp, resp, file.DefaultPermissionOctal)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Successfully output strategy to \"%v\"\n", fp)
 	return nil
 }
 
-func parseExchangeSettings(reader *bufio.Reader, cfg *config.Config) error {
-	var err error
-	addCurrency := y
-	for strings.Contains(addCurrency, y) {
-		var currencySetting *config.CurrencySettings
-		currencySetting, err = addCurrencySetting(reader, cfg.FundingSettings.UseExchangeLevelFunding)
-		if err != nil {
-			return err
-		}
-
-		cfg.CurrencySettings = append(cfg.CurrencySettings, *currencySetting)
-		fmt.Println("Add another exchange currency setting? y/n")
-		addCurrency = quickParse(reader)
+// editList shows a list of settings and returns the action to take
+// along with the index of the setting to act on
+func (b *builder) editList(name string, items []string) (action string, index int) {
+	fmt.Printf("Current %v:\n", name)
+	if len(items) == 0 {
+		fmt.Println("None")
 	}
-
-	return nil
+	for i := range items {
+		fmt.Printf("- %v\n", items[i])
+	}
+	actions := []string{addItem, doneItem}
+	if len(items) > 0 {
+		actions = []string{addItem, editItem, removeItem, doneItem}
+	}
+	action = askOption(b.reader, fmt.Sprintf("What would you like to do with the %v?", name), actions, "")
+	switch action {
+	case editItem, removeItem:
+		index = askIndex(b.reader, fmt.Sprintf("Which of the %v?", name), items)
+	case addItem:
+		index = len(items)
+	}
+	return action, index
 }
 
-func parseStrategySettings(cfg *config.Config, reader *bufio.Reader) error {
-	fmt.Println("Firstly, please select which strategy you wish to use")
+func (b *builder) parseStrategySettings() error {
+	cfg := b.cfg
 	strats := strategies.GetSupportedStrategies()
 	strategiesToUse := make([]string, len(strats))
 	for i := range strats {
-		fmt.Printf("%v. %s\n", i+1, strats[i].Name())
 		strategiesToUse[i] = strats[i].Name()
 	}
-	var err error
-	cfg.StrategySettings.Name, err = parseStratName(quickParse(reader), strategiesToUse)
+	cfg.StrategySettings.Name = askOption(b.reader, "Which strategy do you wish to use?", strategiesToUse, cfg.StrategySettings.Name)
+	strat, err := strategies.LoadStrategyByName(cfg.StrategySettings.Name, false)
 	if err != nil {
 		return err
 	}
-
-	fmt.Println("What is the goal of your strategy?")
-	cfg.Goal = quickParse(reader)
-	fmt.Println("Enter a nickname, it can help distinguish between different configs using the same strategy")
-	cfg.Nickname = quickParse(reader)
-	fmt.Println("Does this strategy have custom settings? y/n")
-	customSettings := quickParse(reader)
-	if strings.Contains(customSettings, y) {
-		cfg.StrategySettings.CustomSettings = customSettingsLoop(reader)
-	}
-	fmt.Println("Do you wish to have strategy performance tracked against USD? y/n")
-	yn := quickParse(reader)
-	cfg.StrategySettings.DisableUSDTracking = !strings.Contains(yn, y)
-	fmt.Println("Will this strategy use simultaneous processing? y/n")
-	yn = quickParse(reader)
-	cfg.StrategySettings.SimultaneousSignalProcessing = strings.Contains(yn, y)
-	if !cfg.StrategySettings.SimultaneousSignalProcessing {
-		return nil
-	}
-	fmt.Println("Will this strategy be able to share funds at an exchange level? y/n")
-	yn = quickParse(reader)
-	cfg.FundingSettings.UseExchangeLevelFunding = strings.Contains(yn, y)
-	if !cfg.FundingSettings.UseExchangeLevelFunding {
-		return nil
+	cfg.Goal = askString(b.reader, "What is the goal of your strategy?", cfg.Goal)
+	cfg.Nickname = askString(b.reader, "Enter a nickname, it can help distinguish between different configs using the same strategy", cfg.Nickname)
+	cfg.StrategySettings.DisableUSDTracking = !askYesNo(b.reader, "Do you wish to have strategy performance tracked against USD?", !cfg.StrategySettings.DisableUSDTracking)
+	if strat.SupportsSimultaneousProcessing() {
+		cfg.StrategySettings.SimultaneousSignalProcessing = askYesNo(b.reader, "Will this strategy use simultaneous processing?", cfg.StrategySettings.SimultaneousSignalProcessing)
+	} else {
+		fmt.Printf("%v does not support simultaneous processing\n", strat.Name())
+		cfg.StrategySettings.SimultaneousSignalProcessing = false
 	}
-
-	addFunding := y
-	for strings.Contains(addFunding, y) {
-		fund := config.ExchangeLevelFunding{}
-		fmt.Println("What is the exchange name to add funding to?")
-		fund.ExchangeName = quickParse(reader)
-		fmt.Println("What is the asset to add funding to?")
-		supported := asset.Supported()
-		for i := range supported {
-			fmt.Printf("%v. %s\n", i+1, supported[i])
+	cfg.FundingSettings.UseExchangeLevelFunding = cfg.StrategySettings.SimultaneousSignalProcessing &&
+		askYesNo(b.reader, "Will this strategy be able to share funds at an exchange level?", cfg.FundingSettings.UseExchangeLevelFunding)
+	if cfg.FundingSettings.UseExchangeLevelFunding {
+		for i := range cfg.CurrencySettings {
+			removeSpotFunds(&cfg.CurrencySettings[i])
 		}
-		response := quickParse(reader)
-		num, err := strconv.ParseFloat(response, 64)
+		b.parseExchangeLevelFunding()
+	} else {
+		cfg.FundingSettings.ExchangeLevelFunding = nil
+	}
+	cfg.StrategySettings.Seed = askInt(b.reader, "Enter a seed to make slippage and execution randomness reproducible. 0 generates a new seed for each run", cfg.StrategySettings.Seed, nil)
+	for {
+		err = b.parseCustomSettings(strat)
 		if err == nil {
-			intNum := int(num)
-			if intNum > len(supported) || intNum <= 0 {
-				return errors.New("unknown option")
-			}
-			fund.Asset = supported[intNum-1]
-		} else {
-			for i := range supported {
-				if strings.EqualFold(response, supported[i].String()) {
-					fund.Asset = supported[i]
-					break
+			break
+		}
+		log.Println(err)
+	}
+	return cfg.ValidateStrategySettings()
+}
+
+// parseCustomSettings lists the custom settings the strategy accepts and
+// validates each value as it is entered
+func (b *builder) parseCustomSettings(strat strategies.Handler) error {
+	schema := strat.CustomSettingsSchema()
+	settings := make(map[string]interface{}, len(b.cfg.StrategySettings.CustomSettings))
+	for k, v := range b.cfg.StrategySettings.CustomSettings {
+		if _, err := schema.Get(k); err != nil && !schema.AdditionalSettings {
+			fmt.Printf("Removing custom setting %v, it is not used by %v\n", k, strat.Name())
+			continue
+		}
+		settings[k] = v
+	}
+	if len(schema.Settings) == 0 && !schema.AdditionalSettings {
+		fmt.Printf("%v does not have custom settings\n", strat.Name())
+		b.cfg.StrategySettings.CustomSettings = nil
+		return nil
+	}
+	if len(schema.Settings) > 0 {
+		fmt.Printf("%v custom settings:\n", strat.Name())
+		for i := range schema.Settings {
+			fmt.Printf("- %v\n", describeSetting(&schema.Settings[i]))
+		}
+	}
+	for i := range schema.Settings {
+		setting := &schema.Settings[i]
+		question := fmt.Sprintf("Enter %v.", setting.Key)
+		var current string
+		if v, ok := settings[setting.Key]; ok {
+			current = fmt.Sprint(v)
+		} else if !setting.Required {
+			question += " Leave blank to use the default"
+		}
+		askUntilValid(b.reader, question, current, func(resp string) error {
+			if resp == "" {
+				if setting.Required {
+					return fmt.Errorf("%v %w", setting.Key, errAnswerRequired)
 				}
+				return nil
 			}
-			if fund.Asset == asset.Empty {
-				return errors.New("unrecognised data option")
+			v, err := setting.Parse(resp)
+			if err != nil {
+				return err
+			}
+			settings[setting.Key] = v
+			return nil
+		})
+	}
+	if schema.AdditionalSettings {
+		fmt.Printf("%v accepts additional custom settings\n", strat.Name())
+		for {
+			key := askString(b.reader, "Enter an additional custom setting name. Enter nothing to stop", "")
+			if key == "" {
+				break
+			}
+			var current string
+			if v, ok := settings[key]; ok {
+				current = fmt.Sprint(v)
 			}
+			settings[key] = parseAdditionalSetting(askRequiredString(b.reader, "Enter a custom setting value", current))
 		}
-
-		fmt.Println("What is the individual currency to add funding to? eg BTC")
-		fund.Currency = currency.NewCode(quickParse(reader))
-		fmt.Printf("How much funding for %v?\n", fund.Currency)
-		fund.InitialFunds, err = decimal.NewFromString(quickParse(reader))
+	}
+	if len(settings) == 0 {
+		b.cfg.StrategySettings.CustomSettings = nil
+		return nil
+	}
+	if schema.Verifiable {
+		// apply the settings to a new instance so the
+		// strategy's own rules are checked before running
+		verify, err := strategies.LoadStrategyByName(strat.Name(), b.cfg.StrategySettings.SimultaneousSignalProcessing)
 		if err != nil {
 			return err
 		}
-
-		fmt.Println("If your strategy utilises fund transfer, what is the transfer fee?")
-		fee := quickParse(reader)
-		if fee != "" {
-			fund.TransferFee, err = decimal.NewFromString(fee)
-			if err != nil {
-				return err
+		verify.SetDefaults()
+		err = verify.SetCustomSettings(settings)
+		if closer, ok := verify.(strategies.Closer); ok {
+			if closeErr := closer.Close(); closeErr != nil {
+				log.Printf("Could not close strategy %v: %v", verify.Name(), closeErr)
 			}
 		}
-		cfg.FundingSettings.ExchangeLevelFunding = append(cfg.FundingSettings.ExchangeLevelFunding, fund)
-		fmt.Println("Add another source of funds? y/n")
-		addFunding = quickParse(reader)
+		if err != nil {
+			return err
+		}
 	}
-
+	b.cfg.StrategySettings.CustomSettings = settings
 	return nil
 }
 
-func parseAPI(reader *bufio.Reader, cfg *config.Config) error {
-	cfg.DataSettings.APIData = &config.APIData{}
-	var startDate, endDate, inclusive string
-	var err error
-	defaultStart := time.Now().Add(-time.Hour * 24 * 365)
-	defaultEnd := time.Now()
-	fmt.Printf("What is the start date? Leave blank for \"%v\"\n", defaultStart.Format(gctcommon.SimpleTimeFormat))
-	startDate = quickParse(reader)
-	if startDate != "" {
-		cfg.DataSettings.APIData.StartDate, err = time.Parse(gctcommon.SimpleTimeFormat, startDate)
-		if err != nil {
-			return err
-		}
-	} else {
-		cfg.DataSettings.APIData.StartDate = defaultStart
+// describeSetting returns a line describing a custom setting
+func describeSetting(c *base.CustomSetting) string {
+	resp := fmt.Sprintf("%v (%v)", c.Key, c.Type)
+	if c.Required {
+		resp += " required"
+	}
+	if c.Default != nil {
+		resp += fmt.Sprintf(" default: %v", c.Default)
+	}
+	if len(c.Options) > 0 {
+		resp += fmt.Sprintf(" options: %v", strings.Join(c.Options, ", "))
 	}
+	if c.Description != "" {
+		resp += " - " + c.Description
+	}
+	return resp
+}
 
-	fmt.Printf("What is the end date? Leave blank for \"%v\"\n", defaultEnd.Format(gctcommon.SimpleTimeFormat))
-	endDate = quickParse(reader)
-	if endDate != "" {
-		cfg.DataSettings.APIData.EndDate, err = time.Parse(gctcommon.SimpleTimeFormat, endDate)
-		if err != nil {
-			return err
+// parseAdditionalSetting converts a value without a described type
+// into the type it would have when read from a config file
+func parseAdditionalSetting(value string) interface{} {
+	if f, err := strconv.ParseFloat(value, 64); err == nil {
+		return f
+	}
+	if strings.EqualFold(value, "true") || strings.EqualFold(value, "false") {
+		return strings.EqualFold(value, "true")
+	}
+	return value
+}
+
+func (b *builder) parseExchangeLevelFunding() {
+	funds := b.cfg.FundingSettings.ExchangeLevelFunding
+	for {
+		items := make([]string, len(funds))
+		for i := range funds {
+			items[i] = fmt.Sprintf("%v %v %v %v", funds[i].ExchangeName, funds[i].Asset, funds[i].Currency, funds[i].InitialFunds)
+		}
+		action, index := b.editList("exchange level funding", items)
+		switch action {
+		case doneItem:
+			b.cfg.FundingSettings.ExchangeLevelFunding = funds
+			return
+		case removeItem:
+			funds = append(funds[:index], funds[index+1:]...)
+			continue
+		case addItem:
+			funds = append(funds, config.ExchangeLevelFunding{})
+		}
+		original := funds[index]
+		for {
+			err := b.parseFunding(&funds[index])
+			if err == nil {
+				break
+			}
+			if !b.editAgain("exchange level funding", err) {
+				if action == addItem {
+					funds = funds[:index]
+				} else {
+					funds[index] = original
+				}
+				break
+			}
 		}
-	} else {
-		cfg.DataSettings.APIData.EndDate = defaultEnd
 	}
-	fmt.Println("Is the end date inclusive? y/n")
-	inclusive = quickParse(reader)
-	cfg.DataSettings.APIData.InclusiveEndDate = inclusive == y || inclusive == yes
+}
 
-	return nil
+// editAgain reports why an item is invalid and asks whether to edit it
+// again or to discard the changes made to it
+func (b *builder) editAgain(name string, err error) bool {
+	log.Println(err)
+	return askYesNo(b.reader, fmt.Sprintf("Do you want to edit the %v again? If no, your changes to it are discarded", name), true)
 }
 
-func parseCSV(reader *bufio.Reader, cfg *config.Config) {
-	cfg.DataSettings.CSVData = &config.CSVData{}
-	fmt.Println("What is path of the CSV file to read?")
-	cfg.DataSettings.CSVData.FullPath = quickParse(reader)
+func (b *builder) parseFunding(fund *config.ExchangeLevelFunding) error {
+	fund.ExchangeName = b.askExchange(fund.ExchangeName)
+	fund.Asset = b.askAsset(fund.ExchangeName, fund.Asset)
+	fund.Currency = currency.NewCode(askRequiredString(b.reader, "What is the individual currency to add funding to? eg BTC", fund.Currency.String()))
+	fund.InitialFunds = askDecimal(b.reader, fmt.Sprintf("How much funding for %v?", fund.Currency), fund.InitialFunds, notNegative)
+	fund.TransferFee = askDecimal(b.reader, "If your strategy utilises fund transfer, what is the transfer fee?", fund.TransferFee, notNegative)
+	fund.TransferDelay = askDuration(b.reader, "How long do transferred funds take to arrive? eg 30m", fund.TransferDelay)
+	return fund.Validate()
 }
 
-func parseDatabase(reader *bufio.Reader, cfg *config.Config) error {
-	cfg.DataSettings.DatabaseData = &config.DatabaseData{}
-	var input string
-	var err error
-	defaultStart := time.Now().Add(-time.Hour * 24 * 365)
-	defaultEnd := time.Now()
-	fmt.Printf("What is the start date? Leave blank for \"%v\"\n", defaultStart.Format(gctcommon.SimpleTimeFormat))
-	startDate := quickParse(reader)
-	if startDate != "" {
-		cfg.DataSettings.DatabaseData.StartDate, err = time.Parse(gctcommon.SimpleTimeFormat, startDate)
-		if err != nil {
-			return err
+func (b *builder) parseCurrencySettings() error {
+	for {
+		items := make([]string, len(b.cfg.CurrencySettings))
+		for i := range b.cfg.CurrencySettings {
+			cs := &b.cfg.CurrencySettings[i]
+			items[i] = fmt.Sprintf("%v %v %v-%v", cs.ExchangeName, cs.Asset, cs.Base, cs.Quote)
+		}
+		action, index := b.editList("currency settings", items)
+		switch action {
+		case doneItem:
+			if len(b.cfg.CurrencySettings) == 0 {
+				log.Println(errNoCurrencySettings)
+				continue
+			}
+			return nil
+		case removeItem:
+			b.cfg.CurrencySettings = append(b.cfg.CurrencySettings[:index], b.cfg.CurrencySettings[index+1:]...)
+			continue
+		case addItem:
+			b.cfg.CurrencySettings = append(b.cfg.CurrencySettings, config.CurrencySettings{})
+		}
+		original := b.cfg.CurrencySettings[index]
+		if original.SpotDetails != nil {
+			// spot funds are edited in place
+			spotDetails := *original.SpotDetails
+			original.SpotDetails = &spotDetails
+		}
+		for {
+			err := b.parseCurrencySetting(&b.cfg.CurrencySettings[index])
+			if err == nil {
+				break
+			}
+			if !b.editAgain("currency setting", err) {
+				if action == addItem {
+					b.cfg.CurrencySettings = b.cfg.CurrencySettings[:index]
+				} else {
+					b.cfg.CurrencySettings[index] = original
+				}
+				break
+			}
 		}
-	} else {
-		cfg.DataSettings.DatabaseData.StartDate = defaultStart
 	}
+}
 
-	fmt.Printf("What is the end date? Leave blank for \"%v\"\n", defaultEnd.Format(gctcommon.SimpleTimeFormat))
-	if endDate := quickParse(reader); endDate != "" {
-		cfg.DataSettings.DatabaseData.EndDate, err = time.Parse(gctcommon.SimpleTimeFormat, endDate)
-		if err != nil {
-			return err
-		}
+func (b *builder) parseCurrencySetting(cs *config.CurrencySettings) error {
+	cs.ExchangeName = b.askExchange(cs.ExchangeName)
+	cs.Asset = b.askAsset(cs.ExchangeName, cs.Asset)
+	cs.Base, cs.Quote = b.askPair(cs.ExchangeName, cs.Asset, cs.Base, cs.Quote)
+	if b.cfg.FundingSettings.UseExchangeLevelFunding {
+		removeSpotFunds(cs)
+	}
+	if cs.Asset == asset.Spot && !b.cfg.FundingSettings.UseExchangeLevelFunding {
+		if cs.SpotDetails == nil {
+			cs.SpotDetails = &config.SpotDetails{}
+		}
+		cs.SpotDetails.InitialBaseFunds = askOptionalDecimal(b.reader, fmt.Sprintf("Enter the initial %v funds. eg 0", cs.Base), cs.SpotDetails.InitialBaseFunds, nil)
+		cs.SpotDetails.InitialQuoteFunds = askOptionalDecimal(b.reader, fmt.Sprintf("Enter the initial %v funds. eg 10000", cs.Quote), cs.SpotDetails.InitialQuoteFunds, func(d *decimal.Decimal) error {
+			spotDetails := *cs.SpotDetails
+			spotDetails.InitialQuoteFunds = d
+			trial := *cs
+			trial.SpotDetails = &spotDetails
+			return trial.ValidateSpotFunds(false)
+		})
+	}
+
+	if askYesNo(b.reader, "Do you want to set custom fees? If no, Backtester will use default fees for exchange", cs.MakerFee != nil || cs.TakerFee != nil) {
+		cs.MakerFee = askOptionalDecimal(b.reader, "Enter the maker-fee. eg 0.001", cs.MakerFee, nil)
+		cs.TakerFee = askOptionalDecimal(b.reader, "Enter the taker-fee. eg 0.01", cs.TakerFee, nil)
 	} else {
-		cfg.DataSettings.DatabaseData.EndDate = defaultEnd
-	}
-	fmt.Println("Is the end date inclusive? y/n")
-	input = quickParse(reader)
-	cfg.DataSettings.DatabaseData.InclusiveEndDate = input == y || input == yes
-	cfg.DataSettings.DatabaseData.Config = database.Config{
-		Enabled: true,
+		cs.MakerFee, cs.TakerFee = nil, nil
 	}
-	fmt.Println("Do you want database verbose output? y/n")
-	input = quickParse(reader)
-	cfg.DataSettings.DatabaseData.Config.Verbose = input == y || input == yes
+	cs.BuySide = b.askMinMax("buy", cs.BuySide)
+	cs.SellSide = b.askMinMax("sell", cs.SellSide)
+	cs.CanUseExchangeLimits = askYesNo(b.reader, "Will the in-sample data amounts conform to current exchange defined order execution limits? i.e. If amount is 1337.001345 and the step size is 0.01 order amount will be re-adjusted to 1337.", cs.CanUseExchangeLimits)
+	cs.SkipCandleVolumeFitting = !askYesNo(b.reader, "Should order size shrink to fit within candle volume?", !cs.SkipCandleVolumeFitting)
 
-	fmt.Printf("What database driver to use? %v %v or %v\n", database.DBPostgreSQL, database.DBSQLite, database.DBSQLite3)
-	cfg.DataSettings.DatabaseData.Config.Driver = quickParse(reader)
-	if cfg.DataSettings.DatabaseData.Config.Driver == database.DBSQLite || cfg.DataSettings.DatabaseData.Config.Driver == database.DBSQLite3 {
-		fmt.Printf("What is the path to the database directory? Leaving blank will use: '%v'", filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "database"))
-		cfg.DataSettings.DatabaseData.Path = quickParse(reader)
+	if askYesNo(b.reader, "Do you wish to include slippage?", !cs.MinimumSlippagePercent.IsZero() || !cs.MaximumSlippagePercent.IsZero()) {
+		fmt.Println("Slippage is randomly determined between the lower and upper bounds.")
+		fmt.Println("If the lower bound is 80, then the price can change up to 80% of itself. eg if the price is 100 and the lower bound is 80, then the lowest slipped price is $80")
+		fmt.Println("If the upper bound is 100, then the price can be unaffected. A minimum of 80 and a maximum of 100 means that the price will randomly be set between those bounds as a way of emulating slippage")
+		cs.MinimumSlippagePercent = askDecimal(b.reader, "What is the lower bounds of slippage? eg 80", cs.MinimumSlippagePercent, notNegative)
+		cs.MaximumSlippagePercent = askDecimal(b.reader, "What is the upper bounds of slippage? eg 100", cs.MaximumSlippagePercent, func(d decimal.Decimal) error {
+			trial := *cs
+			trial.MaximumSlippagePercent = d
+			return trial.ValidateSlippage()
+		})
+	} else {
+		cs.MinimumSlippagePercent, cs.MaximumSlippagePercent = decimal.Zero, decimal.Zero
 	}
-	fmt.Println("What is the database host?")
-	cfg.DataSettings.DatabaseData.Config.Host = quickParse(reader)
+	cs.IntrabarFillAssumption = b.askIntrabarFillAssumption(cs)
+	return b.cfg.ValidateCurrencySetting(cs)
+}
 
-	fmt.Println("What is the database username?")
-	cfg.DataSettings.DatabaseData.Config.Username = quickParse(reader)
+// askIntrabarFillAssumption asks how resting orders fill within a candle.
+// Pessimistic fills are the default and are left unset unless already set
+func (b *builder) askIntrabarFillAssumption(cs *config.CurrencySettings) string {
+	current := strings.ToLower(cs.IntrabarFillAssumption)
+	if err := cs.ValidateIntrabarFillAssumption(); err != nil {
+		log.Println(err)
+		current = ""
+	} else if current == "" {
+		current = config.PessimisticFills
+	}
+	fmt.Println("Pessimistic fills require a candle to trade through a limit price, optimistic fills only require it to be touched")
+	resp := askOption(b.reader, "How should resting limit and stop orders fill within a candle?", []string{config.PessimisticFills, config.OptimisticFills}, current)
+	if resp == config.PessimisticFills && cs.IntrabarFillAssumption == "" {
+		return ""
+	}
+	return resp
+}
 
-	fmt.Println("What is the database password? eg 1234")
-	cfg.DataSettings.DatabaseData.Config.Password = quickParse(reader)
+// removeSpotFunds removes the initial funds of a currency setting, which
+// cannot be set when funding is set at the exchange level
+func removeSpotFunds(cs *config.CurrencySettings) {
+	if cs.SpotDetails == nil ||
+		(cs.SpotDetails.InitialBaseFunds == nil && cs.SpotDetails.InitialQuoteFunds == nil) {
+		return
+	}
+	fmt.Printf("Removing the initial %v-%v funds, funding is set at the exchange level\n", cs.Base, cs.Quote)
+	cs.SpotDetails.InitialBaseFunds, cs.SpotDetails.InitialQuoteFunds = nil, nil
+}
 
-	fmt.Println("What is the database? eg database.db")
-	cfg.DataSettings.DatabaseData.Config.Database = quickParse(reader)
+func (b *builder) askExchange(current string) string {
+	return askOption(b.reader, "Which exchange?", b.meta.exchanges(), current)
+}
 
-	if cfg.DataSettings.DatabaseData.Config.Driver == database.DBPostgreSQL {
-		fmt.Println("What is the database SSLMode? eg disable")
-		cfg.DataSettings.DatabaseData.Config.SSLMode = quickParse(reader)
+// askAsset lists the assets an exchange supports, falling back to
+// all assets when the exchange cannot be loaded
+func (b *builder) askAsset(exchangeName string, current asset.Item) asset.Item {
+	assets, err := b.meta.assets(exchangeName)
+	if err != nil || len(assets) == 0 {
+		log.Printf("Could not load %v assets, listing all assets. err: %v", exchangeName, err)
+		assets = asset.Supported()
 	}
-	fmt.Println("What is the database Port? eg 1337")
-	input = quickParse(reader)
-	var port uint64
-	if input != "" {
-		port, err = strconv.ParseUint(input, 10, 16)
-		if err != nil {
-			return err
-		}
+	var currentAsset string
+	if current.IsValid() {
+		currentAsset = current.String()
 	}
-	cfg.DataSettings.DatabaseData.Config.Port = uint16(port)
-	err = database.DB.SetConfig(&cfg.DataSettings.DatabaseData.Config)
-	if err != nil {
-		return fmt.Errorf("database failed to set config: %w", err)
-	}
-	if cfg.DataSettings.DatabaseData.Config.Driver == database.DBPostgreSQL {
-		_, err = dbPSQL.Connect(&cfg.DataSettings.DatabaseData.Config)
-		if err != nil {
-			return fmt.Errorf("database failed to connect: %v", err)
-		}
-	} else if cfg.DataSettings.DatabaseData.Config.Driver == database.DBSQLite ||
-		cfg.DataSettings.DatabaseData.Config.Driver == database.DBSQLite3 {
-		_, err = dbsqlite3.Connect(cfg.DataSettings.DatabaseData.Config.Database)
+	for {
+		a, err := asset.New(askOption(b.reader, "Which asset?", assets.Strings(), currentAsset))
 		if err != nil {
-			return fmt.Errorf("database failed to connect: %v", err)
+			log.Println(err)
+			continue
 		}
+		return a
 	}
-	return nil
 }
 
-func parseLive(reader *bufio.Reader, cfg *config.Config) {
-	cfg.DataSettings.LiveData = &config.LiveData{}
-	fmt.Println("Do you wish to use live trading? It's highly recommended that you do not. y/n")
-	input := quickParse(reader)
-	cfg.DataSettings.LiveData.RealOrders = input == y || input == yes
-	if cfg.DataSettings.LiveData.RealOrders {
-		fmt.Printf("Do you want to set credentials for exchanges? y/n\n")
-		input = quickParse(reader)
-		if input != yes && input != y {
-			return
+// askPair searches the exchange's tradable pairs for the pair to use.
+// When pairs cannot be retrieved the base and quote are entered freely
+func (b *builder) askPair(exchangeName string, a asset.Item, currentBase, currentQuote currency.Code) (baseCode, quoteCode currency.Code) {
+	pairs, err := b.meta.pairs(exchangeName, a)
+	if err != nil || len(pairs) == 0 {
+		if err != nil {
+			log.Printf("Tradable pairs cannot be listed. err: %v", err)
+		}
+		baseCode = currency.NewCode(askRequiredString(b.reader, "Enter the currency base. eg BTC", currentBase.String()))
+		quoteCode = currency.NewCode(askRequiredString(b.reader, "Enter the currency quote. eg USDT", currentQuote.String()))
+		return baseCode, quoteCode
+	}
+	var current string
+	if !currentBase.IsEmpty() {
+		current = currency.NewPair(currentBase, currentQuote).String()
+	}
+	baseCode, quoteCode = currentBase, currentQuote
+	question := fmt.Sprintf("Enter the currency pair. eg BTC-USDT. Enter part of a pair to search %v tradable pairs", len(pairs))
+	askUntilValid(b.reader, question, current, func(resp string) error {
+		matches := searchPairs(pairs, resp)
+		if len(matches) == 0 {
+			return fmt.Errorf("%w %v", currency.ErrPairNotFound, resp)
+		}
+		for i := range matches {
+			if strings.EqualFold(stripDelimiters(resp), matches[i].Base.String()+matches[i].Quote.String()) {
+				baseCode, quoteCode = matches[i].Base, matches[i].Quote
+				return nil
+			}
 		}
-		for {
-			var creds config.Credentials
-			fmt.Printf("What is the exchange name? y/n\n")
-			creds.Exchange = quickParse(reader)
-			fmt.Println("What is the API key?")
-			creds.Keys.Key = quickParse(reader)
-			fmt.Println("What is the API secret?")
-			creds.Keys.Secret = quickParse(reader)
-			fmt.Println("What is the Client ID? (leave blank if not applicable)")
-			creds.Keys.ClientID = quickParse(reader)
-			fmt.Println("What is the 2FA seed? (leave blank if not applicable)")
-			creds.Keys.OneTimePassword = quickParse(reader)
-			fmt.Println("What is the subaccount to use? (leave blank if not applicable)")
-			creds.Keys.SubAccount = quickParse(reader)
-			fmt.Println("What is the PEM key? (leave blank if not applicable)")
-			creds.Keys.PEMKey = quickParse(reader)
-			cfg.DataSettings.LiveData.ExchangeCredentials = append(cfg.DataSettings.LiveData.ExchangeCredentials, creds)
-			fmt.Printf("Do you want to add another? y/n\n")
-			if input != yes && input != y {
+		for i := range matches {
+			if i == maxPairResults {
+				fmt.Printf("...and %v more\n", len(matches)-maxPairResults)
 				break
 			}
+			fmt.Println(matches[i])
 		}
-	}
+		return errPairNotSelected
+	})
+	return baseCode, quoteCode
 }
 
-func parseDataChoice(reader *bufio.Reader, multiCurrency bool) (string, error) {
-	if multiCurrency {
-		// live trading does not support multiple currencies
-		dataOptions = dataOptions[:3]
+// askMinMax prompts for order limits until they are valid
+func (b *builder) askMinMax(side string, current config.MinMax) config.MinMax {
+	hasLimits := !current.MinimumSize.IsZero() || !current.MaximumSize.IsZero() || !current.MaximumTotal.IsZero()
+	if !askYesNo(b.reader, fmt.Sprintf("Will there be %s-side limits?", side), hasLimits) {
+		return config.MinMax{}
 	}
-	for i := range dataOptions {
-		fmt.Printf("%v. %s\n", i+1, dataOptions[i])
-	}
-	response := quickParse(reader)
-	num, err := strconv.ParseFloat(response, 64)
-	if err == nil {
-		intNum := int(num)
-		if intNum > len(dataOptions) || intNum <= 0 {
-			return "", errors.New("unknown option")
-		}
-		return dataOptions[intNum-1], nil
-	}
-	for i := range dataOptions {
-		if strings.EqualFold(response, dataOptions[i]) {
-			return dataOptions[i], nil
+	for {
+		current.MaximumSize = askDecimal(b.reader, fmt.Sprintf("What is the maximum %s size? eg 1", side), current.MaximumSize, notNegative)
+		current.MinimumSize = askDecimal(b.reader, fmt.Sprintf("What is the minimum %s size? eg 0.1", side), current.MinimumSize, notNegative)
+		current.MaximumTotal = askDecimal(b.reader, fmt.Sprintf("What is the maximum %s total? eg 12000", side), current.MaximumTotal, notNegative)
+		err := current.Validate()
+		if err == nil {
+			return current
 		}
+		log.Println(err)
 	}
-	return "", errors.New("unrecognised data option")
 }
 
-func parseKlineInterval(reader *bufio.Reader) (gctkline.Interval, error) {
-	allCandles := gctkline.SupportedIntervals
-	for i := range allCandles {
-		fmt.Printf("%v. %s\n", i+1, allCandles[i].Word())
-	}
-	response := quickParse(reader)
-	num, err := strconv.ParseFloat(response, 64)
-	if err == nil {
-		intNum := int(num)
-		if intNum > len(allCandles) || intNum <= 0 {
-			return 0, errors.New("unknown option")
-		}
-		return allCandles[intNum-1], nil
-	}
-	for i := range allCandles {
-		if strings.EqualFold(response, allCandles[i].Word()) {
-			return allCandles[i], nil
-		}
-	}
-	return 0, errors.New("unrecognised interval")
+func (b *builder) parsePortfolioSettings() error {
+	b.cfg.PortfolioSettings.BuySide = b.askMinMax("global portfolio buy", b.cfg.PortfolioSettings.BuySide)
+	b.cfg.PortfolioSettings.SellSide = b.askMinMax("global portfolio sell", b.cfg.PortfolioSettings.SellSide)
+	return nil
 }
 
-func parseStratName(name string, strategiesToUse []string) (string, error) {
-	num, err := strconv.ParseFloat(name, 64)
-	if err == nil {
-		intNum := int(num)
-		if intNum > len(strategiesToUse) || intNum <= 0 {
-			return "", errors.New("unknown option")
-		}
-		return strategiesToUse[intNum-1], nil
+func (b *builder) parseDataSettings() error {
+	ds := &b.cfg.DataSettings
+	ds.DataType = askOption(b.reader, "Which type of data will you be using?", []string{common.CandleStr, common.TradeStr, common.OrderbookStr}, ds.DataType)
+	options := dataOptions
+	switch {
+	case ds.DataType == common.TradeStr:
+		fmt.Println("Trade data will be converted into candles")
+	case ds.DataType == common.OrderbookStr:
+		fmt.Println("Orderbook data will be replayed into candles and can only be sourced from CSV")
+		options = []string{"CSV"}
+	case len(b.cfg.CurrencySettings) > 1:
+		// live trading does not support multiple currencies
+		options = dataOptions[:3]
 	}
-	for i := range strategiesToUse {
-		if strings.EqualFold(name, strategiesToUse[i]) {
-			return strategiesToUse[i], nil
-		}
+	current := currentDataSource(ds)
+	if _, err := parseOption(current, options); err != nil {
+		current = ""
 	}
-	return "", errors.New("unrecognised strategy")
-}
+	choice := askOption(b.reader, "Where will this data be sourced?", options, current)
 
-func customSettingsLoop(reader *bufio.Reader) map[string]interface{} {
-	resp := make(map[string]interface{})
-	customSettingField := "loopTime!"
-	for customSettingField != "" {
-		fmt.Println("Enter a custom setting name. Enter nothing to stop")
-		customSettingField = quickParse(reader)
-		if customSettingField != "" {
-			fmt.Println("Enter a custom setting value")
-			resp[customSettingField] = quickParse(reader)
+	intervals := gctkline.SupportedIntervals
+	if choice == "API" || choice == "Live" {
+		exchangeNames := make([]string, len(b.cfg.CurrencySettings))
+		for i := range b.cfg.CurrencySettings {
+			exchangeNames[i] = b.cfg.CurrencySettings[i].ExchangeName
 		}
+		intervals = b.meta.intervals(exchangeNames, choice == "Live")
 	}
-	return resp
+	ds.Interval = b.askInterval(intervals, ds.Interval)
+
+	switch choice {
+	case "API":
+		ds.CSVData, ds.DatabaseData, ds.LiveData = nil, nil, nil
+		return b.parseAPI()
+	case "CSV":
+		ds.APIData, ds.DatabaseData, ds.LiveData = nil, nil, nil
+		b.parseCSV()
+		return nil
+	case "Database":
+		ds.APIData, ds.CSVData, ds.LiveData = nil, nil, nil
+		return b.parseDatabase()
+	case "Live":
+		ds.APIData, ds.CSVData, ds.DatabaseData = nil, nil, nil
+		b.parseLive()
+		return nil
+	}
+	return fmt.Errorf("%w %v", errUnsupportedDataSource, choice)
 }
 
-func addCurrencySetting(reader *bufio.Reader, usingExchangeLevelFunding bool) (*config.CurrencySettings, error) {
-	setting := config.CurrencySettings{}
-	fmt.Println("Enter the exchange name. eg Binance")
-	setting.ExchangeName = quickParse(reader)
+func currentDataSource(ds *config.DataSettings) string {
+	switch {
+	case ds.APIData != nil:
+		return "API"
+	case ds.CSVData != nil:
+		return "CSV"
+	case ds.DatabaseData != nil:
+		return "Database"
+	case ds.LiveData != nil:
+		return "Live"
+	}
+	return ""
+}
 
-	fmt.Println("Please select an asset")
-	supported := asset.Supported()
-	for i := range supported {
-		fmt.Printf("%v. %s\n", i+1, supported[i])
-	}
-	response := quickParse(reader)
-	num, err := strconv.ParseFloat(response, 64)
-	if err == nil {
-		intNum := int(num)
-		if intNum > len(supported) || intNum <= 0 {
-			return nil, errors.New("unknown option")
+// askInterval lists the intervals which can be used with the data source
+func (b *builder) askInterval(intervals []gctkline.Interval, current gctkline.Interval) gctkline.Interval {
+	words := make([]string, len(intervals))
+	var currentWord string
+	for i := range intervals {
+		words[i] = intervals[i].Word()
+		if intervals[i] == current {
+			currentWord = words[i]
 		}
-		setting.Asset = supported[intNum-1]
 	}
-	for i := range supported {
-		if strings.EqualFold(response, supported[i].String()) {
-			setting.Asset = supported[i]
+	resp := askOption(b.reader, "What candle time interval will you use?", words, currentWord)
+	for i := range intervals {
+		if words[i] == resp {
+			return intervals[i]
 		}
 	}
+	return current
+}
 
-	fmt.Println("Enter the currency base. eg BTC")
-	setting.Base = currency.NewCode(quickParse(reader))
-	if setting.Asset == asset.Spot {
-		if !usingExchangeLevelFunding {
-			fmt.Println("Enter the initial base funds. eg 0")
-			parseNum := quickParse(reader)
-			if parseNum != "" {
-				var d decimal.Decimal
-				d, err = decimal.NewFromString(parseNum)
-				if err != nil {
-					return nil, err
-				}
-				setting.SpotDetails = &config.SpotDetails{
-					InitialBaseFunds: &d,
-				}
-			}
+func (b *builder) parseAPI() error {
+	ds := &b.cfg.DataSettings
+	if ds.APIData == nil {
+		ds.APIData = &config.APIData{
+			StartDate: time.Now().Add(-time.Hour * 24 * 365).Truncate(time.Hour),
+			EndDate:   time.Now().Truncate(time.Hour),
 		}
 	}
+	ds.APIData.StartDate = askTime(b.reader, "What is the start date?", ds.APIData.StartDate)
+	ds.APIData.EndDate = askTime(b.reader, "What is the end date?", ds.APIData.EndDate)
+	ds.APIData.InclusiveEndDate = askYesNo(b.reader, "Is the end date inclusive?", ds.APIData.InclusiveEndDate)
+	return gctcommon.StartEndTimeCheck(ds.APIData.StartDate, ds.APIData.EndDate)
+}
 
-	fmt.Println("Enter the currency quote. eg USDT")
-	setting.Quote = currency.NewCode(quickParse(reader))
-	if setting.Asset == asset.Spot && !usingExchangeLevelFunding {
-		fmt.Println("Enter the initial quote funds. eg 10000")
-		parseNum := quickParse(reader)
-		if parseNum != "" {
-			var d decimal.Decimal
-			d, err = decimal.NewFromString(parseNum)
-			if err != nil {
-				return nil, err
-			}
-			if setting.SpotDetails == nil {
-				setting.SpotDetails = &config.SpotDetails{
-					InitialQuoteFunds: &d,
-				}
-			} else {
-				setting.SpotDetails.InitialQuoteFunds = &d
-			}
-		}
+func (b *builder) parseCSV() {
+	ds := &b.cfg.DataSettings
+	if ds.CSVData == nil {
+		ds.CSVData = &config.CSVData{}
 	}
+	ds.CSVData.FullPath = askRequiredString(b.reader, "What is path of the CSV file to read?", ds.CSVData.FullPath)
+}
 
-	fmt.Println("Do you want to set custom fees? If no, Backtester will use default fees for exchange y/n")
-	yn := quickParse(reader)
-	if yn == y || yn == yes {
-		fmt.Println("Enter the maker-fee. eg 0.001")
-		parseNum := quickParse(reader)
-		if parseNum != "" {
-			var d decimal.Decimal
-			d, err = decimal.NewFromString(parseNum)
-			if err != nil {
-				return nil, err
-			}
-			setting.MakerFee = &d
-		}
-		fmt.Println("Enter the taker-fee. eg 0.01")
-		parseNum = quickParse(reader)
-		if parseNum != "" {
-			var d decimal.Decimal
-			d, err = decimal.NewFromString(parseNum)
-			if err != nil {
-				return nil, err
-			}
-			setting.TakerFee = &d
+func (b *builder) parseDatabase() error {
+	ds := &b.cfg.DataSettings
+	if ds.DatabaseData == nil {
+		ds.DatabaseData = &config.DatabaseData{
+			StartDate: time.Now().Add(-time.Hour * 24 * 365).Truncate(time.Hour),
+			EndDate:   time.Now().Truncate(time.Hour),
 		}
 	}
-
-	fmt.Println("Will there be buy-side limits? y/n")
-	yn = quickParse(reader)
-	if yn == y || yn == yes {
-		setting.BuySide, err = minMaxParse("buy", reader)
-		if err != nil {
-			return nil, err
-		}
+	db := ds.DatabaseData
+	db.StartDate = askTime(b.reader, "What is the start date?", db.StartDate)
+	db.EndDate = askTime(b.reader, "What is the end date?", db.EndDate)
+	err := gctcommon.StartEndTimeCheck(db.StartDate, db.EndDate)
+	if err != nil {
+		return err
 	}
-	fmt.Println("Will there be sell-side limits? y/n")
-	yn = quickParse(reader)
-	if yn == y || yn == yes {
-		setting.SellSide, err = minMaxParse("sell", reader)
-		if err != nil {
-			return nil, err
-		}
+	db.InclusiveEndDate = askYesNo(b.reader, "Is the end date inclusive?", db.InclusiveEndDate)
+	db.Config.Enabled = true
+	db.Config.Verbose = askYesNo(b.reader, "Do you want database verbose output?", db.Config.Verbose)
+	db.Config.Driver = askOption(b.reader, "What database driver to use?", []string{database.DBPostgreSQL, database.DBSQLite, database.DBSQLite3}, db.Config.Driver)
+	if db.Config.Driver == database.DBSQLite || db.Config.Driver == database.DBSQLite3 {
+		db.Path = askString(b.reader, fmt.Sprintf("What is the path to the database directory? Leaving blank will use: '%v'", filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "database")), db.Path)
 	}
-	fmt.Println("Will the in-sample data amounts conform to current exchange defined order execution limits? i.e. If amount is 1337.001345 and the step size is 0.01 order amount will be re-adjusted to 1337. y/n")
-	yn = quickParse(reader)
-	if yn == y || yn == yes {
-		setting.CanUseExchangeLimits = true
+	db.Config.Host = askString(b.reader, "What is the database host?", db.Config.Host)
+	db.Config.Username = askString(b.reader, "What is the database username?", db.Config.Username)
+	db.Config.Password = askSecret(b.reader, "What is the database password? eg 1234", db.Config.Password)
+	db.Config.Database = askRequiredString(b.reader, "What is the database? eg database.db", db.Config.Database)
+	if db.Config.Driver == database.DBPostgreSQL {
+		db.Config.SSLMode = askString(b.reader, "What is the database SSLMode? eg disable", db.Config.SSLMode)
 	}
-
-	fmt.Println("Should order size shrink to fit within candle volume? y/n")
-	yn = quickParse(reader)
-	if yn == y || yn == yes {
-		setting.SkipCandleVolumeFitting = true
+	db.Config.Port = uint16(askInt(b.reader, "What is the database Port? eg 1337", int64(db.Config.Port), func(i int64) error {
+		if i < 0 || i > 65535 {
+			return fmt.Errorf("%w port %v", errUnknownOption, i)
+		}
+		return nil
+	}))
+	if !askYesNo(b.reader, "Do you want to test the database connection?", false) {
+		return nil
 	}
-
-	fmt.Println("Do you wish to include slippage? y/n")
-	yn = quickParse(reader)
-	if yn == y || yn == yes {
-		fmt.Println("Slippage is randomly determined between the lower and upper bounds.")
-		fmt.Println("If the lower bound is 80, then the price can change up to 80% of itself. eg if the price is 100 and the lower bound is 80, then the lowest slipped price is $80")
-		fmt.Println("If the upper bound is 100, then the price can be unaffected. A minimum of 80 and a maximum of 100 means that the price will randomly be set between those bounds as a way of emulating slippage")
-
-		fmt.Println("What is the lower bounds of slippage? eg 80")
-		setting.MinimumSlippagePercent, err = decimal.NewFromString(quickParse(reader))
+	err = database.DB.SetConfig(&db.Config)
+	if err != nil {
+		return fmt.Errorf("database failed to set config: %w", err)
+	}
+	if db.Config.Driver == database.DBPostgreSQL {
+		_, err = dbPSQL.Connect(&db.Config)
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("database failed to connect: %v", err)
 		}
-
-		fmt.Println("What is the upper bounds of slippage? eg 100")
-		setting.MaximumSlippagePercent, err = decimal.NewFromString(quickParse(reader))
+	} else {
+		_, err = dbsqlite3.Connect(db.Config.Database)
 		if err != nil {
-			return nil, err
+			return fmt.Errorf("database failed to connect: %v", err)
 		}
 	}
-
-	return &setting, nil
+	return nil
 }
 
-func minMaxParse(buySell string, reader *bufio.Reader) (config.MinMax, error) {
-	resp := config.MinMax{}
-	fmt.Printf("What is the maximum %s size? eg 1\n", buySell)
-	parseNum := quickParse(reader)
-	if parseNum != "" {
-		f, err := strconv.ParseFloat(parseNum, 64)
-		if err != nil {
-			return resp, err
-		}
-		resp.MaximumSize = decimal.NewFromFloat(f)
+func (b *builder) parseLive() {
+	ds := &b.cfg.DataSettings
+	if ds.LiveData == nil {
+		ds.LiveData = &config.LiveData{}
 	}
-	fmt.Printf("What is the minimum %s size? eg 0.1\n", buySell)
-	parseNum = quickParse(reader)
-	if parseNum != "" {
-		f, err := strconv.ParseFloat(parseNum, 64)
-		if err != nil {
-			return resp, err
-		}
-		resp.MinimumSize = decimal.NewFromFloat(f)
+	live := ds.LiveData
+	live.RealOrders = askYesNo(b.reader, "Do you wish to use live trading? It's highly recommended that you do not.", live.RealOrders)
+	if !live.RealOrders {
+		return
 	}
-	fmt.Printf("What is the maximum spend %s buy? eg 12000\n", buySell)
-	parseNum = quickParse(reader)
-	if parseNum != "" {
-		f, err := strconv.ParseFloat(parseNum, 64)
-		if err != nil {
-			return resp, err
+	for {
+		items := make([]string, len(live.ExchangeCredentials))
+		for i := range live.ExchangeCredentials {
+			items[i] = live.ExchangeCredentials[i].Exchange
 		}
-		resp.MaximumTotal = decimal.NewFromFloat(f)
+		action, index := b.editList("exchange credentials", items)
+		switch action {
+		case doneItem:
+			return
+		case removeItem:
+			live.ExchangeCredentials = append(live.ExchangeCredentials[:index], live.ExchangeCredentials[index+1:]...)
+			continue
+		case addItem:
+			live.ExchangeCredentials = append(live.ExchangeCredentials, config.Credentials{})
+		}
+		creds := &live.ExchangeCredentials[index]
+		creds.Exchange = b.askExchange(creds.Exchange)
+		creds.Keys.Key = askSecret(b.reader, "What is the API key?", creds.Keys.Key)
+		creds.Keys.Secret = askSecret(b.reader, "What is the API secret?", creds.Keys.Secret)
+		creds.Keys.ClientID = askSecret(b.reader, "What is the Client ID? (leave blank if not applicable)", creds.Keys.ClientID)
+		creds.Keys.OneTimePassword = askSecret(b.reader, "What is the 2FA seed? (leave blank if not applicable)", creds.Keys.OneTimePassword)
+		creds.Keys.SubAccount = askString(b.reader, "What is the subaccount to use? (leave blank if not applicable)", creds.Keys.SubAccount)
+		creds.Keys.PEMKey = askSecret(b.reader, "What is the PEM key? (leave blank if not applicable)", creds.Keys.PEMKey)
 	}
-
-	return resp, nil
 }
 
-func quickParse(reader *bufio.Reader) string {
-	customSettingField, err := reader.ReadString('\n')
-	if err != nil {
-		log.Fatal(err)
-	}
-	customSettingField = strings.Replace(customSettingField, "\r", "", -1)
-	return strings.Replace(customSettingField, "\n", "", -1)
+func (b *builder) parseStatisticsSettings() error {
+	b.cfg.StatisticSettings.RiskFreeRate = askDecimal(b.reader, "Enter the risk free rate. eg 0.03", b.cfg.StatisticSettings.RiskFreeRate, nil)
+	return nil
 }
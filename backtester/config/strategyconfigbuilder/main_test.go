@@ -0,0 +1,311 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func newTestBuilder(input string) *builder {
+	return &builder{
+		reader: bufio.NewReader(strings.NewReader(input)),
+		meta:   newExchangeMetadata(true),
+		cfg:    &config.Config{},
+	}
+}
+
+func TestParseOption(t *testing.T) {
+	t.Parallel()
+	options := []string{"API", "CSV"}
+	i, err := parseOption("2", options)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if i != 1 {
+		t.Errorf("received '%v' expected '%v'", i, 1)
+	}
+	i, err = parseOption("api", options)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if i != 0 {
+		t.Errorf("received '%v' expected '%v'", i, 0)
+	}
+	_, err = parseOption("3", options)
+	if !errors.Is(err, errUnknownOption) {
+		t.Errorf("received '%v' expected '%v'", err, errUnknownOption)
+	}
+	_, err = parseOption("Database", options)
+	if !errors.Is(err, errUnknownOption) {
+		t.Errorf("received '%v' expected '%v'", err, errUnknownOption)
+	}
+}
+
+func TestAskUntilValid(t *testing.T) {
+	t.Parallel()
+	reader := bufio.NewReader(strings.NewReader("maybe\ny\n"))
+	if !askYesNo(reader, "test", false) {
+		t.Error("expected invalid answer to be asked again")
+	}
+	reader = bufio.NewReader(strings.NewReader("\n"))
+	if !askYesNo(reader, "test", true) {
+		t.Error("expected blank answer to keep current value")
+	}
+
+	reader = bufio.NewReader(strings.NewReader("abc\n-1\n1.5\n"))
+	d := askDecimal(reader, "test", decimal.Zero, notNegative)
+	if !d.Equal(decimal.NewFromFloat(1.5)) {
+		t.Errorf("received '%v' expected '%v'", d, 1.5)
+	}
+
+	reader = bufio.NewReader(strings.NewReader("\n"))
+	leet := decimal.NewFromInt(1337)
+	resp := askOptionalDecimal(reader, "test", &leet, nil)
+	if resp == nil || !resp.Equal(leet) {
+		t.Errorf("received '%v' expected '%v'", resp, leet)
+	}
+	reader = bufio.NewReader(strings.NewReader("\n"))
+	if resp = askOptionalDecimal(reader, "test", nil, nil); resp != nil {
+		t.Errorf("received '%v' expected '%v'", resp, nil)
+	}
+	// kept values are validated again
+	reader = bufio.NewReader(strings.NewReader("\n\n5\n"))
+	resp = askOptionalDecimal(reader, "test", &leet, func(d *decimal.Decimal) error {
+		if d == nil || d.GreaterThan(decimal.NewFromInt(10)) {
+			return errUnknownOption
+		}
+		return nil
+	})
+	if resp == nil || !resp.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received '%v' expected '%v'", resp, 5)
+	}
+
+	reader = bufio.NewReader(strings.NewReader("\nbinance\n"))
+	if s := askRequiredString(reader, "test", ""); s != "binance" {
+		t.Errorf("received '%v' expected '%v'", s, "binance")
+	}
+}
+
+func TestListTemplates(t *testing.T) {
+	t.Parallel()
+	_, err := listTemplates(t.TempDir())
+	if !errors.Is(err, errNoTemplates) {
+		t.Errorf("received '%v' expected '%v'", err, errNoTemplates)
+	}
+	templates, err := listTemplates(filepath.Join("..", "strategyexamples"))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	for i := range templates {
+		if filepath.Ext(templates[i]) != "."+stratExtension {
+			t.Errorf("received '%v' expected a %v file", templates[i], stratExtension)
+		}
+	}
+}
+
+func TestParseCustomSettings(t *testing.T) {
+	t.Parallel()
+	b := newTestBuilder("80\n\nabc\n10\n")
+	b.cfg.StrategySettings.CustomSettings = map[string]interface{}{
+		"rsi-low":     25.0,
+		"unsupported": true,
+	}
+	strat, err := strategies.LoadStrategyByName("rsi", false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = b.parseCustomSettings(strat)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expected := map[string]interface{}{
+		"rsi-high":   80.0,
+		"rsi-low":    25.0,
+		"rsi-period": 10.0,
+	}
+	if len(b.cfg.StrategySettings.CustomSettings) != len(expected) {
+		t.Errorf("received '%v' expected '%v'", b.cfg.StrategySettings.CustomSettings, expected)
+	}
+	for k, v := range expected {
+		if b.cfg.StrategySettings.CustomSettings[k] != v {
+			t.Errorf("received '%v' expected '%v' for %v", b.cfg.StrategySettings.CustomSettings[k], v, k)
+		}
+	}
+
+	// grid verifies its settings, levels must be at least 2
+	b = newTestBuilder("\n\n\n1\n\n\n\n\n\n")
+	strat, err = strategies.LoadStrategyByName("grid", false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = b.parseCustomSettings(strat)
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+
+	b = newTestBuilder("")
+	strat, err = strategies.LoadStrategyByName("dollarcostaverage", false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	b.cfg.StrategySettings.CustomSettings = map[string]interface{}{"unsupported": true}
+	err = b.parseCustomSettings(strat)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if b.cfg.StrategySettings.CustomSettings != nil {
+		t.Errorf("received '%v' expected '%v'", b.cfg.StrategySettings.CustomSettings, nil)
+	}
+}
+
+func TestParseAdditionalSetting(t *testing.T) {
+	t.Parallel()
+	if v := parseAdditionalSetting("1.5"); v != 1.5 {
+		t.Errorf("received '%v' expected '%v'", v, 1.5)
+	}
+	if v := parseAdditionalSetting("True"); v != true {
+		t.Errorf("received '%v' expected '%v'", v, true)
+	}
+	if v := parseAdditionalSetting("script.gct"); v != "script.gct" {
+		t.Errorf("received '%v' expected '%v'", v, "script.gct")
+	}
+}
+
+func TestParseCurrencySetting(t *testing.T) {
+	t.Parallel()
+	// binance, spot, BTC, USDT, no base funds, no quote funds which is
+	// asked again then 1000 quote funds, no fees, no buy or sell limits,
+	// no exchange limits, shrink to candle volume, slippage from 100 to 80
+	// which is asked again then 120, optimistic fills
+	b := newTestBuilder("binance\nspot\nbtc\nusdt\n\n\n1000\nn\nn\nn\nn\ny\ny\n100\n80\n120\noptimistic\n")
+	cs := &config.CurrencySettings{}
+	err := b.parseCurrencySetting(cs)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if cs.ExchangeName != "binance" || cs.Asset != asset.Spot || !cs.Base.Equal(currency.BTC) || !cs.Quote.Equal(currency.USDT) {
+		t.Errorf("received '%v %v %v-%v' expected '%v'", cs.ExchangeName, cs.Asset, cs.Base, cs.Quote, "binance spot BTC-USDT")
+	}
+	if cs.SpotDetails == nil || cs.SpotDetails.InitialQuoteFunds == nil || !cs.SpotDetails.InitialQuoteFunds.Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("received '%v' expected '%v'", cs.SpotDetails, 1000)
+	}
+	if cs.SkipCandleVolumeFitting {
+		t.Error("expected candle volume fitting")
+	}
+	expectAllAnswersUsed(t, b)
+	if !cs.MinimumSlippagePercent.Equal(decimal.NewFromInt(100)) || !cs.MaximumSlippagePercent.Equal(decimal.NewFromInt(120)) {
+		t.Errorf("received '%v-%v' expected '%v-%v'", cs.MinimumSlippagePercent, cs.MaximumSlippagePercent, 100, 120)
+	}
+	if cs.IntrabarFillAssumption != config.OptimisticFills {
+		t.Errorf("received '%v' expected '%v'", cs.IntrabarFillAssumption, config.OptimisticFills)
+	}
+
+	// an invalid fill assumption is asked again rather than kept
+	b = newTestBuilder(strings.Repeat("\n", 15) + "pessimistic\n")
+	cs.IntrabarFillAssumption = "hopeful"
+	err = b.parseCurrencySetting(cs)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if cs.IntrabarFillAssumption != config.PessimisticFills {
+		t.Errorf("received '%v' expected '%v'", cs.IntrabarFillAssumption, config.PessimisticFills)
+	}
+	expectAllAnswersUsed(t, b)
+}
+
+// expectAllAnswersUsed ensures the prompts asked match the answers given
+func expectAllAnswersUsed(t *testing.T, b *builder) {
+	t.Helper()
+	if _, err := b.reader.ReadByte(); err == nil {
+		t.Error("expected all answers to be used")
+	}
+}
+
+func TestIntervals(t *testing.T) {
+	t.Parallel()
+	meta := newExchangeMetadata(true)
+	intervals := meta.intervals([]string{"unsupported"}, false)
+	if len(intervals) != len(gctkline.SupportedIntervals) {
+		t.Errorf("received '%v' expected '%v'", len(intervals), len(gctkline.SupportedIntervals))
+	}
+	intervals = meta.intervals([]string{"binance"}, true)
+	var found bool
+	for i := range intervals {
+		if intervals[i] == gctkline.OneHour {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected binance to support %v", gctkline.OneHour)
+	}
+	_, err := meta.pairs("binance", asset.Spot)
+	if !errors.Is(err, errPairsUnavailable) {
+		t.Errorf("received '%v' expected '%v'", err, errPairsUnavailable)
+	}
+}
+
+func TestSearchPairs(t *testing.T) {
+	t.Parallel()
+	pairs := currency.Pairs{
+		currency.NewPair(currency.BTC, currency.USDT),
+		currency.NewPair(currency.ETH, currency.USDT),
+		currency.NewPair(currency.ETH, currency.BTC),
+	}
+	if resp := searchPairs(pairs, "usdt"); len(resp) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if resp := searchPairs(pairs, "eth-btc"); len(resp) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(resp), 1)
+	}
+}
+
+func TestParseCurrencySettings(t *testing.T) {
+	t.Parallel()
+	// keeps every answer of a currency setting
+	keep := strings.Repeat("\n", 11)
+	// the first setting's funds are removed, the second setting has an
+	// invalid additional interval so its changes are discarded
+	b := newTestBuilder("Edit\n1\n" + keep + "Edit\n2\n" + keep + "n\nDone\n")
+	quoteFunds := decimal.NewFromInt(1000)
+	b.cfg.FundingSettings.UseExchangeLevelFunding = true
+	b.cfg.CurrencySettings = []config.CurrencySettings{
+		{
+			ExchangeName: "binance",
+			Asset:        asset.Spot,
+			Base:         currency.BTC,
+			Quote:        currency.USDT,
+			SpotDetails:  &config.SpotDetails{InitialQuoteFunds: &quoteFunds},
+		},
+		{
+			ExchangeName:        "binance",
+			Asset:               asset.Spot,
+			Base:                currency.ETH,
+			Quote:               currency.USDT,
+			AdditionalIntervals: []gctkline.Interval{gctkline.OneDay},
+		},
+	}
+	err := b.parseCurrencySettings()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(b.cfg.CurrencySettings) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(b.cfg.CurrencySettings), 2)
+	}
+	if b.cfg.CurrencySettings[0].SpotDetails.InitialQuoteFunds != nil {
+		t.Errorf("received '%v' expected '%v'", b.cfg.CurrencySettings[0].SpotDetails.InitialQuoteFunds, nil)
+	}
+	if len(b.cfg.CurrencySettings[1].AdditionalIntervals) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(b.cfg.CurrencySettings[1].AdditionalIntervals), 1)
+	}
+	expectAllAnswersUsed(t, b)
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// pairRequestTimeout limits how long the builder waits
+// for an exchange to return its tradable pairs
+const pairRequestTimeout = 30 * time.Second
+
+var errPairsUnavailable = errors.New("tradable pairs unavailable")
+
+// exchangeMetadata loads exchange details so that supported exchanges,
+// assets, pairs and intervals can be listed rather than typed from memory
+type exchangeMetadata struct {
+	offline     bool
+	manager     *engine.ExchangeManager
+	pairsLoaded map[string]error
+}
+
+func newExchangeMetadata(offline bool) *exchangeMetadata {
+	return &exchangeMetadata{
+		offline:     offline,
+		manager:     engine.NewExchangeManager(),
+		pairsLoaded: make(map[string]error),
+	}
+}
+
+// exchanges returns the names of all supported exchanges
+func (e *exchangeMetadata) exchanges() []string {
+	return exchange.Exchanges
+}
+
+// load returns an exchange with its defaults set, reusing previously loaded exchanges
+func (e *exchangeMetadata) load(name string) (exchange.IBotExchange, error) {
+	exch, err := e.manager.GetExchangeByName(name)
+	if err == nil {
+		return exch, nil
+	}
+	if !errors.Is(err, engine.ErrExchangeNotFound) {
+		return nil, err
+	}
+	exch, err = e.manager.NewExchangeByName(name)
+	if err != nil {
+		return nil, err
+	}
+	exch.SetDefaults()
+	exchBase := exch.GetBase()
+	exchBase.Config = &gctconfig.Exchange{
+		Name:           exchBase.Name,
+		HTTPTimeout:    exchange.DefaultHTTPTimeout,
+		BaseCurrencies: exchBase.BaseCurrencies,
+		CurrencyPairs:  &currency.PairsManager{},
+	}
+	err = e.manager.Add(exch)
+	if err != nil {
+		return nil, err
+	}
+	return exch, nil
+}
+
+// assets returns the asset types supported by an exchange
+func (e *exchangeMetadata) assets(name string) (asset.Items, error) {
+	exch, err := e.load(name)
+	if err != nil {
+		return nil, err
+	}
+	return exch.GetBase().GetAssetTypes(false), nil
+}
+
+// pairs returns the tradable pairs of an exchange asset. Pairs are
+// requested from the exchange once and are unavailable when offline
+func (e *exchangeMetadata) pairs(name string, a asset.Item) (currency.Pairs, error) {
+	if e.offline {
+		return nil, fmt.Errorf("%w offline", errPairsUnavailable)
+	}
+	exch, err := e.load(name)
+	if err != nil {
+		return nil, err
+	}
+	loadErr, ok := e.pairsLoaded[exch.GetName()]
+	if !ok {
+		ctx, cancel := context.WithTimeout(context.Background(), pairRequestTimeout)
+		loadErr = exch.UpdateTradablePairs(ctx, true)
+		cancel()
+		e.pairsLoaded[exch.GetName()] = loadErr
+	}
+	if loadErr != nil {
+		return nil, fmt.Errorf("%w %v", errPairsUnavailable, loadErr)
+	}
+	return exch.GetBase().GetAvailablePairs(a)
+}
+
+// intervals returns the candle intervals every named exchange can provide.
+// Live data requires intervals the exchanges support directly, other data
+// can be built from smaller intervals
+func (e *exchangeMetadata) intervals(names []string, live bool) []gctkline.Interval {
+	resp := make([]gctkline.Interval, 0, len(gctkline.SupportedIntervals))
+	var exchs []exchange.IBotExchange
+	for i := range names {
+		exch, err := e.load(names[i])
+		if err != nil {
+			continue
+		}
+		exchs = append(exchs, exch)
+	}
+	if len(exchs) == 0 {
+		return append(resp, gctkline.SupportedIntervals...)
+	}
+intervals:
+	for i := range gctkline.SupportedIntervals {
+		for j := range exchs {
+			supported := &exchs[j].GetBase().Features.Enabled.Kline.Intervals
+			if live {
+				if !supported.ExchangeSupported(gctkline.SupportedIntervals[i]) {
+					continue intervals
+				}
+				continue
+			}
+			if _, err := supported.Construct(gctkline.SupportedIntervals[i]); err != nil {
+				continue intervals
+			}
+		}
+		resp = append(resp, gctkline.SupportedIntervals[i])
+	}
+	return resp
+}
+
+// searchPairs returns the pairs which contain the search term,
+// ignoring case and delimiters
+func searchPairs(pairs currency.Pairs, search string) currency.Pairs {
+	search = strings.ToLower(stripDelimiters(search))
+	var resp currency.Pairs
+	for i := range pairs {
+		if strings.Contains(pairs[i].Base.Lower().String()+pairs[i].Quote.Lower().String(), search) {
+			resp = append(resp, pairs[i])
+		}
+	}
+	return resp
+}
+
+func stripDelimiters(s string) string {
+	return strings.NewReplacer(currency.DashDelimiter, "", currency.UnderscoreDelimiter, "", currency.ForwardSlashDelimiter, "", " ", "").Replace(s)
+}
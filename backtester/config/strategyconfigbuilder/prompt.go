@@ -0,0 +1,270 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+)
+
+var (
+	errUnknownOption      = errors.New("unknown option")
+	errNegativeValue      = errors.New("value cannot be negative")
+	errAnswerRequired     = errors.New("an answer is required")
+	errUnrecognisedAnswer = errors.New("unrecognised answer, please enter y or n")
+)
+
+// withCurrent adds the value kept by a blank answer to a question
+func withCurrent(question, current string) string {
+	if current == "" {
+		return question
+	}
+	return fmt.Sprintf("%s Leave blank to keep \"%v\"", question, current)
+}
+
+// askUntilValid repeats a question until apply accepts the answer.
+// A blank answer keeps the current value when there is one, which is
+// applied again so that a kept value is still validated
+func askUntilValid(reader *bufio.Reader, question, current string, apply func(string) error) {
+	for {
+		fmt.Println(withCurrent(question, current))
+		resp := quickParse(reader)
+		if resp == "" {
+			resp = current
+		}
+		err := apply(resp)
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		return
+	}
+}
+
+// askString returns the answer to a question or the current value when left blank
+func askString(reader *bufio.Reader, question, current string) string {
+	askUntilValid(reader, question, current, func(resp string) error {
+		current = resp
+		return nil
+	})
+	return current
+}
+
+// askRequiredString returns a non-empty answer to a question
+func askRequiredString(reader *bufio.Reader, question, current string) string {
+	askUntilValid(reader, question, current, func(resp string) error {
+		if resp == "" {
+			return errAnswerRequired
+		}
+		current = resp
+		return nil
+	})
+	return current
+}
+
+// askYesNo returns the answer to a y/n question
+func askYesNo(reader *bufio.Reader, question string, current bool) bool {
+	currentAnswer := "n"
+	if current {
+		currentAnswer = y
+	}
+	askUntilValid(reader, question+" y/n", currentAnswer, func(resp string) error {
+		switch strings.ToLower(resp) {
+		case y, yes:
+			current = true
+		case n, no:
+			current = false
+		default:
+			return errUnrecognisedAnswer
+		}
+		return nil
+	})
+	return current
+}
+
+// askOption lists the options and returns the one selected by number or name
+func askOption(reader *bufio.Reader, question string, options []string, current string) string {
+	printOptions(question, options)
+	askUntilValid(reader, "Select an option by number or name.", current, func(resp string) error {
+		i, err := parseOption(resp, options)
+		if err != nil {
+			return err
+		}
+		current = options[i]
+		return nil
+	})
+	return current
+}
+
+// askIndex lists the options and returns the index of the one selected
+func askIndex(reader *bufio.Reader, question string, options []string) int {
+	printOptions(question, options)
+	var resp int
+	askUntilValid(reader, "Select an option by number or name.", "", func(answer string) error {
+		i, err := parseOption(answer, options)
+		if err != nil {
+			return err
+		}
+		resp = i
+		return nil
+	})
+	return resp
+}
+
+func printOptions(question string, options []string) {
+	fmt.Println(question)
+	for i := range options {
+		fmt.Printf("%v. %s\n", i+1, options[i])
+	}
+}
+
+// parseOption matches a numbered or named response against the options
+// and returns the index of the option
+func parseOption(resp string, options []string) (int, error) {
+	num, err := strconv.ParseInt(resp, 10, 64)
+	if err == nil {
+		if num > int64(len(options)) || num <= 0 {
+			return 0, fmt.Errorf("%w %v", errUnknownOption, resp)
+		}
+		return int(num - 1), nil
+	}
+	for i := range options {
+		if strings.EqualFold(resp, options[i]) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("%w %v", errUnknownOption, resp)
+}
+
+// askSecret returns the answer to a question without showing the current value
+func askSecret(reader *bufio.Reader, question, current string) string {
+	if current != "" {
+		question += " Leave blank to keep the current value"
+	}
+	fmt.Println(question)
+	if resp := quickParse(reader); resp != "" {
+		return resp
+	}
+	return current
+}
+
+// askDecimal returns a number which passes validation
+func askDecimal(reader *bufio.Reader, question string, current decimal.Decimal, validate func(decimal.Decimal) error) decimal.Decimal {
+	askUntilValid(reader, question, current.String(), func(resp string) error {
+		d, err := decimal.NewFromString(resp)
+		if err != nil {
+			return err
+		}
+		if validate != nil {
+			err = validate(d)
+			if err != nil {
+				return err
+			}
+		}
+		current = d
+		return nil
+	})
+	return current
+}
+
+// askOptionalDecimal returns a non-negative number, or nil when no number
+// is entered and there is no current value. Both are checked by validate
+func askOptionalDecimal(reader *bufio.Reader, question string, current *decimal.Decimal, validate func(*decimal.Decimal) error) *decimal.Decimal {
+	var currentAnswer string
+	if current != nil {
+		currentAnswer = current.String()
+	}
+	askUntilValid(reader, question, currentAnswer, func(resp string) error {
+		var answer *decimal.Decimal
+		if resp != "" {
+			d, err := decimal.NewFromString(resp)
+			if err != nil {
+				return err
+			}
+			err = notNegative(d)
+			if err != nil {
+				return err
+			}
+			answer = &d
+		}
+		if validate != nil {
+			err := validate(answer)
+			if err != nil {
+				return err
+			}
+		}
+		current = answer
+		return nil
+	})
+	return current
+}
+
+// askInt returns a whole number which passes validation
+func askInt(reader *bufio.Reader, question string, current int64, validate func(int64) error) int64 {
+	askUntilValid(reader, question, strconv.FormatInt(current, 10), func(resp string) error {
+		i, err := strconv.ParseInt(resp, 10, 64)
+		if err != nil {
+			return err
+		}
+		if validate != nil {
+			err = validate(i)
+			if err != nil {
+				return err
+			}
+		}
+		current = i
+		return nil
+	})
+	return current
+}
+
+// askTime returns a time entered in the simple time format
+func askTime(reader *bufio.Reader, question string, current time.Time) time.Time {
+	askUntilValid(reader, question, current.Format(gctcommon.SimpleTimeFormat), func(resp string) error {
+		t, err := time.Parse(gctcommon.SimpleTimeFormat, resp)
+		if err != nil {
+			return err
+		}
+		current = t
+		return nil
+	})
+	return current
+}
+
+// askDuration returns a non-negative duration such as 30s
+func askDuration(reader *bufio.Reader, question string, current time.Duration) time.Duration {
+	askUntilValid(reader, question, current.String(), func(resp string) error {
+		d, err := time.ParseDuration(resp)
+		if err != nil {
+			return err
+		}
+		if d < 0 {
+			return fmt.Errorf("%w %v", errNegativeValue, d)
+		}
+		current = d
+		return nil
+	})
+	return current
+}
+
+func notNegative(d decimal.Decimal) error {
+	if d.IsNegative() {
+		return fmt.Errorf("%w %v", errNegativeValue, d)
+	}
+	return nil
+}
+
+func quickParse(reader *bufio.Reader) string {
+	customSettingField, err := reader.ReadString('\n')
+	if err != nil {
+		log.Fatal(err)
+	}
+	customSettingField = strings.Replace(customSettingField, "\r", "", -1)
+	return strings.TrimSpace(strings.Replace(customSettingField, "\n", "", -1))
+}
@@ -878,6 +878,10 @@ func (f fakeHandler) HasDataAtTime(time.Time) (bool, error) {
 	return false, nil
 }
 
+func (f fakeHandler) IntervalHistory(gctkline.Interval) (Events, error) {
+	return nil, nil
+}
+
 func (f fakeHandler) Reset() error {
 	return nil
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 )
 
 var (
@@ -80,6 +81,7 @@ type Streamer interface {
 	StreamVol() ([]decimal.Decimal, error)
 
 	HasDataAtTime(time.Time) (bool, error)
+	IntervalHistory(kline.Interval) (Events, error)
 }
 
 // Event interface used for loading and interacting with Data
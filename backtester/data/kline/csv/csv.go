@@ -6,21 +6,34 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
-var errNoUSDData = errors.New("could not retrieve USD CSV candle data")
+const (
+	orderbookSnapshot = "snapshot"
+	orderbookUpdate   = "update"
+)
+
+var (
+	errNoUSDData              = errors.New("could not retrieve USD CSV candle data")
+	errInvalidOrderbookAction = errors.New("invalid orderbook action, expected snapshot or update")
+	errInvalidOrderbookRow    = errors.New("invalid orderbook row")
+	errInvalidFundingRateRow  = errors.New("invalid funding rate row")
+)
 
 // LoadData is a basic csv reader which converts the found CSV file into a kline item
 func LoadData(dataType int64, filepath, exchangeName string, interval time.Duration, fPair currency.Pair, a asset.Item, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
@@ -150,6 +163,94 @@ func LoadData(dataType int64, filepath, exchangeName string, interval time.Durat
 		if err != nil {
 			return nil, fmt.Errorf("could not read csv trade data for %v %v %v, %v", exchangeName, a, fPair, err)
 		}
+	case common.DataOrderbook:
+		var updates []kline.OrderbookUpdate
+		for {
+			row, errCSV := csvData.Read()
+			if errCSV != nil {
+				if errCSV == io.EOF {
+					break
+				}
+				return nil, errCSV
+			}
+
+			if len(row) < 5 {
+				err = fmt.Errorf("%w, expected 5 fields and received %v", errInvalidOrderbookRow, len(row))
+				break
+			}
+			v, errParse := strconv.ParseInt(row[0], 10, 64)
+			if errParse != nil {
+				return nil, errParse
+			}
+			tt := time.UnixMilli(v).UTC()
+
+			var snapshot bool
+			switch strings.ToLower(row[1]) {
+			case orderbookSnapshot:
+				snapshot = true
+			case orderbookUpdate:
+			default:
+				err = fmt.Errorf("%w %v", errInvalidOrderbookAction, row[1])
+			}
+			if err != nil {
+				break
+			}
+
+			var side order.Side
+			side, err = order.StringToOrderSide(row[2])
+			if err != nil {
+				err = fmt.Errorf("could not process orderbook side %v, %v", row[2], err)
+				break
+			}
+
+			var item gctorderbook.Item
+			item.Price, err = strconv.ParseFloat(row[3], 64)
+			if err != nil {
+				err = fmt.Errorf("could not process orderbook price %v, %v", row[3], err)
+				break
+			}
+
+			item.Amount, err = strconv.ParseFloat(row[4], 64)
+			if err != nil {
+				err = fmt.Errorf("could not process orderbook amount %v, %v", row[4], err)
+				break
+			}
+
+			// consecutive rows of the same time and action form one update
+			if len(updates) == 0 ||
+				!updates[len(updates)-1].Time.Equal(tt) ||
+				updates[len(updates)-1].Snapshot != snapshot {
+				updates = append(updates, kline.OrderbookUpdate{
+					Time:     tt,
+					Snapshot: snapshot,
+				})
+			}
+			update := &updates[len(updates)-1]
+			switch side {
+			case order.Bid, order.Buy:
+				update.Bids = append(update.Bids, item)
+			case order.Ask, order.Sell:
+				update.Asks = append(update.Asks, item)
+			default:
+				err = fmt.Errorf("could not process orderbook side %v, %w", row[2], order.ErrSideIsInvalid)
+			}
+			if err != nil {
+				break
+			}
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read csv orderbook data for %v %v %v, %w", exchangeName, a, fPair, err)
+		}
+		resp.Item = &gctkline.Item{
+			Exchange: exchangeName,
+			Pair:     fPair,
+			Asset:    a,
+			Interval: gctkline.Interval(interval),
+		}
+		err = resp.ReplayOrderbook(updates)
+		if err != nil {
+			return nil, fmt.Errorf("could not replay csv orderbook data for %v %v %v, %w", exchangeName, a, fPair, err)
+		}
 	default:
 		if isUSDTrackingPair {
 			return nil, fmt.Errorf("%w for %v %v %v. Please add USD pair data to your CSV or set `disable-usd-tracking` to `true` in your config. %v", errNoUSDData, exchangeName, a, fPair, err)
@@ -163,3 +264,50 @@ func LoadData(dataType int64, filepath, exchangeName string, interval time.Durat
 
 	return resp, nil
 }
+
+// LoadFundingRates reads historical funding rates from a CSV file. Each row
+// is a unix timestamp in seconds followed by the funding rate, eg 0.0001 is
+// 0.01%. Rates are returned in time order
+func LoadFundingRates(filepath string) ([]order.FundingRate, error) {
+	csvFile, err := os.Open(filepath)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = csvFile.Close()
+		if err != nil {
+			log.Errorln(common.Data, err)
+		}
+	}()
+
+	var resp []order.FundingRate
+	csvData := csv.NewReader(csvFile)
+	for {
+		row, errCSV := csvData.Read()
+		if errCSV != nil {
+			if errCSV == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("could not read csv funding rates %v, %w", filepath, errCSV)
+		}
+		if len(row) < 2 {
+			return nil, fmt.Errorf("%w %v, expected a timestamp and rate", errInvalidFundingRateRow, row)
+		}
+		v, errParse := strconv.ParseInt(row[0], 10, 64)
+		if errParse != nil {
+			return nil, fmt.Errorf("could not process funding rate timestamp %v, %w", row[0], errParse)
+		}
+		rate, errParse := decimal.NewFromString(row[1])
+		if errParse != nil {
+			return nil, fmt.Errorf("could not process funding rate %v, %w", row[1], errParse)
+		}
+		resp = append(resp, order.FundingRate{
+			Time: time.Unix(v, 0).UTC(),
+			Rate: rate,
+		})
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Time.Before(resp[j].Time)
+	})
+	return resp, nil
+}
@@ -2,9 +2,11 @@ package csv
 
 import (
 	"errors"
+	"os"
 	"path/filepath"
 	"testing"
 
+	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -47,6 +49,41 @@ func TestLoadDataTrades(t *testing.T) {
 	}
 }
 
+func TestLoadDataOrderbook(t *testing.T) {
+	exch := testExchange
+	a := asset.Spot
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	resp, err := LoadData(
+		common.DataOrderbook,
+		filepath.Join("..", "..", "..", "..", "testdata", "binance_BTCUSDT_orderbook_2020_11_16.csv"),
+		exch,
+		gctkline.OneMin.Duration(),
+		p,
+		a,
+		false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(resp.Item.Candles) != 60 {
+		t.Errorf("received: %v, expected: %v", len(resp.Item.Candles), 60)
+	}
+	if len(resp.Orderbooks) != len(resp.Item.Candles) {
+		t.Errorf("received: %v, expected: %v", len(resp.Orderbooks), len(resp.Item.Candles))
+	}
+
+	_, err = LoadData(
+		common.DataOrderbook,
+		filepath.Join("..", "..", "..", "..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv"),
+		exch,
+		gctkline.OneMin.Duration(),
+		p,
+		a,
+		false)
+	if !errors.Is(err, errInvalidOrderbookRow) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidOrderbookRow)
+	}
+}
+
 func TestLoadDataInvalid(t *testing.T) {
 	exch := testExchange
 	a := asset.Spot
@@ -75,3 +112,40 @@ func TestLoadDataInvalid(t *testing.T) {
 		t.Errorf("received: %v, expected: %v", err, errNoUSDData)
 	}
 }
+
+func TestLoadFundingRates(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rates.csv")
+	err := os.WriteFile(path, []byte("1605513600,0.0002\n1605484800,0.0001\n"), 0o600)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	rates, err := LoadFundingRates(path)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(rates) != 2 {
+		t.Fatalf("received: %v, expected: %v", len(rates), 2)
+	}
+	if !rates[0].Time.Before(rates[1].Time) {
+		t.Errorf("received: %v, expected rates sorted by time", rates)
+	}
+	if !rates[0].Rate.Equal(decimal.NewFromFloat(0.0001)) {
+		t.Errorf("received: %v, expected: %v", rates[0].Rate, 0.0001)
+	}
+
+	err = os.WriteFile(path, []byte("1605513600\n"), 0o600)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	_, err = LoadFundingRates(path)
+	if !errors.Is(err, errInvalidFundingRateRow) {
+		t.Errorf("received: %v, expected: %v", err, errInvalidFundingRateRow)
+	}
+
+	_, err = LoadFundingRates(filepath.Join(dir, "missing.csv"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("received: %v, expected: %v", err, os.ErrNotExist)
+	}
+}
@@ -2,14 +2,19 @@ package kline
 
 import (
 	"fmt"
+	"math"
+	"sort"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/orderbook"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 // NewDataFromKline returns a new struct
@@ -70,12 +75,191 @@ func (d *DataFromKline) Load() error {
 			Volume:           decimal.NewFromFloat(d.Item.Candles[i].Volume),
 			ValidationIssues: d.Item.Candles[i].ValidationIssues,
 		}
+		if book, ok := d.Orderbooks[newKline.Time.UnixNano()]; ok {
+			klineData[i] = &orderbook.Orderbook{
+				Kline: newKline,
+				Book:  book,
+			}
+			continue
+		}
 		klineData[i] = newKline
 	}
 
+	err := d.resample()
+	if err != nil {
+		return err
+	}
 	return d.SetStream(klineData)
 }
 
+// resample builds candles for each additional interval from the loaded
+// candles. Candles are aligned to the start of each interval and any
+// interval which started before the first loaded candle is dropped
+func (d *DataFromKline) resample() error {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if len(d.AdditionalIntervals) == 0 {
+		d.resampled = nil
+		return nil
+	}
+	resampled := make(map[gctkline.Interval][]data.Event, len(d.AdditionalIntervals))
+	for _, interval := range d.AdditionalIntervals {
+		if interval <= d.Item.Interval || d.Item.Interval <= 0 || interval%d.Item.Interval != 0 {
+			return fmt.Errorf("%w %v, must be a larger multiple of %v", errInvalidInterval, interval, d.Item.Interval)
+		}
+		var events []data.Event
+		var current *kline.Kline
+		for i := range d.Item.Candles {
+			c := &d.Item.Candles[i]
+			if c.Open == 0 && c.Close == 0 {
+				// padding, not data
+				continue
+			}
+			start := c.Time.UTC().Truncate(interval.Duration())
+			if start.Before(d.Item.Candles[0].Time) {
+				continue
+			}
+			if current == nil || !current.Time.Equal(start) {
+				current = &kline.Kline{
+					Base: &event.Base{
+						Offset:         int64(len(events) + 1),
+						Exchange:       d.Item.Exchange,
+						Time:           start,
+						Interval:       interval,
+						CurrencyPair:   d.Item.Pair,
+						AssetType:      d.Item.Asset,
+						UnderlyingPair: d.Item.UnderlyingPair,
+					},
+					Open:   decimal.NewFromFloat(c.Open),
+					High:   decimal.NewFromFloat(c.High),
+					Low:    decimal.NewFromFloat(c.Low),
+					Close:  decimal.NewFromFloat(c.Close),
+					Volume: decimal.NewFromFloat(c.Volume),
+				}
+				events = append(events, current)
+				continue
+			}
+			current.High = decimal.Max(current.High, decimal.NewFromFloat(c.High))
+			current.Low = decimal.Min(current.Low, decimal.NewFromFloat(c.Low))
+			current.Close = decimal.NewFromFloat(c.Close)
+			current.Volume = current.Volume.Add(decimal.NewFromFloat(c.Volume))
+		}
+		resampled[interval] = events
+	}
+	d.resampled = resampled
+	return nil
+}
+
+// IntervalHistory returns the candles of an additional interval which have
+// closed by the end of the latest candle. Candles which are still forming
+// are not returned so strategies cannot see into the future
+func (d *DataFromKline) IntervalHistory(interval gctkline.Interval) (data.Events, error) {
+	latest, err := d.Latest()
+	if err != nil {
+		return nil, err
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	events, ok := d.resampled[interval]
+	if !ok {
+		return nil, fmt.Errorf("%w %v", errIntervalNotLoaded, interval)
+	}
+	closed := latest.GetTime().Add(d.Item.Interval.Duration())
+	n := sort.Search(len(events), func(i int) bool {
+		return events[i].GetTime().Add(interval.Duration()).After(closed)
+	})
+	return events[:n], nil
+}
+
+// ReplayOrderbook applies orderbook updates in time order, creating a candle
+// for each interval from the orderbook's mid prices. The state of the
+// orderbook at the close of each candle is stored so that orders can be
+// filled against its liquidity. Updates before the first snapshot are
+// ignored and intervals without updates carry the orderbook forward.
+// Candles created from orderbook data have no volume
+func (d *DataFromKline) ReplayOrderbook(updates []OrderbookUpdate) error {
+	if d.Item == nil {
+		return fmt.Errorf("%w kline item", gctcommon.ErrNilPointer)
+	}
+	interval := d.Item.Interval.Duration()
+	if interval <= 0 {
+		return gctkline.ErrInvalidInterval
+	}
+	if len(updates) == 0 {
+		return errNoOrderbookData
+	}
+	sort.SliceStable(updates, func(i, j int) bool {
+		return updates[i].Time.Before(updates[j].Time)
+	})
+	next := -1
+	for i := range updates {
+		if updates[i].Snapshot {
+			next = i
+			break
+		}
+	}
+	if next == -1 {
+		return errNoOrderbookSnapshot
+	}
+
+	depth := gctorderbook.NewDepth(uuid.Nil)
+	d.Item.Candles = nil
+	d.Orderbooks = make(map[int64]*gctorderbook.Base)
+	var lastClose float64
+	end := updates[len(updates)-1].Time
+	for start := updates[next].Time.Truncate(interval); !start.After(end); start = start.Add(interval) {
+		candle := gctkline.Candle{Time: start.UTC()}
+		hasPrice := len(d.Item.Candles) > 0
+		if hasPrice {
+			candle.Open = lastClose
+			candle.High = lastClose
+			candle.Low = lastClose
+			candle.Close = lastClose
+		}
+		for ; next < len(updates) && updates[next].Time.Before(start.Add(interval)); next++ {
+			if updates[next].Snapshot {
+				updates[next].Bids.SortBids()
+				updates[next].Asks.SortAsks()
+				depth.LoadSnapshot(updates[next].Bids, updates[next].Asks, 0, updates[next].Time, false)
+			} else {
+				depth.UpdateBidAskByPrice(&gctorderbook.Update{
+					UpdateTime: updates[next].Time,
+					Bids:       updates[next].Bids,
+					Asks:       updates[next].Asks,
+				})
+			}
+			mid, err := depth.GetMidPrice()
+			if err != nil {
+				// a side of the orderbook is empty, so there is no price
+				continue
+			}
+			if !hasPrice {
+				candle.Open = mid
+				candle.High = mid
+				candle.Low = mid
+				hasPrice = true
+			}
+			candle.High = math.Max(candle.High, mid)
+			candle.Low = math.Min(candle.Low, mid)
+			candle.Close = mid
+		}
+		if !hasPrice {
+			continue
+		}
+		book, err := depth.Retrieve()
+		if err != nil {
+			return err
+		}
+		d.Orderbooks[candle.Time.UnixNano()] = book
+		d.Item.Candles = append(d.Item.Candles, candle)
+		lastClose = candle.Close
+	}
+	if len(d.Item.Candles) == 0 {
+		return errNoCandleData
+	}
+	return nil
+}
+
 // AppendResults adds a candle item to the data stream and sorts it to ensure it is all in order
 func (d *DataFromKline) AppendResults(ki *gctkline.Item) error {
 	if ki == nil {
@@ -129,6 +313,10 @@ candleLoop:
 
 	d.Item.RemoveDuplicates()
 	d.Item.SortCandlesByTimestamp(false)
+	err = d.resample()
+	if err != nil {
+		return err
+	}
 	if d.RangeHolder != nil {
 		d.RangeHolder, err = gctkline.CalculateCandleDateRanges(d.Item.Candles[0].Time, d.Item.Candles[len(d.Item.Candles)-1].Time.Add(d.Item.Interval.Duration()), d.Item.Interval, uint32(d.RangeHolder.Limit))
 		if err != nil {
@@ -9,10 +9,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/orderbook"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 const testExchange = "binance"
@@ -446,3 +448,202 @@ func TestStreamLow(t *testing.T) {
 		t.Error("expected low")
 	}
 }
+
+func TestReplayOrderbook(t *testing.T) {
+	t.Parallel()
+	d := NewDataFromKline()
+	err := d.ReplayOrderbook(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received: %v, expected: %v", err, gctcommon.ErrNilPointer)
+	}
+	d.Item = &gctkline.Item{
+		Exchange: testExchange,
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+	}
+	err = d.ReplayOrderbook(nil)
+	if !errors.Is(err, gctkline.ErrInvalidInterval) {
+		t.Errorf("received: %v, expected: %v", err, gctkline.ErrInvalidInterval)
+	}
+	d.Item.Interval = gctkline.OneMin
+	err = d.ReplayOrderbook(nil)
+	if !errors.Is(err, errNoOrderbookData) {
+		t.Errorf("received: %v, expected: %v", err, errNoOrderbookData)
+	}
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = d.ReplayOrderbook([]OrderbookUpdate{
+		{Time: tt, Bids: gctorderbook.Items{{Price: 99, Amount: 1}}},
+	})
+	if !errors.Is(err, errNoOrderbookSnapshot) {
+		t.Errorf("received: %v, expected: %v", err, errNoOrderbookSnapshot)
+	}
+
+	err = d.ReplayOrderbook([]OrderbookUpdate{
+		{
+			// updates are sorted by time before being replayed
+			Time: tt.Add(time.Second * 30),
+			Asks: gctorderbook.Items{{Price: 105, Amount: 1}, {Price: 101, Amount: 0}},
+		},
+		{
+			Time:     tt.Add(time.Second * 10),
+			Snapshot: true,
+			Bids:     gctorderbook.Items{{Price: 98, Amount: 2}, {Price: 99, Amount: 1}},
+			Asks:     gctorderbook.Items{{Price: 102, Amount: 2}, {Price: 101, Amount: 1}},
+		},
+		{
+			Time: tt.Add(time.Second * 20),
+			Bids: gctorderbook.Items{{Price: 96, Amount: 1}},
+		},
+		{
+			Time: tt.Add(time.Minute * 2),
+			Bids: gctorderbook.Items{{Price: 100, Amount: 1}},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if len(d.Item.Candles) != 3 {
+		t.Fatalf("received: %v, expected: %v", len(d.Item.Candles), 3)
+	}
+	first := d.Item.Candles[0]
+	if !first.Time.Equal(tt) || first.Open != 100 || first.High != 100.5 || first.Low != 100 || first.Close != 100.5 {
+		t.Errorf("received: %+v, expected: open 100 high 100.5 low 100 close 100.5", first)
+	}
+	// the orderbook carries forward through an interval without updates
+	if d.Item.Candles[1].Open != 100.5 || d.Item.Candles[1].Close != 100.5 {
+		t.Errorf("received: %+v, expected: a flat candle of 100.5", d.Item.Candles[1])
+	}
+	if d.Item.Candles[2].Open != 100.5 || d.Item.Candles[2].Close != 101 {
+		t.Errorf("received: %+v, expected: open 100.5 close 101", d.Item.Candles[2])
+	}
+	book := d.Orderbooks[tt.UnixNano()]
+	if book == nil {
+		t.Fatal("expected orderbook at the close of the first candle")
+	}
+	if len(book.Bids) != 3 || book.Bids[0].Price != 99 {
+		t.Errorf("received: %+v, expected: 3 bids starting at 99", book.Bids)
+	}
+	if len(book.Asks) != 2 || book.Asks[0].Price != 102 {
+		t.Errorf("received: %+v, expected: 2 asks starting at 102", book.Asks)
+	}
+
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	ev, err := d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	ob, ok := ev.(orderbook.Event)
+	if !ok {
+		t.Fatalf("received: %T, expected: orderbook event", ev)
+	}
+	if ob.GetOrderbook() != book {
+		t.Errorf("received: %v, expected: %v", ob.GetOrderbook(), book)
+	}
+}
+
+func TestIntervalHistory(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 20, 0, 0, 0, time.UTC)
+	candles := make([]gctkline.Candle, 30)
+	for i := range candles {
+		candles[i] = gctkline.Candle{
+			Time:   tt.Add(time.Hour * time.Duration(i)),
+			Open:   100 + float64(i),
+			High:   101 + float64(i),
+			Low:    99 + float64(i),
+			Close:  100.5 + float64(i),
+			Volume: 1,
+		}
+	}
+	d := &DataFromKline{
+		Base: &data.Base{},
+		Item: &gctkline.Item{
+			Exchange: testExchange,
+			Pair:     currency.NewPair(currency.BTC, currency.USDT),
+			Asset:    asset.Spot,
+			Interval: gctkline.OneHour,
+			Candles:  candles,
+		},
+		AdditionalIntervals: []gctkline.Interval{gctkline.OneHour + gctkline.ThirtyMin},
+	}
+	err := d.Load()
+	if !errors.Is(err, errInvalidInterval) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidInterval)
+	}
+
+	d.AdditionalIntervals = []gctkline.Interval{gctkline.FourHour, gctkline.OneDay}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	fourHours, err := d.IntervalHistory(gctkline.FourHour)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fourHours) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(fourHours), 0)
+	}
+
+	// up to 2022-01-02 22:00, the daily candle has not closed
+	for i := 0; i < 27; i++ {
+		_, err = d.Next()
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+	_, err = d.IntervalHistory(gctkline.OneWeek)
+	if !errors.Is(err, errIntervalNotLoaded) {
+		t.Errorf("received '%v' expected '%v'", err, errIntervalNotLoaded)
+	}
+	days, err := d.IntervalHistory(gctkline.OneDay)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(days) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(days), 0)
+	}
+	fourHours, err = d.IntervalHistory(gctkline.FourHour)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fourHours) != 6 {
+		t.Errorf("received '%v' expected '%v'", len(fourHours), 6)
+	}
+
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	days, err = d.IntervalHistory(gctkline.OneDay)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(days) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(days), 1)
+	}
+	// the partial day before the first candle is dropped
+	if !days[0].GetTime().Equal(time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("received '%v' expected '%v'", days[0].GetTime(), time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC))
+	}
+	if days[0].GetInterval() != gctkline.OneDay {
+		t.Errorf("received '%v' expected '%v'", days[0].GetInterval(), gctkline.OneDay)
+	}
+	if !days[0].GetOpenPrice().Equal(decimal.NewFromInt(104)) {
+		t.Errorf("received '%v' expected '%v'", days[0].GetOpenPrice(), decimal.NewFromInt(104))
+	}
+	if !days[0].GetHighPrice().Equal(decimal.NewFromInt(128)) {
+		t.Errorf("received '%v' expected '%v'", days[0].GetHighPrice(), decimal.NewFromInt(128))
+	}
+	if !days[0].GetLowPrice().Equal(decimal.NewFromInt(103)) {
+		t.Errorf("received '%v' expected '%v'", days[0].GetLowPrice(), decimal.NewFromInt(103))
+	}
+	if !days[0].GetClosePrice().Equal(decimal.NewFromFloat(127.5)) {
+		t.Errorf("received '%v' expected '%v'", days[0].GetClosePrice(), decimal.NewFromFloat(127.5))
+	}
+	if !days[0].GetVolume().Equal(decimal.NewFromInt(24)) {
+		t.Errorf("received '%v' expected '%v'", days[0].GetVolume(), decimal.NewFromInt(24))
+	}
+}
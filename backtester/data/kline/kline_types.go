@@ -2,12 +2,21 @@ package kline
 
 import (
 	"errors"
+	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
-var errNoCandleData = errors.New("no candle data provided")
+var (
+	errNoCandleData        = errors.New("no candle data provided")
+	errNoOrderbookData     = errors.New("no orderbook data provided")
+	errNoOrderbookSnapshot = errors.New("no orderbook snapshot to start replaying from")
+	errInvalidInterval     = errors.New("invalid additional interval")
+	errIntervalNotLoaded   = errors.New("additional interval not loaded")
+)
 
 // DataFromKline is a struct which implements the data.Streamer interface
 // It holds candle data for a specified range with helper functions
@@ -15,4 +24,22 @@ type DataFromKline struct {
 	*data.Base
 	Item        *gctkline.Item
 	RangeHolder *gctkline.IntervalRangeHolder
+	// Orderbooks holds the state of the orderbook at the close of each
+	// candle when replaying orderbook data, keyed by candle time
+	Orderbooks map[int64]*gctorderbook.Base
+	// AdditionalIntervals are resampled from the candle data when loaded
+	// so strategies can assess larger timeframes
+	AdditionalIntervals []gctkline.Interval
+	m                   sync.Mutex
+	resampled           map[gctkline.Interval][]data.Event
+}
+
+// OrderbookUpdate is a recorded change to an orderbook. A snapshot replaces
+// the entire orderbook, otherwise the amount of each price level is set,
+// with zero amounts removing the level
+type OrderbookUpdate struct {
+	Time     time.Time
+	Snapshot bool
+	Bids     gctorderbook.Items
+	Asks     gctorderbook.Items
 }
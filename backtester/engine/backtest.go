@@ -3,14 +3,17 @@ package engine
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
@@ -143,6 +146,10 @@ func (bt *BackTest) ExecuteStrategy(waitForOfflineCompletion bool) error {
 		bt.m.Unlock()
 		return fmt.Errorf("%w cannot wait for a live task to finish", errCannotHandleRequest)
 	}
+	if waitForOfflineCompletion && bt.debugger.isActive() {
+		bt.m.Unlock()
+		return fmt.Errorf("%w %v", errTaskIsDebugging, bt.MetaData.ID)
+	}
 
 	bt.MetaData.DateStarted = time.Now()
 	liveTesting := bt.MetaData.LiveTesting
@@ -235,6 +242,10 @@ func (bt *BackTest) Run() error {
 			}
 		} else {
 			doubleNil = false
+			if !bt.debugger.beforeEvent(ev, bt.shutdown) {
+				// the task was stopped while paused
+				return nil
+			}
 			err := bt.handleEvent(ev)
 			if err != nil {
 				log.Errorln(common.Backtester, err)
@@ -262,6 +273,12 @@ func (bt *BackTest) handleEvent(ev common.Event) error {
 
 	switch eType := ev.(type) {
 	case kline.Event:
+		// transfers which have arrived are credited before the
+		// strategy can act upon the data
+		err = bt.Funding.ProcessTransfers(eType.GetTime())
+		if err != nil {
+			return err
+		}
 		// using kline.Event as signal.Event also matches data.Event
 		if bt.Strategy.UsingSimultaneousProcessing() {
 			err = bt.processSimultaneousDataEvents()
@@ -269,6 +286,12 @@ func (bt *BackTest) handleEvent(ev common.Event) error {
 			err = bt.processSingleDataEvent(eType, funds.FundReleaser())
 		}
 	case signal.Event:
+		if ids := eType.GetCancelOrderIDs(); len(ids) > 0 {
+			err = bt.Exchange.CancelRestingOrders(eType, ids, funds.FundReleaser())
+			if err != nil {
+				log.Errorf(common.Backtester, "CancelRestingOrders %v %v %v %v", eType.GetExchange(), eType.GetAssetType(), eType.Pair(), err)
+			}
+		}
 		err = bt.processSignalEvent(eType, funds.FundReserver())
 	case order.Event:
 		err = bt.processOrderEvent(eType, funds.FundReleaser())
@@ -290,6 +313,25 @@ func (bt *BackTest) handleEvent(ev common.Event) error {
 			errUnhandledDatatype,
 			ev)
 	}
+	bt.progress.recordEvent(ev)
+	if bt.Portfolio != nil {
+		switch ev.(type) {
+		case kline.Event, fill.Event:
+			// holdings are only needed to stream progress or to
+			// trigger drawdown breakpoints
+			streaming := bt.progress.hasSubscribers()
+			drawdown := bt.debugger.tracksDrawdown()
+			if streaming || drawdown {
+				latest := bt.Portfolio.GetLatestHoldingsForAllCurrencies()
+				if streaming {
+					bt.progress.setHoldings(latest)
+				}
+				if drawdown {
+					bt.debugger.updateDrawdown(latest)
+				}
+			}
+		}
+	}
 	if err != nil {
 		return err
 	}
@@ -307,6 +349,22 @@ func (bt *BackTest) processSingleDataEvent(ev data.Event, funds funding.IFundRel
 	if err != nil {
 		return err
 	}
+	err = bt.processRestingOrders(ev, d, funds)
+	if err != nil {
+		return err
+	}
+	exit, err := bt.Portfolio.CheckExitRules(d)
+	if err != nil {
+		log.Errorf(common.Backtester, "CheckExitRules %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	} else if exit != nil {
+		// an exit rule replaces the strategy's decision for the data event
+		err = bt.Statistic.SetEventForOffset(exit)
+		if err != nil {
+			log.Errorf(common.Backtester, "SetEventForOffset %v", err)
+		}
+		bt.EventQueue.AppendEvent(exit)
+		return nil
+	}
 	s, err := bt.Strategy.OnSignal(d, bt.Funding, bt.Portfolio)
 	if err != nil {
 		if errors.Is(err, base.ErrTooMuchBadData) {
@@ -335,6 +393,7 @@ func (bt *BackTest) processSimultaneousDataEvents() error {
 	}
 
 	dataEvents := make([]data.Handler, 0, len(dataHolders))
+	var exits []signal.Event
 	for i := range dataHolders {
 		var latestData data.Event
 		latestData, err = dataHolders[i].Latest()
@@ -362,6 +421,17 @@ func (bt *BackTest) processSimultaneousDataEvents() error {
 				log.Errorln(common.Backtester, err)
 			}
 		}
+		err = bt.processRestingOrders(latestData, dataHolders[i], funds.FundReleaser())
+		if err != nil {
+			return err
+		}
+		var exit signal.Event
+		exit, err = bt.Portfolio.CheckExitRules(dataHolders[i])
+		if err != nil {
+			log.Errorf(common.Backtester, "CheckExitRules %v %v %v %v", latestData.GetExchange(), latestData.GetAssetType(), latestData.Pair(), err)
+		} else if exit != nil {
+			exits = append(exits, exit)
+		}
 		dataEvents = append(dataEvents, dataHolders[i])
 	}
 	signals, err := bt.Strategy.OnSimultaneousSignals(dataEvents, bt.Funding, bt.Portfolio)
@@ -375,9 +445,10 @@ func (bt *BackTest) processSimultaneousDataEvents() error {
 			return nil
 		default:
 			log.Errorf(common.Backtester, "OnSimultaneousSignals %v", err)
-			return nil
+			signals = nil
 		}
 	}
+	signals = replaceSignalsWithExits(signals, exits)
 	for i := range signals {
 		err = bt.Statistic.SetEventForOffset(signals[i])
 		if err != nil {
@@ -388,6 +459,70 @@ func (bt *BackTest) processSimultaneousDataEvents() error {
 	return nil
 }
 
+// processRestingOrders fills or expires resting limit and stop orders against
+// the latest candle before the strategy is consulted, so that strategies are
+// aware of any fills which occurred during the candle
+func (bt *BackTest) processRestingOrders(ev data.Event, d data.Handler, funds funding.IFundReleaser) error {
+	if bt.Exchange == nil {
+		return nil
+	}
+	fills, err := bt.Exchange.ProcessRestingOrders(d, bt.orderManager, funds)
+	if err != nil {
+		log.Errorf(common.Backtester, "ProcessRestingOrders %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	if len(fills) == 0 {
+		return nil
+	}
+	for i := range fills {
+		err = bt.processFillEvent(fills[i], funds)
+		if err != nil {
+			log.Errorln(common.Backtester, err)
+		}
+		if bt.LiveDataHandler != nil {
+			var result string
+			result, err = bt.Statistic.CreateLog(fills[i])
+			if err != nil {
+				return err
+			}
+			log.Infoln(common.LiveStrategy, result)
+		}
+	}
+	// fills update holdings at their fill price, revalue them at the close
+	err = bt.Portfolio.UpdateHoldings(ev, funds)
+	if err != nil {
+		return err
+	}
+	holding, err := bt.Portfolio.ViewHoldingAtTimePeriod(ev)
+	if err != nil {
+		return err
+	}
+	return bt.Statistic.AddHoldingsForTime(holding)
+}
+
+// replaceSignalsWithExits replaces strategy signals with the exit signals
+// raised by the portfolio manager for the same exchange, asset and pair
+func replaceSignalsWithExits(signals, exits []signal.Event) []signal.Event {
+	if len(exits) == 0 {
+		return signals
+	}
+	resp := make([]signal.Event, 0, len(signals)+len(exits))
+	for i := range signals {
+		replaced := false
+		for j := range exits {
+			if strings.EqualFold(signals[i].GetExchange(), exits[j].GetExchange()) &&
+				signals[i].GetAssetType() == exits[j].GetAssetType() &&
+				signals[i].Pair().Equal(exits[j].Pair()) {
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			resp = append(resp, signals[i])
+		}
+	}
+	return append(resp, exits...)
+}
+
 // updateStatsForDataEvent makes various systems aware of price movements from
 // data events
 func (bt *BackTest) updateStatsForDataEvent(ev data.Event, funds funding.IFundReleaser) error {
@@ -405,6 +540,16 @@ func (bt *BackTest) updateStatsForDataEvent(ev data.Event, funds funding.IFundRe
 		}
 		log.Errorf(common.Backtester, "SetEventForOffset %v", err)
 	}
+	if ev.GetAssetType() == asset.Spot {
+		// accrue borrow costs before holdings are valued
+		err = bt.Funding.UpdateSpotMargin(ev)
+		if err != nil {
+			if !errors.Is(err, funding.ErrMarginLiquidated) {
+				return fmt.Errorf("UpdateSpotMargin %v", err)
+			}
+			log.Warnln(common.Backtester, err)
+		}
+	}
 	// update portfolio manager with the latest price
 	err = bt.Portfolio.UpdateHoldings(ev, funds)
 	if err != nil {
@@ -418,6 +563,18 @@ func (bt *BackTest) updateStatsForDataEvent(ev data.Event, funds funding.IFundRe
 			return err
 		}
 
+		var fundingPayment decimal.Decimal
+		fundingPayment, err = bt.Portfolio.ProcessFundingRates(ev)
+		if err != nil {
+			return fmt.Errorf("ProcessFundingRates %v", err)
+		}
+		if !fundingPayment.IsZero() {
+			err = bt.realiseFundingPayment(ev, fundingPayment)
+			if err != nil {
+				return err
+			}
+		}
+
 		err = bt.Portfolio.UpdatePNL(ev, ev.GetClosePrice())
 		if err != nil {
 			if errors.Is(err, gctorder.ErrPositionNotFound) {
@@ -456,6 +613,31 @@ func (bt *BackTest) updateStatsForDataEvent(ev data.Event, funds funding.IFundRe
 	return nil
 }
 
+// realiseFundingPayment adds or removes a perpetual funding payment from the
+// currency which receives realised PNL and recalculates collateral
+func (bt *BackTest) realiseFundingPayment(ev data.Event, payment decimal.Decimal) error {
+	exch, err := bt.exchangeManager.GetExchangeByName(ev.GetExchange())
+	if err != nil {
+		return fmt.Errorf("GetExchangeByName %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	receivingCurrency, receivingAsset, err := exch.GetCurrencyForRealisedPNL(ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		return fmt.Errorf("GetCurrencyForRealisedPNL %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	err = bt.Funding.RealisePNL(ev.GetExchange(), receivingAsset, receivingCurrency, payment)
+	if err != nil {
+		return fmt.Errorf("RealisePNL %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	if bt.verbose {
+		log.Debugf(common.Backtester, "%v %v %v funding payment of %v %v applied at %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), payment, receivingCurrency, ev.GetTime())
+	}
+	err = bt.Funding.UpdateCollateralForEvent(ev, false)
+	if err != nil {
+		return fmt.Errorf("UpdateCollateralForEvent %v %v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	return nil
+}
+
 // processSignalEvent receives an event from the strategy for processing under the portfolio
 func (bt *BackTest) processSignalEvent(ev signal.Event, funds funding.IFundReserver) error {
 	if ev == nil {
@@ -612,6 +794,7 @@ func (bt *BackTest) Stop() error {
 	if bt.MetaData.Closed {
 		return errAlreadyRan
 	}
+	defer bt.progress.finish()
 	close(bt.shutdown)
 	bt.MetaData.Closed = true
 	bt.MetaData.DateEnded = time.Now()
@@ -621,15 +804,42 @@ func (bt *BackTest) Stop() error {
 			log.Errorf(common.Backtester, "Could not close all positions on stop: %s", err)
 		}
 	}
+	bt.closeStrategy()
+	if bt.Exchange != nil {
+		err := bt.Statistic.AddUnfilledOrders(bt.Exchange.GetUnfilledOrders())
+		if err != nil {
+			log.Errorf(common.Backtester, "Could not add unfilled orders to statistics: %s", err)
+		}
+	}
+	if bt.Portfolio != nil {
+		// final holdings are kept for progress requested after the task ends
+		bt.progress.setHoldings(bt.Portfolio.GetLatestHoldingsForAllCurrencies())
+	}
 	err := bt.Statistic.CalculateAllResults()
 	if err != nil {
 		return err
 	}
-	err = bt.Reports.GenerateReport()
+	// run history is stored first so a failed report does not lose the run
+	if bt.runHistory != nil {
+		err = bt.runHistory.Store(bt)
+		if err != nil {
+			log.Errorf(common.Backtester, "Could not store run history: %s", err)
+		}
+	}
+	return bt.Reports.GenerateReport()
+}
+
+// closeStrategy releases the resources held by the strategy, such as the
+// connection to an external strategy process
+func (bt *BackTest) closeStrategy() {
+	closer, ok := bt.Strategy.(strategies.Closer)
+	if !ok {
+		return
+	}
+	err := closer.Close()
 	if err != nil {
-		return err
+		log.Errorf(common.Backtester, "Could not close strategy %v: %s", bt.Strategy.Name(), err)
 	}
-	return nil
 }
 
 func (bt *BackTest) triggerLiquidationsForExchange(ev data.Event, pnl *portfolio.PNLSummary) error {
@@ -3,6 +3,8 @@ package engine
 import (
 	"context"
 	"errors"
+	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
 	"sync"
@@ -29,6 +31,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	evkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	evorderbook "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	"github.com/thrasher-corp/gocryptotrader/backtester/report"
@@ -44,6 +47,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binanceus"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -196,7 +200,7 @@ func TestLoadDataAPI(t *testing.T) {
 		ConfigFormat:  &currency.PairFormat{Uppercase: true},
 		RequestFormat: &currency.PairFormat{Uppercase: true}}
 
-	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false)
+	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false, nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -249,7 +253,7 @@ func TestLoadDataCSV(t *testing.T) {
 		AssetEnabled:  convert.BoolPtr(true),
 		ConfigFormat:  &currency.PairFormat{Uppercase: true},
 		RequestFormat: &currency.PairFormat{Uppercase: true}}
-	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false)
+	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false, nil)
 	if err != nil &&
 		!strings.Contains(err.Error(), "The system cannot find the file specified.") &&
 		!strings.Contains(err.Error(), "no such file or directory") {
@@ -257,6 +261,47 @@ func TestLoadDataCSV(t *testing.T) {
 	}
 }
 
+func TestLoadDataOrderbook(t *testing.T) {
+	t.Parallel()
+	bt := BackTest{
+		Reports: &report.Data{},
+	}
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	cfg := &config.Config{
+		DataSettings: config.DataSettings{
+			DataType: common.OrderbookStr,
+			Interval: gctkline.OneMin,
+			APIData:  &config.APIData{},
+		},
+	}
+	em := engine.ExchangeManager{}
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false, nil)
+	if !errors.Is(err, errOrderbookCSVOnly) {
+		t.Errorf("received '%v' expected '%v'", err, errOrderbookCSVOnly)
+	}
+
+	cfg.DataSettings.APIData = nil
+	cfg.DataSettings.CSVData = &config.CSVData{
+		FullPath: filepath.Join("..", "..", "testdata", "binance_BTCUSDT_orderbook_2020_11_16.csv"),
+	}
+	resp, err := bt.loadData(cfg, exch, cp, asset.Spot, false, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	latest, err := resp.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if _, ok := latest.(evorderbook.Event); !ok {
+		t.Errorf("received '%T' expected an orderbook event", latest)
+	}
+}
+
 func TestLoadDataDatabase(t *testing.T) {
 	t.Parallel()
 	bt := BackTest{
@@ -318,7 +363,7 @@ func TestLoadDataDatabase(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false)
+	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false, nil)
 	if err != nil && !strings.Contains(err.Error(), "unable to retrieve data from GoCryptoTrader database") {
 		t.Error(err)
 	}
@@ -397,13 +442,13 @@ func TestLoadDataLive(t *testing.T) {
 		AssetEnabled:  convert.BoolPtr(true),
 		ConfigFormat:  &currency.PairFormat{Uppercase: true},
 		RequestFormat: &currency.PairFormat{Uppercase: true}}
-	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false)
+	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false, nil)
 	if !errors.Is(err, gctkline.ErrCannotConstructInterval) {
 		t.Errorf("received: %v, expected: %v", err, gctkline.ErrCannotConstructInterval)
 	}
 
 	cfg.DataSettings.Interval = gctkline.OneMin
-	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false)
+	_, err = bt.loadData(cfg, exch, cp, asset.Spot, false, nil)
 	if !errors.Is(err, nil) {
 		t.Errorf("received: %v, expected: %v", err, nil)
 	}
@@ -558,8 +603,10 @@ func TestFullCycle(t *testing.T) {
 
 func TestStop(t *testing.T) {
 	t.Parallel()
+	strat := &fakeClosingStrat{}
 	bt := &BackTest{
 		shutdown:  make(chan struct{}),
+		Strategy:  strat,
 		Statistic: &fakeStats{},
 		Reports:   &fakeReport{},
 	}
@@ -567,6 +614,9 @@ func TestStop(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Errorf("received: %v, expected: %v", err, nil)
 	}
+	if strat.closed != 1 {
+		t.Errorf("received '%v' expected '%v'", strat.closed, 1)
+	}
 	tt := bt.MetaData.DateEnded
 
 	err = bt.Stop()
@@ -584,6 +634,29 @@ func TestStop(t *testing.T) {
 	}
 }
 
+func TestStopStoresRunHistoryWhenReportFails(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{
+		shutdown:   make(chan struct{}),
+		Statistic:  &fakeStats{},
+		Reports:    &fakeFailingReport{},
+		runHistory: setupTestRunHistory(t),
+	}
+	var err error
+	bt.MetaData.ID, err = uuid.NewV4()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = bt.Stop()
+	if !errors.Is(err, errTestReport) {
+		t.Errorf("received '%v' expected '%v'", err, errTestReport)
+	}
+	_, err = bt.runHistory.GetRun(bt.MetaData.ID.String())
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+}
+
 func TestFullCycleMulti(t *testing.T) {
 	t.Parallel()
 	ex := testExchange
@@ -1624,6 +1697,213 @@ func TestGetFees(t *testing.T) {
 	}
 }
 
+type fakeFundingRateExchange struct {
+	*binance.Binance
+	rates []gctorder.FundingRates
+}
+
+func (f *fakeFundingRateExchange) GetFundingRates(context.Context, *gctorder.FundingRatesRequest) ([]gctorder.FundingRates, error) {
+	return f.rates, nil
+}
+
+func TestIsPerpetual(t *testing.T) {
+	t.Parallel()
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	if !isPerpetual(nil, asset.PerpetualSwap, pair) {
+		t.Error("expected perpetual swap to be perpetual")
+	}
+	if !isPerpetual(nil, asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.PERP)) {
+		t.Error("expected PERP quote to be perpetual")
+	}
+	if !isPerpetual(nil, asset.Futures, currency.NewPair(currency.NewCode("PI"), currency.NewCode("XBTUSD"))) {
+		t.Error("expected PI base to be perpetual")
+	}
+	if isPerpetual(nil, asset.USDTMarginedFutures, pair) {
+		t.Error("expected dated future to not be perpetual")
+	}
+	b := &binance.Binance{}
+	b.SetDefaults()
+	if isPerpetual(b, asset.USDTMarginedFutures, pair) {
+		t.Error("expected unimplemented exchange check to not be perpetual")
+	}
+}
+
+func TestExecutionConditions(t *testing.T) {
+	t.Parallel()
+	if c := executionConditions(nil, 1); c != nil {
+		t.Errorf("received '%v' expected nil", c)
+	}
+	tt := time.Now()
+	c := executionConditions(&config.ExecutionSettings{
+		ExchangeName:         testExchange,
+		RejectionRatePercent: decimal.NewFromInt(5),
+		Outages:              []config.OutageWindow{{Start: tt, End: tt.Add(time.Hour)}},
+		Seed:                 1337,
+		Latency: &config.LatencySettings{
+			Distribution: config.NormalLatency,
+			Mean:         time.Second,
+		},
+	}, 1)
+	if c == nil {
+		t.Fatal("expected execution conditions")
+	}
+	if c.LatencyDistribution != exchange.NormalLatency {
+		t.Errorf("received '%v' expected '%v'", c.LatencyDistribution, exchange.NormalLatency)
+	}
+	if c.MeanLatency != time.Second {
+		t.Errorf("received '%v' expected '%v'", c.MeanLatency, time.Second)
+	}
+	if len(c.Outages) != 1 || !c.Outages[0].End.Equal(tt.Add(time.Hour)) {
+		t.Errorf("received unexpected outages '%v'", c.Outages)
+	}
+	if !c.RejectionRate.Equal(decimal.NewFromInt(5)) || c.Seed != 1337 {
+		t.Errorf("received unexpected conditions '%+v'", c)
+	}
+
+	c = executionConditions(&config.ExecutionSettings{ExchangeName: testExchange}, 1)
+	if c.Seed != 1 {
+		t.Errorf("received '%v' expected '%v'", c.Seed, 1)
+	}
+}
+
+func TestLoadFundingRates(t *testing.T) {
+	t.Parallel()
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := loadFundingRates(context.Background(), nil, asset.PerpetualSwap, pair, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	b := &binance.Binance{}
+	b.SetDefaults()
+	_, err = loadFundingRates(context.Background(), b, asset.PerpetualSwap, pair, nil)
+	if !errors.Is(err, errNilData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilData)
+	}
+	tt := time.Now().Truncate(time.Hour)
+	klineData := &kline.DataFromKline{
+		Item: &gctkline.Item{
+			Interval: gctkline.OneHour,
+		},
+	}
+	_, err = loadFundingRates(context.Background(), b, asset.PerpetualSwap, pair, klineData)
+	if !errors.Is(err, errNilData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilData)
+	}
+	klineData.Item.Candles = []gctkline.Candle{{Time: tt}, {Time: tt.Add(time.Hour)}}
+	_, err = loadFundingRates(context.Background(), b, asset.PerpetualSwap, pair, klineData)
+	if !errors.Is(err, gctcommon.ErrNotYetImplemented) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNotYetImplemented)
+	}
+
+	f := &fakeFundingRateExchange{Binance: b}
+	_, err = loadFundingRates(context.Background(), f, asset.PerpetualSwap, pair, klineData)
+	if !errors.Is(err, errNoFundingRates) {
+		t.Errorf("received '%v' expected '%v'", err, errNoFundingRates)
+	}
+	f.rates = []gctorder.FundingRates{
+		{
+			Pair: pair,
+			FundingRates: []gctorder.FundingRate{
+				{Time: tt.Add(time.Hour), Rate: decimal.NewFromFloat(0.02)},
+				{Time: tt, Rate: decimal.NewFromFloat(0.01)},
+			},
+		},
+	}
+	rates, err := loadFundingRates(context.Background(), f, asset.PerpetualSwap, pair, klineData)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(rates) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(rates), 2)
+	}
+	if !rates[0].Time.Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", rates[0].Time, tt)
+	}
+}
+
+func TestLoadCSVFundingRates(t *testing.T) {
+	t.Parallel()
+	_, err := loadCSVFundingRates("", nil)
+	if !errors.Is(err, errNilData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilData)
+	}
+	tt := time.Date(2020, 11, 16, 0, 0, 0, 0, time.UTC)
+	klineData := &kline.DataFromKline{
+		Item: &gctkline.Item{
+			Interval: gctkline.OneHour,
+			Candles:  []gctkline.Candle{{Time: tt}, {Time: tt.Add(time.Hour)}},
+		},
+	}
+	path := filepath.Join(t.TempDir(), "rates.csv")
+	contents := fmt.Sprintf("%v,0.03\n%v,0.02\n%v,0.01\n", tt.Add(-time.Hour).Unix(), tt.Add(time.Hour).Unix(), tt.Unix())
+	err = os.WriteFile(path, []byte(contents), 0o600)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	rates, err := loadCSVFundingRates(path, klineData)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(rates) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(rates), 2)
+	}
+	if !rates[0].Time.Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", rates[0].Time, tt)
+	}
+
+	klineData.Item.Candles = []gctkline.Candle{{Time: tt.Add(time.Hour * 24)}}
+	_, err = loadCSVFundingRates(path, klineData)
+	if !errors.Is(err, errNoFundingRates) {
+		t.Errorf("received '%v' expected '%v'", err, errNoFundingRates)
+	}
+}
+
+type fakeBorrowRateExchange struct {
+	*binance.Binance
+	rates []margin.Rate
+}
+
+func (f *fakeBorrowRateExchange) GetMarginRatesHistory(context.Context, *margin.RateHistoryRequest) (*margin.RateHistoryResponse, error) {
+	return &margin.RateHistoryResponse{Rates: f.rates}, nil
+}
+
+func TestLoadBorrowRates(t *testing.T) {
+	t.Parallel()
+	_, err := loadBorrowRates(context.Background(), nil, asset.Spot, currency.BTC, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	b := &binance.Binance{}
+	b.SetDefaults()
+	_, err = loadBorrowRates(context.Background(), b, asset.Spot, currency.BTC, nil)
+	if !errors.Is(err, errNilData) {
+		t.Errorf("received '%v' expected '%v'", err, errNilData)
+	}
+	tt := time.Now().Truncate(time.Hour)
+	klineData := &kline.DataFromKline{
+		Item: &gctkline.Item{
+			Interval: gctkline.OneHour,
+			Candles:  []gctkline.Candle{{Time: tt}, {Time: tt.Add(time.Hour)}},
+		},
+	}
+	_, err = loadBorrowRates(context.Background(), b, asset.Spot, currency.BTC, klineData)
+	if !errors.Is(err, gctcommon.ErrNotYetImplemented) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNotYetImplemented)
+	}
+
+	f := &fakeBorrowRateExchange{
+		Binance: b,
+		rates:   []margin.Rate{{Time: tt, HourlyBorrowRate: decimal.NewFromFloat(0.0001)}},
+	}
+	rates, err := loadBorrowRates(context.Background(), f, asset.Spot, currency.BTC, klineData)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(rates) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(rates), 1)
+	}
+}
+
 func TestGenerateSummary(t *testing.T) {
 	t.Parallel()
 	bt := &BackTest{
@@ -2031,3 +2311,35 @@ func TestProcessSingleDataEvent(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", err, nil)
 	}
 }
+
+func TestReplaceSignalsWithExits(t *testing.T) {
+	t.Parallel()
+	btc := currency.NewPair(currency.BTC, currency.USDT)
+	eth := currency.NewPair(currency.ETH, currency.USDT)
+	newSignal := func(cp currency.Pair, dir gctorder.Side) signal.Event {
+		return &signal.Signal{
+			Base: &event.Base{
+				Exchange:     testExchange,
+				AssetType:    asset.Spot,
+				CurrencyPair: cp,
+			},
+			Direction: dir,
+		}
+	}
+	signals := []signal.Event{newSignal(btc, gctorder.Buy), newSignal(eth, gctorder.Buy)}
+	resp := replaceSignalsWithExits(signals, nil)
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+
+	resp = replaceSignalsWithExits(signals, []signal.Event{newSignal(btc, gctorder.ClosePosition)})
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if !resp[0].Pair().Equal(eth) || resp[0].GetDirection() != gctorder.Buy {
+		t.Errorf("expected ETH buy signal to be retained, received %v %v", resp[0].Pair(), resp[0].GetDirection())
+	}
+	if !resp[1].Pair().Equal(btc) || resp[1].GetDirection() != gctorder.ClosePosition {
+		t.Errorf("expected BTC exit signal, received %v %v", resp[1].Pair(), resp[1].GetDirection())
+	}
+}
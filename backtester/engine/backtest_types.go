@@ -26,6 +26,8 @@ var (
 	errNilData             = errors.New("nil data received")
 	errLiveOnly            = errors.New("close all positions is only supported by live data type")
 	errNotSetup            = errors.New("backtesting task not setup")
+	errNoFundingRates      = errors.New("no funding rates found")
+	errOrderbookCSVOnly    = errors.New("orderbook data can only be loaded from CSV")
 )
 
 // BackTest is the main holder of all backtesting functionality
@@ -49,7 +51,11 @@ type BackTest struct {
 	exchangeManager          *engine.ExchangeManager
 	orderManager             *engine.OrderManager
 	databaseManager          *engine.DatabaseConnectionManager
+	sharedData               *sharedData
+	runHistory               *RunHistory
 	hasProcessedDataAtOffset map[int64]bool
+	progress                 Progress
+	debugger                 Debugger
 }
 
 // TaskSummary holds details of a BackTest
@@ -69,6 +75,8 @@ type TaskMetaData struct {
 	ClosePositionsOnStop bool
 	LiveTesting          bool
 	RealOrders           bool
+	// Seed is the random seed used by the task, allowing it to be replayed
+	Seed int64
 }
 
 // TaskManager contains all strategy tasks
@@ -0,0 +1,428 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+)
+
+// beforeEvent is called before each event is handled. It blocks while
+// the task is paused and returns false if the task shuts down while paused
+func (d *Debugger) beforeEvent(ev common.Event, shutdown <-chan struct{}) bool {
+	d.m.Lock()
+	d.eventNumber++
+	d.currentEvent = ev
+	reason := d.pauseCondition(ev)
+	if reason == "" {
+		d.m.Unlock()
+		return true
+	}
+	d.pauseRequested = false
+	d.stepping = false
+	d.pauseReason = reason
+	d.waiting = true
+	if d.resume == nil {
+		d.resume = make(chan struct{}, 1)
+	}
+	resume := d.resume
+	d.m.Unlock()
+
+	select {
+	case <-resume:
+		return true
+	case <-shutdown:
+		d.inspecting.Lock()
+		d.m.Lock()
+		d.waiting = false
+		d.m.Unlock()
+		d.inspecting.Unlock()
+		return false
+	}
+}
+
+// pauseCondition returns the reason the task should pause before the
+// event, or an empty string if it should continue. The caller must
+// hold the lock
+func (d *Debugger) pauseCondition(ev common.Event) string {
+	if d.pauseRequested {
+		return "paused by request"
+	}
+	if d.stepping {
+		if d.stepsRemaining == 0 {
+			return "step complete"
+		}
+		d.stepsRemaining--
+	}
+	for i := range d.breakpoints {
+		b := &d.breakpoints[i]
+		switch b.Type {
+		case BreakpointTime:
+			if !b.triggered && !ev.GetTime().Before(b.Time) {
+				b.triggered = true
+				return fmt.Sprintf("breakpoint %v reached time %v", b.ID, b.Time)
+			}
+		case BreakpointEvent:
+			if d.eventNumber == b.EventNumber {
+				return fmt.Sprintf("breakpoint %v reached event %v", b.ID, b.EventNumber)
+			}
+		case BreakpointEventType:
+			if eventType(ev) == b.EventType {
+				return fmt.Sprintf("breakpoint %v reached %v event", b.ID, b.EventType)
+			}
+		case BreakpointDrawdown:
+			if !d.drawdown.GreaterThan(b.DrawdownPercent) {
+				// re-arm once the drawdown has recovered
+				b.triggered = false
+				continue
+			}
+			if !b.triggered {
+				b.triggered = true
+				return fmt.Sprintf("breakpoint %v drawdown %v%% exceeded %v%%", b.ID, d.drawdown.Round(2), b.DrawdownPercent)
+			}
+		}
+	}
+	return ""
+}
+
+// release allows a paused task to continue. The caller must hold the lock
+// and the inspecting write lock
+func (d *Debugger) release() {
+	if !d.waiting {
+		return
+	}
+	d.waiting = false
+	select {
+	case d.resume <- struct{}{}:
+	default:
+	}
+}
+
+// updateDrawdown tracks how far the value of all holdings has fallen
+// from its highest value
+func (d *Debugger) updateDrawdown(h []holdings.Holding) {
+	var total decimal.Decimal
+	for i := range h {
+		total = total.Add(h[i].TotalValue)
+	}
+	d.m.Lock()
+	defer d.m.Unlock()
+	if total.GreaterThan(d.peakValue) {
+		d.peakValue = total
+	}
+	if d.peakValue.IsPositive() {
+		d.drawdown = d.peakValue.Sub(total).Div(d.peakValue).Mul(decimal.NewFromInt(100))
+	}
+}
+
+// tracksDrawdown returns whether a drawdown breakpoint is set
+func (d *Debugger) tracksDrawdown() bool {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.hasDrawdownBreakpoint()
+}
+
+// hasDrawdownBreakpoint returns whether a drawdown breakpoint is set.
+// The caller must hold the lock
+func (d *Debugger) hasDrawdownBreakpoint() bool {
+	for i := range d.breakpoints {
+		if d.breakpoints[i].Type == BreakpointDrawdown {
+			return true
+		}
+	}
+	return false
+}
+
+// isActive returns whether the debugger will pause the task
+func (d *Debugger) isActive() bool {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return d.waiting || d.pauseRequested || d.stepping || len(d.breakpoints) > 0
+}
+
+// getStatus returns a copy of the debugger's state
+func (d *Debugger) getStatus() *DebugStatus {
+	d.m.Lock()
+	defer d.m.Unlock()
+	resp := &DebugStatus{
+		Paused:          d.waiting,
+		EventNumber:     d.eventNumber,
+		DrawdownPercent: d.drawdown,
+		Breakpoints:     make([]Breakpoint, len(d.breakpoints)),
+	}
+	copy(resp.Breakpoints, d.breakpoints)
+	if d.waiting {
+		resp.PauseReason = d.pauseReason
+		if d.currentEvent != nil {
+			ev := describeEvent(d.currentEvent)
+			ev.ID = d.eventNumber
+			resp.CurrentEvent = &ev
+		}
+	}
+	return resp
+}
+
+// eventType returns the debugger and progress name of an event's type
+func eventType(ev common.Event) string {
+	switch ev.(type) {
+	case kline.Event:
+		return ProgressDataEvent
+	case signal.Event:
+		return ProgressSignalEvent
+	case order.Event:
+		return ProgressOrderEvent
+	case fill.Event:
+		return ProgressFillEvent
+	}
+	return ""
+}
+
+// describeEvent converts an event into a format which
+// can be read by clients
+func describeEvent(ev common.Event) ProgressEvent {
+	resp := ProgressEvent{
+		Type:     eventType(ev),
+		Time:     ev.GetTime(),
+		Exchange: ev.GetExchange(),
+		Asset:    ev.GetAssetType(),
+		Pair:     ev.Pair(),
+		Price:    ev.GetClosePrice(),
+		Reason:   ev.GetConcatReasons(),
+	}
+	switch e := ev.(type) {
+	case kline.Event:
+	case signal.Event:
+		resp.Direction = e.GetDirection()
+		resp.Amount = e.GetAmount()
+	case order.Event:
+		resp.Direction = e.GetDirection()
+		resp.Amount = e.GetAmount()
+	case fill.Event:
+		resp.Direction = e.GetDirection()
+		resp.Price = e.GetPurchasePrice()
+		resp.Amount = e.GetAmount()
+		resp.Fee = e.GetExchangeFee()
+	}
+	return resp
+}
+
+// canDebug ensures the task is an offline task which has not finished
+func (bt *BackTest) canDebug() error {
+	if bt == nil {
+		return fmt.Errorf("%w BackTest", gctcommon.ErrNilPointer)
+	}
+	bt.m.Lock()
+	defer bt.m.Unlock()
+	if bt.MetaData.LiveTesting {
+		return fmt.Errorf("%w %v", errCannotDebugLive, bt.MetaData.ID)
+	}
+	if bt.MetaData.Closed {
+		return fmt.Errorf("%w %v", errAlreadyRan, bt.MetaData.ID)
+	}
+	return nil
+}
+
+// Debug pauses, steps or resumes a task. Pausing takes effect before the
+// next event is handled. Stepping handles the amount of steps as events
+// before pausing again. Resuming runs the task until a breakpoint is met
+func (bt *BackTest) Debug(action string, steps uint64) (*DebugStatus, error) {
+	err := bt.canDebug()
+	if err != nil {
+		return nil, err
+	}
+	d := &bt.debugger
+	d.inspecting.Lock()
+	defer d.inspecting.Unlock()
+	d.m.Lock()
+	switch strings.ToLower(action) {
+	case DebugPause:
+		if !d.waiting {
+			d.pauseRequested = true
+		}
+	case DebugStep:
+		if steps == 0 {
+			steps = 1
+		}
+		d.pauseRequested = false
+		d.stepping = true
+		d.stepsRemaining = steps
+		if d.waiting {
+			// the event the task is paused before is the first step
+			d.stepsRemaining--
+		}
+		d.release()
+	case DebugResume:
+		d.pauseRequested = false
+		d.stepping = false
+		d.release()
+	default:
+		d.m.Unlock()
+		return nil, fmt.Errorf("%w %q", errInvalidDebugAction, action)
+	}
+	d.m.Unlock()
+	return d.getStatus(), nil
+}
+
+// SetBreakpoint adds a breakpoint which pauses the task when its
+// condition is met
+func (bt *BackTest) SetBreakpoint(b Breakpoint) (*DebugStatus, error) {
+	err := bt.canDebug()
+	if err != nil {
+		return nil, err
+	}
+	b.Type = strings.ToLower(b.Type)
+	b.EventType = strings.ToLower(b.EventType)
+	switch b.Type {
+	case BreakpointTime:
+		if b.Time.IsZero() {
+			return nil, fmt.Errorf("%w %v %w", errInvalidBreakpoint, b.Type, gctcommon.ErrDateUnset)
+		}
+	case BreakpointEvent:
+		if b.EventNumber == 0 {
+			return nil, fmt.Errorf("%w %v event number must be greater than zero", errInvalidBreakpoint, b.Type)
+		}
+	case BreakpointEventType:
+		switch b.EventType {
+		case ProgressDataEvent, ProgressSignalEvent, ProgressOrderEvent, ProgressFillEvent:
+		default:
+			return nil, fmt.Errorf("%w %v %q must be one of %v, %v, %v or %v", errInvalidBreakpoint, b.Type, b.EventType, ProgressDataEvent, ProgressSignalEvent, ProgressOrderEvent, ProgressFillEvent)
+		}
+	case BreakpointDrawdown:
+		if !b.DrawdownPercent.IsPositive() {
+			return nil, fmt.Errorf("%w %v percentage must be greater than zero", errInvalidBreakpoint, b.Type)
+		}
+	default:
+		return nil, fmt.Errorf("%w type %q", errInvalidBreakpoint, b.Type)
+	}
+	d := &bt.debugger
+	d.m.Lock()
+	if b.Type == BreakpointDrawdown && !d.hasDrawdownBreakpoint() {
+		// drawdown is only tracked while a drawdown breakpoint is set,
+		// so it is measured from the value of holdings from here on
+		d.peakValue = decimal.Zero
+		d.drawdown = decimal.Zero
+	}
+	d.breakpointID++
+	b.ID = d.breakpointID
+	b.triggered = false
+	d.breakpoints = append(d.breakpoints, b)
+	d.m.Unlock()
+	return d.getStatus(), nil
+}
+
+// ClearBreakpoints removes a breakpoint by its ID. An ID of zero
+// removes all breakpoints
+func (bt *BackTest) ClearBreakpoints(id uint64) (*DebugStatus, error) {
+	err := bt.canDebug()
+	if err != nil {
+		return nil, err
+	}
+	d := &bt.debugger
+	d.m.Lock()
+	if id == 0 {
+		d.breakpoints = nil
+		d.m.Unlock()
+		return d.getStatus(), nil
+	}
+	for i := range d.breakpoints {
+		if d.breakpoints[i].ID == id {
+			d.breakpoints = append(d.breakpoints[:i], d.breakpoints[i+1:]...)
+			d.m.Unlock()
+			return d.getStatus(), nil
+		}
+	}
+	d.m.Unlock()
+	return nil, fmt.Errorf("%w %v", errBreakpointNotFound, id)
+}
+
+// Inspect returns the event queue, data history, holdings, funding
+// and pending orders of a paused task. The task's event loop is
+// blocked while it is paused and cannot be released until the
+// inspection finishes, so its state is safe to read
+func (bt *BackTest) Inspect(historyLimit int64) (*TaskInspection, error) {
+	err := bt.canDebug()
+	if err != nil {
+		return nil, err
+	}
+	bt.debugger.inspecting.RLock()
+	defer bt.debugger.inspecting.RUnlock()
+	status := bt.debugger.getStatus()
+	if !status.Paused {
+		return nil, fmt.Errorf("%w %v", errTaskNotPaused, bt.MetaData.ID)
+	}
+	if historyLimit <= 0 {
+		historyLimit = defaultDebugHistory
+	}
+	resp := &TaskInspection{
+		Status: *status,
+		Seed:   bt.MetaData.Seed,
+	}
+	if bt.EventQueue != nil {
+		queue := bt.EventQueue.PeekEvents()
+		resp.Queue = make([]ProgressEvent, len(queue))
+		for i := range queue {
+			resp.Queue[i] = describeEvent(queue[i])
+		}
+	}
+	if bt.DataHolder != nil {
+		resp.History, err = bt.dataHistory(historyLimit)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if bt.Portfolio != nil {
+		resp.Holdings = bt.Portfolio.GetLatestHoldingsForAllCurrencies()
+	}
+	if bt.Funding != nil {
+		resp.Funding, err = bt.Funding.GetAllFunding()
+		if err != nil {
+			return nil, err
+		}
+		resp.PendingTransfers = bt.Funding.GetPendingTransfers()
+	}
+	if bt.Exchange != nil {
+		resp.PendingOrders = bt.Exchange.GetUnfilledOrders()
+	}
+	return resp, nil
+}
+
+// dataHistory returns the latest processed data events of each data handler
+func (bt *BackTest) dataHistory(limit int64) ([]DataHistory, error) {
+	dataHandlers, err := bt.DataHolder.GetAllData()
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]DataHistory, len(dataHandlers))
+	for i := range dataHandlers {
+		resp[i].Exchange, resp[i].Asset, resp[i].Pair, err = dataHandlers[i].GetDetails()
+		if err != nil {
+			return nil, err
+		}
+		history, err := dataHandlers[i].History()
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(history)) > limit {
+			history = history[int64(len(history))-limit:]
+		}
+		resp[i].Candles = make([]DataCandle, len(history))
+		for j := range history {
+			resp[i].Candles[j] = DataCandle{
+				Time:   history[j].GetTime(),
+				Open:   history[j].GetOpenPrice(),
+				High:   history[j].GetHighPrice(),
+				Low:    history[j].GetLowPrice(),
+				Close:  history[j].GetClosePrice(),
+				Volume: history[j].GetVolume(),
+			}
+		}
+	}
+	return resp, nil
+}
@@ -0,0 +1,420 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	evkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func debugEvent(tt time.Time) *evkline.Kline {
+	return &evkline.Kline{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			Time:         tt,
+			AssetType:    asset.Spot,
+			CurrencyPair: currency.NewPair(currency.BTC, currency.USDT),
+		},
+	}
+}
+
+// waitForPause waits for a task's event loop to block on the debugger
+func waitForPause(t *testing.T, d *Debugger) {
+	t.Helper()
+	for i := 0; i < 200; i++ {
+		if d.getStatus().Paused {
+			return
+		}
+		time.Sleep(time.Millisecond * 5)
+	}
+	t.Fatal("expected debugger to pause")
+}
+
+func TestBeforeEvent(t *testing.T) {
+	t.Parallel()
+	d := &Debugger{}
+	shutdown := make(chan struct{})
+	tt := time.Now().Truncate(time.Hour)
+	if !d.beforeEvent(debugEvent(tt), shutdown) {
+		t.Error("expected event to be handled")
+	}
+	if d.eventNumber != 1 {
+		t.Errorf("received '%v' expected '%v'", d.eventNumber, 1)
+	}
+
+	d.pauseRequested = true
+	handled := make(chan bool)
+	go func() {
+		handled <- d.beforeEvent(debugEvent(tt), shutdown)
+	}()
+	waitForPause(t, d)
+	status := d.getStatus()
+	if status.PauseReason != "paused by request" {
+		t.Errorf("received '%v' expected '%v'", status.PauseReason, "paused by request")
+	}
+	if status.CurrentEvent == nil || status.CurrentEvent.Type != ProgressDataEvent {
+		t.Errorf("received '%v' expected a data event", status.CurrentEvent)
+	}
+	d.m.Lock()
+	d.release()
+	d.m.Unlock()
+	if !<-handled {
+		t.Error("expected event to be handled")
+	}
+
+	d.pauseRequested = true
+	go func() {
+		handled <- d.beforeEvent(debugEvent(tt), shutdown)
+	}()
+	waitForPause(t, d)
+	close(shutdown)
+	if <-handled {
+		t.Error("expected event not to be handled after shutdown")
+	}
+	if d.getStatus().Paused {
+		t.Error("expected debugger to be unpaused after shutdown")
+	}
+}
+
+func TestPauseCondition(t *testing.T) {
+	t.Parallel()
+	d := &Debugger{}
+	tt := time.Now().Truncate(time.Hour)
+	ev := debugEvent(tt)
+	if reason := d.pauseCondition(ev); reason != "" {
+		t.Errorf("received '%v' expected no reason", reason)
+	}
+
+	d.stepping = true
+	d.stepsRemaining = 1
+	if reason := d.pauseCondition(ev); reason != "" {
+		t.Errorf("received '%v' expected no reason", reason)
+	}
+	if reason := d.pauseCondition(ev); reason != "step complete" {
+		t.Errorf("received '%v' expected '%v'", reason, "step complete")
+	}
+	d.stepping = false
+
+	d.breakpoints = []Breakpoint{{ID: 1, Type: BreakpointTime, Time: tt.Add(time.Hour)}}
+	if reason := d.pauseCondition(ev); reason != "" {
+		t.Errorf("received '%v' expected no reason", reason)
+	}
+	if reason := d.pauseCondition(debugEvent(tt.Add(time.Hour))); reason == "" {
+		t.Error("expected time breakpoint to pause")
+	}
+	if reason := d.pauseCondition(debugEvent(tt.Add(time.Hour * 2))); reason != "" {
+		t.Errorf("received '%v' expected time breakpoint to trigger once", reason)
+	}
+
+	d.eventNumber = 5
+	d.breakpoints = []Breakpoint{{ID: 2, Type: BreakpointEvent, EventNumber: 5}}
+	if reason := d.pauseCondition(ev); reason == "" {
+		t.Error("expected event breakpoint to pause")
+	}
+
+	d.breakpoints = []Breakpoint{{ID: 3, Type: BreakpointEventType, EventType: ProgressFillEvent}}
+	if reason := d.pauseCondition(ev); reason != "" {
+		t.Errorf("received '%v' expected no reason", reason)
+	}
+	if reason := d.pauseCondition(&fill.Fill{Base: ev.Base}); reason == "" {
+		t.Error("expected event type breakpoint to pause")
+	}
+
+	d.breakpoints = []Breakpoint{{ID: 4, Type: BreakpointDrawdown, DrawdownPercent: decimal.NewFromInt(10)}}
+	d.drawdown = decimal.NewFromInt(20)
+	if reason := d.pauseCondition(ev); reason == "" {
+		t.Error("expected drawdown breakpoint to pause")
+	}
+	if reason := d.pauseCondition(ev); reason != "" {
+		t.Errorf("received '%v' expected drawdown breakpoint to trigger once", reason)
+	}
+	d.drawdown = decimal.NewFromInt(5)
+	if reason := d.pauseCondition(ev); reason != "" {
+		t.Errorf("received '%v' expected no reason", reason)
+	}
+	d.drawdown = decimal.NewFromInt(15)
+	if reason := d.pauseCondition(ev); reason == "" {
+		t.Error("expected drawdown breakpoint to re-arm after recovering")
+	}
+}
+
+func TestUpdateDrawdown(t *testing.T) {
+	t.Parallel()
+	d := &Debugger{}
+	d.updateDrawdown([]holdings.Holding{{TotalValue: decimal.NewFromInt(100)}, {TotalValue: decimal.NewFromInt(100)}})
+	if !d.peakValue.Equal(decimal.NewFromInt(200)) {
+		t.Errorf("received '%v' expected '%v'", d.peakValue, 200)
+	}
+	d.updateDrawdown([]holdings.Holding{{TotalValue: decimal.NewFromInt(150)}})
+	if !d.drawdown.Equal(decimal.NewFromInt(25)) {
+		t.Errorf("received '%v' expected '%v'", d.drawdown, 25)
+	}
+}
+
+func TestTracksDrawdown(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{}
+	if bt.debugger.tracksDrawdown() {
+		t.Error("expected drawdown to be untracked without a drawdown breakpoint")
+	}
+	_, err := bt.SetBreakpoint(Breakpoint{Type: BreakpointTime, Time: time.Now()})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if bt.debugger.tracksDrawdown() {
+		t.Error("expected drawdown to be untracked without a drawdown breakpoint")
+	}
+
+	bt.debugger.peakValue = decimal.NewFromInt(200)
+	bt.debugger.drawdown = decimal.NewFromInt(50)
+	_, err = bt.SetBreakpoint(Breakpoint{Type: BreakpointDrawdown, DrawdownPercent: decimal.NewFromInt(10)})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !bt.debugger.tracksDrawdown() {
+		t.Error("expected drawdown to be tracked with a drawdown breakpoint")
+	}
+	if !bt.debugger.peakValue.IsZero() || !bt.debugger.drawdown.IsZero() {
+		t.Errorf("received '%v %v' expected stale drawdown to be reset", bt.debugger.peakValue, bt.debugger.drawdown)
+	}
+
+	bt.debugger.peakValue = decimal.NewFromInt(200)
+	_, err = bt.SetBreakpoint(Breakpoint{Type: BreakpointDrawdown, DrawdownPercent: decimal.NewFromInt(20)})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !bt.debugger.peakValue.Equal(decimal.NewFromInt(200)) {
+		t.Errorf("received '%v' expected '%v'", bt.debugger.peakValue, 200)
+	}
+}
+
+func TestDescribeEvent(t *testing.T) {
+	t.Parallel()
+	b := debugEvent(time.Now()).Base
+	ev := describeEvent(&signal.Signal{Base: b, Direction: gctorder.Buy, Amount: leet})
+	if ev.Type != ProgressSignalEvent || !ev.Amount.Equal(leet) {
+		t.Errorf("received '%v %v' expected '%v %v'", ev.Type, ev.Amount, ProgressSignalEvent, leet)
+	}
+	ev = describeEvent(&order.Order{Base: b, Direction: gctorder.Sell})
+	if ev.Type != ProgressOrderEvent || ev.Direction != gctorder.Sell {
+		t.Errorf("received '%v %v' expected '%v %v'", ev.Type, ev.Direction, ProgressOrderEvent, gctorder.Sell)
+	}
+	ev = describeEvent(&fill.Fill{Base: b, PurchasePrice: leet})
+	if ev.Type != ProgressFillEvent || !ev.Price.Equal(leet) {
+		t.Errorf("received '%v %v' expected '%v %v'", ev.Type, ev.Price, ProgressFillEvent, leet)
+	}
+}
+
+func TestDebug(t *testing.T) {
+	t.Parallel()
+	var bt *BackTest
+	_, err := bt.Debug(DebugPause, 0)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	bt = &BackTest{}
+	_, err = bt.Debug("rewind", 0)
+	if !errors.Is(err, errInvalidDebugAction) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidDebugAction)
+	}
+	_, err = bt.Debug(DebugPause, 0)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !bt.debugger.isActive() {
+		t.Error("expected debugger to be active")
+	}
+
+	shutdown := make(chan struct{})
+	handled := make(chan bool)
+	go func() {
+		handled <- bt.debugger.beforeEvent(debugEvent(time.Now()), shutdown)
+	}()
+	waitForPause(t, &bt.debugger)
+	_, err = bt.Debug(DebugStep, 2)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	<-handled
+	if !bt.debugger.beforeEvent(debugEvent(time.Now()), shutdown) {
+		t.Error("expected second step to be handled")
+	}
+	go func() {
+		handled <- bt.debugger.beforeEvent(debugEvent(time.Now()), shutdown)
+	}()
+	waitForPause(t, &bt.debugger)
+	if reason := bt.debugger.getStatus().PauseReason; reason != "step complete" {
+		t.Errorf("received '%v' expected '%v'", reason, "step complete")
+	}
+	_, err = bt.Debug(DebugResume, 0)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	<-handled
+	if bt.debugger.isActive() {
+		t.Error("expected debugger to be inactive after resuming")
+	}
+
+	bt.MetaData.LiveTesting = true
+	_, err = bt.Debug(DebugPause, 0)
+	if !errors.Is(err, errCannotDebugLive) {
+		t.Errorf("received '%v' expected '%v'", err, errCannotDebugLive)
+	}
+	bt.MetaData.LiveTesting = false
+	bt.MetaData.Closed = true
+	_, err = bt.Debug(DebugPause, 0)
+	if !errors.Is(err, errAlreadyRan) {
+		t.Errorf("received '%v' expected '%v'", err, errAlreadyRan)
+	}
+}
+
+func TestSetBreakpoint(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{}
+	_, err := bt.SetBreakpoint(Breakpoint{Type: "bananas"})
+	if !errors.Is(err, errInvalidBreakpoint) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidBreakpoint)
+	}
+	_, err = bt.SetBreakpoint(Breakpoint{Type: BreakpointTime})
+	if !errors.Is(err, gctcommon.ErrDateUnset) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrDateUnset)
+	}
+	_, err = bt.SetBreakpoint(Breakpoint{Type: BreakpointEvent})
+	if !errors.Is(err, errInvalidBreakpoint) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidBreakpoint)
+	}
+	_, err = bt.SetBreakpoint(Breakpoint{Type: BreakpointEventType, EventType: "bananas"})
+	if !errors.Is(err, errInvalidBreakpoint) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidBreakpoint)
+	}
+	_, err = bt.SetBreakpoint(Breakpoint{Type: BreakpointDrawdown})
+	if !errors.Is(err, errInvalidBreakpoint) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidBreakpoint)
+	}
+
+	_, err = bt.SetBreakpoint(Breakpoint{Type: BreakpointTime, Time: time.Now()})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	status, err := bt.SetBreakpoint(Breakpoint{Type: "EVENT-TYPE", EventType: "Fill"})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(status.Breakpoints) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(status.Breakpoints), 2)
+	}
+	if status.Breakpoints[1].ID != 2 || status.Breakpoints[1].EventType != ProgressFillEvent {
+		t.Errorf("received '%v %v' expected '%v %v'", status.Breakpoints[1].ID, status.Breakpoints[1].EventType, 2, ProgressFillEvent)
+	}
+}
+
+func TestClearBreakpoints(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{}
+	for i := 0; i < 3; i++ {
+		_, err := bt.SetBreakpoint(Breakpoint{Type: BreakpointDrawdown, DrawdownPercent: decimal.NewFromInt(10)})
+		if !errors.Is(err, nil) {
+			t.Errorf("received '%v' expected '%v'", err, nil)
+		}
+	}
+	_, err := bt.ClearBreakpoints(1337)
+	if !errors.Is(err, errBreakpointNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errBreakpointNotFound)
+	}
+	status, err := bt.ClearBreakpoints(2)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(status.Breakpoints) != 2 || status.Breakpoints[1].ID != 3 {
+		t.Errorf("received '%v' expected breakpoints 1 and 3", status.Breakpoints)
+	}
+	status, err = bt.ClearBreakpoints(0)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(status.Breakpoints) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(status.Breakpoints), 0)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{
+		EventQueue: &eventholder.Holder{},
+		DataHolder: &data.HandlerHolder{},
+	}
+	_, err := bt.Inspect(0)
+	if !errors.Is(err, errTaskNotPaused) {
+		t.Errorf("received '%v' expected '%v'", err, errTaskNotPaused)
+	}
+
+	bt.EventQueue.AppendEvent(debugEvent(time.Now()))
+	bt.MetaData.Seed = 1337
+	bt.debugger.waiting = true
+	inspection, err := bt.Inspect(0)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if inspection.Seed != 1337 {
+		t.Errorf("received '%v' expected '%v'", inspection.Seed, 1337)
+	}
+	if len(inspection.Queue) != 1 || inspection.Queue[0].Type != ProgressDataEvent {
+		t.Errorf("received '%v' expected a queued data event", inspection.Queue)
+	}
+	if !inspection.Status.Paused {
+		t.Error("expected paused status")
+	}
+
+	// a task cannot be released while it is being inspected
+	bt.debugger.inspecting.RLock()
+	resumed := make(chan struct{})
+	go func() {
+		_, resumeErr := bt.Debug(DebugResume, 0)
+		if !errors.Is(resumeErr, nil) {
+			t.Errorf("received '%v' expected '%v'", resumeErr, nil)
+		}
+		close(resumed)
+	}()
+	select {
+	case <-resumed:
+		t.Error("expected resume to wait for the inspection to finish")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if !bt.debugger.getStatus().Paused {
+		t.Error("expected task to remain paused during inspection")
+	}
+	bt.debugger.inspecting.RUnlock()
+	<-resumed
+	if bt.debugger.getStatus().Paused {
+		t.Error("expected task to be resumed")
+	}
+}
+
+func TestExecuteStrategyWhileDebugging(t *testing.T) {
+	t.Parallel()
+	bt := &BackTest{
+		MetaData: TaskMetaData{DateLoaded: time.Now()},
+	}
+	_, err := bt.SetBreakpoint(Breakpoint{Type: BreakpointEventType, EventType: ProgressFillEvent})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = bt.ExecuteStrategy(true)
+	if !errors.Is(err, errTaskIsDebugging) {
+		t.Errorf("received '%v' expected '%v'", err, errTaskIsDebugging)
+	}
+}
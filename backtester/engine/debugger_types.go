@@ -0,0 +1,125 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// defaultDebugHistory is the amount of data events returned for each
+// data handler when inspecting a task without a history limit
+const defaultDebugHistory = 25
+
+// Debug actions
+const (
+	DebugPause  = "pause"
+	DebugStep   = "step"
+	DebugResume = "resume"
+)
+
+// Breakpoint types
+const (
+	// BreakpointTime pauses at the first event at or after a time
+	BreakpointTime = "time"
+	// BreakpointEvent pauses before the numbered event is handled
+	BreakpointEvent = "event"
+	// BreakpointEventType pauses before every event of a type
+	BreakpointEventType = "event-type"
+	// BreakpointDrawdown pauses when the value of all holdings falls
+	// further than a percentage from its highest value
+	BreakpointDrawdown = "drawdown"
+)
+
+var (
+	errInvalidDebugAction = errors.New("invalid debug action")
+	errInvalidBreakpoint  = errors.New("invalid breakpoint")
+	errBreakpointNotFound = errors.New("breakpoint not found")
+	errTaskNotPaused      = errors.New("task is not paused")
+	errCannotDebugLive    = errors.New("live tasks cannot be debugged")
+	errTaskIsDebugging    = errors.New("task is paused or has breakpoints set")
+)
+
+// Debugger pauses a task's event loop between events so that the
+// state of the task can be inspected
+type Debugger struct {
+	m sync.Mutex
+	// inspecting is read locked while a paused task is inspected and write
+	// locked before the task is released, so the task's state cannot change
+	// during an inspection. It must be acquired before m
+	inspecting     sync.RWMutex
+	resume         chan struct{}
+	waiting        bool
+	pauseRequested bool
+	stepping       bool
+	stepsRemaining uint64
+	pauseReason    string
+	eventNumber    uint64
+	currentEvent   common.Event
+	breakpoints    []Breakpoint
+	breakpointID   uint64
+	peakValue      decimal.Decimal
+	drawdown       decimal.Decimal
+}
+
+// Breakpoint pauses a task when its condition is met. Only the
+// field associated with the breakpoint's type is used
+type Breakpoint struct {
+	ID          uint64
+	Type        string
+	Time        time.Time
+	EventNumber uint64
+	EventType   string
+	// DrawdownPercent is expressed as a whole number, eg 5 is 5%
+	DrawdownPercent decimal.Decimal
+	triggered       bool
+}
+
+// DebugStatus is a point in time copy of a task's debugger.
+// Paused is only true when the task is waiting between events
+type DebugStatus struct {
+	Paused          bool
+	PauseReason     string
+	EventNumber     uint64
+	CurrentEvent    *ProgressEvent
+	DrawdownPercent decimal.Decimal
+	Breakpoints     []Breakpoint
+}
+
+// TaskInspection holds the state of a paused task
+type TaskInspection struct {
+	Status           DebugStatus
+	Seed             int64
+	Queue            []ProgressEvent
+	History          []DataHistory
+	Holdings         []holdings.Holding
+	Funding          []funding.BasicItem
+	PendingOrders    []exchange.RestingOrder
+	PendingTransfers []funding.TransferRecord
+}
+
+// DataHistory holds the latest data events processed by
+// a data handler
+type DataHistory struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	Candles  []DataCandle
+}
+
+// DataCandle is a processed data event
+type DataCandle struct {
+	Time   time.Time
+	Open   decimal.Decimal
+	High   decimal.Decimal
+	Low    decimal.Decimal
+	Close  decimal.Decimal
+	Volume decimal.Decimal
+}
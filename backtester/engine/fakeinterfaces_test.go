@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"errors"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -12,11 +13,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/backtester/report"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -83,6 +87,10 @@ func (f fakeFolio) UpdatePNL(common.Event, decimal.Decimal) error {
 	return nil
 }
 
+func (f fakeFolio) ProcessFundingRates(data.Event) (decimal.Decimal, error) {
+	return decimal.Zero, nil
+}
+
 func (f fakeFolio) GetLatestPNLForEvent(common.Event) (*portfolio.PNLSummary, error) {
 	return &portfolio.PNLSummary{}, nil
 }
@@ -107,6 +115,10 @@ func (f fakeFolio) Reset() error {
 	return nil
 }
 
+func (f fakeFolio) CheckExitRules(data.Handler) (signal.Event, error) {
+	return nil, nil
+}
+
 type fakeReport struct{}
 
 func (f fakeReport) GenerateReport() error {
@@ -119,6 +131,23 @@ func (f fakeReport) SetKlineData(*gctkline.Item) error {
 
 func (f fakeReport) UseDarkMode(bool) {}
 
+func (f fakeReport) SetExportFormats([]string) {}
+
+func (f fakeReport) CreateExport() (*report.Export, error) {
+	return &report.Export{}, nil
+}
+
+var errTestReport = errors.New("test report error")
+
+// fakeFailingReport fails to generate a report
+type fakeFailingReport struct {
+	fakeReport
+}
+
+func (f fakeFailingReport) GenerateReport() error {
+	return errTestReport
+}
+
 type fakeStats struct{}
 
 func (f *fakeStats) SetStrategyName(string) {
@@ -153,6 +182,18 @@ func (f *fakeStats) AddPNLForTime(*portfolio.PNLSummary) error {
 	return nil
 }
 
+func (f *fakeStats) AddUnfilledOrders([]exchange.RestingOrder) error {
+	return nil
+}
+
+func (f *fakeStats) GetPerformanceSummary() (*statistics.PerformanceSummary, error) {
+	return &statistics.PerformanceSummary{}, nil
+}
+
+func (f *fakeStats) GetEquityCurve() ([]statistics.ValueAtTime, error) {
+	return nil, nil
+}
+
 func (f *fakeStats) CreateLog(common.Event) (string, error) {
 	return "", nil
 }
@@ -278,6 +319,26 @@ func (f fakeFunding) RealisePNL(string, asset.Item, currency.Code, decimal.Decim
 	return nil
 }
 
+func (f fakeFunding) SetupSpotMargin(*funding.SpotMarginSetup) error {
+	return nil
+}
+
+func (f fakeFunding) UpdateSpotMargin(common.Event) error {
+	return nil
+}
+
+func (f fakeFunding) RequestTransfer(*funding.TransferRequest) (*funding.TransferRecord, error) {
+	return nil, nil
+}
+
+func (f fakeFunding) ProcessTransfers(time.Time) error {
+	return nil
+}
+
+func (f fakeFunding) GetPendingTransfers() []funding.TransferRecord {
+	return nil
+}
+
 type fakeStrat struct{}
 
 func (f fakeStrat) Name() string {
@@ -312,6 +373,10 @@ func (f fakeStrat) SetCustomSettings(map[string]interface{}) error {
 
 func (f fakeStrat) SetDefaults() {}
 
+func (f fakeStrat) CustomSettingsSchema() base.CustomSettingsSchema {
+	return base.CustomSettingsSchema{}
+}
+
 func (f fakeStrat) CloseAllPositions([]holdings.Holding, []data.Event) ([]signal.Event, error) {
 	return []signal.Event{
 		&signal.Signal{
@@ -336,3 +401,14 @@ func (f fakeStrat) CloseAllPositions([]holdings.Holding, []data.Event) ([]signal
 		},
 	}, nil
 }
+
+// fakeClosingStrat counts the times its resources are released
+type fakeClosingStrat struct {
+	fakeStrat
+	closed int
+}
+
+func (f *fakeClosingStrat) Close() error {
+	f.closed++
+	return nil
+}
@@ -17,11 +17,13 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/backtestrun"
 	gctengine "github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -42,8 +44,9 @@ var (
 // GRPCServer struct
 type GRPCServer struct {
 	btrpc.BacktesterServiceServer
-	config  *config.BacktesterConfig
-	manager *TaskManager
+	config     *config.BacktesterConfig
+	manager    *TaskManager
+	runHistory *RunHistory
 }
 
 // SetupRPCServer sets up the gRPC server
@@ -54,9 +57,14 @@ func SetupRPCServer(cfg *config.BacktesterConfig, manager *TaskManager) (*GRPCSe
 	if manager == nil {
 		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
 	}
+	runHistory, err := SetupRunHistory(&cfg.RunHistory)
+	if err != nil {
+		return nil, err
+	}
 	return &GRPCServer{
-		config:  cfg,
-		manager: manager,
+		config:     cfg,
+		manager:    manager,
+		runHistory: runHistory,
 	}, nil
 }
 
@@ -80,6 +88,7 @@ func StartRPCServer(server *GRPCServer) error {
 	opts := []grpc.ServerOption{
 		grpc.Creds(creds),
 		grpc.UnaryInterceptor(grpcauth.UnaryServerInterceptor(server.authenticateClient)),
+		grpc.StreamInterceptor(grpcauth.StreamServerInterceptor(server.authenticateClient)),
 	}
 	s := grpc.NewServer(opts...)
 	btrpc.RegisterBacktesterServiceServer(s, server)
@@ -176,6 +185,7 @@ func convertSummary(task *TaskSummary) *btrpc.TaskSummary {
 		Closed:       task.MetaData.Closed,
 		LiveTesting:  task.MetaData.LiveTesting,
 		RealOrders:   task.MetaData.RealOrders,
+		Seed:         task.MetaData.Seed,
 	}
 	if !task.MetaData.DateStarted.IsZero() {
 		taskSummary.DateStarted = task.MetaData.DateStarted.Format(gctcommon.SimpleTimeFormatWithTimezone)
@@ -189,6 +199,23 @@ func convertSummary(task *TaskSummary) *btrpc.TaskSummary {
 	return taskSummary
 }
 
+// reportConfig copies the backtester config for a task, applying
+// any requested export formats. Report paths are cleared when the task
+// neither generates a report nor exports its results
+func (s *GRPCServer) reportConfig(exportFormats []string) *config.BacktesterConfig {
+	btCfg := *s.config
+	if len(exportFormats) > 0 {
+		btCfg.Report.ExportFormats = exportFormats
+	}
+	if !btCfg.Report.GenerateReport {
+		btCfg.Report.TemplatePath = ""
+		if len(btCfg.Report.ExportFormats) == 0 {
+			btCfg.Report.OutputPath = ""
+		}
+	}
+	return &btCfg
+}
+
 // ExecuteStrategyFromFile will backtest a strategy from the filepath provided
 func (s *GRPCServer) ExecuteStrategyFromFile(_ context.Context, request *btrpc.ExecuteStrategyFromFileRequest) (*btrpc.ExecuteStrategyResponse, error) {
 	if s.config == nil {
@@ -242,15 +269,11 @@ func (s *GRPCServer) ExecuteStrategyFromFile(_ context.Context, request *btrpc.E
 		return nil, err
 	}
 
-	if !s.config.Report.GenerateReport {
-		s.config.Report.OutputPath = ""
-		s.config.Report.TemplatePath = ""
-	}
-
-	bt, err := NewBacktesterFromConfigs(cfg, s.config)
+	bt, err := NewBacktesterFromConfigs(cfg, s.reportConfig(request.ExportFormats))
 	if err != nil {
 		return nil, err
 	}
+	bt.SetRunHistory(s.runHistory)
 
 	if !request.DoNotStore {
 		err = s.manager.AddTask(bt)
@@ -587,6 +610,7 @@ func (s *GRPCServer) ExecuteStrategyFromConfig(_ context.Context, request *btrpc
 			SimultaneousSignalProcessing: request.Config.StrategySettings.UseSimultaneousSignalProcessing,
 			DisableUSDTracking:           request.Config.StrategySettings.DisableUsdTracking,
 			CustomSettings:               customSettings,
+			Seed:                         request.Config.StrategySettings.Seed,
 		},
 		FundingSettings: config.FundingSettings{
 			UseExchangeLevelFunding: request.Config.FundingSettings.UseExchangeLevelFunding,
@@ -624,15 +648,11 @@ func (s *GRPCServer) ExecuteStrategyFromConfig(_ context.Context, request *btrpc
 		},
 	}
 
-	if !s.config.Report.GenerateReport {
-		s.config.Report.OutputPath = ""
-		s.config.Report.TemplatePath = ""
-	}
-
-	bt, err := NewBacktesterFromConfigs(cfg, s.config)
+	bt, err := NewBacktesterFromConfigs(cfg, s.reportConfig(request.ExportFormats))
 	if err != nil {
 		return nil, err
 	}
+	bt.SetRunHistory(s.runHistory)
 
 	if !request.DoNotStore {
 		err = s.manager.AddTask(bt)
@@ -806,3 +826,435 @@ func (s *GRPCServer) ClearAllTasks(_ context.Context, _ *btrpc.ClearAllTasksRequ
 		RemainingTasks: remainingResponse,
 	}, nil
 }
+
+// ListRuns returns a summary of completed runs stored in the run history
+func (s *GRPCServer) ListRuns(_ context.Context, request *btrpc.ListRunsRequest) (*btrpc.ListRunsResponse, error) {
+	if request == nil {
+		return nil, fmt.Errorf("%w request", gctcommon.ErrNilPointer)
+	}
+	filter := &backtestrun.Filter{
+		Strategy:   request.Strategy,
+		Nickname:   request.Nickname,
+		ConfigHash: request.ConfigHash,
+		Limit:      int(request.Limit),
+	}
+	if request.CreatedFrom != nil {
+		filter.CreatedFrom = request.CreatedFrom.AsTime()
+	}
+	if request.CreatedTo != nil {
+		filter.CreatedTo = request.CreatedTo.AsTime()
+	}
+	runs, err := s.runHistory.GetRuns(filter)
+	if err != nil {
+		return nil, err
+	}
+	resp := &btrpc.ListRunsResponse{
+		Runs: make([]*btrpc.RunSummary, len(runs)),
+	}
+	for i := range runs {
+		resp.Runs[i] = convertRunSummary(&runs[i])
+	}
+	return resp, nil
+}
+
+// GetRun returns a completed run stored in the run history with its
+// equity curve and trades. The exported results are included on request
+func (s *GRPCServer) GetRun(_ context.Context, request *btrpc.GetRunRequest) (*btrpc.GetRunResponse, error) {
+	if request == nil {
+		return nil, fmt.Errorf("%w request", gctcommon.ErrNilPointer)
+	}
+	run, err := s.runHistory.GetRun(request.Id)
+	if err != nil {
+		return nil, err
+	}
+	resp := &btrpc.GetRunResponse{
+		Run:         convertRunSummary(run),
+		Config:      run.Config,
+		EquityCurve: make([]*btrpc.EquityPoint, len(run.EquityCurve)),
+		Trades:      make([]*btrpc.RunTrade, len(run.Trades)),
+	}
+	for i := range run.EquityCurve {
+		resp.EquityCurve[i] = &btrpc.EquityPoint{
+			Time:  run.EquityCurve[i].Time.Format(gctcommon.SimpleTimeFormatWithTimezone),
+			Value: run.EquityCurve[i].Value,
+		}
+	}
+	for i := range run.Trades {
+		resp.Trades[i] = &btrpc.RunTrade{
+			Exchange:  run.Trades[i].Exchange,
+			Asset:     run.Trades[i].Asset,
+			Base:      run.Trades[i].Base,
+			Quote:     run.Trades[i].Quote,
+			Side:      run.Trades[i].Side,
+			Price:     run.Trades[i].Price,
+			Amount:    run.Trades[i].Amount,
+			Fee:       run.Trades[i].Fee,
+			Timestamp: run.Trades[i].Timestamp.Format(gctcommon.SimpleTimeFormatWithTimezone),
+		}
+	}
+	if request.IncludeReport {
+		resp.Report = run.Report
+	}
+	return resp, nil
+}
+
+// CompareRuns compares the headline metrics of runs stored in the run history
+func (s *GRPCServer) CompareRuns(_ context.Context, request *btrpc.CompareRunsRequest) (*btrpc.CompareRunsResponse, error) {
+	if request == nil {
+		return nil, fmt.Errorf("%w request", gctcommon.ErrNilPointer)
+	}
+	comparison, err := s.runHistory.CompareRuns(request.Ids)
+	if err != nil {
+		return nil, err
+	}
+	resp := &btrpc.CompareRunsResponse{
+		Runs:                make([]*btrpc.RunComparison, len(comparison.Runs)),
+		HighestTotalReturn:  comparison.HighestTotalReturn,
+		HighestSharpeRatio:  comparison.HighestSharpeRatio,
+		HighestSortinoRatio: comparison.HighestSortinoRatio,
+		HighestCalmarRatio:  comparison.HighestCalmarRatio,
+		LowestMaxDrawdown:   comparison.LowestMaxDrawdown,
+	}
+	for i := range comparison.Runs {
+		resp.Runs[i] = &btrpc.RunComparison{
+			Run:         convertRunSummary(&comparison.Runs[i]),
+			TotalTrades: uint64(len(comparison.Runs[i].Trades)),
+			SameConfig:  comparison.Runs[i].ConfigHash == comparison.Runs[0].ConfigHash,
+		}
+	}
+	return resp, nil
+}
+
+// StreamTaskProgress streams the progress of a strategy task until it
+// has finished, including any generated signals and fills when requested
+func (s *GRPCServer) StreamTaskProgress(req *btrpc.StreamTaskProgressRequest, stream btrpc.BacktesterService_StreamTaskProgressServer) error {
+	if s.manager == nil {
+		return fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if req == nil {
+		return fmt.Errorf("%w StreamTaskProgressRequest", gctcommon.ErrNilPointer)
+	}
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return err
+	}
+	unsubscribe, err := s.manager.SubscribeProgress(id)
+	if err != nil {
+		return err
+	}
+	defer unsubscribe()
+	ticker := time.NewTicker(progressStreamInterval)
+	defer ticker.Stop()
+	var previous *TaskProgress
+	var lastEventID uint64
+	for {
+		var progress *TaskProgress
+		progress, err = s.manager.GetProgress(id, lastEventID, req.IncludeEvents)
+		if err != nil {
+			return err
+		}
+		if isNewerProgress(previous, progress) {
+			if len(progress.Events) > 0 {
+				lastEventID = progress.Events[len(progress.Events)-1].ID
+			}
+			err = stream.Send(convertProgress(progress))
+			if err != nil {
+				return err
+			}
+			previous = progress
+		}
+		if progress.Finished {
+			return nil
+		}
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// convertProgress converts a task's progress into a RPC format
+func convertProgress(progress *TaskProgress) *btrpc.StreamTaskProgressResponse {
+	resp := &btrpc.StreamTaskProgressResponse{
+		Task:                  convertSummary(&TaskSummary{MetaData: progress.MetaData}),
+		ProgressPercentage:    progress.PercentComplete.InexactFloat64(),
+		DataEventsProcessed:   progress.DataEvents,
+		SignalEventsProcessed: progress.SignalEvents,
+		OrderEventsProcessed:  progress.OrderEvents,
+		FillEventsProcessed:   progress.FillEvents,
+		Holdings:              make([]*btrpc.TaskHolding, len(progress.Holdings)),
+		Events:                make([]*btrpc.TaskEvent, len(progress.Events)),
+		Finished:              progress.Finished,
+	}
+	if !progress.CurrentTime.IsZero() {
+		resp.CurrentTime = progress.CurrentTime.Format(gctcommon.SimpleTimeFormatWithTimezone)
+	}
+	for i := range progress.Holdings {
+		resp.Holdings[i] = convertHolding(&progress.Holdings[i])
+	}
+	for i := range progress.Events {
+		resp.Events[i] = convertEvent(&progress.Events[i])
+	}
+	return resp
+}
+
+// convertHolding converts a holding into a RPC format
+func convertHolding(h *holdings.Holding) *btrpc.TaskHolding {
+	return &btrpc.TaskHolding{
+		Exchange:   h.Exchange,
+		Asset:      h.Asset.String(),
+		Base:       h.Pair.Base.String(),
+		Quote:      h.Pair.Quote.String(),
+		BaseSize:   h.BaseSize.InexactFloat64(),
+		BaseValue:  h.BaseValue.InexactFloat64(),
+		QuoteSize:  h.QuoteSize.InexactFloat64(),
+		TotalValue: h.TotalValue.InexactFloat64(),
+		TotalFees:  h.TotalFees.InexactFloat64(),
+	}
+}
+
+// convertEvent converts a progress event into a RPC format
+func convertEvent(ev *ProgressEvent) *btrpc.TaskEvent {
+	return &btrpc.TaskEvent{
+		Id:        ev.ID,
+		Type:      ev.Type,
+		Time:      ev.Time.Format(gctcommon.SimpleTimeFormatWithTimezone),
+		Exchange:  ev.Exchange,
+		Asset:     ev.Asset.String(),
+		Base:      ev.Pair.Base.String(),
+		Quote:     ev.Pair.Quote.String(),
+		Direction: ev.Direction.String(),
+		Price:     ev.Price.InexactFloat64(),
+		Amount:    ev.Amount.InexactFloat64(),
+		Fee:       ev.Fee.InexactFloat64(),
+		Reason:    ev.Reason,
+	}
+}
+
+// DebugTask pauses, steps or resumes a strategy task
+func (s *GRPCServer) DebugTask(_ context.Context, req *btrpc.DebugTaskRequest) (*btrpc.DebugTaskResponse, error) {
+	if s.manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if req == nil {
+		return nil, fmt.Errorf("%w DebugTaskRequest", gctcommon.ErrNilPointer)
+	}
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	status, err := s.manager.DebugTask(id, req.Action, req.Steps)
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.DebugTaskResponse{
+		Status: convertDebugStatus(status),
+	}, nil
+}
+
+// SetTaskBreakpoint adds a breakpoint which pauses a strategy task
+// when its condition is met
+func (s *GRPCServer) SetTaskBreakpoint(_ context.Context, req *btrpc.SetTaskBreakpointRequest) (*btrpc.SetTaskBreakpointResponse, error) {
+	if s.manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if req == nil {
+		return nil, fmt.Errorf("%w SetTaskBreakpointRequest", gctcommon.ErrNilPointer)
+	}
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	b := Breakpoint{
+		Type:            req.Type,
+		EventNumber:     req.EventNumber,
+		EventType:       req.EventType,
+		DrawdownPercent: decimal.NewFromFloat(req.DrawdownPercent),
+	}
+	if req.Time != nil {
+		b.Time = req.Time.AsTime()
+	}
+	status, err := s.manager.SetTaskBreakpoint(id, b)
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.SetTaskBreakpointResponse{
+		Status: convertDebugStatus(status),
+	}, nil
+}
+
+// ClearTaskBreakpoints removes a breakpoint from a strategy task.
+// A breakpoint ID of zero removes all breakpoints
+func (s *GRPCServer) ClearTaskBreakpoints(_ context.Context, req *btrpc.ClearTaskBreakpointsRequest) (*btrpc.ClearTaskBreakpointsResponse, error) {
+	if s.manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if req == nil {
+		return nil, fmt.Errorf("%w ClearTaskBreakpointsRequest", gctcommon.ErrNilPointer)
+	}
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	status, err := s.manager.ClearTaskBreakpoints(id, req.BreakpointId)
+	if err != nil {
+		return nil, err
+	}
+	return &btrpc.ClearTaskBreakpointsResponse{
+		Status: convertDebugStatus(status),
+	}, nil
+}
+
+// InspectTask returns the event queue, data history, holdings,
+// funding and pending orders of a paused strategy task
+func (s *GRPCServer) InspectTask(_ context.Context, req *btrpc.InspectTaskRequest) (*btrpc.InspectTaskResponse, error) {
+	if s.manager == nil {
+		return nil, fmt.Errorf("%w task manager", gctcommon.ErrNilPointer)
+	}
+	if req == nil {
+		return nil, fmt.Errorf("%w InspectTaskRequest", gctcommon.ErrNilPointer)
+	}
+	id, err := uuid.FromString(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	inspection, err := s.manager.InspectTask(id, req.HistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+	return convertInspection(inspection), nil
+}
+
+// convertDebugStatus converts a task's debug status into a RPC format
+func convertDebugStatus(status *DebugStatus) *btrpc.TaskDebugStatus {
+	resp := &btrpc.TaskDebugStatus{
+		Paused:          status.Paused,
+		PauseReason:     status.PauseReason,
+		EventNumber:     status.EventNumber,
+		DrawdownPercent: status.DrawdownPercent.InexactFloat64(),
+		Breakpoints:     make([]*btrpc.TaskBreakpoint, len(status.Breakpoints)),
+	}
+	if status.CurrentEvent != nil {
+		resp.CurrentEvent = convertEvent(status.CurrentEvent)
+	}
+	for i := range status.Breakpoints {
+		resp.Breakpoints[i] = &btrpc.TaskBreakpoint{
+			Id:              status.Breakpoints[i].ID,
+			Type:            status.Breakpoints[i].Type,
+			EventNumber:     status.Breakpoints[i].EventNumber,
+			EventType:       status.Breakpoints[i].EventType,
+			DrawdownPercent: status.Breakpoints[i].DrawdownPercent.InexactFloat64(),
+		}
+		if !status.Breakpoints[i].Time.IsZero() {
+			resp.Breakpoints[i].Time = status.Breakpoints[i].Time.Format(gctcommon.SimpleTimeFormatWithTimezone)
+		}
+	}
+	return resp
+}
+
+// convertInspection converts the state of a paused task into a RPC format
+func convertInspection(inspection *TaskInspection) *btrpc.InspectTaskResponse {
+	resp := &btrpc.InspectTaskResponse{
+		Status:           convertDebugStatus(&inspection.Status),
+		Seed:             inspection.Seed,
+		Queue:            make([]*btrpc.TaskEvent, len(inspection.Queue)),
+		History:          make([]*btrpc.TaskDataHistory, len(inspection.History)),
+		Holdings:         make([]*btrpc.TaskHolding, len(inspection.Holdings)),
+		Funding:          make([]*btrpc.TaskFunding, len(inspection.Funding)),
+		PendingOrders:    make([]*btrpc.TaskRestingOrder, len(inspection.PendingOrders)),
+		PendingTransfers: make([]*btrpc.TaskTransfer, len(inspection.PendingTransfers)),
+	}
+	for i := range inspection.Queue {
+		resp.Queue[i] = convertEvent(&inspection.Queue[i])
+	}
+	for i := range inspection.History {
+		h := &inspection.History[i]
+		resp.History[i] = &btrpc.TaskDataHistory{
+			Exchange: h.Exchange,
+			Asset:    h.Asset.String(),
+			Base:     h.Pair.Base.String(),
+			Quote:    h.Pair.Quote.String(),
+			Candles:  make([]*btrpc.TaskCandle, len(h.Candles)),
+		}
+		for j := range h.Candles {
+			resp.History[i].Candles[j] = &btrpc.TaskCandle{
+				Time:   h.Candles[j].Time.Format(gctcommon.SimpleTimeFormatWithTimezone),
+				Open:   h.Candles[j].Open.InexactFloat64(),
+				High:   h.Candles[j].High.InexactFloat64(),
+				Low:    h.Candles[j].Low.InexactFloat64(),
+				Close:  h.Candles[j].Close.InexactFloat64(),
+				Volume: h.Candles[j].Volume.InexactFloat64(),
+			}
+		}
+	}
+	for i := range inspection.Holdings {
+		resp.Holdings[i] = convertHolding(&inspection.Holdings[i])
+	}
+	for i := range inspection.Funding {
+		resp.Funding[i] = &btrpc.TaskFunding{
+			Exchange:     inspection.Funding[i].Exchange,
+			Asset:        inspection.Funding[i].Asset.String(),
+			Currency:     inspection.Funding[i].Currency.String(),
+			InitialFunds: inspection.Funding[i].InitialFunds.InexactFloat64(),
+			Available:    inspection.Funding[i].Available.InexactFloat64(),
+			Reserved:     inspection.Funding[i].Reserved.InexactFloat64(),
+		}
+	}
+	for i := range inspection.PendingOrders {
+		o := &inspection.PendingOrders[i]
+		resp.PendingOrders[i] = &btrpc.TaskRestingOrder{
+			Id:           o.ID,
+			Exchange:     o.Exchange,
+			Asset:        o.Asset.String(),
+			Base:         o.Pair.Base.String(),
+			Quote:        o.Pair.Quote.String(),
+			Type:         o.Type.String(),
+			Side:         o.Side.String(),
+			Status:       o.Status.String(),
+			Amount:       o.Amount.InexactFloat64(),
+			LimitPrice:   o.LimitPrice.InexactFloat64(),
+			TriggerPrice: o.TriggerPrice.InexactFloat64(),
+			Triggered:    o.Triggered,
+			Placed:       o.Placed.Format(gctcommon.SimpleTimeFormatWithTimezone),
+		}
+		if !o.GoodTillTime.IsZero() {
+			resp.PendingOrders[i].GoodTillTime = o.GoodTillTime.Format(gctcommon.SimpleTimeFormatWithTimezone)
+		}
+	}
+	for i := range inspection.PendingTransfers {
+		t := &inspection.PendingTransfers[i]
+		resp.PendingTransfers[i] = &btrpc.TaskTransfer{
+			SendingExchange:   t.SendingExchange,
+			SendingAsset:      t.SendingAsset.String(),
+			ReceivingExchange: t.ReceivingExchange,
+			ReceivingAsset:    t.ReceivingAsset.String(),
+			Currency:          t.Currency.String(),
+			Chain:             t.Chain,
+			Sent:              t.Sent.InexactFloat64(),
+			Fee:               t.Fee.InexactFloat64(),
+			Received:          t.Received.InexactFloat64(),
+			SentAt:            t.SentAt.Format(gctcommon.SimpleTimeFormatWithTimezone),
+			ArrivesAt:         t.ArrivesAt.Format(gctcommon.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp
+}
+
+func convertRunSummary(run *backtestrun.Run) *btrpc.RunSummary {
+	return &btrpc.RunSummary{
+		Id:           run.ID,
+		Nickname:     run.Nickname,
+		Strategy:     run.Strategy,
+		ConfigHash:   run.ConfigHash,
+		Parameters:   run.Parameters,
+		StartDate:    run.StartDate.Format(gctcommon.SimpleTimeFormatWithTimezone),
+		EndDate:      run.EndDate.Format(gctcommon.SimpleTimeFormatWithTimezone),
+		TotalReturn:  run.TotalReturn,
+		MaxDrawdown:  run.MaxDrawdown,
+		SharpeRatio:  run.SharpeRatio,
+		SortinoRatio: run.SortinoRatio,
+		CalmarRatio:  run.CalmarRatio,
+		CreatedAt:    run.CreatedAt.Format(gctcommon.SimpleTimeFormatWithTimezone),
+	}
+}
@@ -8,15 +8,19 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/backtester/btrpc"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/eventholder"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/binancecashandcarry"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -666,3 +670,276 @@ func TestGRPCClearAllTasks(t *testing.T) {
 		t.Fatalf("received '%v' expecting '%v'", len(s.manager.tasks), 0)
 	}
 }
+
+func TestReportConfig(t *testing.T) {
+	t.Parallel()
+	s := &GRPCServer{
+		config: &config.BacktesterConfig{
+			Report: config.Report{
+				TemplatePath: "tpl.gohtml",
+				OutputPath:   "results",
+			},
+		},
+	}
+	cfg := s.reportConfig(nil)
+	if cfg.Report.TemplatePath != "" || cfg.Report.OutputPath != "" {
+		t.Errorf("received '%v' '%v' expected empty report paths", cfg.Report.TemplatePath, cfg.Report.OutputPath)
+	}
+
+	cfg = s.reportConfig([]string{config.ExportJSON})
+	if cfg.Report.TemplatePath != "" {
+		t.Errorf("received '%v' expected '%v'", cfg.Report.TemplatePath, "")
+	}
+	if cfg.Report.OutputPath != "results" {
+		t.Errorf("received '%v' expected '%v'", cfg.Report.OutputPath, "results")
+	}
+	if len(s.config.Report.ExportFormats) != 0 || s.config.Report.OutputPath != "results" {
+		t.Error("expected server config to be unchanged")
+	}
+}
+
+func TestRunHistoryRPCs(t *testing.T) {
+	t.Parallel()
+	s := &GRPCServer{}
+	_, err := s.ListRuns(context.Background(), nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = s.ListRuns(context.Background(), &btrpc.ListRunsRequest{})
+	if !errors.Is(err, errRunHistoryDisabled) {
+		t.Errorf("received '%v' expected '%v'", err, errRunHistoryDisabled)
+	}
+	_, err = s.GetRun(context.Background(), &btrpc.GetRunRequest{})
+	if !errors.Is(err, errRunHistoryDisabled) {
+		t.Errorf("received '%v' expected '%v'", err, errRunHistoryDisabled)
+	}
+	_, err = s.CompareRuns(context.Background(), &btrpc.CompareRunsRequest{})
+	if !errors.Is(err, errRunHistoryDisabled) {
+		t.Errorf("received '%v' expected '%v'", err, errRunHistoryDisabled)
+	}
+
+	s.runHistory = setupTestRunHistory(t)
+	ids := make([]string, 2)
+	for i := range ids {
+		bt := &BackTest{
+			Reports:   &fakeReport{},
+			Statistic: &statistics.Statistic{},
+		}
+		bt.MetaData.ID, err = uuid.NewV4()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = s.runHistory.Store(bt)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		ids[i] = bt.MetaData.ID.String()
+	}
+
+	list, err := s.ListRuns(context.Background(), &btrpc.ListRunsRequest{
+		Limit:       1,
+		CreatedFrom: timestamppb.New(time.Now().Add(-time.Hour)),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(list.Runs) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(list.Runs), 1)
+	}
+
+	run, err := s.GetRun(context.Background(), &btrpc.GetRunRequest{Id: ids[0]})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if run.Run.Id != ids[0] {
+		t.Errorf("received '%v' expected '%v'", run.Run.Id, ids[0])
+	}
+	if run.Report != "" {
+		t.Error("expected report to be excluded")
+	}
+	run, err = s.GetRun(context.Background(), &btrpc.GetRunRequest{Id: ids[0], IncludeReport: true})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if run.Report == "" {
+		t.Error("expected report to be included")
+	}
+
+	comparison, err := s.CompareRuns(context.Background(), &btrpc.CompareRunsRequest{Ids: ids})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(comparison.Runs) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(comparison.Runs), 2)
+	}
+	if !comparison.Runs[1].SameConfig {
+		t.Error("expected runs to share a config")
+	}
+}
+
+// fakeProgressStream captures the responses sent to a progress stream
+type fakeProgressStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*btrpc.StreamTaskProgressResponse
+}
+
+func (f *fakeProgressStream) Send(resp *btrpc.StreamTaskProgressResponse) error {
+	f.responses = append(f.responses, resp)
+	return nil
+}
+
+func (f *fakeProgressStream) Context() context.Context {
+	return f.ctx
+}
+
+func TestGRPCStreamTaskProgress(t *testing.T) {
+	t.Parallel()
+	s := &GRPCServer{}
+	stream := &fakeProgressStream{ctx: context.Background()}
+	err := s.StreamTaskProgress(nil, stream)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expecting '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	s.manager = NewTaskManager()
+	err = s.StreamTaskProgress(nil, stream)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expecting '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	id, err := uuid.NewV4()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = s.StreamTaskProgress(&btrpc.StreamTaskProgressRequest{Id: id.String()}, stream)
+	if !errors.Is(err, errTaskNotFound) {
+		t.Errorf("received '%v' expecting '%v'", err, errTaskNotFound)
+	}
+
+	bt := &BackTest{
+		Strategy:   &binancecashandcarry.Strategy{},
+		EventQueue: &eventholder.Holder{},
+		DataHolder: &data.HandlerHolder{},
+		Statistic:  &statistics.Statistic{},
+		shutdown:   make(chan struct{}),
+	}
+	err = s.manager.AddTask(bt)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	stream.ctx = ctx
+	err = s.StreamTaskProgress(&btrpc.StreamTaskProgressRequest{Id: bt.MetaData.ID.String()}, stream)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("received '%v' expecting '%v'", err, context.Canceled)
+	}
+	if len(stream.responses) != 1 {
+		t.Errorf("received '%v' expecting '%v'", len(stream.responses), 1)
+	}
+
+	bt.progress.appendEvent(ProgressEvent{Type: ProgressFillEvent, Direction: gctorder.Buy})
+	bt.progress.setHoldings([]holdings.Holding{{Exchange: testExchange}})
+	bt.progress.finish()
+	stream = &fakeProgressStream{ctx: context.Background()}
+	err = s.StreamTaskProgress(&btrpc.StreamTaskProgressRequest{Id: bt.MetaData.ID.String(), IncludeEvents: true}, stream)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expecting '%v'", err, nil)
+	}
+	if len(stream.responses) != 1 {
+		t.Fatalf("received '%v' expecting '%v'", len(stream.responses), 1)
+	}
+	resp := stream.responses[0]
+	if !resp.Finished {
+		t.Error("expected finished response")
+	}
+	if len(resp.Events) != 1 || resp.Events[0].Direction != gctorder.Buy.String() {
+		t.Errorf("received '%v' expecting a single buy event", resp.Events)
+	}
+	if len(resp.Holdings) != 1 || resp.Holdings[0].Exchange != testExchange {
+		t.Errorf("received '%v' expecting a single holding", resp.Holdings)
+	}
+}
+
+func TestGRPCTaskDebugging(t *testing.T) {
+	t.Parallel()
+	s := &GRPCServer{}
+	_, err := s.DebugTask(context.Background(), nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expecting '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = s.SetTaskBreakpoint(context.Background(), nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expecting '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = s.ClearTaskBreakpoints(context.Background(), nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expecting '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = s.InspectTask(context.Background(), nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expecting '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	s.manager = NewTaskManager()
+	_, err = s.DebugTask(context.Background(), nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expecting '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	bt := &BackTest{
+		Strategy:   &binancecashandcarry.Strategy{},
+		EventQueue: &eventholder.Holder{},
+		DataHolder: &data.HandlerHolder{},
+		Statistic:  &statistics.Statistic{},
+		shutdown:   make(chan struct{}),
+	}
+	err = s.manager.AddTask(bt)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	id := bt.MetaData.ID.String()
+	tt := time.Now().Truncate(time.Hour)
+	resp, err := s.SetTaskBreakpoint(context.Background(), &btrpc.SetTaskBreakpointRequest{
+		Id:   id,
+		Type: BreakpointTime,
+		Time: timestamppb.New(tt),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expecting '%v'", err, nil)
+	}
+	if len(resp.Status.Breakpoints) != 1 || resp.Status.Breakpoints[0].Time != tt.Format(gctcommon.SimpleTimeFormatWithTimezone) {
+		t.Errorf("received '%v' expecting a single time breakpoint", resp.Status.Breakpoints)
+	}
+
+	debugResp, err := s.DebugTask(context.Background(), &btrpc.DebugTaskRequest{Id: id, Action: DebugPause})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expecting '%v'", err, nil)
+	}
+	if debugResp.Status.Paused {
+		t.Error("expected task not to be paused before it has handled an event")
+	}
+
+	_, err = s.InspectTask(context.Background(), &btrpc.InspectTaskRequest{Id: id})
+	if !errors.Is(err, errTaskNotPaused) {
+		t.Errorf("received '%v' expecting '%v'", err, errTaskNotPaused)
+	}
+	bt.debugger.waiting = true
+	inspectResp, err := s.InspectTask(context.Background(), &btrpc.InspectTaskRequest{Id: id})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expecting '%v'", err, nil)
+	}
+	if !inspectResp.Status.Paused {
+		t.Error("expected paused status")
+	}
+	bt.debugger.waiting = false
+
+	clearResp, err := s.ClearTaskBreakpoints(context.Background(), &btrpc.ClearTaskBreakpointsRequest{Id: id})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expecting '%v'", err, nil)
+	}
+	if len(clearResp.Status.Breakpoints) != 0 {
+		t.Errorf("received '%v' expecting '%v'", len(clearResp.Status.Breakpoints), 0)
+	}
+}
@@ -311,6 +311,7 @@ func (d *dataChecker) AppendDataSource(dataSource *liveDataSourceSetup) error {
 		Asset:          dataSource.asset,
 		Interval:       dataSource.interval,
 	}
+	k.AdditionalIntervals = dataSource.additionalIntervals
 
 	err := k.SetLive(true)
 	if err != nil {
@@ -87,6 +87,7 @@ type liveDataSourceSetup struct {
 	dataRequestRetryTolerance int64
 	dataRequestRetryWaitTime  time.Duration
 	verboseExchangeRequest    bool
+	additionalIntervals       []gctkline.Interval
 }
 
 // liveDataSourceDataHandler is used to collect
@@ -0,0 +1,374 @@
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/report"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errOptimisationSettingsUnset = errors.New("optimisation settings unset")
+	errNoSuccessfulRuns          = errors.New("no optimisation runs completed successfully")
+	errUnsupportedMetric         = errors.New("unsupported optimisation metric")
+)
+
+// bestTask holds the best scoring task of an optimisation
+type bestTask struct {
+	m     sync.Mutex
+	index int
+	score decimal.Decimal
+	task  *BackTest
+}
+
+// sharedData holds candle data loaded once and shared read-only between the
+// runs of an optimisation, rather than each run loading the full dataset
+type sharedData struct {
+	m       sync.Mutex
+	entries map[string]*sharedDataEntry
+}
+
+// sharedDataEntry holds the candle data loaded for a single key
+type sharedDataEntry struct {
+	once sync.Once
+	data *kline.DataFromKline
+	err  error
+}
+
+// RunOptimisation runs the strategy once for every parameter set generated
+// from the config's optimisation settings. Runs are executed in parallel and
+// share candle data loaded once. Results are ranked by the optimisation
+// metric and only the best ranked run is kept in the task manager. When
+// enabled, a report is generated for the best ranked run which includes a
+// comparison of all runs
+func (r *TaskManager) RunOptimisation(cfg *config.Config, btCfg *config.BacktesterConfig) (*report.OptimisationResults, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w strategy config", gctcommon.ErrNilPointer)
+	}
+	if btCfg == nil {
+		return nil, fmt.Errorf("%w backtester config", gctcommon.ErrNilPointer)
+	}
+	if cfg.OptimisationSettings == nil {
+		return nil, errOptimisationSettingsUnset
+	}
+	results, best, err := r.optimise(cfg, btCfg)
+	if err != nil {
+		return results, err
+	}
+	log.Infof(common.Backtester, "Best %v of %v with %v", results.Metric, results.Runs[0].Score, results.Runs[0].Parameters)
+	if !btCfg.Report.GenerateReport && len(btCfg.Report.ExportFormats) == 0 {
+		return results, nil
+	}
+	d, err := prepareReport(best, btCfg)
+	if err != nil {
+		return results, err
+	}
+	d.Optimisation = results
+	return results, d.GenerateReport()
+}
+
+// optimise runs and ranks every parameter set of the optimisation settings
+// in parallel, returning the results and the task of the best ranked run.
+// Every other task is cleared from the task manager once it has been scored
+func (r *TaskManager) optimise(cfg *config.Config, btCfg *config.BacktesterConfig) (*report.OptimisationResults, *BackTest, error) {
+	cfg, err := sharedSeedConfig(cfg, "optimisation")
+	if err != nil {
+		return nil, nil, err
+	}
+	settings := cfg.OptimisationSettings
+	sets, err := settings.GenerateParameterSets()
+	if err != nil {
+		return nil, nil, err
+	}
+	results := &report.OptimisationResults{
+		Method:     settings.Method,
+		Metric:     settings.Metric,
+		Parameters: parameterNames(settings),
+		Runs:       make([]report.OptimisationRun, len(sets)),
+	}
+	concurrentTasks := int(settings.MaximumConcurrentTasks)
+	if concurrentTasks == 0 {
+		concurrentTasks = runtime.NumCPU()
+	}
+	taskCfg := taskBacktesterConfig(btCfg)
+
+	log.Infof(common.Backtester, "Running %v optimisation of %v runs", settings.Method, len(sets))
+	shared := newSharedData()
+	best := &bestTask{}
+	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, concurrentTasks)
+	for i := range sets {
+		results.Runs[i].Parameters = make([]interface{}, len(results.Parameters))
+		for j := range results.Parameters {
+			results.Runs[i].Parameters[j] = sets[i][results.Parameters[j]]
+		}
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+			run := &results.Runs[i]
+			var task *BackTest
+			taskStrategyCfg, runErr := taskConfig(cfg, sets[i], fmt.Sprintf("%v-optimisation-%v", cfg.Nickname, i+1))
+			if runErr == nil {
+				task, run.Summary, runErr = r.runTask(taskStrategyCfg, taskCfg, shared)
+			}
+			if runErr == nil {
+				run.Score, runErr = optimisationScore(run.Summary, settings.Metric)
+			}
+			if runErr != nil {
+				log.Errorf(common.Backtester, "Optimisation run %v %v failed: %v", i+1, sets[i], runErr)
+				run.Summary = nil
+				run.Error = runErr.Error()
+				r.clearTask(task)
+				return
+			}
+			r.clearTask(best.keep(i, run.Score, task))
+		}(i)
+	}
+	wg.Wait()
+
+	rankOptimisationRuns(results.Runs)
+	if best.task == nil {
+		return results, nil, errNoSuccessfulRuns
+	}
+	return results, best.task, nil
+}
+
+// runTask creates, stores and runs a backtest to completion. Candle data is
+// loaded from the shared data when it is not nil
+func (r *TaskManager) runTask(cfg *config.Config, btCfg *config.BacktesterConfig, shared *sharedData) (*BackTest, *statistics.PerformanceSummary, error) {
+	bt, err := newBacktesterFromConfigs(cfg, btCfg, shared)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = r.AddTask(bt)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = bt.ExecuteStrategy(true)
+	if err != nil {
+		return bt, nil, err
+	}
+	summary, err := bt.Statistic.GetPerformanceSummary()
+	if err != nil {
+		return bt, nil, err
+	}
+	return bt, summary, nil
+}
+
+// clearTask removes a finished optimisation task from the task manager so
+// that its data can be released
+func (r *TaskManager) clearTask(bt *BackTest) {
+	if bt == nil {
+		return
+	}
+	err := r.ClearTask(bt.MetaData.ID)
+	if err != nil && !errors.Is(err, errTaskNotFound) {
+		log.Errorf(common.Backtester, "Could not clear optimisation task %v: %v", bt.MetaData.ID, err)
+	}
+}
+
+// keep compares a scored task to the best task so far, ties are won by the
+// lowest run index to match the ranking of runs. The task which is no longer
+// needed is returned
+func (b *bestTask) keep(index int, score decimal.Decimal, task *BackTest) *BackTest {
+	b.m.Lock()
+	defer b.m.Unlock()
+	if b.task != nil &&
+		(score.LessThan(b.score) || (score.Equal(b.score) && index > b.index)) {
+		return task
+	}
+	discard := b.task
+	b.index = index
+	b.score = score
+	b.task = task
+	return discard
+}
+
+// newSharedData returns an empty store of shared candle data
+func newSharedData() *sharedData {
+	return &sharedData{entries: make(map[string]*sharedDataEntry)}
+}
+
+// sharedDataKey returns the key candle data is shared by
+func sharedDataKey(exch string, a asset.Item, pair currency.Pair, isUSDTrackingPair bool) string {
+	return fmt.Sprintf("%v-%v-%v-%v", strings.ToLower(exch), a, pair, isUSDTrackingPair)
+}
+
+// load returns the candle data for the key, calling fn to load it the first
+// time it is requested. Each caller receives its own data handler which
+// shares the loaded candles read-only. A nil sharedData always calls fn
+func (s *sharedData) load(key string, fn func() (*kline.DataFromKline, error)) (*kline.DataFromKline, error) {
+	if s == nil {
+		return fn()
+	}
+	s.m.Lock()
+	entry, ok := s.entries[key]
+	if !ok {
+		entry = &sharedDataEntry{}
+		s.entries[key] = entry
+	}
+	s.m.Unlock()
+	entry.once.Do(func() {
+		entry.data, entry.err = fn()
+	})
+	if entry.err != nil {
+		return nil, entry.err
+	}
+	item := *entry.data.Item
+	// the capacity is capped so appending to a run's candles cannot write to
+	// the shared candles
+	item.Candles = item.Candles[:len(item.Candles):len(item.Candles)]
+	resp := kline.NewDataFromKline()
+	resp.Item = &item
+	resp.RangeHolder = entry.data.RangeHolder
+	resp.Orderbooks = entry.data.Orderbooks
+	return resp, nil
+}
+
+// taskBacktesterConfig copies the backtester config without report
+// generation, as only a final report is generated for an optimisation
+func taskBacktesterConfig(btCfg *config.BacktesterConfig) *config.BacktesterConfig {
+	taskCfg := *btCfg
+	taskCfg.Report.TemplatePath = ""
+	taskCfg.Report.OutputPath = ""
+	return &taskCfg
+}
+
+// prepareReport sets the report output settings for a task which
+// ran without generating a report or exporting its results
+func prepareReport(bt *BackTest, btCfg *config.BacktesterConfig) (*report.Data, error) {
+	d, ok := bt.Reports.(*report.Data)
+	if !ok {
+		return nil, fmt.Errorf("%w report data", gctcommon.ErrTypeAssertFailure)
+	}
+	if btCfg.Report.GenerateReport {
+		d.TemplatePath = btCfg.Report.TemplatePath
+	}
+	d.OutputPath = btCfg.Report.OutputPath
+	d.UseDarkMode(btCfg.Report.DarkMode)
+	d.SetExportFormats(btCfg.Report.ExportFormats)
+	return d, nil
+}
+
+// copyConfig copies the strategy config via JSON so that tasks do not
+// share any settings
+func copyConfig(cfg *config.Config) (*config.Config, error) {
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	resp := &config.Config{}
+	err = json.Unmarshal(b, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// sharedSeedConfig copies the strategy config and generates its seed when
+// unset, so that every run of an optimisation or walk-forward analysis shares
+// one seed without changing the caller's config
+func sharedSeedConfig(cfg *config.Config, analysis string) (*config.Config, error) {
+	resp, err := copyConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StrategySettings.Seed == 0 {
+		resp.StrategySettings.Seed = time.Now().UnixNano()
+		log.Infof(common.Backtester, "Generated random seed %v for all %v runs", resp.StrategySettings.Seed, analysis)
+	}
+	return resp, nil
+}
+
+// taskConfig copies the strategy config without its optimisation settings
+// and applies the parameter set to its custom settings
+func taskConfig(cfg *config.Config, set map[string]interface{}, nickname string) (*config.Config, error) {
+	resp, err := copyConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	resp.OptimisationSettings = nil
+	resp.Nickname = nickname
+	if resp.StrategySettings.CustomSettings == nil {
+		resp.StrategySettings.CustomSettings = make(map[string]interface{}, len(set))
+	}
+	for k, v := range set {
+		resp.StrategySettings.CustomSettings[k] = v
+	}
+	return resp, nil
+}
+
+func parameterNames(settings *config.OptimisationSettings) []string {
+	resp := make([]string, len(settings.Parameters))
+	for i := range settings.Parameters {
+		resp[i] = settings.Parameters[i].Name
+	}
+	return resp
+}
+
+// optimisationScore returns the value of the metric from the summary.
+// Drawdowns are negative percentages, so higher scores are always better
+func optimisationScore(s *statistics.PerformanceSummary, metric string) (decimal.Decimal, error) {
+	if s == nil {
+		return decimal.Zero, fmt.Errorf("%w performance summary", gctcommon.ErrNilPointer)
+	}
+	switch metric {
+	case config.SharpeRatio:
+		return s.SharpeRatio, nil
+	case config.SortinoRatio:
+		return s.SortinoRatio, nil
+	case config.CalmarRatio:
+		return s.CalmarRatio, nil
+	case config.TotalReturn:
+		return s.TotalReturn, nil
+	case config.MaxDrawdown:
+		return s.MaxDrawdown, nil
+	}
+	return decimal.Zero, fmt.Errorf("%w '%v'", errUnsupportedMetric, metric)
+}
+
+// rankOptimisationRuns sorts runs by score, with failed runs last, and sets
+// their rank
+func rankOptimisationRuns(runs []report.OptimisationRun) {
+	order := make([]int, len(runs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := &runs[order[i]], &runs[order[j]]
+		if (a.Error == "") != (b.Error == "") {
+			return a.Error == ""
+		}
+		return a.Score.GreaterThan(b.Score)
+	})
+	sorted := make([]report.OptimisationRun, len(runs))
+	for i := range order {
+		sorted[i] = runs[order[i]]
+		if sorted[i].Error == "" {
+			sorted[i].Rank = i + 1
+		}
+	}
+	copy(runs, sorted)
+}
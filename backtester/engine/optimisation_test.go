@@ -0,0 +1,233 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/report"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func TestRunOptimisation(t *testing.T) {
+	t.Parallel()
+	var r *TaskManager
+	_, err := r.RunOptimisation(nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	r = NewTaskManager()
+	_, err = r.RunOptimisation(nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = r.RunOptimisation(&config.Config{}, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = r.RunOptimisation(&config.Config{}, &config.BacktesterConfig{})
+	if !errors.Is(err, errOptimisationSettingsUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errOptimisationSettingsUnset)
+	}
+
+	cfg := &config.Config{
+		StrategySettings: config.StrategySettings{Name: "rsi"},
+		OptimisationSettings: &config.OptimisationSettings{
+			Method: config.GridSearch,
+			Metric: config.SharpeRatio,
+			Parameters: []config.OptimisationParameter{
+				{Name: "rsi-period", Values: []interface{}{7.0, 14.0}},
+			},
+		},
+	}
+	results, err := r.RunOptimisation(cfg, &config.BacktesterConfig{})
+	if !errors.Is(err, errNoSuccessfulRuns) {
+		t.Errorf("received '%v' expected '%v'", err, errNoSuccessfulRuns)
+	}
+	if len(results.Runs) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(results.Runs), 2)
+	}
+	for i := range results.Runs {
+		if results.Runs[i].Error == "" {
+			t.Error("expected invalid config to fail each run")
+		}
+	}
+}
+
+func TestTaskConfig(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{
+		Nickname: "test",
+		StrategySettings: config.StrategySettings{
+			CustomSettings: map[string]interface{}{
+				"rsi-high": 70.0,
+				"rsi-low":  30.0,
+			},
+		},
+		OptimisationSettings: &config.OptimisationSettings{},
+	}
+	resp, err := taskConfig(cfg, map[string]interface{}{"rsi-low": 20.0}, "test-optimisation-2")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.OptimisationSettings != nil {
+		t.Error("expected optimisation settings to be removed")
+	}
+	if resp.Nickname != "test-optimisation-2" {
+		t.Errorf("received '%v' expected '%v'", resp.Nickname, "test-optimisation-2")
+	}
+	if resp.StrategySettings.CustomSettings["rsi-low"] != 20.0 || resp.StrategySettings.CustomSettings["rsi-high"] != 70.0 {
+		t.Errorf("received '%v' expected rsi-low 20 and rsi-high 70", resp.StrategySettings.CustomSettings)
+	}
+	if cfg.StrategySettings.CustomSettings["rsi-low"] != 30.0 {
+		t.Error("expected original config to be unchanged")
+	}
+}
+
+func TestOptimisationScore(t *testing.T) {
+	t.Parallel()
+	_, err := optimisationScore(nil, config.SharpeRatio)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	s := &statistics.PerformanceSummary{
+		SharpeRatio:  decimal.NewFromInt(1),
+		SortinoRatio: decimal.NewFromInt(2),
+		CalmarRatio:  decimal.NewFromInt(3),
+		TotalReturn:  decimal.NewFromInt(4),
+		MaxDrawdown:  decimal.NewFromInt(-5),
+	}
+	for metric, expected := range map[string]int64{
+		config.SharpeRatio:  1,
+		config.SortinoRatio: 2,
+		config.CalmarRatio:  3,
+		config.TotalReturn:  4,
+		config.MaxDrawdown:  -5,
+	} {
+		score, err := optimisationScore(s, metric)
+		if !errors.Is(err, nil) {
+			t.Errorf("received '%v' expected '%v'", err, nil)
+		}
+		if !score.Equal(decimal.NewFromInt(expected)) {
+			t.Errorf("received '%v' expected '%v' for %v", score, expected, metric)
+		}
+	}
+	_, err = optimisationScore(s, "lol")
+	if !errors.Is(err, errUnsupportedMetric) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedMetric)
+	}
+}
+
+func TestRankOptimisationRuns(t *testing.T) {
+	t.Parallel()
+	rankOptimisationRuns(nil)
+	runs := []report.OptimisationRun{
+		{Score: decimal.NewFromInt(1)},
+		{Error: "failed"},
+		{Score: decimal.NewFromInt(3)},
+		{Score: decimal.NewFromInt(2)},
+	}
+	rankOptimisationRuns(runs)
+	for i, expected := range []int64{3, 2, 1} {
+		if !runs[i].Score.Equal(decimal.NewFromInt(expected)) || runs[i].Rank != i+1 {
+			t.Errorf("received score '%v' rank '%v' expected score '%v' rank '%v'", runs[i].Score, runs[i].Rank, expected, i+1)
+		}
+	}
+	if runs[3].Error == "" || runs[3].Rank != 0 {
+		t.Error("expected failed run to be ranked last without a rank")
+	}
+}
+
+func TestBestTaskKeep(t *testing.T) {
+	t.Parallel()
+	b := &bestTask{}
+	tasks := []*BackTest{{}, {}, {}, {}}
+	if discard := b.keep(1, decimal.NewFromInt(1), tasks[1]); discard != nil {
+		t.Error("expected nothing to discard for the first task")
+	}
+	if discard := b.keep(2, decimal.NewFromInt(3), tasks[2]); discard != tasks[1] {
+		t.Error("expected the lower scoring task to be discarded")
+	}
+	if discard := b.keep(3, decimal.NewFromInt(2), tasks[3]); discard != tasks[3] {
+		t.Error("expected the lower scoring task to be discarded")
+	}
+	if discard := b.keep(0, decimal.NewFromInt(3), tasks[0]); discard != tasks[2] {
+		t.Error("expected a tie to be won by the lowest run index")
+	}
+	if b.task != tasks[0] {
+		t.Error("expected the best task to be kept")
+	}
+}
+
+func TestSharedDataLoad(t *testing.T) {
+	t.Parallel()
+	var loads int
+	fn := func() (*kline.DataFromKline, error) {
+		loads++
+		d := kline.NewDataFromKline()
+		d.Item = &gctkline.Item{
+			Exchange: testExchange,
+			Candles:  make([]gctkline.Candle, 2, 4),
+		}
+		return d, nil
+	}
+	var s *sharedData
+	if _, err := s.load("key", fn); err != nil {
+		t.Fatal(err)
+	}
+	s = newSharedData()
+	first, err := s.load("key", fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := s.load("key", fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loads != 2 {
+		t.Errorf("received '%v' expected '%v'", loads, 2)
+	}
+	if first == second || first.Item == second.Item {
+		t.Error("expected each run to receive its own data")
+	}
+	if cap(first.Item.Candles) != len(first.Item.Candles) {
+		t.Errorf("received '%v' expected '%v'", cap(first.Item.Candles), len(first.Item.Candles))
+	}
+	first.Item.Candles = append(first.Item.Candles, gctkline.Candle{Close: 1337})
+	if len(second.Item.Candles) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(second.Item.Candles), 2)
+	}
+
+	errLoad := errors.New("load failure")
+	_, err = s.load("bad", func() (*kline.DataFromKline, error) { return nil, errLoad })
+	if !errors.Is(err, errLoad) {
+		t.Errorf("received '%v' expected '%v'", err, errLoad)
+	}
+}
+
+func TestSharedSeedConfig(t *testing.T) {
+	t.Parallel()
+	cfg := &config.Config{Nickname: "test"}
+	resp, err := sharedSeedConfig(cfg, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StrategySettings.Seed == 0 {
+		t.Error("expected a seed to be generated")
+	}
+	if cfg.StrategySettings.Seed != 0 {
+		t.Error("expected the config to be unchanged")
+	}
+	cfg.StrategySettings.Seed = 1337
+	resp, err = sharedSeedConfig(cfg, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StrategySettings.Seed != 1337 {
+		t.Errorf("received '%v' expected '%v'", resp.StrategySettings.Seed, 1337)
+	}
+}
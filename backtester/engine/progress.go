@@ -0,0 +1,193 @@
+package engine
+
+import (
+	"sync"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// recordEvent counts a processed event and adds signals and fills
+// which act upon the market to the event log
+func (p *Progress) recordEvent(ev common.Event) {
+	if ev == nil {
+		return
+	}
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.sequence++
+	switch e := ev.(type) {
+	case kline.Event:
+		p.dataEvents++
+		if e.GetTime().After(p.currentTime) {
+			p.currentTime = e.GetTime()
+		}
+	case signal.Event:
+		p.signalEvents++
+		if e.GetDirection() == gctorder.DoNothing {
+			return
+		}
+		p.appendEvent(describeEvent(e))
+	case order.Event:
+		p.orderEvents++
+	case fill.Event:
+		p.fillEvents++
+		if e.GetDirection() == gctorder.DoNothing {
+			return
+		}
+		p.appendEvent(describeEvent(e))
+	}
+}
+
+// appendEvent adds an event to the log, discarding the oldest
+// event once the log is full. The caller must hold the lock
+func (p *Progress) appendEvent(ev ProgressEvent) {
+	p.lastEventID++
+	ev.ID = p.lastEventID
+	if len(p.events) >= maximumProgressEvents {
+		p.events = append(p.events[:0], p.events[1:]...)
+	}
+	p.events = append(p.events, ev)
+}
+
+// setHoldings stores the latest holdings of all currencies
+func (p *Progress) setHoldings(h []holdings.Holding) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.holdings = h
+}
+
+// subscribe registers a progress subscriber so that holdings are kept up to
+// date. The returned func must be called once the subscriber has finished
+func (p *Progress) subscribe() func() {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.subscribers++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			p.m.Lock()
+			defer p.m.Unlock()
+			p.subscribers--
+			if p.subscribers == 0 && !p.finished {
+				// holdings are no longer updated, so would be stale
+				// for the next subscriber
+				p.holdings = nil
+			}
+		})
+	}
+}
+
+// hasSubscribers returns whether anything is streaming the progress
+func (p *Progress) hasSubscribers() bool {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.subscribers > 0
+}
+
+// SubscribeProgress keeps the holdings returned by GetProgress up to date
+// until the returned func is called. Holdings are only calculated while
+// there is a subscriber, as doing so for every event is expensive
+func (bt *BackTest) SubscribeProgress() (func(), error) {
+	if bt == nil {
+		return nil, gctcommon.ErrNilPointer
+	}
+	return bt.progress.subscribe(), nil
+}
+
+// finish marks the task as complete so streams can end
+func (p *Progress) finish() {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.sequence++
+	p.finished = true
+}
+
+// GetProgress returns the progress of the task along with any
+// signal and fill events with an ID greater than afterEventID.
+// Percentage complete is only calculated for historical data
+func (bt *BackTest) GetProgress(afterEventID uint64, includeEvents bool) (*TaskProgress, error) {
+	if bt == nil {
+		return nil, gctcommon.ErrNilPointer
+	}
+	bt.m.Lock()
+	resp := &TaskProgress{
+		MetaData: bt.MetaData,
+	}
+	bt.m.Unlock()
+	if !resp.MetaData.LiveTesting && bt.DataHolder != nil {
+		percent, err := bt.percentComplete()
+		if err != nil {
+			return nil, err
+		}
+		resp.PercentComplete = percent
+	}
+
+	bt.progress.m.Lock()
+	defer bt.progress.m.Unlock()
+	resp.Sequence = bt.progress.sequence
+	resp.CurrentTime = bt.progress.currentTime
+	resp.DataEvents = bt.progress.dataEvents
+	resp.SignalEvents = bt.progress.signalEvents
+	resp.OrderEvents = bt.progress.orderEvents
+	resp.FillEvents = bt.progress.fillEvents
+	resp.Finished = bt.progress.finished
+	resp.Holdings = make([]holdings.Holding, len(bt.progress.holdings))
+	copy(resp.Holdings, bt.progress.holdings)
+	if !includeEvents {
+		return resp, nil
+	}
+	for i := range bt.progress.events {
+		if bt.progress.events[i].ID > afterEventID {
+			resp.Events = append(resp.Events, bt.progress.events[i:]...)
+			break
+		}
+	}
+	return resp, nil
+}
+
+// percentComplete compares the amount of data events which have been
+// loaded into the event queue against the amount of data events available
+func (bt *BackTest) percentComplete() (decimal.Decimal, error) {
+	dataHandlers, err := bt.DataHolder.GetAllData()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	var processed, total, offset int64
+	var stream data.Events
+	for i := range dataHandlers {
+		offset, err = dataHandlers[i].Offset()
+		if err != nil {
+			return decimal.Zero, err
+		}
+		stream, err = dataHandlers[i].GetStream()
+		if err != nil {
+			return decimal.Zero, err
+		}
+		processed += offset
+		total += int64(len(stream))
+	}
+	if total == 0 {
+		return decimal.Zero, nil
+	}
+	return decimal.NewFromInt(processed).Div(decimal.NewFromInt(total)).Mul(decimal.NewFromInt(100)), nil
+}
+
+// isNewerProgress determines whether a task has changed since
+// the previous progress was sent to a client
+func isNewerProgress(previous, current *TaskProgress) bool {
+	if previous == nil {
+		return true
+	}
+	return current.Sequence != previous.Sequence ||
+		current.MetaData != previous.MetaData ||
+		len(current.Events) > 0
+}
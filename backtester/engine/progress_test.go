@@ -0,0 +1,215 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	evkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestRecordEvent(t *testing.T) {
+	t.Parallel()
+	p := &Progress{}
+	p.recordEvent(nil)
+	if p.sequence != 0 {
+		t.Errorf("received '%v' expected '%v'", p.sequence, 0)
+	}
+
+	tt := time.Now().Truncate(time.Hour)
+	b := &event.Base{
+		Exchange:     testExchange,
+		Time:         tt,
+		AssetType:    asset.Spot,
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USDT),
+	}
+	p.recordEvent(&evkline.Kline{Base: b})
+	p.recordEvent(&signal.Signal{Base: b, Direction: gctorder.DoNothing})
+	p.recordEvent(&signal.Signal{Base: b, Direction: gctorder.Buy, ClosePrice: leet})
+	p.recordEvent(&order.Order{Base: b})
+	p.recordEvent(&fill.Fill{Base: b, Direction: gctorder.Buy, PurchasePrice: leet, Amount: decimal.NewFromInt(1)})
+	if p.sequence != 5 {
+		t.Errorf("received '%v' expected '%v'", p.sequence, 5)
+	}
+	if p.dataEvents != 1 || p.signalEvents != 2 || p.orderEvents != 1 || p.fillEvents != 1 {
+		t.Errorf("received '%v %v %v %v' expected '1 2 1 1'", p.dataEvents, p.signalEvents, p.orderEvents, p.fillEvents)
+	}
+	if !p.currentTime.Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", p.currentTime, tt)
+	}
+	if len(p.events) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(p.events), 2)
+	}
+	if p.events[0].Type != ProgressSignalEvent || p.events[0].ID != 1 {
+		t.Errorf("received '%v %v' expected '%v %v'", p.events[0].Type, p.events[0].ID, ProgressSignalEvent, 1)
+	}
+	if p.events[1].Type != ProgressFillEvent || !p.events[1].Price.Equal(leet) {
+		t.Errorf("received '%v %v' expected '%v %v'", p.events[1].Type, p.events[1].Price, ProgressFillEvent, leet)
+	}
+}
+
+func TestAppendEvent(t *testing.T) {
+	t.Parallel()
+	p := &Progress{}
+	for i := 0; i < maximumProgressEvents+5; i++ {
+		p.appendEvent(ProgressEvent{})
+	}
+	if len(p.events) != maximumProgressEvents {
+		t.Errorf("received '%v' expected '%v'", len(p.events), maximumProgressEvents)
+	}
+	if p.events[0].ID != 6 {
+		t.Errorf("received '%v' expected '%v'", p.events[0].ID, 6)
+	}
+	if p.events[len(p.events)-1].ID != maximumProgressEvents+5 {
+		t.Errorf("received '%v' expected '%v'", p.events[len(p.events)-1].ID, maximumProgressEvents+5)
+	}
+}
+
+func TestGetProgress(t *testing.T) {
+	t.Parallel()
+	var bt *BackTest
+	_, err := bt.GetProgress(0, false)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	d := &data.Base{}
+	err = d.SetStream([]data.Event{
+		&evkline.Kline{Base: &event.Base{Exchange: testExchange, Time: time.Now(), Interval: gctkline.OneDay, CurrencyPair: cp, AssetType: asset.Spot}},
+		&evkline.Kline{Base: &event.Base{Exchange: testExchange, Time: time.Now().Add(gctkline.OneDay.Duration()), Interval: gctkline.OneDay, CurrencyPair: cp, AssetType: asset.Spot}},
+	})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	bt = &BackTest{DataHolder: &data.HandlerHolder{}}
+	err = bt.DataHolder.SetDataForCurrency(testExchange, asset.Spot, cp, &kline.DataFromKline{
+		Item: &gctkline.Item{Exchange: testExchange, Asset: asset.Spot, Pair: cp},
+		Base: d,
+	})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	bt.progress.appendEvent(ProgressEvent{Type: ProgressSignalEvent})
+	bt.progress.appendEvent(ProgressEvent{Type: ProgressFillEvent})
+	bt.progress.setHoldings([]holdings.Holding{{Exchange: testExchange}})
+
+	progress, err := bt.GetProgress(1, true)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !progress.PercentComplete.Equal(decimal.NewFromInt(50)) {
+		t.Errorf("received '%v' expected '%v'", progress.PercentComplete, 50)
+	}
+	if len(progress.Events) != 1 || progress.Events[0].Type != ProgressFillEvent {
+		t.Errorf("received '%v' expected a single fill event", progress.Events)
+	}
+	if len(progress.Holdings) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(progress.Holdings), 1)
+	}
+
+	progress, err = bt.GetProgress(0, false)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(progress.Events) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(progress.Events), 0)
+	}
+
+	bt.MetaData.LiveTesting = true
+	bt.progress.finish()
+	progress, err = bt.GetProgress(0, false)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !progress.PercentComplete.IsZero() {
+		t.Errorf("received '%v' expected '%v'", progress.PercentComplete, 0)
+	}
+	if !progress.Finished {
+		t.Error("expected finished progress")
+	}
+}
+
+func TestSubscribeProgress(t *testing.T) {
+	t.Parallel()
+	var bt *BackTest
+	_, err := bt.SubscribeProgress()
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	bt = &BackTest{}
+	if bt.progress.hasSubscribers() {
+		t.Error("expected no subscribers")
+	}
+	first, err := bt.SubscribeProgress()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	second, err := bt.SubscribeProgress()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	bt.progress.setHoldings([]holdings.Holding{{Exchange: testExchange}})
+	first()
+	first()
+	if !bt.progress.hasSubscribers() {
+		t.Error("expected unsubscribing twice to only remove one subscriber")
+	}
+	if len(bt.progress.holdings) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(bt.progress.holdings), 1)
+	}
+	second()
+	if bt.progress.hasSubscribers() {
+		t.Error("expected no subscribers")
+	}
+	if bt.progress.holdings != nil {
+		t.Errorf("received '%v' expected stale holdings to be cleared", bt.progress.holdings)
+	}
+
+	unsubscribe, err := bt.SubscribeProgress()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	bt.progress.setHoldings([]holdings.Holding{{Exchange: testExchange}})
+	bt.progress.finish()
+	unsubscribe()
+	if len(bt.progress.holdings) != 1 {
+		t.Errorf("received '%v' expected final holdings to be kept", len(bt.progress.holdings))
+	}
+}
+
+func TestIsNewerProgress(t *testing.T) {
+	t.Parallel()
+	current := &TaskProgress{Sequence: 1}
+	if !isNewerProgress(nil, current) {
+		t.Error("expected newer progress")
+	}
+	if isNewerProgress(&TaskProgress{Sequence: 1}, current) {
+		t.Error("expected unchanged progress")
+	}
+	if !isNewerProgress(&TaskProgress{}, current) {
+		t.Error("expected newer progress")
+	}
+	current.MetaData.Closed = true
+	if !isNewerProgress(&TaskProgress{Sequence: 1}, current) {
+		t.Error("expected newer progress")
+	}
+}
@@ -0,0 +1,76 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// maximumProgressEvents limits the amount of signal and fill events
+// retained for clients tailing a task's event log
+const maximumProgressEvents = 1000
+
+// progressStreamInterval is how often a task's progress is checked
+// for changes to send to streaming clients
+const progressStreamInterval = time.Millisecond * 500
+
+// Progress event types
+const (
+	ProgressDataEvent   = "data"
+	ProgressSignalEvent = "signal"
+	ProgressOrderEvent  = "order"
+	ProgressFillEvent   = "fill"
+)
+
+// Progress tracks the events processed by a task so that
+// clients can follow a task while it runs
+type Progress struct {
+	m            sync.Mutex
+	sequence     uint64
+	lastEventID  uint64
+	currentTime  time.Time
+	dataEvents   uint64
+	signalEvents uint64
+	orderEvents  uint64
+	fillEvents   uint64
+	holdings     []holdings.Holding
+	events       []ProgressEvent
+	finished     bool
+	subscribers  int
+}
+
+// TaskProgress is a point in time copy of a task's progress.
+// Sequence increases whenever the task processes an event
+type TaskProgress struct {
+	MetaData        TaskMetaData
+	Sequence        uint64
+	PercentComplete decimal.Decimal
+	CurrentTime     time.Time
+	DataEvents      uint64
+	SignalEvents    uint64
+	OrderEvents     uint64
+	FillEvents      uint64
+	Holdings        []holdings.Holding
+	Events          []ProgressEvent
+	Finished        bool
+}
+
+// ProgressEvent is a signal or fill event processed by a task
+type ProgressEvent struct {
+	ID        uint64
+	Type      string
+	Time      time.Time
+	Exchange  string
+	Asset     asset.Item
+	Pair      currency.Pair
+	Direction gctorder.Side
+	Price     decimal.Decimal
+	Amount    decimal.Decimal
+	Fee       decimal.Decimal
+	Reason    string
+}
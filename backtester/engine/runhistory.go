@@ -0,0 +1,284 @@
+package engine
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/report"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctdatabase "github.com/thrasher-corp/gocryptotrader/database"
+	dbpsql "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
+	dbsqlite3 "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/backtestrun"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupRunHistory connects to the run history database. The connection is
+// independent of any database used as a data source. Returns nil when
+// run history is disabled
+func SetupRunHistory(cfg *config.RunHistory) (*RunHistory, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("%w run history config", gctcommon.ErrNilPointer)
+	}
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	dbCfg := cfg.Config
+	dbCfg.Enabled = true
+	instance := &gctdatabase.Instance{
+		DataPath: cfg.Path,
+	}
+	if instance.DataPath == "" {
+		instance.DataPath = filepath.Join(gctcommon.GetDefaultDataDir(runtime.GOOS), "database")
+	}
+	err := instance.SetConfig(&dbCfg)
+	if err != nil {
+		return nil, err
+	}
+	switch dbCfg.Driver {
+	case gctdatabase.DBSQLite, gctdatabase.DBSQLite3:
+		_, err = dbsqlite3.ConnectInstance(instance, dbCfg.Database)
+	case gctdatabase.DBPostgreSQL:
+		_, err = dbpsql.ConnectInstance(instance, &dbCfg)
+	default:
+		err = fmt.Errorf("%w '%v'", gctdatabase.ErrFailedToConnect, dbCfg.Driver)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("run history %w", err)
+	}
+	instance.SetConnected(true)
+	service, err := backtestrun.Setup(instance)
+	if err != nil {
+		return nil, err
+	}
+	return &RunHistory{
+		instance: instance,
+		service:  service,
+	}, nil
+}
+
+// Close disconnects from the run history database
+func (r *RunHistory) Close() error {
+	if r == nil {
+		return fmt.Errorf("%w run history", gctcommon.ErrNilPointer)
+	}
+	if r.instance == nil {
+		return nil
+	}
+	return r.instance.CloseConnection()
+}
+
+// Store saves a completed backtest run with its summary metrics, equity curve,
+// trades and exported results
+func (r *RunHistory) Store(bt *BackTest) error {
+	if r == nil {
+		return fmt.Errorf("%w run history", gctcommon.ErrNilPointer)
+	}
+	if bt == nil {
+		return fmt.Errorf("%w backtest", gctcommon.ErrNilPointer)
+	}
+	if bt.Reports == nil || bt.Statistic == nil {
+		return fmt.Errorf("%w reports or statistics", gctcommon.ErrNilPointer)
+	}
+	export, err := bt.Reports.CreateExport()
+	if err != nil {
+		return err
+	}
+	run, err := createRun(bt.MetaData.ID, export, bt.Statistic)
+	if err != nil {
+		return err
+	}
+	err = r.service.Upsert(run)
+	if err != nil {
+		return err
+	}
+	log.Infof(common.Backtester, "Stored run %v %v in run history", run.ID, run.Strategy)
+	return nil
+}
+
+// createRun converts the exported results and statistics of a
+// run into a form which can be stored
+func createRun(id uuid.UUID, export *report.Export, stats statistics.Handler) (*backtestrun.Run, error) {
+	if export == nil {
+		return nil, fmt.Errorf("%w export", gctcommon.ErrNilPointer)
+	}
+	if stats == nil {
+		return nil, fmt.Errorf("%w statistics", gctcommon.ErrNilPointer)
+	}
+	cfg := export.Config
+	if cfg != nil && cfg.StrategySettings.Seed == 0 && export.Seed != 0 {
+		// the stored config holds the generated seed so the run can be replayed
+		seeded := *cfg
+		seeded.StrategySettings.Seed = export.Seed
+		cfg = &seeded
+	}
+	cfgJSON, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	reportJSON, err := json.Marshal(export)
+	if err != nil {
+		return nil, err
+	}
+	hashJSON := cfgJSON
+	if cfg != nil && cfg.StrategySettings.Seed != 0 {
+		// runs with the same settings share a hash regardless of their seed
+		cfgCopy := *cfg
+		cfgCopy.StrategySettings.Seed = 0
+		hashJSON, err = json.Marshal(&cfgCopy)
+		if err != nil {
+			return nil, err
+		}
+	}
+	hash := sha256.Sum256(hashJSON)
+	run := &backtestrun.Run{
+		Nickname:   export.Nickname,
+		Strategy:   export.Strategy,
+		ConfigHash: hex.EncodeToString(hash[:]),
+		Config:     string(cfgJSON),
+		Report:     string(reportJSON),
+		CreatedAt:  export.GeneratedAt,
+	}
+	if !id.IsNil() {
+		run.ID = id.String()
+	}
+	if export.Config != nil && len(export.Config.StrategySettings.CustomSettings) > 0 {
+		var params []byte
+		params, err = json.Marshal(export.Config.StrategySettings.CustomSettings)
+		if err != nil {
+			return nil, err
+		}
+		run.Parameters = string(params)
+	}
+	for i := range export.Events {
+		if run.StartDate.IsZero() || export.Events[i].Time.Before(run.StartDate) {
+			run.StartDate = export.Events[i].Time
+		}
+		if export.Events[i].Time.After(run.EndDate) {
+			run.EndDate = export.Events[i].Time
+		}
+	}
+	summary, err := stats.GetPerformanceSummary()
+	if err != nil {
+		log.Warnf(common.Backtester, "Run history will not store summary metrics: %v", err)
+	} else {
+		run.TotalReturn = summary.TotalReturn.InexactFloat64()
+		run.MaxDrawdown = summary.MaxDrawdown.InexactFloat64()
+		run.SharpeRatio = summary.SharpeRatio.InexactFloat64()
+		run.SortinoRatio = summary.SortinoRatio.InexactFloat64()
+		run.CalmarRatio = summary.CalmarRatio.InexactFloat64()
+		var equityCurve []statistics.ValueAtTime
+		equityCurve, err = stats.GetEquityCurve()
+		if err != nil {
+			return nil, err
+		}
+		run.EquityCurve = make([]backtestrun.EquityPoint, len(equityCurve))
+		for i := range equityCurve {
+			run.EquityCurve[i] = backtestrun.EquityPoint{
+				Time:  equityCurve[i].Time,
+				Value: equityCurve[i].Value.InexactFloat64(),
+			}
+		}
+	}
+	run.Trades = make([]backtestrun.Trade, len(export.Fills))
+	for i := range export.Fills {
+		price := export.Fills[i].PurchasePrice
+		if price.IsZero() {
+			price = export.Fills[i].ClosePrice
+		}
+		run.Trades[i] = backtestrun.Trade{
+			Exchange:  export.Fills[i].Exchange,
+			Asset:     export.Fills[i].Asset.String(),
+			Base:      export.Fills[i].Pair.Base.String(),
+			Quote:     export.Fills[i].Pair.Quote.String(),
+			Side:      export.Fills[i].Direction,
+			Price:     price.InexactFloat64(),
+			Amount:    export.Fills[i].Amount.InexactFloat64(),
+			Fee:       export.Fills[i].Fee.InexactFloat64(),
+			Timestamp: export.Fills[i].Time,
+		}
+	}
+	return run, nil
+}
+
+// GetRuns returns a summary of stored runs matching the filter
+func (r *RunHistory) GetRuns(filter *backtestrun.Filter) ([]backtestrun.Run, error) {
+	if r == nil {
+		return nil, errRunHistoryDisabled
+	}
+	return r.service.GetRuns(filter)
+}
+
+// GetRun returns a stored run along with its trades and report
+func (r *RunHistory) GetRun(id string) (*backtestrun.Run, error) {
+	if r == nil {
+		return nil, errRunHistoryDisabled
+	}
+	return r.service.GetByID(id)
+}
+
+// CompareRuns retrieves stored runs and determines which
+// performed best for each headline metric
+func (r *RunHistory) CompareRuns(ids []string) (*RunComparison, error) {
+	if r == nil {
+		return nil, errRunHistoryDisabled
+	}
+	if len(ids) < 2 {
+		return nil, fmt.Errorf("%w, received %v", errNotEnoughRunsToCheck, len(ids))
+	}
+	resp := &RunComparison{
+		Runs: make([]backtestrun.Run, len(ids)),
+	}
+	for i := range ids {
+		run, err := r.service.GetByID(strings.TrimSpace(ids[i]))
+		if err != nil {
+			return nil, err
+		}
+		resp.Runs[i] = *run
+	}
+	var highestReturn, highestSharpe, highestSortino, highestCalmar, lowestDrawdown int
+	for i := range resp.Runs {
+		if resp.Runs[i].TotalReturn > resp.Runs[highestReturn].TotalReturn {
+			highestReturn = i
+		}
+		if resp.Runs[i].SharpeRatio > resp.Runs[highestSharpe].SharpeRatio {
+			highestSharpe = i
+		}
+		if resp.Runs[i].SortinoRatio > resp.Runs[highestSortino].SortinoRatio {
+			highestSortino = i
+		}
+		if resp.Runs[i].CalmarRatio > resp.Runs[highestCalmar].CalmarRatio {
+			highestCalmar = i
+		}
+		if math.Abs(resp.Runs[i].MaxDrawdown) < math.Abs(resp.Runs[lowestDrawdown].MaxDrawdown) {
+			lowestDrawdown = i
+		}
+	}
+	resp.HighestTotalReturn = resp.Runs[highestReturn].ID
+	resp.HighestSharpeRatio = resp.Runs[highestSharpe].ID
+	resp.HighestSortinoRatio = resp.Runs[highestSortino].ID
+	resp.HighestCalmarRatio = resp.Runs[highestCalmar].ID
+	resp.LowestMaxDrawdown = resp.Runs[lowestDrawdown].ID
+	return resp, nil
+}
+
+// SetRunHistory sets where the run is stored once it has completed.
+// A nil run history disables storage
+func (bt *BackTest) SetRunHistory(r *RunHistory) {
+	if bt == nil {
+		return
+	}
+	bt.m.Lock()
+	bt.runHistory = r
+	bt.m.Unlock()
+}
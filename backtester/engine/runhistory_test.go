@@ -0,0 +1,263 @@
+package engine
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/report"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	gctdatabase "github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/goose"
+)
+
+// setupTestRunHistory connects to a fresh sqlite database
+// and applies the GoCryptoTrader database migrations
+func setupTestRunHistory(t *testing.T) *RunHistory {
+	t.Helper()
+	r, err := SetupRunHistory(&config.RunHistory{
+		Enabled: true,
+		Path:    t.TempDir(),
+		Config: gctdatabase.Config{
+			Driver:            gctdatabase.DBSQLite3,
+			ConnectionDetails: drivers.ConnectionDetails{Database: "runhistory.db"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := r.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	err = goose.Run("up", r.instance.SQL, gctdatabase.DBSQLite3, filepath.Join("..", "..", "database", "migrations"), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func TestSetupRunHistory(t *testing.T) {
+	t.Parallel()
+	_, err := SetupRunHistory(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	r, err := SetupRunHistory(&config.RunHistory{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if r != nil {
+		t.Errorf("received '%v' expected '%v'", r, nil)
+	}
+
+	_, err = SetupRunHistory(&config.RunHistory{Enabled: true})
+	if !errors.Is(err, gctdatabase.ErrFailedToConnect) {
+		t.Errorf("received '%v' expected '%v'", err, gctdatabase.ErrFailedToConnect)
+	}
+
+	r = setupTestRunHistory(t)
+	if r.service == nil {
+		t.Error("expected run history service to be set")
+	}
+}
+
+func TestCreateRun(t *testing.T) {
+	t.Parallel()
+	_, err := createRun(uuid.Nil, nil, &statistics.Statistic{})
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = createRun(uuid.Nil, &report.Export{}, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	id, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+	export := &report.Export{
+		Nickname: "test",
+		Strategy: "rsi",
+		Config: &config.Config{
+			StrategySettings: config.StrategySettings{
+				CustomSettings: map[string]interface{}{"rsi-period": 14},
+			},
+		},
+		Events: []report.ExportEvent{{Time: tt.Add(time.Hour)}, {Time: tt}},
+		Fills: []report.ExportFill{
+			{
+				Exchange:      testExchange,
+				Asset:         asset.Spot,
+				Pair:          currency.NewPair(currency.BTC, currency.USDT),
+				Time:          tt,
+				Direction:     gctorder.Buy.String(),
+				Amount:        decimal.NewFromInt(1),
+				PurchasePrice: decimal.NewFromInt(1337),
+				Fee:           decimal.NewFromInt(1),
+			},
+		},
+	}
+	stats := &statistics.Statistic{
+		FundingStatistics: &statistics.FundingStatistics{
+			TotalUSDStatistics: &statistics.TotalFundingStatistics{
+				HoldingValueDifference: decimal.NewFromInt(5),
+				ArithmeticRatios:       &statistics.Ratios{SharpeRatio: decimal.NewFromInt(2)},
+				HoldingValues:          []statistics.ValueAtTime{{Time: tt, Value: decimal.NewFromInt(100)}},
+			},
+		},
+	}
+	run, err := createRun(id, export, stats)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if run.ID != id.String() {
+		t.Errorf("received '%v' expected '%v'", run.ID, id)
+	}
+	if run.ConfigHash == "" {
+		t.Error("expected config hash to be set")
+	}
+	export.Config.StrategySettings.Seed = 1337
+	seeded, err := createRun(id, export, stats)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if seeded.ConfigHash != run.ConfigHash {
+		t.Errorf("received '%v' expected '%v'", seeded.ConfigHash, run.ConfigHash)
+	}
+	export.Config.StrategySettings.Seed = 0
+	export.Seed = 1337
+	generated, err := createRun(id, export, stats)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if generated.Config != seeded.Config {
+		t.Errorf("received '%v' expected '%v'", generated.Config, seeded.Config)
+	}
+	if export.Config.StrategySettings.Seed != 0 {
+		t.Error("expected the exported config to be unchanged")
+	}
+	if run.Parameters != `{"rsi-period":14}` {
+		t.Errorf("received '%v' expected '%v'", run.Parameters, `{"rsi-period":14}`)
+	}
+	if !run.StartDate.Equal(tt) || !run.EndDate.Equal(tt.Add(time.Hour)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", run.StartDate, run.EndDate, tt, tt.Add(time.Hour))
+	}
+	if run.TotalReturn != 5 || run.SharpeRatio != 2 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", run.TotalReturn, run.SharpeRatio, 5, 2)
+	}
+	if len(run.EquityCurve) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(run.EquityCurve), 1)
+	}
+	if len(run.Trades) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(run.Trades), 1)
+	}
+	if run.Trades[0].Price != 1337 || run.Trades[0].Base != "BTC" {
+		t.Errorf("received '%v' expected a BTC trade at 1337", run.Trades[0])
+	}
+
+	// summary metrics are optional when they cannot be determined
+	run, err = createRun(id, export, &statistics.Statistic{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if run.TotalReturn != 0 || len(run.EquityCurve) != 0 {
+		t.Error("expected no summary metrics")
+	}
+}
+
+func TestRunHistoryStoreAndCompare(t *testing.T) {
+	t.Parallel()
+	var r *RunHistory
+	_, err := r.GetRuns(nil)
+	if !errors.Is(err, errRunHistoryDisabled) {
+		t.Errorf("received '%v' expected '%v'", err, errRunHistoryDisabled)
+	}
+	err = r.Store(&BackTest{})
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	r = setupTestRunHistory(t)
+	err = r.Store(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	err = r.Store(&BackTest{})
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	ids := make([]string, 2)
+	for i := range ids {
+		bt := &BackTest{
+			Reports:   &fakeReport{},
+			Statistic: &statistics.Statistic{},
+		}
+		bt.MetaData.ID, err = uuid.NewV4()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = r.Store(bt)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+		ids[i] = bt.MetaData.ID.String()
+	}
+
+	runs, err := r.GetRuns(nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(runs) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(runs), 2)
+	}
+
+	run, err := r.GetRun(ids[0])
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if run.Report == "" {
+		t.Error("expected report to be stored")
+	}
+
+	_, err = r.CompareRuns(ids[:1])
+	if !errors.Is(err, errNotEnoughRunsToCheck) {
+		t.Errorf("received '%v' expected '%v'", err, errNotEnoughRunsToCheck)
+	}
+	comparison, err := r.CompareRuns(ids)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(comparison.Runs) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(comparison.Runs), 2)
+	}
+	if comparison.HighestTotalReturn != ids[0] {
+		t.Errorf("received '%v' expected '%v'", comparison.HighestTotalReturn, ids[0])
+	}
+}
+
+func TestSetRunHistory(t *testing.T) {
+	t.Parallel()
+	var bt *BackTest
+	bt.SetRunHistory(&RunHistory{})
+	bt = &BackTest{}
+	r := &RunHistory{}
+	bt.SetRunHistory(r)
+	if bt.runHistory != r {
+		t.Errorf("received '%v' expected '%v'", bt.runHistory, r)
+	}
+}
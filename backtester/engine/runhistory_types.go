@@ -0,0 +1,31 @@
+package engine
+
+import (
+	"errors"
+
+	gctdatabase "github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/backtestrun"
+)
+
+var (
+	errRunHistoryDisabled   = errors.New("run history is disabled")
+	errNotEnoughRunsToCheck = errors.New("at least two runs are required to compare")
+)
+
+// RunHistory stores completed backtest runs and their results in a database
+// so they can be listed, retrieved and compared after the task is cleared
+type RunHistory struct {
+	instance *gctdatabase.Instance
+	service  backtestrun.IDBService
+}
+
+// RunComparison holds stored runs alongside the ID of the run
+// which performed best for each headline metric
+type RunComparison struct {
+	Runs                []backtestrun.Run
+	HighestTotalReturn  string
+	HighestSharpeRatio  string
+	HighestSortinoRatio string
+	HighestCalmarRatio  string
+	LowestMaxDrawdown   string
+}
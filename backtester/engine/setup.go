@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
@@ -40,6 +42,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -105,6 +108,18 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 		BuySide:  buyRule,
 		SellSide: sellRule,
 	}
+	if cfg.PortfolioSettings.Sizing != nil {
+		sizeManager.Model = size.Model{
+			Name:               cfg.PortfolioSettings.Sizing.Model,
+			Fraction:           cfg.PortfolioSettings.Sizing.Fraction,
+			MaximumFraction:    cfg.PortfolioSettings.Sizing.MaximumFraction,
+			TargetVolatility:   cfg.PortfolioSettings.Sizing.TargetVolatility,
+			VolatilityMeasure:  cfg.PortfolioSettings.Sizing.VolatilityMeasure,
+			VolatilityPeriod:   cfg.PortfolioSettings.Sizing.VolatilityPeriod,
+			KellyWindow:        cfg.PortfolioSettings.Sizing.KellyWindow,
+			KellyMinimumTrades: cfg.PortfolioSettings.Sizing.KellyMinimumTrades,
+		}
+	}
 
 	funds, err := funding.SetupFundingManager(
 		bt.exchangeManager,
@@ -129,6 +144,18 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 			if err != nil {
 				return err
 			}
+			chains := make([]funding.TransferChain, len(cfg.FundingSettings.ExchangeLevelFunding[i].TransferChains))
+			for j := range cfg.FundingSettings.ExchangeLevelFunding[i].TransferChains {
+				chains[j] = funding.TransferChain{
+					Chain:             cfg.FundingSettings.ExchangeLevelFunding[i].TransferChains[j].Chain,
+					WithdrawalFee:     cfg.FundingSettings.ExchangeLevelFunding[i].TransferChains[j].WithdrawalFee,
+					ConfirmationDelay: cfg.FundingSettings.ExchangeLevelFunding[i].TransferChains[j].ConfirmationDelay,
+				}
+			}
+			err = item.SetTransferSettings(cfg.FundingSettings.ExchangeLevelFunding[i].TransferDelay, chains)
+			if err != nil {
+				return err
+			}
 			err = funds.AddItem(item)
 			if err != nil {
 				return err
@@ -390,6 +417,11 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 			return err
 		}
 	}
+	bt.MetaData.Seed = cfg.StrategySettings.Seed
+	if bt.MetaData.Seed == 0 {
+		bt.MetaData.Seed = time.Now().UnixNano()
+		log.Infof(common.Setup, "Generated random seed %v, set it in the strategy settings to replay this run", bt.MetaData.Seed)
+	}
 	stats := &statistics.Statistic{
 		StrategyName:                bt.Strategy.Name(),
 		StrategyNickname:            cfg.Nickname,
@@ -398,8 +430,37 @@ func (bt *BackTest) SetupFromConfig(cfg *config.Config, templatePath, output str
 		ExchangeAssetPairStatistics: make(map[string]map[asset.Item]map[*currency.Item]map[*currency.Item]*statistics.CurrencyPairStatistic),
 		RiskFreeRate:                cfg.StatisticSettings.RiskFreeRate,
 		CandleInterval:              cfg.DataSettings.Interval,
+		Seed:                        bt.MetaData.Seed,
 		FundManager:                 bt.Funding,
 	}
+	if mc := cfg.StatisticSettings.MonteCarlo; mc != nil {
+		stats.MonteCarloSettings = &statistics.MonteCarloSettings{
+			Simulations:                 mc.Simulations,
+			Bootstrap:                   mc.Method == config.MonteCarloBootstrap,
+			ResampleTrades:              mc.Sample == config.MonteCarloTrades,
+			Seed:                        mc.Seed,
+			ConfidenceLevel:             mc.ConfidenceLevel,
+			RuinThresholdPercent:        mc.RuinThresholdPercent,
+			SlippagePerturbationPercent: mc.SlippagePerturbationPercent,
+			FeePerturbationPercent:      mc.FeePerturbationPercent,
+		}
+	}
+	stats.ValueAtRiskConfidence = cfg.StatisticSettings.ValueAtRiskConfidence
+	if b := cfg.StatisticSettings.Benchmark; b != nil {
+		stats.BenchmarkSettings = &statistics.BenchmarkSettings{
+			Type:     b.Type,
+			Exchange: b.ExchangeName,
+			Asset:    b.Asset,
+			Pair:     currency.NewPair(b.Base, b.Quote),
+		}
+		if b.Type == statistics.BenchmarkCSV {
+			stats.BenchmarkSettings.Name = filepath.Base(b.CSVPath)
+			stats.BenchmarkSettings.Values, err = statistics.LoadBenchmarkCSV(b.CSVPath)
+			if err != nil {
+				return err
+			}
+		}
+	}
 	bt.Statistic = stats
 	reports.Statistics = stats
 
@@ -483,6 +544,8 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 	log.Infoln(common.Setup, "Setting exchange settings...")
 
 	resp := &exchange.Exchange{}
+	resp.SetRandomSeed(bt.MetaData.Seed)
+	conditions := make(map[string]*exchange.ExecutionConditions)
 	for i := range cfg.CurrencySettings {
 		exch, pair, a, err := bt.loadExchangePairAssetBase(
 			cfg.CurrencySettings[i].ExchangeName,
@@ -494,7 +557,7 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 		}
 
 		exchangeName := strings.ToLower(exch.GetName())
-		klineData, err := bt.loadData(cfg, exch, pair, a, cfg.CurrencySettings[i].USDTrackingPair)
+		klineData, err := bt.loadData(cfg, exch, pair, a, cfg.CurrencySettings[i].USDTrackingPair, cfg.CurrencySettings[i].AdditionalIntervals)
 		if err != nil {
 			return nil, err
 		}
@@ -611,6 +674,50 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 				MaximumOrdersWithLeverageRatio: cfg.CurrencySettings[i].FuturesDetails.Leverage.MaximumOrdersWithLeverageRatio,
 			}
 		}
+		var isPerp bool
+		var fundingRates []gctorder.FundingRate
+		if a.IsFutures() {
+			isPerp = isPerpetual(exch, a, pair)
+			if isPerp {
+				switch {
+				case bt.LiveDataHandler != nil:
+					if !realOrders {
+						log.Warnf(common.Setup, "Funding payments are not simulated for live perpetual %v %v %v", exch.GetName(), a, pair)
+					}
+				case cfg.CurrencySettings[i].FuturesDetails != nil && cfg.CurrencySettings[i].FuturesDetails.FundingRatesCSVPath != "":
+					fundingRates, err = loadCSVFundingRates(cfg.CurrencySettings[i].FuturesDetails.FundingRatesCSVPath, klineData)
+					if err != nil {
+						return nil, err
+					}
+				default:
+					if cfg.DataSettings.APIData == nil {
+						log.Warnf(common.Setup, "Funding rates for %v %v %v are retrieved from the exchange API, set funding-rates-csv-path to run offline", exch.GetName(), a, pair)
+					}
+					fundingRates, err = loadFundingRates(context.TODO(), exch, a, pair, klineData)
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+		if cfg.CurrencySettings[i].SpotDetails != nil && cfg.CurrencySettings[i].SpotDetails.Margin != nil {
+			if bt.LiveDataHandler == nil {
+				err = bt.setupSpotMargin(context.TODO(), cfg.CurrencySettings[i].SpotDetails.Margin, exch, a, pair, klineData)
+				if err != nil {
+					return nil, err
+				}
+			} else if !realOrders {
+				log.Warnf(common.Setup, "Spot margin borrowing is not simulated for live %v %v %v", exch.GetName(), a, pair)
+			}
+		}
+		ec, ok := conditions[exchangeName]
+		if !ok {
+			ec = executionConditions(cfg.GetExecutionSettings(exchangeName), bt.MetaData.Seed)
+			conditions[exchangeName] = ec
+			if ec != nil && realOrders {
+				log.Warnf(common.Setup, "Execution settings for %v are not simulated when using real orders", exch.GetName())
+			}
+		}
 		resp.CurrencySettings = append(resp.CurrencySettings, exchange.Settings{
 			Exchange:                  exch,
 			MinimumSlippageRate:       cfg.CurrencySettings[i].MinimumSlippagePercent,
@@ -627,12 +734,207 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (*exchange.Exchang
 			SkipCandleVolumeFitting:   cfg.CurrencySettings[i].SkipCandleVolumeFitting,
 			CanUseExchangeLimits:      cfg.CurrencySettings[i].CanUseExchangeLimits,
 			UseExchangePNLCalculation: cfg.CurrencySettings[i].UseExchangePNLCalculation,
+			IsPerpetual:               isPerp,
+			FundingRates:              fundingRates,
+			OptimisticIntrabarFills:   strings.EqualFold(cfg.CurrencySettings[i].IntrabarFillAssumption, config.OptimisticFills),
+			ExitRules:                 exitRules(cfg.GetExitRules(&cfg.CurrencySettings[i])),
+			ExecutionConditions:       ec,
+		})
+	}
+
+	return resp, nil
+}
+
+// exitRules converts config exit rules to the exchange settings which the
+// portfolio manager enforces
+func exitRules(r config.ExitRules) exchange.ExitRules {
+	return exchange.ExitRules{
+		StopLossPercent:         r.StopLossPercent,
+		TakeProfitPercent:       r.TakeProfitPercent,
+		TrailingStopPercent:     r.TrailingStopPercent,
+		ATRPeriod:               r.ATRPeriod,
+		StopLossATRMultiple:     r.StopLossATRMultiple,
+		TakeProfitATRMultiple:   r.TakeProfitATRMultiple,
+		TrailingStopATRMultiple: r.TrailingStopATRMultiple,
+		MaximumHoldingPeriods:   r.MaximumHoldingPeriods,
+	}
+}
+
+// executionConditions converts config execution settings to the conditions
+// shared by all of an exchange's currency settings. The run's seed is used
+// when the execution settings do not set their own
+func executionConditions(e *config.ExecutionSettings, runSeed int64) *exchange.ExecutionConditions {
+	if e == nil {
+		return nil
+	}
+	resp := &exchange.ExecutionConditions{
+		RejectionRate: e.RejectionRatePercent,
+		Seed:          e.Seed,
+	}
+	if resp.Seed == 0 {
+		resp.Seed = runSeed
+	}
+	for i := range e.Outages {
+		resp.Outages = append(resp.Outages, exchange.Outage{
+			Start: e.Outages[i].Start,
+			End:   e.Outages[i].End,
+		})
+	}
+	if e.Latency != nil {
+		switch e.Latency.Distribution {
+		case config.UniformLatency:
+			resp.LatencyDistribution = exchange.UniformLatency
+		case config.NormalLatency:
+			resp.LatencyDistribution = exchange.NormalLatency
+		default:
+			resp.LatencyDistribution = exchange.FixedLatency
+		}
+		resp.MinimumLatency = e.Latency.Minimum
+		resp.MaximumLatency = e.Latency.Maximum
+		resp.MeanLatency = e.Latency.Mean
+		resp.LatencyStandardDeviation = e.Latency.StandardDeviation
+	}
+	return resp
+}
+
+// isPerpetual determines whether a futures asset and pair is a perpetual
+// contract, falling back to the exchange when it cannot be determined by
+// asset or naming convention
+func isPerpetual(exch gctexchange.IBotExchange, a asset.Item, pair currency.Pair) bool {
+	if a == asset.PerpetualSwap || a == asset.PerpetualContract {
+		return true
+	}
+	if pair.Quote.Equal(currency.PERP) || strings.EqualFold(pair.Base.String(), "PI") {
+		return true
+	}
+	if exch == nil {
+		return false
+	}
+	isPerp, err := exch.IsPerpetualFutureCurrency(a, pair)
+	return err == nil && isPerp
+}
+
+// loadFundingRates retrieves historical funding rates from the exchange
+// covering the range of the loaded candle data
+func loadFundingRates(ctx context.Context, exch gctexchange.IBotExchange, a asset.Item, pair currency.Pair, klineData *kline.DataFromKline) ([]gctorder.FundingRate, error) {
+	if exch == nil {
+		return nil, fmt.Errorf("exchange %w", gctcommon.ErrNilPointer)
+	}
+	if klineData == nil || klineData.Item == nil {
+		return nil, errNilData
+	}
+	if len(klineData.Item.Candles) == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v", errNilData, exch.GetName(), a, pair)
+	}
+	log.Infof(common.Setup, "Loading funding rates for %v %v %v...\n", exch.GetName(), a, pair)
+	rates, err := exch.GetFundingRates(ctx, &gctorder.FundingRatesRequest{
+		Asset:     a,
+		Pairs:     currency.Pairs{pair},
+		StartDate: klineData.Item.Candles[0].Time,
+		EndDate:   klineData.Item.Candles[len(klineData.Item.Candles)-1].Time.Add(klineData.Item.Interval.Duration()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not load funding rates for perpetual %v %v %v %w", exch.GetName(), a, pair, err)
+	}
+	for i := range rates {
+		if !rates[i].Pair.Equal(pair) {
+			continue
+		}
+		resp := make([]gctorder.FundingRate, len(rates[i].FundingRates))
+		copy(resp, rates[i].FundingRates)
+		sort.Slice(resp, func(j, k int) bool {
+			return resp[j].Time.Before(resp[k].Time)
 		})
+		return resp, nil
 	}
+	return nil, fmt.Errorf("%w for %v %v %v", errNoFundingRates, exch.GetName(), a, pair)
+}
 
+// loadCSVFundingRates reads historical funding rates from a CSV file and
+// returns those covering the range of the loaded candle data
+func loadCSVFundingRates(path string, klineData *kline.DataFromKline) ([]gctorder.FundingRate, error) {
+	if klineData == nil || klineData.Item == nil || len(klineData.Item.Candles) == 0 {
+		return nil, errNilData
+	}
+	log.Infof(common.Setup, "Loading funding rates from %v...\n", path)
+	rates, err := csv.LoadFundingRates(path)
+	if err != nil {
+		return nil, err
+	}
+	start := klineData.Item.Candles[0].Time
+	end := klineData.Item.Candles[len(klineData.Item.Candles)-1].Time.Add(klineData.Item.Interval.Duration())
+	resp := make([]gctorder.FundingRate, 0, len(rates))
+	for i := range rates {
+		if rates[i].Time.Before(start) || !rates[i].Time.Before(end) {
+			continue
+		}
+		resp = append(resp, rates[i])
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%w in %v between %v and %v", errNoFundingRates, path, start, end)
+	}
 	return resp, nil
 }
 
+// setupSpotMargin allows the funding of a spot pair to be borrowed,
+// loading historical borrow rates from the exchange when configured
+func (bt *BackTest) setupSpotMargin(ctx context.Context, m *config.SpotMargin, exch gctexchange.IBotExchange, a asset.Item, pair currency.Pair, klineData *kline.DataFromKline) error {
+	if m == nil {
+		return fmt.Errorf("%w spot margin settings", gctcommon.ErrNilPointer)
+	}
+	setup := &funding.SpotMarginSetup{
+		Exchange:               strings.ToLower(exch.GetName()),
+		Asset:                  a,
+		Pair:                   pair,
+		MaximumLeverage:        m.MaximumLeverage,
+		LiquidationMarginLevel: m.LiquidationMarginLevel,
+		BaseYearlyBorrowRate:   m.BaseYearlyBorrowRate,
+		QuoteYearlyBorrowRate:  m.QuoteYearlyBorrowRate,
+	}
+	if m.UseExchangeBorrowRates {
+		var err error
+		setup.BaseBorrowRates, err = loadBorrowRates(ctx, exch, a, pair.Base, klineData)
+		if err != nil {
+			return err
+		}
+		setup.QuoteBorrowRates, err = loadBorrowRates(ctx, exch, a, pair.Quote, klineData)
+		if err != nil {
+			return err
+		}
+	}
+	return bt.Funding.SetupSpotMargin(setup)
+}
+
+// loadBorrowRates retrieves historical margin borrow rates for a currency
+// covering the range of the loaded candle data
+func loadBorrowRates(ctx context.Context, exch gctexchange.IBotExchange, a asset.Item, code currency.Code, klineData *kline.DataFromKline) ([]margin.Rate, error) {
+	if exch == nil {
+		return nil, fmt.Errorf("exchange %w", gctcommon.ErrNilPointer)
+	}
+	if klineData == nil || klineData.Item == nil {
+		return nil, errNilData
+	}
+	if len(klineData.Item.Candles) == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v", errNilData, exch.GetName(), a, code)
+	}
+	log.Infof(common.Setup, "Loading borrow rates for %v %v %v...\n", exch.GetName(), a, code)
+	rates, err := exch.GetMarginRatesHistory(ctx, &margin.RateHistoryRequest{
+		Exchange:       exch.GetName(),
+		Asset:          a,
+		Currency:       code,
+		StartDate:      klineData.Item.Candles[0].Time,
+		EndDate:        klineData.Item.Candles[len(klineData.Item.Candles)-1].Time.Add(klineData.Item.Interval.Duration()),
+		GetBorrowRates: true,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not load borrow rates for %v %v %v %w", exch.GetName(), a, code, err)
+	}
+	if len(rates.Rates) == 0 {
+		log.Warnf(common.Setup, "No borrow rates returned for %v %v %v, using configured yearly borrow rate", exch.GetName(), a, code)
+	}
+	return rates.Rates, nil
+}
+
 func (bt *BackTest) loadExchangePairAssetBase(exch string, base, quote currency.Code, ai asset.Item) (gctexchange.IBotExchange, currency.Pair, asset.Item, error) {
 	e, err := bt.exchangeManager.GetExchangeByName(exch)
 	if err != nil {
@@ -686,7 +988,7 @@ func getFees(ctx context.Context, exch gctexchange.IBotExchange, fPair currency.
 
 // loadData will create kline data from the sources defined in start config files. It can exist from databases, csv or API endpoints
 // it can also be generated from trade data which will be converted into kline data
-func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
+func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, isUSDTrackingPair bool, additionalIntervals []gctkline.Interval) (*kline.DataFromKline, error) {
 	if exch == nil {
 		return nil, engine.ErrExchangeNotFound
 	}
@@ -710,6 +1012,9 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 	if err != nil {
 		return nil, err
 	}
+	if dataType == common.DataOrderbook && cfg.DataSettings.CSVData == nil {
+		return nil, errOrderbookCSVOnly
+	}
 
 	log.Infof(common.Setup, "Loading data for %v %v %v...\n", exch.GetName(), a, fPair)
 	resp := kline.NewDataFromKline()
@@ -729,6 +1034,56 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		underlyingPair = currency.NewPair(fPair.Base, curr)
 	}
 
+	if cfg.DataSettings.LiveData != nil {
+		if !b.Features.Enabled.Kline.Intervals.ExchangeSupported(cfg.DataSettings.Interval) {
+			return nil, fmt.Errorf("%w don't trade live on custom candle interval of %v",
+				gctkline.ErrCannotConstructInterval,
+				cfg.DataSettings.Interval)
+		}
+		err = bt.exchangeManager.Add(exch)
+		if err != nil {
+			return nil, err
+		}
+		err = bt.LiveDataHandler.AppendDataSource(&liveDataSourceSetup{
+			exchange:                  exch,
+			interval:                  cfg.DataSettings.Interval,
+			asset:                     a,
+			pair:                      fPair,
+			underlyingPair:            underlyingPair,
+			dataType:                  dataType,
+			dataRequestRetryTolerance: cfg.DataSettings.LiveData.DataRequestRetryTolerance,
+			dataRequestRetryWaitTime:  cfg.DataSettings.LiveData.DataRequestRetryWaitTime,
+			verboseExchangeRequest:    cfg.DataSettings.VerboseExchangeRequests,
+			additionalIntervals:       additionalIntervals,
+		})
+		return nil, err
+	}
+	resp, err = bt.sharedData.load(sharedDataKey(exch.GetName(), a, fPair, isUSDTrackingPair), func() (*kline.DataFromKline, error) {
+		return bt.loadDataSource(cfg, exch, fPair, a, dataType, isUSDTrackingPair)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Item.UnderlyingPair = underlyingPair
+	resp.AdditionalIntervals = additionalIntervals
+	err = resp.Load()
+	if err != nil {
+		return nil, err
+	}
+	err = bt.Reports.SetKlineData(resp.Item)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// loadDataSource loads candle data from the CSV, database or API data source
+// defined in the config
+func (bt *BackTest) loadDataSource(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, dataType int64, isUSDTrackingPair bool) (*kline.DataFromKline, error) {
+	b := exch.GetBase()
+	var resp *kline.DataFromKline
+	var err error
 	switch {
 	case cfg.DataSettings.CSVData != nil:
 		if cfg.DataSettings.Interval <= 0 {
@@ -832,42 +1187,10 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if err != nil {
 			return resp, err
 		}
-	case cfg.DataSettings.LiveData != nil:
-		if !b.Features.Enabled.Kline.Intervals.ExchangeSupported(cfg.DataSettings.Interval) {
-			return nil, fmt.Errorf("%w don't trade live on custom candle interval of %v",
-				gctkline.ErrCannotConstructInterval,
-				cfg.DataSettings.Interval)
-		}
-		err = bt.exchangeManager.Add(exch)
-		if err != nil {
-			return nil, err
-		}
-		err = bt.LiveDataHandler.AppendDataSource(&liveDataSourceSetup{
-			exchange:                  exch,
-			interval:                  cfg.DataSettings.Interval,
-			asset:                     a,
-			pair:                      fPair,
-			underlyingPair:            underlyingPair,
-			dataType:                  dataType,
-			dataRequestRetryTolerance: cfg.DataSettings.LiveData.DataRequestRetryTolerance,
-			dataRequestRetryWaitTime:  cfg.DataSettings.LiveData.DataRequestRetryWaitTime,
-			verboseExchangeRequest:    cfg.DataSettings.VerboseExchangeRequests,
-		})
-		return nil, err
 	}
 	if resp == nil {
 		return nil, fmt.Errorf("processing error, response returned nil")
 	}
-
-	resp.Item.UnderlyingPair = underlyingPair
-	err = resp.Load()
-	if err != nil {
-		return nil, err
-	}
-	err = bt.Reports.SetKlineData(resp.Item)
-	if err != nil {
-		return nil, err
-	}
 	return resp, nil
 }
 
@@ -972,6 +1295,12 @@ func setExchangeCredentials(cfg *config.Config, base *gctexchange.Base) error {
 
 // NewBacktesterFromConfigs creates a new backtester based on config settings
 func NewBacktesterFromConfigs(strategyCfg *config.Config, backtesterCfg *config.BacktesterConfig) (*BackTest, error) {
+	return newBacktesterFromConfigs(strategyCfg, backtesterCfg, nil)
+}
+
+// newBacktesterFromConfigs creates a new backtester based on config settings,
+// loading candle data from the shared data when it is not nil
+func newBacktesterFromConfigs(strategyCfg *config.Config, backtesterCfg *config.BacktesterConfig, shared *sharedData) (*BackTest, error) {
 	if strategyCfg == nil {
 		return nil, fmt.Errorf("%w strategy config", gctcommon.ErrNilPointer)
 	}
@@ -981,16 +1310,23 @@ func NewBacktesterFromConfigs(strategyCfg *config.Config, backtesterCfg *config.
 	if err := strategyCfg.Validate(); err != nil {
 		return nil, err
 	}
+	if err := backtesterCfg.Report.ValidateExportFormats(); err != nil {
+		return nil, err
+	}
 	bt, err := NewBacktester()
 	if err != nil {
 		return nil, err
 	}
+	bt.sharedData = shared
 	err = bt.SetupFromConfig(strategyCfg, backtesterCfg.Report.TemplatePath, backtesterCfg.Report.OutputPath, backtesterCfg.Verbose)
 	if err != nil {
+		bt.closeStrategy()
 		return nil, err
 	}
+	bt.Reports.SetExportFormats(backtesterCfg.Report.ExportFormats)
 	err = bt.SetupMetaData()
 	if err != nil {
+		bt.closeStrategy()
 		return nil, err
 	}
 	return bt, nil
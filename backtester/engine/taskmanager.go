@@ -80,6 +80,105 @@ func (r *TaskManager) GetSummary(id uuid.UUID) (*TaskSummary, error) {
 	return nil, fmt.Errorf("%s %w", id, errTaskNotFound)
 }
 
+// GetProgress returns the progress of a strategy task along with any
+// signal and fill events with an ID greater than afterEventID
+func (r *TaskManager) GetProgress(id uuid.UUID, afterEventID uint64, includeEvents bool) (*TaskProgress, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	for i := range r.tasks {
+		if !r.tasks[i].MatchesID(id) {
+			continue
+		}
+		return r.tasks[i].GetProgress(afterEventID, includeEvents)
+	}
+	return nil, fmt.Errorf("%s %w", id, errTaskNotFound)
+}
+
+// SubscribeProgress keeps the holdings of a strategy task's progress up to
+// date until the returned func is called
+func (r *TaskManager) SubscribeProgress(id uuid.UUID) (func(), error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	for i := range r.tasks {
+		if !r.tasks[i].MatchesID(id) {
+			continue
+		}
+		return r.tasks[i].SubscribeProgress()
+	}
+	return nil, fmt.Errorf("%s %w", id, errTaskNotFound)
+}
+
+// DebugTask pauses, steps or resumes a strategy task
+func (r *TaskManager) DebugTask(id uuid.UUID, action string, steps uint64) (*DebugStatus, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	for i := range r.tasks {
+		if !r.tasks[i].MatchesID(id) {
+			continue
+		}
+		return r.tasks[i].Debug(action, steps)
+	}
+	return nil, fmt.Errorf("%s %w", id, errTaskNotFound)
+}
+
+// SetTaskBreakpoint adds a breakpoint to a strategy task
+func (r *TaskManager) SetTaskBreakpoint(id uuid.UUID, b Breakpoint) (*DebugStatus, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	for i := range r.tasks {
+		if !r.tasks[i].MatchesID(id) {
+			continue
+		}
+		return r.tasks[i].SetBreakpoint(b)
+	}
+	return nil, fmt.Errorf("%s %w", id, errTaskNotFound)
+}
+
+// ClearTaskBreakpoints removes a breakpoint from a strategy task.
+// A breakpointID of zero removes all breakpoints
+func (r *TaskManager) ClearTaskBreakpoints(id uuid.UUID, breakpointID uint64) (*DebugStatus, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	for i := range r.tasks {
+		if !r.tasks[i].MatchesID(id) {
+			continue
+		}
+		return r.tasks[i].ClearBreakpoints(breakpointID)
+	}
+	return nil, fmt.Errorf("%s %w", id, errTaskNotFound)
+}
+
+// InspectTask returns the state of a paused strategy task
+func (r *TaskManager) InspectTask(id uuid.UUID, historyLimit int64) (*TaskInspection, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	r.m.Lock()
+	defer r.m.Unlock()
+	for i := range r.tasks {
+		if !r.tasks[i].MatchesID(id) {
+			continue
+		}
+		return r.tasks[i].Inspect(historyLimit)
+	}
+	return nil, fmt.Errorf("%s %w", id, errTaskNotFound)
+}
+
 // StopTask stops a strategy task if enabled, this will run CloseAllPositions
 func (r *TaskManager) StopTask(id uuid.UUID) error {
 	if r == nil {
@@ -185,6 +284,8 @@ func (r *TaskManager) ClearTask(id uuid.UUID) error {
 		if r.tasks[i].IsRunning() {
 			return fmt.Errorf("%w %v, currently running. Stop it first", errCannotClear, r.tasks[i].MetaData.ID)
 		}
+		// tasks which were never started still hold their strategy
+		r.tasks[i].closeStrategy()
 		r.tasks = append(r.tasks[:i], r.tasks[i+1:]...)
 		return nil
 	}
@@ -208,6 +309,7 @@ func (r *TaskManager) ClearAllTasks() (clearedRuns, remainingRuns []*TaskSummary
 			remainingRuns = append(remainingRuns, run)
 		} else {
 			clearedRuns = append(clearedRuns, run)
+			r.tasks[i].closeStrategy()
 			r.tasks = append(r.tasks[:i], r.tasks[i+1:]...)
 			i--
 		}
@@ -330,8 +330,9 @@ func TestClearRun(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", err, errTaskNotFound)
 	}
 
+	strat := &fakeClosingStrat{}
 	bt := &BackTest{
-		Strategy:   &binancecashandcarry.Strategy{},
+		Strategy:   strat,
 		EventQueue: &eventholder.Holder{},
 		DataHolder: &data.HandlerHolder{},
 		Statistic:  &statistics.Statistic{},
@@ -357,6 +358,9 @@ func TestClearRun(t *testing.T) {
 	if !errors.Is(err, nil) {
 		t.Errorf("received '%v' expected '%v'", err, nil)
 	}
+	if strat.closed != 1 {
+		t.Errorf("received '%v' expected '%v'", strat.closed, 1)
+	}
 	list, err := rm.List()
 	if !errors.Is(err, nil) {
 		t.Errorf("received '%v' expected '%v'", err, nil)
@@ -440,3 +444,132 @@ func TestClearAllRuns(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
 	}
 }
+
+func TestTaskManagerGetProgress(t *testing.T) {
+	t.Parallel()
+	rm := NewTaskManager()
+	id, err := uuid.NewV4()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = rm.GetProgress(id, 0, false)
+	if !errors.Is(err, errTaskNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errTaskNotFound)
+	}
+
+	bt := &BackTest{
+		Strategy:   &binancecashandcarry.Strategy{},
+		Statistic:  &statistics.Statistic{},
+		DataHolder: &data.HandlerHolder{},
+	}
+	err = rm.AddTask(bt)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	progress, err := rm.GetProgress(bt.MetaData.ID, 0, false)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if progress.MetaData.ID != bt.MetaData.ID {
+		t.Errorf("received '%v' expected '%v'", progress.MetaData.ID, bt.MetaData.ID)
+	}
+
+	_, err = rm.SubscribeProgress(id)
+	if !errors.Is(err, errTaskNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errTaskNotFound)
+	}
+	unsubscribe, err := rm.SubscribeProgress(bt.MetaData.ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !bt.progress.hasSubscribers() {
+		t.Error("expected a progress subscriber")
+	}
+	unsubscribe()
+
+	rm = nil
+	_, err = rm.GetProgress(id, 0, false)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = rm.SubscribeProgress(id)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+}
+
+func TestTaskManagerDebugging(t *testing.T) {
+	t.Parallel()
+	rm := NewTaskManager()
+	id, err := uuid.NewV4()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = rm.DebugTask(id, DebugPause, 0)
+	if !errors.Is(err, errTaskNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errTaskNotFound)
+	}
+	_, err = rm.SetTaskBreakpoint(id, Breakpoint{})
+	if !errors.Is(err, errTaskNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errTaskNotFound)
+	}
+	_, err = rm.ClearTaskBreakpoints(id, 0)
+	if !errors.Is(err, errTaskNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errTaskNotFound)
+	}
+	_, err = rm.InspectTask(id, 0)
+	if !errors.Is(err, errTaskNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errTaskNotFound)
+	}
+
+	bt := &BackTest{
+		Strategy:   &binancecashandcarry.Strategy{},
+		Statistic:  &statistics.Statistic{},
+		DataHolder: &data.HandlerHolder{},
+		EventQueue: &eventholder.Holder{},
+	}
+	err = rm.AddTask(bt)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = rm.DebugTask(bt.MetaData.ID, DebugPause, 0)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	status, err := rm.SetTaskBreakpoint(bt.MetaData.ID, Breakpoint{Type: BreakpointEvent, EventNumber: 2})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(status.Breakpoints) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(status.Breakpoints), 1)
+	}
+	status, err = rm.ClearTaskBreakpoints(bt.MetaData.ID, 0)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(status.Breakpoints) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(status.Breakpoints), 0)
+	}
+	_, err = rm.InspectTask(bt.MetaData.ID, 0)
+	if !errors.Is(err, errTaskNotPaused) {
+		t.Errorf("received '%v' expected '%v'", err, errTaskNotPaused)
+	}
+
+	rm = nil
+	_, err = rm.DebugTask(id, DebugPause, 0)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = rm.SetTaskBreakpoint(id, Breakpoint{})
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = rm.ClearTaskBreakpoints(id, 0)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = rm.InspectTask(id, 0)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+}
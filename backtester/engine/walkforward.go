@@ -0,0 +1,195 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/report"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	errWalkForwardSettingsUnset = errors.New("walk-forward settings unset")
+	errNoEquityCurve            = errors.New("no equity curve to stitch")
+	errWalkForwardWindowFailed  = errors.New("walk-forward window failed")
+)
+
+// RunWalkForward splits the config's date range into rolling windows.
+// Parameters are optimised over each in-sample window and the best parameters
+// are run over the following out-of-sample window. The out-of-sample equity
+// curves are stitched together to measure how the strategy performs on data
+// it was not optimised against. A failed window fails the analysis, as
+// stitching around it would hide the gap from the equity curve and metrics
+func (r *TaskManager) RunWalkForward(cfg *config.Config, btCfg *config.BacktesterConfig) (*report.WalkForwardResults, error) {
+	if r == nil {
+		return nil, fmt.Errorf("%w TaskManager", gctcommon.ErrNilPointer)
+	}
+	if cfg == nil {
+		return nil, fmt.Errorf("%w strategy config", gctcommon.ErrNilPointer)
+	}
+	if btCfg == nil {
+		return nil, fmt.Errorf("%w backtester config", gctcommon.ErrNilPointer)
+	}
+	if cfg.OptimisationSettings == nil || cfg.OptimisationSettings.WalkForward == nil {
+		return nil, errWalkForwardSettingsUnset
+	}
+	windows, err := cfg.GenerateWalkForwardWindows()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err = sharedSeedConfig(cfg, "walk-forward")
+	if err != nil {
+		return nil, err
+	}
+	results := &report.WalkForwardResults{
+		Method:     cfg.OptimisationSettings.Method,
+		Metric:     cfg.OptimisationSettings.Metric,
+		Parameters: parameterNames(cfg.OptimisationSettings),
+		Windows:    make([]report.WalkForwardWindow, len(windows)),
+	}
+	for i := range windows {
+		results.Windows[i].InSampleStart = windows[i].InSampleStart
+		results.Windows[i].InSampleEnd = windows[i].InSampleEnd
+		results.Windows[i].OutOfSampleStart = windows[i].OutOfSampleStart
+		results.Windows[i].OutOfSampleEnd = windows[i].OutOfSampleEnd
+	}
+	taskCfg := taskBacktesterConfig(btCfg)
+	var last *BackTest
+	curves := make([][]statistics.ValueAtTime, 0, len(windows))
+	for i := range windows {
+		log.Infof(common.Backtester, "Running walk-forward window %v of %v, in-sample %v to %v, out-of-sample %v to %v",
+			i+1, len(windows), windows[i].InSampleStart, windows[i].InSampleEnd, windows[i].OutOfSampleStart, windows[i].OutOfSampleEnd)
+		window := &results.Windows[i]
+		bt, curve, err := r.runWalkForwardWindow(cfg, taskCfg, window, i)
+		if err != nil {
+			window.OutOfSample = nil
+			window.Error = err.Error()
+			return results, fmt.Errorf("%w %v of %v: %w", errWalkForwardWindowFailed, i+1, len(windows), err)
+		}
+		last = bt
+		curves = append(curves, curve)
+	}
+	results.EquityCurve, err = stitchEquityCurves(curves)
+	if err != nil {
+		return results, err
+	}
+	first := results.EquityCurve[0].Value
+	if !first.IsZero() {
+		results.TotalReturn = results.EquityCurve[len(results.EquityCurve)-1].Value.Sub(first).Div(first).Mul(decimal.NewFromInt(100))
+	}
+	drawdown, err := statistics.CalculateBiggestValueAtTimeDrawdown(results.EquityCurve, cfg.DataSettings.Interval)
+	if err != nil {
+		return results, err
+	}
+	results.MaxDrawdown = drawdown.DrawdownPercent
+	log.Infof(common.Backtester, "Walk-forward out-of-sample total return %v%%, max drawdown %v%%", results.TotalReturn.Round(2), results.MaxDrawdown.Round(2))
+	if !btCfg.Report.GenerateReport && len(btCfg.Report.ExportFormats) == 0 {
+		return results, nil
+	}
+	d, err := prepareReport(last, btCfg)
+	if err != nil {
+		return results, err
+	}
+	d.WalkForward = results
+	return results, d.GenerateReport()
+}
+
+// runWalkForwardWindow optimises the in-sample range of a window, then runs
+// the best parameters over its out-of-sample range
+func (r *TaskManager) runWalkForwardWindow(cfg *config.Config, btCfg *config.BacktesterConfig, window *report.WalkForwardWindow, idx int) (*BackTest, []statistics.ValueAtTime, error) {
+	inSampleCfg, err := copyConfig(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	inSampleCfg.Nickname = fmt.Sprintf("%v-in-sample-%v", cfg.Nickname, idx+1)
+	err = setDateRange(inSampleCfg, window.InSampleStart, window.InSampleEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+	optimisation, inSample, err := r.optimise(inSampleCfg, btCfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	// only the parameters of the best in-sample run are needed
+	r.clearTask(inSample)
+	window.Parameters = optimisation.Runs[0].Parameters
+	window.InSampleScore = optimisation.Runs[0].Score
+
+	set := make(map[string]interface{}, len(optimisation.Parameters))
+	for i := range optimisation.Parameters {
+		set[optimisation.Parameters[i]] = window.Parameters[i]
+	}
+	outOfSampleCfg, err := taskConfig(cfg, set, fmt.Sprintf("%v-walk-forward-%v", cfg.Nickname, idx+1))
+	if err != nil {
+		return nil, nil, err
+	}
+	err = setDateRange(outOfSampleCfg, window.OutOfSampleStart, window.OutOfSampleEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+	bt, summary, err := r.runTask(outOfSampleCfg, btCfg, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	window.OutOfSample = summary
+	window.OutOfSampleScore, err = optimisationScore(summary, cfg.OptimisationSettings.Metric)
+	if err != nil {
+		return nil, nil, err
+	}
+	curve, err := bt.Statistic.GetEquityCurve()
+	if err != nil {
+		return nil, nil, err
+	}
+	return bt, curve, nil
+}
+
+// setDateRange sets the API or database date range of the config.
+// The end date is exclusive
+func setDateRange(cfg *config.Config, start, end time.Time) error {
+	switch {
+	case cfg.DataSettings.APIData != nil:
+		cfg.DataSettings.APIData.StartDate = start
+		cfg.DataSettings.APIData.EndDate = end
+		cfg.DataSettings.APIData.InclusiveEndDate = false
+	case cfg.DataSettings.DatabaseData != nil:
+		cfg.DataSettings.DatabaseData.StartDate = start
+		cfg.DataSettings.DatabaseData.EndDate = end
+		cfg.DataSettings.DatabaseData.InclusiveEndDate = false
+	default:
+		return fmt.Errorf("%w API or database data settings", gctcommon.ErrNilPointer)
+	}
+	return nil
+}
+
+// stitchEquityCurves joins equity curves end to end. Each curve is scaled so
+// that it starts at the value the previous curve finished at, compounding the
+// returns of each window. An empty curve would leave a gap, so it errors
+func stitchEquityCurves(curves [][]statistics.ValueAtTime) ([]statistics.ValueAtTime, error) {
+	var resp []statistics.ValueAtTime
+	for i := range curves {
+		if len(curves[i]) == 0 {
+			return nil, fmt.Errorf("%w for window %v", errNoEquityCurve, i+1)
+		}
+		scale := decimal.NewFromInt(1)
+		if len(resp) > 0 && !curves[i][0].Value.IsZero() {
+			scale = resp[len(resp)-1].Value.Div(curves[i][0].Value)
+		}
+		for j := range curves[i] {
+			resp = append(resp, statistics.ValueAtTime{
+				Time:  curves[i][j].Time,
+				Value: curves[i][j].Value.Mul(scale),
+				Set:   true,
+			})
+		}
+	}
+	if len(resp) == 0 {
+		return nil, errNoEquityCurve
+	}
+	return resp, nil
+}
@@ -0,0 +1,149 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func TestRunWalkForward(t *testing.T) {
+	t.Parallel()
+	var r *TaskManager
+	_, err := r.RunWalkForward(nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	r = NewTaskManager()
+	_, err = r.RunWalkForward(nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = r.RunWalkForward(&config.Config{}, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = r.RunWalkForward(&config.Config{}, &config.BacktesterConfig{})
+	if !errors.Is(err, errWalkForwardSettingsUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errWalkForwardSettingsUnset)
+	}
+
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cfg := &config.Config{
+		StrategySettings: config.StrategySettings{Name: "rsi"},
+		DataSettings: config.DataSettings{
+			Interval: gctkline.OneDay,
+			APIData: &config.APIData{
+				StartDate: start,
+				EndDate:   start.Add(gctkline.OneDay.Duration() * 6),
+			},
+		},
+		OptimisationSettings: &config.OptimisationSettings{
+			Method: config.GridSearch,
+			Metric: config.SharpeRatio,
+			Parameters: []config.OptimisationParameter{
+				{Name: "rsi-period", Values: []interface{}{7.0, 14.0}},
+			},
+			WalkForward: &config.WalkForwardSettings{
+				InSamplePeriods:    2,
+				OutOfSamplePeriods: 2,
+			},
+		},
+	}
+	results, err := r.RunWalkForward(cfg, &config.BacktesterConfig{})
+	if !errors.Is(err, errWalkForwardWindowFailed) {
+		t.Errorf("received '%v' expected '%v'", err, errWalkForwardWindowFailed)
+	}
+	if len(results.Windows) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(results.Windows), 2)
+	}
+	if results.Windows[0].Error == "" {
+		t.Error("expected invalid config to fail the first window")
+	}
+	if results.Windows[1].Error != "" {
+		t.Errorf("received '%v' expected the analysis to stop at the first failed window", results.Windows[1].Error)
+	}
+	if results.EquityCurve != nil {
+		t.Errorf("received '%v' expected no stitched equity curve", results.EquityCurve)
+	}
+	if !results.Windows[1].OutOfSampleEnd.Equal(start.Add(gctkline.OneDay.Duration() * 6)) {
+		t.Errorf("received '%v' expected '%v'", results.Windows[1].OutOfSampleEnd, start.Add(gctkline.OneDay.Duration()*6))
+	}
+}
+
+func TestSetDateRange(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(time.Hour)
+	cfg := &config.Config{}
+	err := setDateRange(cfg, start, end)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	cfg.DataSettings.DatabaseData = &config.DatabaseData{InclusiveEndDate: true}
+	err = setDateRange(cfg, start, end)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !cfg.DataSettings.DatabaseData.StartDate.Equal(start) ||
+		!cfg.DataSettings.DatabaseData.EndDate.Equal(end) ||
+		cfg.DataSettings.DatabaseData.InclusiveEndDate {
+		t.Errorf("received '%+v' expected exclusive range", cfg.DataSettings.DatabaseData)
+	}
+
+	cfg.DataSettings.APIData = &config.APIData{InclusiveEndDate: true}
+	err = setDateRange(cfg, start, end)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !cfg.DataSettings.APIData.StartDate.Equal(start) ||
+		!cfg.DataSettings.APIData.EndDate.Equal(end) ||
+		cfg.DataSettings.APIData.InclusiveEndDate {
+		t.Errorf("received '%+v' expected exclusive range", cfg.DataSettings.APIData)
+	}
+}
+
+func TestStitchEquityCurves(t *testing.T) {
+	t.Parallel()
+	_, err := stitchEquityCurves(nil)
+	if !errors.Is(err, errNoEquityCurve) {
+		t.Errorf("received '%v' expected '%v'", err, errNoEquityCurve)
+	}
+
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	first := []statistics.ValueAtTime{
+		{Time: tt, Value: decimal.NewFromInt(100)},
+		{Time: tt.Add(time.Hour), Value: decimal.NewFromInt(110)},
+	}
+	_, err = stitchEquityCurves([][]statistics.ValueAtTime{first, nil})
+	if !errors.Is(err, errNoEquityCurve) {
+		t.Errorf("received '%v' expected '%v'", err, errNoEquityCurve)
+	}
+
+	curve, err := stitchEquityCurves([][]statistics.ValueAtTime{
+		first,
+		{
+			{Time: tt.Add(time.Hour * 2), Value: decimal.NewFromInt(100)},
+			{Time: tt.Add(time.Hour * 3), Value: decimal.NewFromInt(120)},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(curve) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(curve), 4)
+	}
+	// the second curve's 20% gain compounds on the first curve's final value
+	if !curve[2].Value.Equal(decimal.NewFromInt(110)) {
+		t.Errorf("received '%v' expected '%v'", curve[2].Value, 110)
+	}
+	if !curve[3].Value.Equal(decimal.NewFromInt(132)) {
+		t.Errorf("received '%v' expected '%v'", curve[3].Value, 132)
+	}
+}
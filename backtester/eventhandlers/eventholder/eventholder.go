@@ -30,3 +30,11 @@ func (h *Holder) NextEvent() (i common.Event) {
 
 	return i
 }
+
+// PeekEvents returns a copy of the events waiting in the queue
+// without removing them
+func (h *Holder) PeekEvents() []common.Event {
+	resp := make([]common.Event, len(h.Queue))
+	copy(resp, h.Queue)
+	return resp
+}
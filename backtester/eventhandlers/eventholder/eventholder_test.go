@@ -56,3 +56,21 @@ func TestNextEvent(t *testing.T) {
 		t.Error("expected 2")
 	}
 }
+
+func TestPeekEvents(t *testing.T) {
+	t.Parallel()
+	e := Holder{}
+	if evs := e.PeekEvents(); len(evs) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(evs), 0)
+	}
+	e.AppendEvent(&order.Order{})
+	e.AppendEvent(&order.Order{})
+	evs := e.PeekEvents()
+	if len(evs) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(evs), 2)
+	}
+	evs[0] = nil
+	if len(e.Queue) != 2 || e.Queue[0] == nil {
+		t.Error("expected queue to be unchanged")
+	}
+}
@@ -14,4 +14,5 @@ type EventHolder interface {
 	Reset() error
 	AppendEvent(common.Event)
 	NextEvent() common.Event
+	PeekEvents() []common.Event
 }
@@ -3,6 +3,7 @@ package exchange
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"strings"
 	"time"
 
@@ -13,12 +14,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange/slippage"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 // Reset returns the exchange to initial settings
@@ -27,9 +30,19 @@ func (e *Exchange) Reset() error {
 		return gctcommon.ErrNilPointer
 	}
 	e.CurrencySettings = nil
+	e.restingOrders = nil
+	e.inFlightOrders = nil
+	e.closedOrders = nil
+	e.rng = nil
 	return nil
 }
 
+// SetRandomSeed seeds the random slippage applied to orders so that
+// runs using the same seed produce the same results
+func (e *Exchange) SetRandomSeed(seed int64) {
+	e.rng = rand.New(rand.NewSource(seed)) //nolint:gosec // not used for security purposes
+}
+
 // ExecuteOrder assesses the portfolio manager's order event and if it passes validation
 // will send an order to the exchange/fake order manager to be stored and raise a fill event
 func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.OrderManager, funds funding.IFundReleaser) (fill.Event, error) {
@@ -40,23 +53,36 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 		ClosePrice:         o.GetClosePrice(),
 		FillDependentEvent: o.GetFillDependentEvent(),
 		Liquidated:         o.IsLiquidating(),
+		ExitRule:           o.GetExitRule(),
 	}
 	if !common.CanTransact(o.GetDirection()) {
 		return f, fmt.Errorf("%w order direction %v", ErrCannotTransact, o.GetDirection())
 	}
 
-	allocatedFunds := o.GetAllocatedFunds()
 	cs, err := e.GetCurrencySettings(o.GetExchange(), o.GetAssetType(), o.Pair())
 	if err != nil {
 		return f, err
 	}
+	if cs.ExecutionConditions != nil && cs.ExecutionConditions.applies(o, &cs) {
+		return e.submitOrder(o, f, dh, om, funds, &cs)
+	}
+	if isRestingOrderType(o.GetOrderType()) {
+		return f, e.placeRestingOrder(o, f, funds, &cs)
+	}
+	return e.executeOrder(o, f, o.GetClosePrice(), false, dh, om, funds, &cs)
+}
+
+// executeOrder fills the order at the provided price. Maker orders have
+// their price guaranteed, so are not subject to slippage and use the maker fee
+func (e *Exchange) executeOrder(o order.Event, f *fill.Fill, price decimal.Decimal, isMaker bool, dh data.Handler, om *engine.OrderManager, funds funding.IFundReleaser, cs *Settings) (fill.Event, error) {
+	var err error
+	allocatedFunds := o.GetAllocatedFunds()
 	f.Direction = o.GetDirection()
 
-	var price, adjustedPrice,
+	var adjustedPrice,
 		amount, adjustedAmount,
 		fee decimal.Decimal
 	amount = o.GetAmount()
-	price = o.GetClosePrice()
 	if cs.UseRealOrders {
 		if o.IsLiquidating() {
 			// Liquidation occurs serverside
@@ -80,36 +106,58 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 			return f, nil
 		}
 	} else {
-		slippageRate := slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate)
-		if cs.SkipCandleVolumeFitting || o.GetAssetType().IsFutures() || o.GetDirection() == gctorder.ClosePosition {
-			f.VolumeAdjustedPrice = f.ClosePrice
-			amount = f.Amount
-		} else {
+		var book *gctorderbook.Base
+		if !isMaker && o.GetDirection() != gctorder.ClosePosition {
 			var latest data.Event
 			latest, err = dh.Latest()
 			if err != nil {
 				return nil, err
 			}
-			adjustedPrice, adjustedAmount = ensureOrderFitsWithinHLV(price, amount, latest.GetHighPrice(), latest.GetLowPrice(), latest.GetVolume())
-			if !amount.Equal(adjustedAmount) {
-				f.AppendReasonf("Order size shrunk from %v to %v to fit candle", amount, adjustedAmount)
-				amount = adjustedAmount
+			if ob, ok := latest.(orderbook.Event); ok {
+				book = ob.GetOrderbook()
+			}
+		}
+		if book != nil {
+			price, amount, err = fillFromOrderbook(f, book, price, amount)
+			if err != nil {
+				return f, err
+			}
+			adjustedPrice = price
+		} else {
+			slippageRate := decimal.NewFromInt(1)
+			if !isMaker {
+				slippageRate = slippage.EstimateSlippagePercentage(cs.MinimumSlippageRate, cs.MaximumSlippageRate, e.rng)
+			}
+			if cs.SkipCandleVolumeFitting || o.GetAssetType().IsFutures() || o.GetDirection() == gctorder.ClosePosition {
+				f.VolumeAdjustedPrice = f.ClosePrice
+				amount = f.Amount
+			} else {
+				var latest data.Event
+				latest, err = dh.Latest()
+				if err != nil {
+					return nil, err
+				}
+				adjustedPrice, adjustedAmount = ensureOrderFitsWithinHLV(price, amount, latest.GetHighPrice(), latest.GetLowPrice(), latest.GetVolume())
+				if !amount.Equal(adjustedAmount) {
+					f.AppendReasonf("Order size shrunk from %v to %v to fit candle", amount, adjustedAmount)
+					amount = adjustedAmount
+				}
+				if !adjustedPrice.Equal(price) {
+					f.AppendReasonf("Price adjusted fitting to candle from %v to %v", price, adjustedPrice)
+					price = adjustedPrice
+					f.VolumeAdjustedPrice = price
+				}
+			}
+			adjustedPrice, err = applySlippageToPrice(f.GetDirection(), price, slippageRate)
+			if err != nil {
+				return f, err
 			}
 			if !adjustedPrice.Equal(price) {
-				f.AppendReasonf("Price adjusted fitting to candle from %v to %v", price, adjustedPrice)
+				f.AppendReasonf("Price has slipped from %v to %v", price, adjustedPrice)
 				price = adjustedPrice
-				f.VolumeAdjustedPrice = price
 			}
+			f.Slippage = slippageRate.Mul(decimal.NewFromInt(100)).Sub(decimal.NewFromInt(100))
 		}
-		adjustedPrice, err = applySlippageToPrice(f.GetDirection(), price, slippageRate)
-		if err != nil {
-			return f, err
-		}
-		if !adjustedPrice.Equal(price) {
-			f.AppendReasonf("Price has slipped from %v to %v", price, adjustedPrice)
-			price = adjustedPrice
-		}
-		f.Slippage = slippageRate.Mul(decimal.NewFromInt(100)).Sub(decimal.NewFromInt(100))
 	}
 
 	adjustedAmount = reduceAmountToFitPortfolioLimit(adjustedPrice, amount, allocatedFunds, f.GetDirection())
@@ -129,12 +177,16 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 			amount = adjustedAmount
 		}
 	}
-	err = verifyOrderWithinLimits(f, amount, &cs)
+	err = verifyOrderWithinLimits(f, amount, cs)
 	if err != nil {
 		return f, err
 	}
 
-	fee = calculateExchangeFee(price, amount, cs.TakerFee)
+	feeRate := cs.TakerFee
+	if isMaker {
+		feeRate = cs.MakerFee
+	}
+	fee = calculateExchangeFee(price, amount, feeRate)
 
 	orderID, err := e.placeOrder(context.TODO(), price, amount, fee, cs.UseRealOrders, cs.CanUseExchangeLimits, f, om)
 	if err != nil {
@@ -171,6 +223,29 @@ func (e *Exchange) ExecuteOrder(o order.Event, dh data.Handler, om *engine.Order
 	return f, nil
 }
 
+// fillFromOrderbook fills the amount by walking the levels of the orderbook,
+// using the market impact of the order in place of estimated slippage
+func fillFromOrderbook(f *fill.Fill, book *gctorderbook.Base, price, amount decimal.Decimal) (adjustedPrice, adjustedAmount decimal.Decimal, err error) {
+	adjustedPrice, adjustedAmount, err = slippage.WalkOrderbook(book, f.GetDirection(), amount)
+	if err != nil {
+		return price, amount, err
+	}
+	if !adjustedAmount.Equal(amount) {
+		f.AppendReasonf("Order size shrunk from %v to %v to fit orderbook liquidity", amount, adjustedAmount)
+	}
+	if !adjustedPrice.Equal(price) {
+		f.AppendReasonf("Price has slipped from %v to %v walking the orderbook", price, adjustedPrice)
+	}
+	if !price.IsZero() {
+		// slippage is negative when the fill is worse than the price
+		f.Slippage = adjustedPrice.Sub(price).Div(price).Mul(decimal.NewFromInt(100))
+		if f.GetDirection() == gctorder.Buy || f.GetDirection() == gctorder.Bid || f.GetDirection() == gctorder.Long {
+			f.Slippage = f.Slippage.Neg()
+		}
+	}
+	return adjustedPrice, adjustedAmount, nil
+}
+
 func allocateFundsPostOrder(f *fill.Fill, funds funding.IFundReleaser, orderError error, orderAmount, allocatedFunds, limitReducedAmount, adjustedPrice, fee decimal.Decimal) error {
 	if f == nil {
 		return fmt.Errorf("%w: fill event", common.ErrNilEvent)
@@ -26,6 +26,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 const testExchange = "binance"
@@ -120,6 +121,20 @@ func TestReset(t *testing.T) {
 	}
 }
 
+func TestSetRandomSeed(t *testing.T) {
+	t.Parallel()
+	e := &Exchange{}
+	e.SetRandomSeed(1337)
+	if e.rng == nil {
+		t.Fatal("expected random source")
+	}
+	e2 := &Exchange{}
+	e2.SetRandomSeed(1337)
+	if first, second := e.rng.Int63(), e2.rng.Int63(); first != second {
+		t.Errorf("received '%v' expected '%v'", second, first)
+	}
+}
+
 func TestSetCurrency(t *testing.T) {
 	t.Parallel()
 	e := Exchange{}
@@ -568,6 +583,137 @@ func TestApplySlippageToPrice(t *testing.T) {
 	}
 }
 
+func TestFillFromOrderbook(t *testing.T) {
+	t.Parallel()
+	book := &gctorderbook.Base{
+		Bids: gctorderbook.Items{{Price: 99, Amount: 1}, {Price: 98, Amount: 1}},
+		Asks: gctorderbook.Items{{Price: 101, Amount: 1}, {Price: 102, Amount: 1}},
+	}
+	f := &fill.Fill{Base: &event.Base{}, Direction: gctorder.Buy}
+	price, amount, err := fillFromOrderbook(f, book, decimal.NewFromInt(100), decimal.NewFromInt(2))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !price.Equal(decimal.NewFromFloat(101.5)) || !amount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' '%v' expected '101.5' '2'", price, amount)
+	}
+	if !f.Slippage.Equal(decimal.NewFromFloat(-1.5)) {
+		t.Errorf("received '%v' expected '%v'", f.Slippage, -1.5)
+	}
+
+	f = &fill.Fill{Base: &event.Base{}, Direction: gctorder.Sell}
+	price, amount, err = fillFromOrderbook(f, book, decimal.NewFromInt(100), decimal.NewFromInt(3))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !price.Equal(decimal.NewFromFloat(98.5)) || !amount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' '%v' expected '98.5' '2'", price, amount)
+	}
+	if !f.Slippage.Equal(decimal.NewFromFloat(-1.5)) {
+		t.Errorf("received '%v' expected '%v'", f.Slippage, -1.5)
+	}
+	if !strings.Contains(f.GetConcatReasons(), "to fit orderbook liquidity") {
+		t.Errorf("received '%v' expected the order size to shrink", f.GetConcatReasons())
+	}
+
+	_, _, err = fillFromOrderbook(f, &gctorderbook.Base{}, decimal.NewFromInt(100), decimal.NewFromInt(1))
+	if err == nil {
+		t.Error("expected an error filling from an empty orderbook")
+	}
+}
+
+func TestExecuteOrderFromOrderbook(t *testing.T) {
+	t.Parallel()
+	em := engine.NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	exch.GetBase().States = currencystate.NewCurrencyStates()
+	err = em.Add(exch)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	bot := &engine.Engine{ExchangeManager: em}
+	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, &bot.ServicesWG, false, false, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = bot.OrderManager.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	f := &binance.Binance{}
+	f.Name = testExchange
+	e := Exchange{}
+	e.SetExchangeAssetCurrencySettings(asset.Spot, p, &Settings{
+		Exchange: f,
+		Pair:     p,
+		Asset:    asset.Spot,
+		// estimated slippage must not be applied to orderbook fills
+		MinimumSlippageRate: decimal.NewFromInt(50),
+		MaximumSlippageRate: decimal.NewFromInt(50),
+	})
+	tt := time.Now().Truncate(time.Hour)
+	d := kline.NewDataFromKline()
+	d.Item = &gctkline.Item{
+		Exchange: testExchange,
+		Pair:     p,
+		Asset:    asset.Spot,
+		Interval: gctkline.OneHour,
+	}
+	err = d.ReplayOrderbook([]kline.OrderbookUpdate{
+		{
+			Time:     tt,
+			Snapshot: true,
+			Bids:     gctorderbook.Items{{Price: 99, Amount: 1}},
+			Asks:     gctorderbook.Items{{Price: 101, Amount: 1}, {Price: 103, Amount: 1}},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	o := &order.Order{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			Time:         tt,
+			Interval:     gctkline.OneHour,
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+		},
+		Direction:      gctorder.Buy,
+		Amount:         decimal.NewFromInt(2),
+		AllocatedFunds: decimal.NewFromInt(1000),
+		ClosePrice:     decimal.NewFromInt(100),
+	}
+	ev, err := e.ExecuteOrder(o, d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// 1 at 101 and 1 at 103
+	if !ev.GetPurchasePrice().Equal(decimal.NewFromInt(102)) {
+		t.Errorf("received '%v' expected '%v'", ev.GetPurchasePrice(), 102)
+	}
+	if !ev.GetAmount().Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", ev.GetAmount(), 2)
+	}
+	if !ev.GetSlippageRate().Equal(decimal.NewFromInt(-2)) {
+		t.Errorf("received '%v' expected '%v'", ev.GetSlippageRate(), -2)
+	}
+}
+
 func TestReduceAmountToFitPortfolioLimit(t *testing.T) {
 	t.Parallel()
 	initialPrice := decimal.NewFromInt(100)
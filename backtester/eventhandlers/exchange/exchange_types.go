@@ -2,8 +2,11 @@ package exchange
 
 import (
 	"errors"
+	"math/rand"
+	"time"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
@@ -23,6 +26,24 @@ var (
 	errNilCurrencySettings     = errors.New("received nil currency settings")
 	errInvalidDirection        = errors.New("received invalid order direction")
 	errNoCurrencySettingsFound = errors.New("no currency settings found")
+	errUnsupportedOrderType    = errors.New("unsupported order type")
+	errInvalidRestingOrder     = errors.New("invalid resting order")
+	errExchangeOutage          = errors.New("exchange outage")
+	errOrderRejected           = errors.New("order rejected by exchange")
+)
+
+// LatencyDistribution determines how order latency is sampled
+type LatencyDistribution uint8
+
+// Latency distributions
+const (
+	// FixedLatency delays every order by the mean latency
+	FixedLatency LatencyDistribution = iota
+	// UniformLatency samples latency between the minimum and maximum
+	UniformLatency
+	// NormalLatency samples latency around the mean, bounded
+	// by the minimum and maximum when set
+	NormalLatency
 )
 
 // ExecutionHandler interface dictates what functions are required to submit an order
@@ -30,12 +51,46 @@ type ExecutionHandler interface {
 	SetExchangeAssetCurrencySettings(asset.Item, currency.Pair, *Settings)
 	GetCurrencySettings(string, asset.Item, currency.Pair) (Settings, error)
 	ExecuteOrder(order.Event, data.Handler, *engine.OrderManager, funding.IFundReleaser) (fill.Event, error)
+	ProcessRestingOrders(data.Handler, *engine.OrderManager, funding.IFundReleaser) ([]fill.Event, error)
+	CancelRestingOrders(common.Event, []string, funding.IFundReleaser) error
+	GetUnfilledOrders() []RestingOrder
 	Reset() error
 }
 
 // Exchange contains all the currency settings
 type Exchange struct {
 	CurrencySettings []Settings
+	restingOrders    []*RestingOrder
+	inFlightOrders   []*RestingOrder
+	closedOrders     []RestingOrder
+	// rng generates slippage so that runs with the same seed
+	// are reproducible
+	rng *rand.Rand
+}
+
+// RestingOrder is a limit, stop or stop-limit order which has been placed
+// and waits for a subsequent candle to cross its price before being filled.
+// Orders delayed by latency are also tracked as resting orders until they
+// arrive at the exchange
+type RestingOrder struct {
+	ID            string          `json:"id"`
+	ClientOrderID string          `json:"client-order-id"`
+	Exchange      string          `json:"exchange"`
+	Asset         asset.Item      `json:"asset"`
+	Pair          currency.Pair   `json:"pair"`
+	Type          gctorder.Type   `json:"type"`
+	Side          gctorder.Side   `json:"side"`
+	Status        gctorder.Status `json:"status"`
+	Amount        decimal.Decimal `json:"amount"`
+	LimitPrice    decimal.Decimal `json:"limit-price"`
+	TriggerPrice  decimal.Decimal `json:"trigger-price"`
+	GoodTillTime  time.Time       `json:"good-till-time"`
+	Triggered     bool            `json:"triggered"`
+	Placed        time.Time       `json:"placed"`
+	Arrival       time.Time       `json:"arrival"`
+	Closed        time.Time       `json:"closed"`
+	Reason        string          `json:"reason,omitempty"`
+	orderEvent    order.Event
 }
 
 // Settings allow the eventhandler to size an order within the limitations set by the config file
@@ -62,6 +117,37 @@ type Settings struct {
 	SkipCandleVolumeFitting bool
 
 	UseExchangePNLCalculation bool
+
+	IsPerpetual  bool
+	FundingRates []gctorder.FundingRate
+
+	OptimisticIntrabarFills bool
+
+	ExitRules ExitRules
+
+	// ExecutionConditions is shared by all settings of the same exchange
+	ExecutionConditions *ExecutionConditions
+}
+
+// ExecutionConditions simulate order latency, random rejections and
+// outages for an exchange. Rejection rate is a percentage, eg 5 is 5%
+type ExecutionConditions struct {
+	LatencyDistribution      LatencyDistribution
+	MinimumLatency           time.Duration
+	MaximumLatency           time.Duration
+	MeanLatency              time.Duration
+	LatencyStandardDeviation time.Duration
+	RejectionRate            decimal.Decimal
+	Outages                  []Outage
+	// Seed allows latency and rejections to be repeated. Zero uses a new seed each run
+	Seed int64
+	rng  *rand.Rand
+}
+
+// Outage is a period of time where an exchange cannot accept orders
+type Outage struct {
+	Start time.Time
+	End   time.Time
 }
 
 // MinMax are the rules which limit the placement of orders.
@@ -71,6 +157,20 @@ type MinMax struct {
 	MaximumTotal decimal.Decimal
 }
 
+// ExitRules are enforced by the portfolio manager to automatically close
+// open positions. Percentages are expressed as whole numbers, eg 5 is 5%.
+// Zero values disable a rule
+type ExitRules struct {
+	StopLossPercent         decimal.Decimal
+	TakeProfitPercent       decimal.Decimal
+	TrailingStopPercent     decimal.Decimal
+	ATRPeriod               int64
+	StopLossATRMultiple     decimal.Decimal
+	TakeProfitATRMultiple   decimal.Decimal
+	TrailingStopATRMultiple decimal.Decimal
+	MaximumHoldingPeriods   int64
+}
+
 // Leverage rules are used to allow or limit the use of leverage in orders
 // when supported
 type Leverage struct {
@@ -0,0 +1,203 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// applies determines whether the order is subject to execution conditions.
+// Like resting orders, only simulated spot orders are supported and
+// liquidations are never delayed or rejected
+func (c *ExecutionConditions) applies(o order.Event, cs *Settings) bool {
+	return !cs.UseRealOrders &&
+		o.GetAssetType() == asset.Spot &&
+		!o.IsLiquidating() &&
+		o.GetDirection() != gctorder.ClosePosition
+}
+
+func (c *ExecutionConditions) random() *rand.Rand {
+	if c.rng == nil {
+		seed := c.Seed
+		if seed == 0 {
+			seed = time.Now().UnixNano()
+		}
+		c.rng = rand.New(rand.NewSource(seed)) //nolint:gosec // not used for security purposes
+	}
+	return c.rng
+}
+
+// latency samples how long an order takes to arrive at the exchange
+func (c *ExecutionConditions) latency() time.Duration {
+	var l time.Duration
+	switch c.LatencyDistribution {
+	case FixedLatency:
+		return c.MeanLatency
+	case UniformLatency:
+		if c.MaximumLatency <= c.MinimumLatency {
+			return c.MinimumLatency
+		}
+		return c.MinimumLatency + time.Duration(c.random().Int63n(int64(c.MaximumLatency-c.MinimumLatency)+1))
+	case NormalLatency:
+		l = c.MeanLatency + time.Duration(c.random().NormFloat64()*float64(c.LatencyStandardDeviation))
+	}
+	if l < c.MinimumLatency {
+		l = c.MinimumLatency
+	}
+	if c.MaximumLatency > 0 && l > c.MaximumLatency {
+		l = c.MaximumLatency
+	}
+	return l
+}
+
+// assess returns an error when an order arriving at the time
+// falls within an outage or is randomly rejected
+func (c *ExecutionConditions) assess(t time.Time) error {
+	for i := range c.Outages {
+		if !t.Before(c.Outages[i].Start) && t.Before(c.Outages[i].End) {
+			return fmt.Errorf("%w between %v and %v", errExchangeOutage, c.Outages[i].Start, c.Outages[i].End)
+		}
+	}
+	if c.RejectionRate.IsPositive() &&
+		decimal.NewFromFloat(c.random().Float64()*100).LessThan(c.RejectionRate) {
+		return fmt.Errorf("%w at %v%% rejection rate", errOrderRejected, c.RejectionRate)
+	}
+	return nil
+}
+
+// submitOrder applies the exchange's execution conditions to an order.
+// Delayed orders are held in flight with their funds reserved until the
+// data reaches their arrival time. Orders without latency are assessed
+// for outages and rejections immediately
+func (e *Exchange) submitOrder(o order.Event, f *fill.Fill, dh data.Handler, om *engine.OrderManager, funds funding.IFundReleaser, cs *Settings) (fill.Event, error) {
+	isResting := isRestingOrderType(o.GetOrderType())
+	if isResting {
+		err := validateRestingOrder(o, cs)
+		if err != nil {
+			f.AppendReasonf("could not place %v order: %v", o.GetOrderType(), err)
+			return f, allocateFundsPostOrder(f, funds, err, o.GetAmount(), o.GetAllocatedFunds(), decimal.Zero, decimal.Zero, decimal.Zero)
+		}
+	}
+	latency := cs.ExecutionConditions.latency()
+	if latency <= 0 {
+		err := cs.ExecutionConditions.assess(o.GetTime())
+		if err != nil {
+			return f, e.rejectOrder(o, f, funds, err)
+		}
+		if isResting {
+			return f, e.placeRestingOrder(o, f, funds, cs)
+		}
+		return e.executeOrder(o, f, o.GetClosePrice(), false, dh, om, funds, cs)
+	}
+	ro, err := newRestingOrder(o, gctorder.Pending)
+	if err != nil {
+		return f, err
+	}
+	if !isResting {
+		ro.Type = gctorder.Market
+	}
+	ro.Arrival = o.GetTime().Add(latency)
+	e.inFlightOrders = append(e.inFlightOrders, ro)
+	f.AppendReasonf("Submitted %v %v order %v with %v latency, arriving at %v",
+		ro.Type,
+		ro.Side,
+		ro.ID,
+		latency,
+		ro.Arrival)
+	f.SetDirection(gctorder.DoNothing)
+	f.FillDependentEvent = nil
+	return f, nil
+}
+
+// rejectOrder releases the funds of an order which failed to reach the
+// exchange and records it as an unfilled order
+func (e *Exchange) rejectOrder(o order.Event, f *fill.Fill, funds funding.IFundReleaser, reason error) error {
+	f.AppendReasonf("order failed: %v", reason)
+	err := allocateFundsPostOrder(f, funds, reason, o.GetAmount(), o.GetAllocatedFunds(), decimal.Zero, decimal.Zero, decimal.Zero)
+	if !errors.Is(err, reason) {
+		return err
+	}
+	ro, err := newRestingOrder(o, gctorder.Rejected)
+	if err != nil {
+		return err
+	}
+	if !isRestingOrderType(ro.Type) {
+		ro.Type = gctorder.Market
+	}
+	ro.Closed = o.GetTime()
+	ro.Reason = reason.Error()
+	e.closedOrders = append(e.closedOrders, *ro)
+	return nil
+}
+
+// processInFlightOrders handles delayed orders which have arrived at the
+// exchange by the latest data event. Orders arriving during an outage or
+// which are randomly rejected have their funds released. Market orders
+// are filled at the latest close price and resting orders are placed to
+// be assessed against subsequent candles
+func (e *Exchange) processInFlightOrders(latest data.Event, dh data.Handler, om *engine.OrderManager, funds funding.IFundReleaser) ([]fill.Event, error) {
+	if len(e.inFlightOrders) == 0 {
+		return nil, nil
+	}
+	var fills []fill.Event
+	remaining := make([]*RestingOrder, 0, len(e.inFlightOrders))
+	for i := range e.inFlightOrders {
+		ro := e.inFlightOrders[i]
+		if !ro.matches(latest) || latest.GetTime().Before(ro.Arrival) {
+			remaining = append(remaining, ro)
+			continue
+		}
+		cs, err := e.GetCurrencySettings(ro.Exchange, ro.Asset, ro.Pair)
+		if err != nil {
+			// keep the order and those not yet processed so that
+			// processed orders are not processed again
+			e.inFlightOrders = append(remaining, e.inFlightOrders[i:]...)
+			return fills, err
+		}
+		if cs.ExecutionConditions != nil {
+			err = cs.ExecutionConditions.assess(ro.Arrival)
+			if err != nil {
+				ro.Reason = err.Error()
+				err = e.closeRestingOrder(ro, gctorder.Rejected, latest, funds)
+				if err != nil {
+					e.inFlightOrders = append(remaining, e.inFlightOrders[i:]...)
+					return fills, err
+				}
+				continue
+			}
+		}
+		if isRestingOrderType(ro.Type) {
+			ro.Status = gctorder.Active
+			ro.Placed = latest.GetTime()
+			e.restingOrders = append(e.restingOrders, ro)
+			continue
+		}
+		var f fill.Event
+		f, err = e.fillRestingOrder(ro, latest, latest.GetClosePrice(), dh, om, funds, &cs)
+		if err != nil {
+			ro.Status = gctorder.Rejected
+			ro.Closed = latest.GetTime()
+			ro.Reason = err.Error()
+			e.closedOrders = append(e.closedOrders, *ro)
+			if f == nil {
+				// the rejected order is closed, only keep those not yet processed
+				e.inFlightOrders = append(remaining, e.inFlightOrders[i+1:]...)
+				return fills, err
+			}
+			f.AppendReasonf("could not fill delayed order %v: %v", ro.ID, err)
+		}
+		fills = append(fills, f)
+	}
+	e.inFlightOrders = remaining
+	return fills, nil
+}
@@ -0,0 +1,423 @@
+package exchange
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestLatency(t *testing.T) {
+	t.Parallel()
+	c := &ExecutionConditions{
+		LatencyDistribution: FixedLatency,
+		MeanLatency:         time.Second,
+	}
+	if l := c.latency(); l != time.Second {
+		t.Errorf("received '%v' expected '%v'", l, time.Second)
+	}
+
+	c.LatencyDistribution = UniformLatency
+	c.MinimumLatency = time.Millisecond
+	c.MaximumLatency = time.Millisecond * 5
+	c.Seed = 1337
+	for i := 0; i < 100; i++ {
+		l := c.latency()
+		if l < c.MinimumLatency || l > c.MaximumLatency {
+			t.Fatalf("received '%v' outside of uniform bounds", l)
+		}
+	}
+
+	c.LatencyDistribution = NormalLatency
+	c.MeanLatency = time.Millisecond * 3
+	c.LatencyStandardDeviation = time.Millisecond * 10
+	for i := 0; i < 100; i++ {
+		l := c.latency()
+		if l < c.MinimumLatency || l > c.MaximumLatency {
+			t.Fatalf("received '%v' outside of normal bounds", l)
+		}
+	}
+
+	a := &ExecutionConditions{LatencyDistribution: NormalLatency, MeanLatency: time.Second, LatencyStandardDeviation: time.Second, Seed: 1}
+	b := &ExecutionConditions{LatencyDistribution: NormalLatency, MeanLatency: time.Second, LatencyStandardDeviation: time.Second, Seed: 1}
+	for i := 0; i < 10; i++ {
+		if a.latency() != b.latency() {
+			t.Fatal("expected seeded latency to be repeatable")
+		}
+	}
+}
+
+func TestAssess(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := &ExecutionConditions{
+		Outages: []Outage{{Start: tt, End: tt.Add(time.Hour)}},
+	}
+	err := c.assess(tt.Add(-time.Second))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = c.assess(tt)
+	if !errors.Is(err, errExchangeOutage) {
+		t.Errorf("received '%v' expected '%v'", err, errExchangeOutage)
+	}
+	err = c.assess(tt.Add(time.Hour))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+
+	c.RejectionRate = decimal.NewFromInt(100)
+	err = c.assess(tt.Add(time.Hour))
+	if !errors.Is(err, errOrderRejected) {
+		t.Errorf("received '%v' expected '%v'", err, errOrderRejected)
+	}
+}
+
+func TestApplies(t *testing.T) {
+	t.Parallel()
+	c := &ExecutionConditions{}
+	o := &order.Order{
+		Base:      &event.Base{AssetType: asset.Spot},
+		Direction: gctorder.Buy,
+	}
+	cs := &Settings{}
+	if !c.applies(o, cs) {
+		t.Error("expected conditions to apply")
+	}
+	o.LiquidatingPosition = true
+	if c.applies(o, cs) {
+		t.Error("expected liquidations to be exempt")
+	}
+	o.LiquidatingPosition = false
+	o.AssetType = asset.Futures
+	if c.applies(o, cs) {
+		t.Error("expected futures to be exempt")
+	}
+	o.AssetType = asset.Spot
+	cs.UseRealOrders = true
+	if c.applies(o, cs) {
+		t.Error("expected real orders to be exempt")
+	}
+}
+
+func TestInFlightOrders(t *testing.T) {
+	t.Parallel()
+	em := engine.NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	exch.GetBase().States = currencystate.NewCurrencyStates()
+	err = em.Add(exch)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	bot := &engine.Engine{ExchangeManager: em}
+	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, &bot.ServicesWG, false, false, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = bot.OrderManager.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	tt := time.Now().Truncate(time.Hour)
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	f := &binance.Binance{}
+	f.Name = testExchange
+	conditions := &ExecutionConditions{
+		LatencyDistribution: FixedLatency,
+		MeanLatency:         time.Minute * 90,
+		Outages:             []Outage{{Start: tt.Add(time.Hour * 2), End: tt.Add(time.Hour * 3)}},
+	}
+	e := Exchange{}
+	e.SetExchangeAssetCurrencySettings(asset.Spot, p, &Settings{
+		Exchange:                f,
+		Pair:                    p,
+		Asset:                   asset.Spot,
+		MakerFee:                decimal.NewFromFloat(0.001),
+		TakerFee:                decimal.NewFromFloat(0.002),
+		SkipCandleVolumeFitting: true,
+		ExecutionConditions:     conditions,
+	})
+	d := &kline.DataFromKline{
+		Base: &data.Base{},
+		Item: &gctkline.Item{
+			Exchange: testExchange,
+			Pair:     p,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneHour,
+			Candles: []gctkline.Candle{
+				{Time: tt, Open: 100, High: 101, Low: 99, Close: 100, Volume: 1337},
+				{Time: tt.Add(time.Hour), Open: 100, High: 110, Low: 90, Close: 95, Volume: 1337},
+				{Time: tt.Add(time.Hour * 2), Open: 95, High: 96, Low: 94, Close: 96, Volume: 1337},
+				{Time: tt.Add(time.Hour * 3), Open: 96, High: 99, Low: 94, Close: 97, Volume: 1337},
+			},
+		},
+	}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	newOrder := func(offset time.Duration) *order.Order {
+		return &order.Order{
+			Base: &event.Base{
+				Exchange:     testExchange,
+				Time:         tt.Add(offset),
+				Interval:     gctkline.OneHour,
+				CurrencyPair: p,
+				AssetType:    asset.Spot,
+			},
+			Direction:      gctorder.Buy,
+			OrderType:      gctorder.Market,
+			Amount:         decimal.NewFromInt(1),
+			AllocatedFunds: decimal.NewFromInt(200),
+			ClosePrice:     decimal.NewFromInt(100),
+		}
+	}
+	ev, err := e.ExecuteOrder(newOrder(0), d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if ev.GetDirection() != gctorder.DoNothing {
+		t.Errorf("received '%v' expected '%v'", ev.GetDirection(), gctorder.DoNothing)
+	}
+	// arrives during the outage
+	_, err = e.ExecuteOrder(newOrder(time.Hour), d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(e.inFlightOrders) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(e.inFlightOrders), 2)
+	}
+
+	// the first order has not arrived yet
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	fills, err := e.ProcessRestingOrders(d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(fills), 0)
+	}
+
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	fills, err = e.ProcessRestingOrders(d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	if fills[0].GetDirection() != gctorder.Buy {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetDirection(), gctorder.Buy)
+	}
+	if !fills[0].GetTime().Equal(tt.Add(time.Hour * 2)) {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetTime(), tt.Add(time.Hour*2))
+	}
+	if !fills[0].GetClosePrice().Equal(decimal.NewFromInt(96)) {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetClosePrice(), decimal.NewFromInt(96))
+	}
+
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	fills, err = e.ProcessRestingOrders(d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(fills), 0)
+	}
+	unfilled := e.GetUnfilledOrders()
+	if len(unfilled) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(unfilled), 1)
+	}
+	if unfilled[0].Status != gctorder.Rejected {
+		t.Errorf("received '%v' expected '%v'", unfilled[0].Status, gctorder.Rejected)
+	}
+	if unfilled[0].Reason == "" {
+		t.Error("expected rejection reason")
+	}
+
+	// orders without latency are rejected immediately
+	conditions.MeanLatency = 0
+	conditions.RejectionRate = decimal.NewFromInt(100)
+	ev, err = e.ExecuteOrder(newOrder(time.Hour*3), d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if ev.GetDirection() != gctorder.CouldNotBuy {
+		t.Errorf("received '%v' expected '%v'", ev.GetDirection(), gctorder.CouldNotBuy)
+	}
+	if len(e.GetUnfilledOrders()) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(e.GetUnfilledOrders()), 2)
+	}
+
+	err = e.Reset()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(e.inFlightOrders) != 0 {
+		t.Error("expected no in flight orders after reset")
+	}
+}
+
+func TestInFlightOrdersKeepQueueOnError(t *testing.T) {
+	t.Parallel()
+	em := engine.NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	exch.GetBase().States = currencystate.NewCurrencyStates()
+	err = em.Add(exch)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	bot := &engine.Engine{ExchangeManager: em}
+	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, &bot.ServicesWG, false, false, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = bot.OrderManager.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	tt := time.Now().Truncate(time.Hour)
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	f := &binance.Binance{}
+	f.Name = testExchange
+	e := Exchange{}
+	e.SetExchangeAssetCurrencySettings(asset.Spot, p, &Settings{
+		Exchange:                f,
+		Pair:                    p,
+		Asset:                   asset.Spot,
+		SkipCandleVolumeFitting: true,
+		ExecutionConditions: &ExecutionConditions{
+			LatencyDistribution: FixedLatency,
+			MeanLatency:         time.Minute * 90,
+			Outages:             []Outage{{Start: tt.Add(time.Minute * 95), End: tt.Add(time.Minute * 105)}},
+		},
+	})
+	d := &kline.DataFromKline{
+		Base: &data.Base{},
+		Item: &gctkline.Item{
+			Exchange: testExchange,
+			Pair:     p,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneHour,
+			Candles: []gctkline.Candle{
+				{Time: tt, Open: 100, High: 101, Low: 99, Close: 100, Volume: 1337},
+				{Time: tt.Add(time.Hour), Open: 100, High: 110, Low: 90, Close: 95, Volume: 1337},
+				{Time: tt.Add(time.Hour * 2), Open: 95, High: 96, Low: 94, Close: 96, Volume: 1337},
+				{Time: tt.Add(time.Hour * 3), Open: 96, High: 99, Low: 94, Close: 97, Volume: 1337},
+			},
+		},
+	}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	newOrder := func(offset time.Duration, allocated int64) *order.Order {
+		return &order.Order{
+			Base: &event.Base{
+				Exchange:     testExchange,
+				Time:         tt.Add(offset),
+				Interval:     gctkline.OneHour,
+				CurrencyPair: p,
+				AssetType:    asset.Spot,
+			},
+			Direction:      gctorder.Buy,
+			OrderType:      gctorder.Market,
+			Amount:         decimal.NewFromInt(1),
+			AllocatedFunds: decimal.NewFromInt(allocated),
+			ClosePrice:     decimal.NewFromInt(100),
+		}
+	}
+	// the second order arrives during the outage and cannot release more
+	// funds than are reserved, the third arrives after the data ends
+	orders := []*order.Order{
+		newOrder(0, 200),
+		newOrder(time.Minute*10, 2000),
+		newOrder(time.Hour*2, 200),
+	}
+	for i := range orders {
+		_, err = e.ExecuteOrder(orders[i], d, bot.OrderManager, &fakeFund{})
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	fills, err := e.ProcessRestingOrders(d, bot.OrderManager, &fakeFund{})
+	if err == nil {
+		t.Error("expected error releasing more funds than reserved")
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	if len(e.inFlightOrders) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(e.inFlightOrders), 2)
+	}
+
+	orders[1].AllocatedFunds = decimal.NewFromInt(200)
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// the filled order would fill again on this candle if it remained in flight
+	fills, err = e.ProcessRestingOrders(d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(fills), 0)
+	}
+	if len(e.inFlightOrders) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(e.inFlightOrders), 1)
+	}
+	if !e.inFlightOrders[0].Placed.Equal(tt.Add(time.Hour * 2)) {
+		t.Errorf("received '%v' expected '%v'", e.inFlightOrders[0].Placed, tt.Add(time.Hour*2))
+	}
+}
@@ -0,0 +1,368 @@
+package exchange
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func isRestingOrderType(t gctorder.Type) bool {
+	switch t {
+	case gctorder.UnknownType, gctorder.Market:
+		return false
+	}
+	return true
+}
+
+// placeRestingOrder validates and stores a limit, stop or stop-limit order
+// to be assessed against subsequent candles. The order's funds remain
+// reserved until it is filled, cancelled or expires
+func (e *Exchange) placeRestingOrder(o order.Event, f *fill.Fill, funds funding.IFundReleaser, cs *Settings) error {
+	err := validateRestingOrder(o, cs)
+	if err != nil {
+		f.AppendReasonf("could not place %v order: %v", o.GetOrderType(), err)
+		return allocateFundsPostOrder(f, funds, err, o.GetAmount(), o.GetAllocatedFunds(), decimal.Zero, decimal.Zero, decimal.Zero)
+	}
+	ro, err := newRestingOrder(o, gctorder.Active)
+	if err != nil {
+		return err
+	}
+	e.restingOrders = append(e.restingOrders, ro)
+	f.AppendReasonf("Placed resting %v %v order %v for %v at limit %v trigger %v",
+		ro.Type,
+		ro.Side,
+		ro.ID,
+		ro.Amount,
+		ro.LimitPrice,
+		ro.TriggerPrice)
+	f.SetDirection(gctorder.DoNothing)
+	f.FillDependentEvent = nil
+	return nil
+}
+
+func newRestingOrder(o order.Event, status gctorder.Status) (*RestingOrder, error) {
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	return &RestingOrder{
+		ID:            id.String(),
+		ClientOrderID: o.GetClientOrderID(),
+		Exchange:      o.GetExchange(),
+		Asset:         o.GetAssetType(),
+		Pair:          o.Pair(),
+		Type:          o.GetOrderType(),
+		Side:          o.GetDirection(),
+		Status:        status,
+		Amount:        o.GetAmount(),
+		LimitPrice:    o.GetLimitPrice(),
+		TriggerPrice:  o.GetTriggerPrice(),
+		GoodTillTime:  o.GetGoodTillTime(),
+		Placed:        o.GetTime(),
+		orderEvent:    o,
+	}, nil
+}
+
+func validateRestingOrder(o order.Event, cs *Settings) error {
+	if cs.UseRealOrders {
+		return fmt.Errorf("%w %v when using real orders", errUnsupportedOrderType, o.GetOrderType())
+	}
+	if o.GetAssetType() != asset.Spot {
+		return fmt.Errorf("%w %v for asset %v, only spot is supported", errUnsupportedOrderType, o.GetOrderType(), o.GetAssetType())
+	}
+	if !o.GetDirection().IsLong() && !o.GetDirection().IsShort() {
+		return fmt.Errorf("%w: %v", errInvalidDirection, o.GetDirection())
+	}
+	if o.GetAmount().LessThanOrEqual(decimal.Zero) {
+		return fmt.Errorf("%w amount %v", errInvalidRestingOrder, o.GetAmount())
+	}
+	switch o.GetOrderType() {
+	case gctorder.Limit:
+		if o.GetLimitPrice().LessThanOrEqual(decimal.Zero) {
+			return fmt.Errorf("%w limit price %v", errInvalidRestingOrder, o.GetLimitPrice())
+		}
+	case gctorder.Stop:
+		if o.GetTriggerPrice().LessThanOrEqual(decimal.Zero) {
+			return fmt.Errorf("%w trigger price %v", errInvalidRestingOrder, o.GetTriggerPrice())
+		}
+	case gctorder.StopLimit:
+		if o.GetLimitPrice().LessThanOrEqual(decimal.Zero) ||
+			o.GetTriggerPrice().LessThanOrEqual(decimal.Zero) {
+			return fmt.Errorf("%w limit price %v trigger price %v", errInvalidRestingOrder, o.GetLimitPrice(), o.GetTriggerPrice())
+		}
+	default:
+		return fmt.Errorf("%w %v", errUnsupportedOrderType, o.GetOrderType())
+	}
+	return nil
+}
+
+// ProcessRestingOrders assesses resting orders for the latest candle of the
+// data handler after any delayed orders which have arrived are processed.
+// Orders are only assessed against candles after the one they were placed
+// on. Expired orders have their funds released and filled orders are
+// executed and returned as fill events
+func (e *Exchange) ProcessRestingOrders(dh data.Handler, om *engine.OrderManager, funds funding.IFundReleaser) ([]fill.Event, error) {
+	if dh == nil {
+		return nil, fmt.Errorf("%w: data handler", gctcommon.ErrNilPointer)
+	}
+	if len(e.restingOrders) == 0 && len(e.inFlightOrders) == 0 {
+		return nil, nil
+	}
+	latest, err := dh.Latest()
+	if err != nil {
+		return nil, err
+	}
+	fills, err := e.processInFlightOrders(latest, dh, om, funds)
+	if err != nil {
+		return fills, err
+	}
+	remaining := make([]*RestingOrder, 0, len(e.restingOrders))
+	for i := range e.restingOrders {
+		ro := e.restingOrders[i]
+		if !ro.matches(latest) || !ro.Placed.Before(latest.GetTime()) {
+			remaining = append(remaining, ro)
+			continue
+		}
+		if !ro.GoodTillTime.IsZero() && !latest.GetTime().Before(ro.GoodTillTime) {
+			err = e.closeRestingOrder(ro, gctorder.Expired, latest, funds)
+			if err != nil {
+				// keep the order and those not yet assessed so that
+				// processed orders are not assessed again
+				e.restingOrders = append(remaining, e.restingOrders[i:]...)
+				return fills, err
+			}
+			continue
+		}
+		var cs Settings
+		cs, err = e.GetCurrencySettings(ro.Exchange, ro.Asset, ro.Pair)
+		if err != nil {
+			e.restingOrders = append(remaining, e.restingOrders[i:]...)
+			return fills, err
+		}
+		price, filled := ro.assessFill(latest.GetOpenPrice(), latest.GetHighPrice(), latest.GetLowPrice(), cs.OptimisticIntrabarFills)
+		if !filled {
+			remaining = append(remaining, ro)
+			continue
+		}
+		var f fill.Event
+		f, err = e.fillRestingOrder(ro, latest, price, dh, om, funds, &cs)
+		if err != nil {
+			ro.Status = gctorder.Rejected
+			ro.Closed = latest.GetTime()
+			e.closedOrders = append(e.closedOrders, *ro)
+			if f == nil {
+				// the rejected order is closed, only keep those not yet assessed
+				e.restingOrders = append(remaining, e.restingOrders[i+1:]...)
+				return fills, err
+			}
+			f.AppendReasonf("could not fill resting order %v: %v", ro.ID, err)
+		}
+		fills = append(fills, f)
+	}
+	e.restingOrders = remaining
+	return fills, nil
+}
+
+// assessFill determines whether the order fills on a candle and at what
+// price. Stop orders trigger when the candle reaches the trigger price and
+// fill at it, or at the open when the candle gapped past it. Limit orders
+// fill at the limit price, or at the open when it was more favourable.
+// When not optimistic, a limit price must be traded through rather than
+// touched, and a stop-limit cannot fill on the candle that triggered it
+func (r *RestingOrder) assessFill(open, high, low decimal.Decimal, optimistic bool) (decimal.Decimal, bool) {
+	isBuy := r.Side.IsLong()
+	switch r.Type {
+	case gctorder.Stop:
+		if isBuy {
+			if high.LessThan(r.TriggerPrice) {
+				return decimal.Zero, false
+			}
+			return decimal.Max(open, r.TriggerPrice), true
+		}
+		if low.GreaterThan(r.TriggerPrice) {
+			return decimal.Zero, false
+		}
+		return decimal.Min(open, r.TriggerPrice), true
+	case gctorder.StopLimit:
+		if !r.Triggered {
+			if (isBuy && high.LessThan(r.TriggerPrice)) ||
+				(!isBuy && low.GreaterThan(r.TriggerPrice)) {
+				return decimal.Zero, false
+			}
+			r.Triggered = true
+			if !optimistic {
+				return decimal.Zero, false
+			}
+			// the open has already passed, so only the limit price is achievable
+			if isBuy && low.LessThanOrEqual(r.LimitPrice) ||
+				!isBuy && high.GreaterThanOrEqual(r.LimitPrice) {
+				return r.LimitPrice, true
+			}
+			return decimal.Zero, false
+		}
+	case gctorder.Limit:
+	default:
+		return decimal.Zero, false
+	}
+	if isBuy {
+		if low.GreaterThan(r.LimitPrice) || (!optimistic && low.Equal(r.LimitPrice)) {
+			return decimal.Zero, false
+		}
+		return decimal.Min(open, r.LimitPrice), true
+	}
+	if high.LessThan(r.LimitPrice) || (!optimistic && high.Equal(r.LimitPrice)) {
+		return decimal.Zero, false
+	}
+	return decimal.Max(open, r.LimitPrice), true
+}
+
+// fillRestingOrder executes the resting order at the price determined
+// against the latest candle
+func (e *Exchange) fillRestingOrder(ro *RestingOrder, latest data.Event, price decimal.Decimal, dh data.Handler, om *engine.OrderManager, funds funding.IFundReleaser, cs *Settings) (fill.Event, error) {
+	o := &order.Order{
+		Base: &event.Base{
+			Offset:         latest.GetOffset(),
+			Exchange:       latest.GetExchange(),
+			Time:           latest.GetTime(),
+			Interval:       latest.GetInterval(),
+			CurrencyPair:   latest.Pair(),
+			UnderlyingPair: latest.GetUnderlyingPair(),
+			AssetType:      latest.GetAssetType(),
+		},
+		ID:                 ro.ID,
+		Direction:          ro.Side,
+		Amount:             ro.Amount,
+		ClosePrice:         price,
+		OrderType:          ro.Type,
+		LimitPrice:         ro.LimitPrice,
+		TriggerPrice:       ro.TriggerPrice,
+		GoodTillTime:       ro.GoodTillTime,
+		ClientOrderID:      ro.ClientOrderID,
+		AllocatedFunds:     ro.orderEvent.GetAllocatedFunds(),
+		FillDependentEvent: ro.orderEvent.GetFillDependentEvent(),
+	}
+	f := &fill.Fill{
+		Base:               o.GetBase(),
+		Direction:          o.GetDirection(),
+		Amount:             o.GetAmount(),
+		ClosePrice:         price,
+		FillDependentEvent: o.GetFillDependentEvent(),
+	}
+	if isRestingOrderType(ro.Type) {
+		f.AppendReasonf("Resting %v %v order %v placed at %v filled at %v",
+			ro.Type,
+			ro.Side,
+			ro.ID,
+			ro.Placed,
+			price)
+	} else {
+		f.AppendReasonf("Delayed %v %v order %v submitted at %v arrived at %v filled at %v",
+			ro.Type,
+			ro.Side,
+			ro.ID,
+			ro.Placed,
+			ro.Arrival,
+			price)
+	}
+	// stop orders become market orders once triggered
+	isMaker := ro.Type == gctorder.Limit || ro.Type == gctorder.StopLimit
+	resp, err := e.executeOrder(o, f, price, isMaker, dh, om, funds, cs)
+	if err != nil {
+		return f, err
+	}
+	ro.Status = gctorder.Filled
+	return resp, nil
+}
+
+// CancelRestingOrders cancels resting orders for the event's exchange, asset
+// and pair which match the provided order IDs or client order IDs, releasing
+// their reserved funds
+func (e *Exchange) CancelRestingOrders(ev common.Event, ids []string, funds funding.IFundReleaser) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	remaining := make([]*RestingOrder, 0, len(e.restingOrders))
+	for i := range e.restingOrders {
+		ro := e.restingOrders[i]
+		if !ro.matches(ev) || !ro.hasID(ids) {
+			remaining = append(remaining, ro)
+			continue
+		}
+		err := e.closeRestingOrder(ro, gctorder.Cancelled, ev, funds)
+		if err != nil {
+			return err
+		}
+	}
+	e.restingOrders = remaining
+	return nil
+}
+
+// GetUnfilledOrders returns all resting orders which were not filled,
+// including those still open or in flight at the time of calling
+func (e *Exchange) GetUnfilledOrders() []RestingOrder {
+	resp := make([]RestingOrder, 0, len(e.closedOrders)+len(e.restingOrders)+len(e.inFlightOrders))
+	resp = append(resp, e.closedOrders...)
+	for i := range e.restingOrders {
+		resp = append(resp, *e.restingOrders[i])
+	}
+	for i := range e.inFlightOrders {
+		resp = append(resp, *e.inFlightOrders[i])
+	}
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].Placed.Before(resp[j].Placed)
+	})
+	return resp
+}
+
+func (e *Exchange) closeRestingOrder(ro *RestingOrder, status gctorder.Status, ev common.Event, funds funding.IFundReleaser) error {
+	if funds == nil {
+		return fmt.Errorf("%w: funding", gctcommon.ErrNilPointer)
+	}
+	pr, err := funds.PairReleaser()
+	if err != nil {
+		return err
+	}
+	allocated := ro.orderEvent.GetAllocatedFunds()
+	err = pr.Release(allocated, allocated, ro.Side)
+	if err != nil {
+		return err
+	}
+	ro.Status = status
+	ro.Closed = ev.GetTime()
+	e.closedOrders = append(e.closedOrders, *ro)
+	return nil
+}
+
+func (r *RestingOrder) matches(ev common.Event) bool {
+	return strings.EqualFold(r.Exchange, ev.GetExchange()) &&
+		r.Asset == ev.GetAssetType() &&
+		r.Pair.Equal(ev.Pair())
+}
+
+func (r *RestingOrder) hasID(ids []string) bool {
+	for i := range ids {
+		if ids[i] == "" {
+			continue
+		}
+		if ids[i] == r.ID || ids[i] == r.ClientOrderID {
+			return true
+		}
+	}
+	return false
+}
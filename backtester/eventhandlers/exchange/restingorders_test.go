@@ -0,0 +1,426 @@
+package exchange
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestAssessFill(t *testing.T) {
+	t.Parallel()
+	d := decimal.NewFromInt
+	tt := []struct {
+		name       string
+		order      RestingOrder
+		open       decimal.Decimal
+		optimistic bool
+		price      decimal.Decimal
+		filled     bool
+	}{
+		{name: "buy limit not reached", order: RestingOrder{Type: gctorder.Limit, Side: gctorder.Buy, LimitPrice: d(80)}, open: d(100)},
+		{name: "buy limit touched pessimistic", order: RestingOrder{Type: gctorder.Limit, Side: gctorder.Buy, LimitPrice: d(90)}, open: d(100)},
+		{name: "buy limit touched optimistic", order: RestingOrder{Type: gctorder.Limit, Side: gctorder.Buy, LimitPrice: d(90)}, open: d(100), optimistic: true, price: d(90), filled: true},
+		{name: "buy limit traded through", order: RestingOrder{Type: gctorder.Limit, Side: gctorder.Buy, LimitPrice: d(95)}, open: d(100), price: d(95), filled: true},
+		{name: "buy limit gapped through", order: RestingOrder{Type: gctorder.Limit, Side: gctorder.Buy, LimitPrice: d(105)}, open: d(100), price: d(100), filled: true},
+		{name: "sell limit touched pessimistic", order: RestingOrder{Type: gctorder.Limit, Side: gctorder.Sell, LimitPrice: d(110)}, open: d(100)},
+		{name: "sell limit traded through", order: RestingOrder{Type: gctorder.Limit, Side: gctorder.Sell, LimitPrice: d(105)}, open: d(100), price: d(105), filled: true},
+		{name: "sell limit gapped through", order: RestingOrder{Type: gctorder.Limit, Side: gctorder.Sell, LimitPrice: d(95)}, open: d(100), price: d(100), filled: true},
+		{name: "buy stop not triggered", order: RestingOrder{Type: gctorder.Stop, Side: gctorder.Buy, TriggerPrice: d(111)}, open: d(100)},
+		{name: "buy stop triggered", order: RestingOrder{Type: gctorder.Stop, Side: gctorder.Buy, TriggerPrice: d(110)}, open: d(100), price: d(110), filled: true},
+		{name: "sell stop gapped", order: RestingOrder{Type: gctorder.Stop, Side: gctorder.Sell, TriggerPrice: d(105)}, open: d(100), price: d(100), filled: true},
+		{name: "stop limit pessimistic", order: RestingOrder{Type: gctorder.StopLimit, Side: gctorder.Buy, TriggerPrice: d(105), LimitPrice: d(106)}, open: d(100)},
+		{name: "stop limit optimistic", order: RestingOrder{Type: gctorder.StopLimit, Side: gctorder.Buy, TriggerPrice: d(105), LimitPrice: d(106)}, open: d(100), optimistic: true, price: d(106), filled: true},
+		{name: "triggered stop limit", order: RestingOrder{Type: gctorder.StopLimit, Side: gctorder.Sell, TriggerPrice: d(95), LimitPrice: d(105), Triggered: true}, open: d(100), price: d(105), filled: true},
+		{name: "unsupported", order: RestingOrder{Type: gctorder.TrailingStop, Side: gctorder.Sell}, open: d(100)},
+	}
+	for i := range tt {
+		tc := tt[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			price, filled := tc.order.assessFill(tc.open, d(110), d(90), tc.optimistic)
+			if filled != tc.filled {
+				t.Errorf("received '%v' expected '%v'", filled, tc.filled)
+			}
+			if !price.Equal(tc.price) {
+				t.Errorf("received '%v' expected '%v'", price, tc.price)
+			}
+		})
+	}
+
+	ro := RestingOrder{Type: gctorder.StopLimit, Side: gctorder.Buy, TriggerPrice: d(105), LimitPrice: d(80)}
+	_, filled := ro.assessFill(d(100), d(110), d(90), false)
+	if filled {
+		t.Error("expected unfilled")
+	}
+	if !ro.Triggered {
+		t.Error("expected order to be triggered")
+	}
+}
+
+func TestValidateRestingOrder(t *testing.T) {
+	t.Parallel()
+	o := &order.Order{
+		Base:      &event.Base{AssetType: asset.Spot},
+		Direction: gctorder.Buy,
+		OrderType: gctorder.Limit,
+		Amount:    decimal.NewFromInt(1),
+	}
+	cs := &Settings{UseRealOrders: true}
+	err := validateRestingOrder(o, cs)
+	if !errors.Is(err, errUnsupportedOrderType) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedOrderType)
+	}
+	cs.UseRealOrders = false
+	o.AssetType = asset.Futures
+	err = validateRestingOrder(o, cs)
+	if !errors.Is(err, errUnsupportedOrderType) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedOrderType)
+	}
+	o.AssetType = asset.Spot
+	err = validateRestingOrder(o, cs)
+	if !errors.Is(err, errInvalidRestingOrder) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRestingOrder)
+	}
+	o.LimitPrice = decimal.NewFromInt(100)
+	err = validateRestingOrder(o, cs)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	o.OrderType = gctorder.StopLimit
+	err = validateRestingOrder(o, cs)
+	if !errors.Is(err, errInvalidRestingOrder) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRestingOrder)
+	}
+	o.TriggerPrice = decimal.NewFromInt(100)
+	err = validateRestingOrder(o, cs)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	o.OrderType = gctorder.IOS
+	err = validateRestingOrder(o, cs)
+	if !errors.Is(err, errUnsupportedOrderType) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedOrderType)
+	}
+	o.Direction = gctorder.ClosePosition
+	err = validateRestingOrder(o, cs)
+	if !errors.Is(err, errInvalidDirection) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidDirection)
+	}
+}
+
+func TestRestingOrders(t *testing.T) {
+	t.Parallel()
+	em := engine.NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	exch.GetBase().States = currencystate.NewCurrencyStates()
+	err = em.Add(exch)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	bot := &engine.Engine{ExchangeManager: em}
+	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, &bot.ServicesWG, false, false, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = bot.OrderManager.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	f := &binance.Binance{}
+	f.Name = testExchange
+	e := Exchange{}
+	e.SetExchangeAssetCurrencySettings(asset.Spot, p, &Settings{
+		Exchange: f,
+		Pair:     p,
+		Asset:    asset.Spot,
+		MakerFee: decimal.NewFromFloat(0.001),
+		TakerFee: decimal.NewFromFloat(0.002),
+	})
+	tt := time.Now().Truncate(time.Hour)
+	d := &kline.DataFromKline{
+		Base: &data.Base{},
+		Item: &gctkline.Item{
+			Exchange: testExchange,
+			Pair:     p,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneHour,
+			Candles: []gctkline.Candle{
+				{Time: tt, Open: 100, High: 101, Low: 99, Close: 100, Volume: 1337},
+				{Time: tt.Add(time.Hour), Open: 100, High: 110, Low: 90, Close: 95, Volume: 1337},
+				{Time: tt.Add(time.Hour * 2), Open: 95, High: 96, Low: 94, Close: 95, Volume: 1337},
+			},
+		},
+	}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	_, err = e.ProcessRestingOrders(nil, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	newOrder := func(side gctorder.Side, limit int64, gtt time.Time, clientID string) *order.Order {
+		return &order.Order{
+			Base: &event.Base{
+				Exchange:     testExchange,
+				Time:         tt,
+				Interval:     gctkline.OneHour,
+				CurrencyPair: p,
+				AssetType:    asset.Spot,
+			},
+			Direction:      side,
+			OrderType:      gctorder.Limit,
+			Amount:         decimal.NewFromInt(1),
+			AllocatedFunds: decimal.NewFromInt(200),
+			ClosePrice:     decimal.NewFromInt(100),
+			LimitPrice:     decimal.NewFromInt(limit),
+			GoodTillTime:   gtt,
+			ClientOrderID:  clientID,
+		}
+	}
+	ev, err := e.ExecuteOrder(newOrder(gctorder.Buy, 0, time.Time{}, ""), d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, errInvalidRestingOrder) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidRestingOrder)
+	}
+	if ev.GetDirection() != gctorder.CouldNotBuy {
+		t.Errorf("received '%v' expected '%v'", ev.GetDirection(), gctorder.CouldNotBuy)
+	}
+
+	ev, err = e.ExecuteOrder(newOrder(gctorder.Buy, 95, time.Time{}, ""), d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if ev.GetDirection() != gctorder.DoNothing {
+		t.Errorf("received '%v' expected '%v'", ev.GetDirection(), gctorder.DoNothing)
+	}
+	_, err = e.ExecuteOrder(newOrder(gctorder.Sell, 200, tt.Add(time.Hour), ""), d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = e.ExecuteOrder(newOrder(gctorder.Sell, 300, time.Time{}, "cancel-me"), d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = e.ExecuteOrder(newOrder(gctorder.Sell, 400, time.Time{}, "still-open"), d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(e.restingOrders) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(e.restingOrders), 4)
+	}
+
+	// orders are not assessed against the candle they were placed on
+	fills, err := e.ProcessRestingOrders(d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(fills), 0)
+	}
+
+	err = e.CancelRestingOrders(nil, nil, &fakeFund{})
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	err = e.CancelRestingOrders(newOrder(gctorder.Sell, 300, time.Time{}, ""), []string{"cancel-me"}, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(e.restingOrders) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(e.restingOrders), 3)
+	}
+
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	fills, err = e.ProcessRestingOrders(d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	if fills[0].GetDirection() != gctorder.Buy {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetDirection(), gctorder.Buy)
+	}
+	if !fills[0].GetPurchasePrice().Equal(decimal.NewFromInt(95)) {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetPurchasePrice(), decimal.NewFromInt(95))
+	}
+	if !fills[0].GetExchangeFee().Equal(decimal.NewFromFloat(0.095)) {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetExchangeFee(), decimal.NewFromFloat(0.095))
+	}
+	if !fills[0].GetTime().Equal(tt.Add(time.Hour)) {
+		t.Errorf("received '%v' expected '%v'", fills[0].GetTime(), tt.Add(time.Hour))
+	}
+
+	unfilled := e.GetUnfilledOrders()
+	if len(unfilled) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(unfilled), 3)
+	}
+	statuses := make(map[gctorder.Status]int)
+	for i := range unfilled {
+		statuses[unfilled[i].Status]++
+	}
+	if statuses[gctorder.Cancelled] != 1 || statuses[gctorder.Expired] != 1 || statuses[gctorder.Active] != 1 {
+		t.Errorf("received unexpected statuses '%v'", statuses)
+	}
+
+	err = e.Reset()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(e.GetUnfilledOrders()) != 0 {
+		t.Error("expected no unfilled orders after reset")
+	}
+}
+
+func TestProcessRestingOrdersKeepsQueueOnError(t *testing.T) {
+	t.Parallel()
+	em := engine.NewExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	exch.GetBase().States = currencystate.NewCurrencyStates()
+	err = em.Add(exch)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	bot := &engine.Engine{ExchangeManager: em}
+	bot.OrderManager, err = engine.SetupOrderManager(em, &engine.CommunicationManager{}, &bot.ServicesWG, false, false, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = bot.OrderManager.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	f := &binance.Binance{}
+	f.Name = testExchange
+	e := Exchange{}
+	e.SetExchangeAssetCurrencySettings(asset.Spot, p, &Settings{
+		Exchange: f,
+		Pair:     p,
+		Asset:    asset.Spot,
+	})
+	tt := time.Now().Truncate(time.Hour)
+	d := &kline.DataFromKline{
+		Base: &data.Base{},
+		Item: &gctkline.Item{
+			Exchange: testExchange,
+			Pair:     p,
+			Asset:    asset.Spot,
+			Interval: gctkline.OneHour,
+			Candles: []gctkline.Candle{
+				{Time: tt, Open: 100, High: 101, Low: 99, Close: 100, Volume: 1337},
+				{Time: tt.Add(time.Hour), Open: 100, High: 110, Low: 90, Close: 95, Volume: 1337},
+				{Time: tt.Add(time.Hour * 2), Open: 95, High: 96, Low: 94, Close: 95, Volume: 1337},
+			},
+		},
+	}
+	err = d.Load()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	newOrder := func(side gctorder.Side, limit, allocated int64, gtt time.Time) *order.Order {
+		return &order.Order{
+			Base: &event.Base{
+				Exchange:     testExchange,
+				Time:         tt,
+				Interval:     gctkline.OneHour,
+				CurrencyPair: p,
+				AssetType:    asset.Spot,
+			},
+			Direction:      side,
+			OrderType:      gctorder.Limit,
+			Amount:         decimal.NewFromInt(1),
+			AllocatedFunds: decimal.NewFromInt(allocated),
+			ClosePrice:     decimal.NewFromInt(100),
+			LimitPrice:     decimal.NewFromInt(limit),
+			GoodTillTime:   gtt,
+		}
+	}
+	// the expiring order cannot release more funds than are reserved
+	orders := []*order.Order{
+		newOrder(gctorder.Buy, 95, 200, time.Time{}),
+		newOrder(gctorder.Sell, 200, 2000, tt.Add(time.Hour)),
+		newOrder(gctorder.Sell, 400, 200, time.Time{}),
+	}
+	for i := range orders {
+		_, err = e.ExecuteOrder(orders[i], d, bot.OrderManager, &fakeFund{})
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	fills, err := e.ProcessRestingOrders(d, bot.OrderManager, &fakeFund{})
+	if err == nil {
+		t.Error("expected error releasing more funds than reserved")
+	}
+	if len(fills) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(fills), 1)
+	}
+	if len(e.restingOrders) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(e.restingOrders), 2)
+	}
+
+	orders[1].AllocatedFunds = decimal.NewFromInt(200)
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// the filled buy order would fill again on this candle if it remained queued
+	fills, err = e.ProcessRestingOrders(d, bot.OrderManager, &fakeFund{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if len(fills) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(fills), 0)
+	}
+	if len(e.restingOrders) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(e.restingOrders), 1)
+	}
+	if e.restingOrders[0].LimitPrice.IntPart() != 400 {
+		t.Errorf("received '%v' expected '%v'", e.restingOrders[0].LimitPrice, 400)
+	}
+}
@@ -1,16 +1,19 @@
 package slippage
 
 import (
+	"fmt"
 	"math/rand"
 
 	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 // EstimateSlippagePercentage takes in an int range of numbers
-// turns it into a percentage
-func EstimateSlippagePercentage(maximumSlippageRate, minimumSlippageRate decimal.Decimal) decimal.Decimal {
+// turns it into a percentage. A seeded random source allows the
+// slippage of a run to be reproduced, nil uses the default source
+func EstimateSlippagePercentage(maximumSlippageRate, minimumSlippageRate decimal.Decimal, r *rand.Rand) decimal.Decimal {
 	if minimumSlippageRate.LessThan(decimal.NewFromInt(1)) || minimumSlippageRate.GreaterThan(decimal.NewFromInt(100)) {
 		return decimal.NewFromInt(1)
 	}
@@ -22,7 +25,12 @@ func EstimateSlippagePercentage(maximumSlippageRate, minimumSlippageRate decimal
 	// eg 80 means for every dollar, keep 80%
 	randSeed := int(minimumSlippageRate.IntPart()) - int(maximumSlippageRate.IntPart())
 	if randSeed > 0 {
-		result := int64(rand.Intn(randSeed)) //nolint:gosec // basic number generation required, no need for crypto/rand
+		var result int64
+		if r != nil {
+			result = int64(r.Intn(randSeed))
+		} else {
+			result = int64(rand.Intn(randSeed)) //nolint:gosec // basic number generation required, no need for crypto/rand
+		}
 
 		return maximumSlippageRate.Add(decimal.NewFromInt(result)).Div(decimal.NewFromInt(100))
 	}
@@ -42,3 +50,34 @@ func CalculateSlippageByOrderbook(ob *orderbook.Base, side gctorder.Side, alloca
 	amount = decimal.NewFromFloat(result.Amount * (1 - feeRate.InexactFloat64()))
 	return
 }
+
+// WalkOrderbook fills the base amount by walking the levels of the orderbook
+// from the best price. Buys lift the asks and sells hit the bids. It returns
+// the average price of the fill and the amount filled, which is less than the
+// amount requested when the orderbook does not have enough liquidity
+func WalkOrderbook(ob *orderbook.Base, side gctorder.Side, amount decimal.Decimal) (averagePrice, filledAmount decimal.Decimal, err error) {
+	if ob == nil {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%w orderbook", gctcommon.ErrNilPointer)
+	}
+	if !amount.IsPositive() {
+		return decimal.Zero, decimal.Zero, errInvalidAmount
+	}
+	var levels orderbook.Items
+	switch side {
+	case gctorder.Buy, gctorder.Bid, gctorder.Long:
+		levels = ob.Asks
+	case gctorder.Sell, gctorder.Ask, gctorder.Short:
+		levels = ob.Bids
+	default:
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%v %w", side, gctorder.ErrSideIsInvalid)
+	}
+	if len(levels) == 0 {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%w to %v", errNoLiquidity, side)
+	}
+	nominal, remaining := levels.FindNominalAmount(amount.InexactFloat64())
+	filledAmount = amount.Sub(decimal.NewFromFloat(remaining))
+	if !filledAmount.IsPositive() {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("%w to %v", errNoLiquidity, side)
+	}
+	return decimal.NewFromFloat(nominal).Div(filledAmount), filledAmount, nil
+}
@@ -2,21 +2,35 @@ package slippage
 
 import (
 	"context"
+	"errors"
+	"math/rand"
 	"testing"
 
 	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 )
 
 func TestRandomSlippage(t *testing.T) {
 	t.Parallel()
-	resp := EstimateSlippagePercentage(decimal.NewFromInt(80), decimal.NewFromInt(100))
+	resp := EstimateSlippagePercentage(decimal.NewFromInt(80), decimal.NewFromInt(100), nil)
 	if resp.LessThan(decimal.NewFromFloat(0.8)) || resp.GreaterThan(decimal.NewFromInt(1)) {
 		t.Error("expected result > 0.8 and < 100")
 	}
+
+	r1 := rand.New(rand.NewSource(1337)) //nolint:gosec // not used for security purposes
+	r2 := rand.New(rand.NewSource(1337)) //nolint:gosec // not used for security purposes
+	for i := 0; i < 10; i++ {
+		first := EstimateSlippagePercentage(decimal.NewFromInt(80), decimal.NewFromInt(100), r1)
+		second := EstimateSlippagePercentage(decimal.NewFromInt(80), decimal.NewFromInt(100), r2)
+		if !first.Equal(second) {
+			t.Errorf("received '%v' expected '%v'", second, first)
+		}
+	}
 }
 
 func TestCalculateSlippageByOrderbook(t *testing.T) {
@@ -38,3 +52,43 @@ func TestCalculateSlippageByOrderbook(t *testing.T) {
 		t.Error("order size must be less than funds")
 	}
 }
+
+func TestWalkOrderbook(t *testing.T) {
+	t.Parallel()
+	_, _, err := WalkOrderbook(nil, gctorder.Buy, decimal.NewFromInt(1))
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	ob := &orderbook.Base{}
+	_, _, err = WalkOrderbook(ob, gctorder.Buy, decimal.Zero)
+	if !errors.Is(err, errInvalidAmount) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidAmount)
+	}
+	_, _, err = WalkOrderbook(ob, gctorder.ClosePosition, decimal.NewFromInt(1))
+	if !errors.Is(err, gctorder.ErrSideIsInvalid) {
+		t.Errorf("received '%v' expected '%v'", err, gctorder.ErrSideIsInvalid)
+	}
+	_, _, err = WalkOrderbook(ob, gctorder.Buy, decimal.NewFromInt(1))
+	if !errors.Is(err, errNoLiquidity) {
+		t.Errorf("received '%v' expected '%v'", err, errNoLiquidity)
+	}
+
+	ob.Bids = orderbook.Items{{Price: 99, Amount: 1}, {Price: 97, Amount: 1}}
+	ob.Asks = orderbook.Items{{Price: 101, Amount: 1}, {Price: 103, Amount: 1}}
+	price, amount, err := WalkOrderbook(ob, gctorder.Buy, decimal.NewFromFloat(1.5))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// 1 at 101 and 0.5 at 103
+	if !price.Round(8).Equal(decimal.NewFromFloat(101.66666667)) || !amount.Equal(decimal.NewFromFloat(1.5)) {
+		t.Errorf("received '%v' '%v' expected '101.66666667' '1.5'", price, amount)
+	}
+	price, amount, err = WalkOrderbook(ob, gctorder.Sell, decimal.NewFromInt(3))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// the entire bid side is consumed
+	if !price.Equal(decimal.NewFromInt(98)) || !amount.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' '%v' expected '98' '2'", price, amount)
+	}
+}
@@ -1,6 +1,15 @@
 package slippage
 
-import "github.com/shopspring/decimal"
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	errInvalidAmount = errors.New("amount must be greater than zero")
+	errNoLiquidity   = errors.New("no orderbook liquidity")
+)
 
 // Default slippage rates. It works on a percentage basis
 // 100 means unaffected, 95 would mean 95%
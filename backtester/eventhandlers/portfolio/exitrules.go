@@ -0,0 +1,246 @@
+package portfolio
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var oneHundred = decimal.NewFromInt(100)
+
+// CheckExitRules assesses the open position for the data handler's latest
+// event against the configured exit rules. When a rule is triggered, a
+// closing signal tagged with the rule is returned. A nil signal is returned
+// when there is no open position or no rule has been triggered
+func (p *Portfolio) CheckExitRules(d data.Handler) (signal.Event, error) {
+	if d == nil {
+		return nil, fmt.Errorf("%w data handler", gctcommon.ErrNilPointer)
+	}
+	latest, err := d.Latest()
+	if err != nil {
+		return nil, err
+	}
+	lookup := p.exchangeAssetPairPortfolioSettings[latest.GetExchange()][latest.GetAssetType()][latest.Pair().Base.Item][latest.Pair().Quote.Item]
+	if lookup == nil {
+		return nil, fmt.Errorf("%w for %v %v %v", errNoPortfolioSettings, latest.GetExchange(), latest.GetAssetType(), latest.Pair())
+	}
+	if !exitRulesEnabled(&lookup.exitRules) {
+		return nil, nil
+	}
+	if latest.GetAssetType().IsFutures() {
+		lookup.syncFuturesExitPosition()
+	}
+	pos := lookup.exitPosition
+	if pos == nil || !pos.entryTime.Before(latest.GetTime()) {
+		return nil, nil
+	}
+	if pos.atr.IsZero() && usesATR(&lookup.exitRules) {
+		pos.atr, err = averageTrueRange(d, lookup.exitRules.ATRPeriod)
+		if err != nil {
+			return nil, err
+		}
+	}
+	closePrice := latest.GetClosePrice()
+	rule := pos.assess(closePrice, latest.GetTime(), latest.GetInterval().Duration(), &lookup.exitRules)
+	pos.updateExtremes(closePrice)
+	if rule == "" {
+		return nil, nil
+	}
+	s := &signal.Signal{
+		Base:       latest.GetBase(),
+		OpenPrice:  latest.GetOpenPrice(),
+		HighPrice:  latest.GetHighPrice(),
+		LowPrice:   latest.GetLowPrice(),
+		ClosePrice: closePrice,
+		Volume:     latest.GetVolume(),
+		Direction:  gctorder.ClosePosition,
+		ExitRule:   rule,
+	}
+	if latest.GetAssetType() == asset.Spot {
+		s.Amount = pos.size
+	}
+	s.AppendReasonf("%v exit rule triggered at %v for %v position entered at %v on %v",
+		rule,
+		closePrice,
+		pos.direction,
+		pos.entryPrice,
+		pos.entryTime)
+	return s, nil
+}
+
+// trackSpotExitPosition maintains the size and average entry price of a spot
+// position opened by buy orders so that exit rules can be enforced against it
+func (s *Settings) trackSpotExitPosition(ev fill.Event) {
+	amount := ev.GetAmount()
+	price := ev.GetPurchasePrice()
+	if amount.LessThanOrEqual(decimal.Zero) || price.LessThanOrEqual(decimal.Zero) {
+		return
+	}
+	switch ev.GetDirection() {
+	case gctorder.Buy, gctorder.Bid:
+		if s.exitPosition == nil {
+			s.exitPosition = &exitPosition{
+				direction:  gctorder.Long,
+				entryPrice: price,
+				entryTime:  ev.GetTime(),
+				size:       amount,
+				highest:    price,
+				lowest:     price,
+			}
+			return
+		}
+		total := s.exitPosition.size.Add(amount)
+		s.exitPosition.entryPrice = s.exitPosition.entryPrice.Mul(s.exitPosition.size).Add(price.Mul(amount)).Div(total)
+		s.exitPosition.size = total
+	case gctorder.Sell, gctorder.Ask, gctorder.ClosePosition:
+		if s.exitPosition == nil {
+			return
+		}
+		s.exitPosition.size = s.exitPosition.size.Sub(amount)
+		if s.exitPosition.size.LessThanOrEqual(decimal.Zero) {
+			s.exitPosition = nil
+		}
+	}
+}
+
+// syncFuturesExitPosition derives the exit position from the latest futures
+// position, retaining extremes while the same position remains open
+func (s *Settings) syncFuturesExitPosition() {
+	if s.FuturesTracker == nil {
+		s.exitPosition = nil
+		return
+	}
+	positions := s.FuturesTracker.GetPositions()
+	if len(positions) == 0 {
+		s.exitPosition = nil
+		return
+	}
+	pos := positions[len(positions)-1]
+	if pos.Status != gctorder.Open || pos.LatestSize.IsZero() {
+		s.exitPosition = nil
+		return
+	}
+	if s.exitPosition != nil && s.exitPosition.entryTime.Equal(pos.OpeningDate) {
+		s.exitPosition.size = pos.LatestSize
+		s.exitPosition.direction = pos.LatestDirection
+		return
+	}
+	s.exitPosition = &exitPosition{
+		direction:  pos.OpeningDirection,
+		entryPrice: pos.OpeningPrice,
+		entryTime:  pos.OpeningDate,
+		size:       pos.LatestSize,
+		highest:    pos.OpeningPrice,
+		lowest:     pos.OpeningPrice,
+	}
+}
+
+// assess returns the first exit rule triggered by the price. Stop-losses
+// take precedence over trailing stops, take-profits and time-based exits
+func (e *exitPosition) assess(price decimal.Decimal, t time.Time, interval time.Duration, r *exchange.ExitRules) string {
+	isShort := e.direction.IsShort()
+	// adverse reports whether the price has moved against the position beyond the level
+	adverse := func(level decimal.Decimal) bool {
+		if isShort {
+			return price.GreaterThanOrEqual(level)
+		}
+		return price.LessThanOrEqual(level)
+	}
+	favourable := func(level decimal.Decimal) bool {
+		if isShort {
+			return price.LessThanOrEqual(level)
+		}
+		return price.GreaterThanOrEqual(level)
+	}
+	// offset moves the reference price against the position
+	offset := func(reference, distance decimal.Decimal) decimal.Decimal {
+		if isShort {
+			return reference.Add(distance)
+		}
+		return reference.Sub(distance)
+	}
+	extreme := e.highest
+	if isShort {
+		extreme = e.lowest
+	}
+	switch {
+	case r.StopLossPercent.IsPositive() &&
+		adverse(offset(e.entryPrice, e.entryPrice.Mul(r.StopLossPercent).Div(oneHundred))):
+		return StopLossExit
+	case r.StopLossATRMultiple.IsPositive() && e.atr.IsPositive() &&
+		adverse(offset(e.entryPrice, e.atr.Mul(r.StopLossATRMultiple))):
+		return ATRStopLossExit
+	case r.TrailingStopPercent.IsPositive() &&
+		adverse(offset(extreme, extreme.Mul(r.TrailingStopPercent).Div(oneHundred))):
+		return TrailingStopExit
+	case r.TrailingStopATRMultiple.IsPositive() && e.atr.IsPositive() &&
+		adverse(offset(extreme, e.atr.Mul(r.TrailingStopATRMultiple))):
+		return ATRTrailingStopExit
+	case r.TakeProfitPercent.IsPositive() &&
+		favourable(offset(e.entryPrice, e.entryPrice.Mul(r.TakeProfitPercent).Div(oneHundred).Neg())):
+		return TakeProfitExit
+	case r.TakeProfitATRMultiple.IsPositive() && e.atr.IsPositive() &&
+		favourable(offset(e.entryPrice, e.atr.Mul(r.TakeProfitATRMultiple).Neg())):
+		return ATRTakeProfitExit
+	case r.MaximumHoldingPeriods > 0 && interval > 0 &&
+		t.Sub(e.entryTime) >= interval*time.Duration(r.MaximumHoldingPeriods):
+		return TimeExit
+	}
+	return ""
+}
+
+func (e *exitPosition) updateExtremes(price decimal.Decimal) {
+	if price.GreaterThan(e.highest) {
+		e.highest = price
+	}
+	if price.LessThan(e.lowest) {
+		e.lowest = price
+	}
+}
+
+// averageTrueRange calculates the simple average true range over the period
+// using the data handler's history up to and including the latest event
+func averageTrueRange(d data.Handler, period int64) (decimal.Decimal, error) {
+	if period <= 0 {
+		return decimal.Zero, fmt.Errorf("%w %v", errInvalidATRPeriod, period)
+	}
+	history, err := d.History()
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if int64(len(history)) <= period {
+		// not enough data yet, the ATR will be calculated on a later event
+		return decimal.Zero, nil
+	}
+	var total decimal.Decimal
+	for i := int64(len(history)) - period; i < int64(len(history)); i++ {
+		high := history[i].GetHighPrice()
+		low := history[i].GetLowPrice()
+		prevClose := history[i-1].GetClosePrice()
+		trueRange := decimal.Max(high.Sub(low), high.Sub(prevClose).Abs(), low.Sub(prevClose).Abs())
+		total = total.Add(trueRange)
+	}
+	return total.Div(decimal.NewFromInt(period)), nil
+}
+
+func exitRulesEnabled(r *exchange.ExitRules) bool {
+	return r.StopLossPercent.IsPositive() ||
+		r.TakeProfitPercent.IsPositive() ||
+		r.TrailingStopPercent.IsPositive() ||
+		usesATR(r) ||
+		r.MaximumHoldingPeriods > 0
+}
+
+func usesATR(r *exchange.ExitRules) bool {
+	return r.StopLossATRMultiple.IsPositive() ||
+		r.TakeProfitATRMultiple.IsPositive() ||
+		r.TrailingStopATRMultiple.IsPositive()
+}
@@ -0,0 +1,256 @@
+package portfolio
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	datakline "github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func exitRulesTestData(t *testing.T, closes ...int64) *datakline.DataFromKline {
+	t.Helper()
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := make([]data.Event, len(closes))
+	for i := range closes {
+		c := decimal.NewFromInt(closes[i])
+		events[i] = &kline.Kline{
+			Base: &event.Base{
+				Exchange:     testExchange,
+				Time:         tt.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+				Interval:     gctkline.OneDay,
+				CurrencyPair: currency.NewPair(currency.BTC, currency.USDT),
+				AssetType:    asset.Spot,
+			},
+			Open:  c,
+			High:  c.Add(decimal.NewFromInt(2)),
+			Low:   c.Sub(decimal.NewFromInt(2)),
+			Close: c,
+		}
+	}
+	d := &datakline.DataFromKline{Base: &data.Base{}}
+	err := d.SetStream(events)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	return d
+}
+
+func TestCheckExitRules(t *testing.T) {
+	t.Parallel()
+	p := &Portfolio{}
+	_, err := p.CheckExitRules(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	d := exitRulesTestData(t, 100, 95, 89)
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	_, err = p.CheckExitRules(d)
+	if !errors.Is(err, errNoPortfolioSettings) {
+		t.Errorf("received '%v' expected '%v'", err, errNoPortfolioSettings)
+	}
+
+	ff := &binance.Binance{}
+	ff.Name = testExchange
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	err = p.SetCurrencySettingsMap(&exchange.Settings{
+		Exchange: ff,
+		Asset:    asset.Spot,
+		Pair:     cp,
+		ExitRules: exchange.ExitRules{
+			StopLossPercent: decimal.NewFromInt(10),
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	s, err := p.CheckExitRules(d)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s != nil {
+		t.Error("expected no exit without an open position")
+	}
+
+	latest, err := d.Latest()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	settings := p.exchangeAssetPairPortfolioSettings[testExchange][asset.Spot][cp.Base.Item][cp.Quote.Item]
+	settings.trackSpotExitPosition(&fill.Fill{
+		Base:          latest.GetBase(),
+		Direction:     gctorder.Buy,
+		Amount:        decimal.NewFromInt(2),
+		PurchasePrice: decimal.NewFromInt(100),
+	})
+	s, err = p.CheckExitRules(d)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s != nil {
+		t.Error("expected no exit on the entry candle")
+	}
+
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	s, err = p.CheckExitRules(d)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s != nil {
+		t.Error("expected no exit above the stop-loss")
+	}
+
+	_, err = d.Next()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	s, err = p.CheckExitRules(d)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if s == nil {
+		t.Fatal("expected stop-loss exit")
+	}
+	if s.GetExitRule() != StopLossExit {
+		t.Errorf("received '%v' expected '%v'", s.GetExitRule(), StopLossExit)
+	}
+	if s.GetDirection() != gctorder.ClosePosition {
+		t.Errorf("received '%v' expected '%v'", s.GetDirection(), gctorder.ClosePosition)
+	}
+	if !s.GetAmount().Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", s.GetAmount(), 2)
+	}
+}
+
+func TestTrackSpotExitPosition(t *testing.T) {
+	t.Parallel()
+	s := &Settings{}
+	b := &event.Base{Time: time.Now()}
+	s.trackSpotExitPosition(&fill.Fill{Base: b, Direction: gctorder.Sell, Amount: decimal.NewFromInt(1), PurchasePrice: decimal.NewFromInt(100)})
+	if s.exitPosition != nil {
+		t.Error("expected no position from a sell without holdings")
+	}
+	s.trackSpotExitPosition(&fill.Fill{Base: b, Direction: gctorder.Buy, Amount: decimal.NewFromInt(1), PurchasePrice: decimal.NewFromInt(100)})
+	s.trackSpotExitPosition(&fill.Fill{Base: b, Direction: gctorder.Buy, Amount: decimal.NewFromInt(1), PurchasePrice: decimal.NewFromInt(200)})
+	if s.exitPosition == nil {
+		t.Fatal("expected position")
+	}
+	if !s.exitPosition.entryPrice.Equal(decimal.NewFromInt(150)) {
+		t.Errorf("received '%v' expected '%v'", s.exitPosition.entryPrice, 150)
+	}
+	if !s.exitPosition.size.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", s.exitPosition.size, 2)
+	}
+	s.trackSpotExitPosition(&fill.Fill{Base: b, Direction: gctorder.Sell, Amount: decimal.NewFromInt(2), PurchasePrice: decimal.NewFromInt(150)})
+	if s.exitPosition != nil {
+		t.Error("expected position to be closed")
+	}
+}
+
+func TestAssess(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	long := func() *exitPosition {
+		return &exitPosition{
+			direction:  gctorder.Long,
+			entryPrice: decimal.NewFromInt(100),
+			entryTime:  tt,
+			highest:    decimal.NewFromInt(120),
+			lowest:     decimal.NewFromInt(100),
+			atr:        decimal.NewFromInt(5),
+		}
+	}
+	short := func() *exitPosition {
+		return &exitPosition{
+			direction:  gctorder.Short,
+			entryPrice: decimal.NewFromInt(100),
+			entryTime:  tt,
+			highest:    decimal.NewFromInt(100),
+			lowest:     decimal.NewFromInt(80),
+			atr:        decimal.NewFromInt(5),
+		}
+	}
+	day := gctkline.OneDay.Duration()
+	testCases := []struct {
+		name     string
+		pos      *exitPosition
+		price    int64
+		elapsed  time.Duration
+		rules    exchange.ExitRules
+		expected string
+	}{
+		{"long stop-loss", long(), 90, day, exchange.ExitRules{StopLossPercent: decimal.NewFromInt(10)}, StopLossExit},
+		{"long stop-loss untouched", long(), 91, day, exchange.ExitRules{StopLossPercent: decimal.NewFromInt(10)}, ""},
+		{"short stop-loss", short(), 110, day, exchange.ExitRules{StopLossPercent: decimal.NewFromInt(10)}, StopLossExit},
+		{"long atr stop-loss", long(), 90, day, exchange.ExitRules{StopLossATRMultiple: decimal.NewFromInt(2)}, ATRStopLossExit},
+		{"long trailing stop", long(), 108, day, exchange.ExitRules{TrailingStopPercent: decimal.NewFromInt(10)}, TrailingStopExit},
+		{"short trailing stop", short(), 88, day, exchange.ExitRules{TrailingStopPercent: decimal.NewFromInt(10)}, TrailingStopExit},
+		{"long atr trailing stop", long(), 110, day, exchange.ExitRules{TrailingStopATRMultiple: decimal.NewFromInt(2)}, ATRTrailingStopExit},
+		{"long take-profit", long(), 110, day, exchange.ExitRules{TakeProfitPercent: decimal.NewFromInt(10)}, TakeProfitExit},
+		{"short take-profit", short(), 90, day, exchange.ExitRules{TakeProfitPercent: decimal.NewFromInt(10)}, TakeProfitExit},
+		{"short atr take-profit", short(), 85, day, exchange.ExitRules{TakeProfitATRMultiple: decimal.NewFromInt(3)}, ATRTakeProfitExit},
+		{"time exit", long(), 100, day * 3, exchange.ExitRules{MaximumHoldingPeriods: 3}, TimeExit},
+		{"time exit pending", long(), 100, day * 2, exchange.ExitRules{MaximumHoldingPeriods: 3}, ""},
+		{"stop-loss precedence", long(), 80, day * 3, exchange.ExitRules{StopLossPercent: decimal.NewFromInt(10), MaximumHoldingPeriods: 1}, StopLossExit},
+	}
+	for i := range testCases {
+		tc := testCases[i]
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rule := tc.pos.assess(decimal.NewFromInt(tc.price), tt.Add(tc.elapsed), day, &tc.rules)
+			if rule != tc.expected {
+				t.Errorf("received '%v' expected '%v'", rule, tc.expected)
+			}
+		})
+	}
+}
+
+func TestAverageTrueRange(t *testing.T) {
+	t.Parallel()
+	d := exitRulesTestData(t, 100, 104, 100)
+	_, err := averageTrueRange(d, 0)
+	if !errors.Is(err, errInvalidATRPeriod) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidATRPeriod)
+	}
+	for i := 0; i < 3; i++ {
+		_, err = d.Next()
+		if !errors.Is(err, nil) {
+			t.Fatalf("received '%v' expected '%v'", err, nil)
+		}
+	}
+	atr, err := averageTrueRange(d, 3)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !atr.IsZero() {
+		t.Errorf("received '%v' expected '%v'", atr, 0)
+	}
+	atr, err = averageTrueRange(d, 2)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	// each candle gaps 4 from the previous close with a range of 4,
+	// producing a true range of 6
+	if !atr.Equal(decimal.NewFromInt(6)) {
+		t.Errorf("received '%v' expected '%v'", atr, 6)
+	}
+}
@@ -96,8 +96,9 @@ func (h *Holding) update(e fill.Event, f funding.IFundReader) error {
 		if err != nil {
 			return err
 		}
-		h.BaseSize = spotR.BaseAvailable()
-		h.QuoteSize = spotR.QuoteAvailable()
+		// borrowed funds are owed, so sizes are net of spot margin borrowing
+		h.BaseSize = spotR.BaseAvailable().Sub(spotR.BaseBorrowed())
+		h.QuoteSize = spotR.QuoteAvailable().Sub(spotR.QuoteBorrowed())
 	case a.IsFutures():
 		collat, err := f.GetCollateralReader()
 		if err != nil {
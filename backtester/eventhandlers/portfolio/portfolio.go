@@ -50,6 +50,7 @@ func (p *Portfolio) OnSignal(ev signal.Event, exchangeSettings *exchange.Setting
 		FillDependentEvent: ev.GetFillDependentEvent(),
 		Amount:             ev.GetAmount(),
 		ClosePrice:         ev.GetClosePrice(),
+		ExitRule:           ev.GetExitRule(),
 	}
 	if ev.GetDirection() == gctorder.UnknownSide {
 		return o, errInvalidDirection
@@ -74,6 +75,13 @@ func (p *Portfolio) OnSignal(ev signal.Event, exchangeSettings *exchange.Setting
 	}
 
 	o.OrderType = gctorder.Market
+	if ev.GetOrderType() != gctorder.UnknownType {
+		o.OrderType = ev.GetOrderType()
+		o.LimitPrice = ev.GetLimitPrice()
+		o.TriggerPrice = ev.GetTriggerPrice()
+		o.GoodTillTime = ev.GetGoodTillTime()
+		o.ClientOrderID = ev.GetClientOrderID()
+	}
 	o.BuyLimit = ev.GetBuyLimit()
 	o.SellLimit = ev.GetSellLimit()
 	var sizingFunds decimal.Decimal
@@ -86,11 +94,12 @@ func (p *Portfolio) OnSignal(ev signal.Event, exchangeSettings *exchange.Setting
 		if err != nil {
 			return nil, err
 		}
+		// spot margin pairs can size orders using borrowable funds
 		switch side {
 		case gctorder.Buy, gctorder.Bid:
-			sizingFunds = pReader.QuoteAvailable()
+			sizingFunds = pReader.QuoteAvailable().Add(pReader.QuoteBorrowable())
 		case gctorder.Sell, gctorder.Ask:
-			sizingFunds = pReader.BaseAvailable()
+			sizingFunds = pReader.BaseAvailable().Add(pReader.BaseBorrowable())
 		}
 	} else if ev.GetAssetType().IsFutures() {
 		if ev.GetDirection() == gctorder.ClosePosition {
@@ -254,6 +263,15 @@ func (p *Portfolio) OnFill(ev fill.Event, funds funding.IFundReleaser) (fill.Eve
 	if err != nil {
 		return nil, err
 	}
+	if ev.GetAssetType() == asset.Spot {
+		lookup.trackSpotExitPosition(ev)
+	}
+	if p.sizeManager != nil {
+		err = p.sizeManager.TrackFill(ev)
+		if err != nil {
+			return nil, err
+		}
+	}
 	err = p.SetHoldingsForTimestamp(h)
 	if err != nil {
 		return nil, err
@@ -381,6 +399,68 @@ func (p *Portfolio) UpdatePNL(e common.Event, closePrice decimal.Decimal) error
 	return nil
 }
 
+// ProcessFundingRates applies any perpetual funding rates which have occurred
+// up to and including the event time to the latest open position. The event
+// close price is used as the mark price to value the position. A positive
+// rate is paid by longs to shorts. The sum of payments is returned so it can
+// be realised against the exchange's collateral
+func (p *Portfolio) ProcessFundingRates(ev data.Event) (decimal.Decimal, error) {
+	settings, err := p.getFuturesSettingsFromEvent(ev)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if !settings.isPerpetual {
+		return decimal.Zero, nil
+	}
+	var due []gctorder.FundingRate
+	for ; settings.fundingRateIndex < len(settings.fundingRates); settings.fundingRateIndex++ {
+		if settings.fundingRates[settings.fundingRateIndex].Time.After(ev.GetTime()) {
+			break
+		}
+		due = append(due, settings.fundingRates[settings.fundingRateIndex])
+	}
+	if len(due) == 0 {
+		return decimal.Zero, nil
+	}
+	positions := settings.FuturesTracker.GetPositions()
+	if len(positions) == 0 {
+		return decimal.Zero, nil
+	}
+	pos := positions[len(positions)-1]
+	if pos.Status.IsInactive() || !pos.LatestSize.IsPositive() {
+		return decimal.Zero, nil
+	}
+
+	notional := pos.LatestSize
+	if !pos.CollateralCurrency.Equal(pos.Underlying) {
+		// linear contracts are valued in the quote currency
+		notional = notional.Mul(ev.GetClosePrice())
+	}
+	var sum decimal.Decimal
+	for i := range due {
+		due[i].Payment = notional.Mul(due[i].Rate)
+		if pos.LatestDirection.IsLong() {
+			due[i].Payment = due[i].Payment.Neg()
+		}
+		sum = sum.Add(due[i].Payment)
+	}
+	err = settings.FuturesTracker.TrackFundingDetails(&gctorder.FundingRates{
+		Exchange:     ev.GetExchange(),
+		Asset:        ev.GetAssetType(),
+		Pair:         ev.Pair(),
+		StartDate:    due[0].Time,
+		EndDate:      due[len(due)-1].Time,
+		LatestRate:   due[len(due)-1],
+		FundingRates: due,
+		PaymentSum:   sum,
+	})
+	if err != nil {
+		return decimal.Zero, err
+	}
+	settings.fundingPayments = settings.fundingPayments.Add(sum)
+	return sum, nil
+}
+
 // TrackFuturesOrder updates the futures tracker with a new order
 // from a fill event
 func (p *Portfolio) TrackFuturesOrder(ev fill.Event, fund funding.IFundReleaser) (*PNLSummary, error) {
@@ -455,6 +535,11 @@ func (p *Portfolio) GetLatestPNLForEvent(e common.Event) (*PNLSummary, error) {
 		Pair:     e.Pair(),
 		Offset:   e.GetOffset(),
 	}
+	settings, err := p.getFuturesSettingsFromEvent(e)
+	if err != nil {
+		return nil, err
+	}
+	response.FundingPayments = settings.fundingPayments
 	position, err := p.GetLatestPosition(e)
 	if err != nil {
 		return nil, err
@@ -637,6 +722,12 @@ func (p *Portfolio) UpdateHoldings(e data.Event, funds funding.IFundReleaser) er
 	if err != nil {
 		return fmt.Errorf("%v %v %v %w", e.GetExchange(), e.GetAssetType(), e.Pair(), err)
 	}
+	if p.sizeManager != nil {
+		err = p.sizeManager.TrackData(e)
+		if err != nil {
+			return err
+		}
+	}
 	h, err := settings.GetLatestHoldings()
 	if err != nil {
 		if !errors.Is(err, errNoHoldings) {
@@ -749,8 +840,8 @@ func (p *Portfolio) SetHoldingsForEvent(fm funding.IFundReader, e common.Event)
 		if err != nil {
 			return err
 		}
-		h.BaseSize = pr.BaseAvailable()
-		h.QuoteSize = pr.QuoteAvailable()
+		h.BaseSize = pr.BaseAvailable().Sub(pr.BaseBorrowed())
+		h.QuoteSize = pr.QuoteAvailable().Sub(pr.QuoteBorrowed())
 	}
 	err = h.UpdateValue(e)
 	if err != nil {
@@ -777,6 +868,16 @@ func (p *PNLSummary) GetRealisedPNL() BasicPNLResult {
 	}
 }
 
+// GetFundingPayments returns a basic struct containing the sum of
+// funding payments made or received
+func (p *PNLSummary) GetFundingPayments() BasicPNLResult {
+	return BasicPNLResult{
+		Time:     p.Result.Time,
+		PNL:      p.FundingPayments,
+		Currency: p.CollateralCurrency,
+	}
+}
+
 // GetExposure returns the position exposure
 func (p *PNLSummary) GetExposure() decimal.Decimal {
 	return p.Result.Exposure
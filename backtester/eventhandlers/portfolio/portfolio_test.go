@@ -1700,3 +1700,142 @@ func TestSetHoldingsForEvent(t *testing.T) {
 		t.Errorf("received '%v', expected '%v'", err, nil)
 	}
 }
+
+func TestProcessFundingRates(t *testing.T) {
+	t.Parallel()
+	p := &Portfolio{}
+	_, err := p.ProcessFundingRates(nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Fatalf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	tt := time.Now().Truncate(time.Hour)
+	ev := &kline.Kline{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			CurrencyPair: currency.NewPair(currency.BTC, currency.USDT),
+			AssetType:    asset.USDTMarginedFutures,
+			Time:         tt,
+		},
+		Close: decimal.NewFromInt(100),
+	}
+	_, err = p.ProcessFundingRates(ev)
+	if !errors.Is(err, errNoPortfolioSettings) {
+		t.Fatalf("received '%v' expected '%v'", err, errNoPortfolioSettings)
+	}
+
+	mpt, err := gctorder.SetupMultiPositionTracker(&gctorder.MultiPositionTrackerSetup{
+		Exchange:           testExchange,
+		Asset:              ev.AssetType,
+		Pair:               ev.Pair(),
+		Underlying:         currency.BTC,
+		CollateralCurrency: currency.USDT,
+		OfflineCalculation: true,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v', expected '%v'", err, nil)
+	}
+	s := &Settings{
+		FuturesTracker: mpt,
+		fundingRates: []gctorder.FundingRate{
+			{Time: tt.Add(-time.Hour), Rate: decimal.NewFromFloat(0.01)},
+			{Time: tt, Rate: decimal.NewFromFloat(0.01)},
+			{Time: tt.Add(time.Hour), Rate: decimal.NewFromFloat(-0.01)},
+		},
+	}
+	p.exchangeAssetPairPortfolioSettings = make(map[string]map[asset.Item]map[*currency.Item]map[*currency.Item]*Settings)
+	p.exchangeAssetPairPortfolioSettings[testExchange] = make(map[asset.Item]map[*currency.Item]map[*currency.Item]*Settings)
+	p.exchangeAssetPairPortfolioSettings[testExchange][ev.AssetType] = make(map[*currency.Item]map[*currency.Item]*Settings)
+	p.exchangeAssetPairPortfolioSettings[testExchange][ev.AssetType][ev.Pair().Base.Item] = make(map[*currency.Item]*Settings)
+	p.exchangeAssetPairPortfolioSettings[testExchange][ev.AssetType][ev.Pair().Base.Item][ev.Pair().Quote.Item] = s
+
+	payment, err := p.ProcessFundingRates(ev)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !payment.IsZero() {
+		t.Errorf("received '%v' expected '%v'", payment, decimal.Zero)
+	}
+
+	s.isPerpetual = true
+	payment, err = p.ProcessFundingRates(ev)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !payment.IsZero() {
+		t.Errorf("received '%v' expected '%v'", payment, decimal.Zero)
+	}
+	if s.fundingRateIndex != 2 {
+		t.Errorf("received '%v' expected '%v'", s.fundingRateIndex, 2)
+	}
+
+	err = s.FuturesTracker.TrackNewOrder(&gctorder.Detail{
+		Exchange:  ev.GetExchange(),
+		AssetType: ev.AssetType,
+		Pair:      ev.Pair(),
+		Amount:    2,
+		Price:     100,
+		OrderID:   "one",
+		Date:      tt,
+		Side:      gctorder.Long,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	ev.Time = tt.Add(time.Hour)
+	ev.Close = decimal.NewFromInt(200)
+	payment, err = p.ProcessFundingRates(ev)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	// a negative rate is paid from shorts to longs, 2 * 200 * 0.01
+	if !payment.Equal(decimal.NewFromInt(4)) {
+		t.Errorf("received '%v' expected '%v'", payment, 4)
+	}
+	if !s.fundingPayments.Equal(decimal.NewFromInt(4)) {
+		t.Errorf("received '%v' expected '%v'", s.fundingPayments, 4)
+	}
+	positions := s.FuturesTracker.GetPositions()
+	if len(positions[0].FundingRates.FundingRates) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(positions[0].FundingRates.FundingRates), 1)
+	}
+
+	pnl, err := p.GetLatestPNLForEvent(ev)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !pnl.FundingPayments.Equal(decimal.NewFromInt(4)) {
+		t.Errorf("received '%v' expected '%v'", pnl.FundingPayments, 4)
+	}
+
+	// no rates remain
+	ev.Time = tt.Add(time.Hour * 2)
+	payment, err = p.ProcessFundingRates(ev)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !payment.IsZero() {
+		t.Errorf("received '%v' expected '%v'", payment, decimal.Zero)
+	}
+}
+
+func TestGetFundingPayments(t *testing.T) {
+	t.Parallel()
+	p := PNLSummary{
+		CollateralCurrency: currency.USDT,
+		FundingPayments:    leet,
+		Result: gctorder.PNLResult{
+			Time: time.Now(),
+		},
+	}
+	result := p.GetFundingPayments()
+	if !result.PNL.Equal(leet) {
+		t.Errorf("received '%v' expected '%v'", result.PNL, leet)
+	}
+	if !result.Time.Equal(p.Result.Time) {
+		t.Errorf("received '%v' expected '%v'", result.Time, p.Result.Time)
+	}
+	if !result.Currency.Equal(currency.USDT) {
+		t.Errorf("received '%v' expected '%v'", result.Currency, currency.USDT)
+	}
+}
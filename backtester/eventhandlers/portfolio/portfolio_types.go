@@ -23,6 +23,17 @@ import (
 
 const notEnoughFundsTo = "not enough funds to"
 
+// Exit rules which can close a position, used to tag exit signals
+const (
+	StopLossExit        = "stop-loss"
+	ATRStopLossExit     = "atr-stop-loss"
+	TrailingStopExit    = "trailing-stop"
+	ATRTrailingStopExit = "atr-trailing-stop"
+	TakeProfitExit      = "take-profit"
+	ATRTakeProfitExit   = "atr-take-profit"
+	TimeExit            = "time-exit"
+)
+
 var (
 	errInvalidDirection     = errors.New("invalid direction")
 	errRiskManagerUnset     = errors.New("risk manager unset")
@@ -35,6 +46,7 @@ var (
 	errNoHoldings           = errors.New("no holdings found")
 	errHoldingsNoTimestamp  = errors.New("holding with unset timestamp received")
 	errUnsetFuturesTracker  = errors.New("portfolio settings futures tracker unset")
+	errInvalidATRPeriod     = errors.New("invalid ATR period")
 )
 
 // Portfolio stores all holdings and rules to assess orders, allowing the portfolio manager to
@@ -58,6 +70,7 @@ type Handler interface {
 	GetPositions(common.Event) ([]gctorder.Position, error)
 	TrackFuturesOrder(fill.Event, funding.IFundReleaser) (*PNLSummary, error)
 	UpdatePNL(common.Event, decimal.Decimal) error
+	ProcessFundingRates(data.Event) (decimal.Decimal, error)
 	GetLatestPNLForEvent(common.Event) (*PNLSummary, error)
 	CheckLiquidationStatus(data.Event, funding.ICollateralReader, *PNLSummary) error
 	CreateLiquidationOrdersForExchange(data.Event, funding.IFundingManager) ([]order.Event, error)
@@ -65,11 +78,14 @@ type Handler interface {
 	Reset() error
 	SetHoldingsForEvent(funding.IFundReader, common.Event) error
 	GetLatestComplianceSnapshot(string, asset.Item, currency.Pair) (*compliance.Snapshot, error)
+	CheckExitRules(data.Handler) (signal.Event, error)
 }
 
 // SizeHandler is the interface to help size orders
 type SizeHandler interface {
 	SizeOrder(order.Event, decimal.Decimal, *exchange.Settings) (*order.Order, decimal.Decimal, error)
+	TrackData(data.Event) error
+	TrackFill(fill.Event) error
 }
 
 // Settings holds all important information for the portfolio manager
@@ -86,6 +102,28 @@ type Settings struct {
 	ComplianceManager compliance.Manager
 	Exchange          gctexchange.IBotExchange
 	FuturesTracker    *gctorder.MultiPositionTracker
+
+	isPerpetual      bool
+	fundingRates     []gctorder.FundingRate
+	fundingRateIndex int
+	fundingPayments  decimal.Decimal
+
+	exitRules    exchange.ExitRules
+	exitPosition *exitPosition
+}
+
+// exitPosition tracks the open position which exit rules are enforced against
+type exitPosition struct {
+	direction  gctorder.Side
+	entryPrice decimal.Decimal
+	entryTime  time.Time
+	size       decimal.Decimal
+	// highest and lowest track the extreme close prices since
+	// entry for trailing stops
+	highest decimal.Decimal
+	lowest  decimal.Decimal
+	// atr is the average true range when the position was first assessed
+	atr decimal.Decimal
 }
 
 // PNLSummary holds a PNL result along with
@@ -97,6 +135,7 @@ type PNLSummary struct {
 	CollateralCurrency currency.Code
 	Offset             int64
 	Result             gctorder.PNLResult
+	FundingPayments    decimal.Decimal
 }
 
 // IPNL defines an interface for an implementation
@@ -104,6 +143,7 @@ type PNLSummary struct {
 type IPNL interface {
 	GetUnrealisedPNL() BasicPNLResult
 	GetRealisedPNL() BasicPNLResult
+	GetFundingPayments() BasicPNLResult
 	GetCollateralCurrency() currency.Code
 	GetDirection() gctorder.Side
 	GetPositionStatus() gctorder.Status
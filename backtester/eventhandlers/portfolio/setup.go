@@ -87,6 +87,7 @@ func (p *Portfolio) SetCurrencySettingsMap(setup *exchange.Settings) error {
 		SellSideSizing:    setup.SellSide,
 		Leverage:          setup.Leverage,
 		HoldingsSnapshots: make(map[int64]*holdings.Holding),
+		exitRules:         setup.ExitRules,
 	}
 	if setup.Asset.IsFutures() {
 		collateralCurrency, _, err := setup.Exchange.GetCollateralCurrencyForContract(setup.Asset, setup.Pair)
@@ -111,6 +112,8 @@ func (p *Portfolio) SetCurrencySettingsMap(setup *exchange.Settings) error {
 			return err
 		}
 		settings.FuturesTracker = tracker
+		settings.isPerpetual = setup.IsPerpetual
+		settings.fundingRates = setup.FundingRates
 	}
 	m3[setup.Pair.Quote.Item] = settings
 	return nil
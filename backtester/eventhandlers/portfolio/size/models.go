@@ -0,0 +1,334 @@
+package size
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var oneHundred = decimal.NewFromInt(100)
+
+// TrackData stores the latest candle of a currency so that volatility based
+// sizing models can be calculated. Candles at or before the latest tracked
+// candle are ignored
+func (s *Size) TrackData(ev data.Event) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	if !s.usesVolatility() {
+		return nil
+	}
+	t := s.getTracker(ev)
+	if !t.lastCandle.IsZero() && !ev.GetTime().After(t.lastCandle) {
+		return nil
+	}
+	t.lastCandle = ev.GetTime()
+	t.interval = ev.GetInterval()
+	t.highs = append(t.highs, ev.GetHighPrice())
+	t.lows = append(t.lows, ev.GetLowPrice())
+	t.closes = append(t.closes, ev.GetClosePrice())
+	if limit := int(s.Model.VolatilityPeriod) + 1; len(t.closes) > limit {
+		t.highs = t.highs[len(t.highs)-limit:]
+		t.lows = t.lows[len(t.lows)-limit:]
+		t.closes = t.closes[len(t.closes)-limit:]
+	}
+	return nil
+}
+
+// TrackFill maintains the open trade of a currency from fill events,
+// recording the return of each completed trade for Kelly sizing models
+func (s *Size) TrackFill(ev fill.Event) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	if s.Model.Name != Kelly && s.Model.Name != HalfKelly {
+		return nil
+	}
+	amount := ev.GetAmount()
+	price := ev.GetPurchasePrice()
+	if amount.LessThanOrEqual(decimal.Zero) || price.LessThanOrEqual(decimal.Zero) {
+		return nil
+	}
+	var direction decimal.Decimal
+	switch ev.GetDirection() {
+	case gctorder.Buy, gctorder.Bid, gctorder.Long:
+		direction = decimal.NewFromInt(1)
+	case gctorder.Sell, gctorder.Ask, gctorder.Short:
+		direction = decimal.NewFromInt(-1)
+	default:
+		return nil
+	}
+	t := s.getTracker(ev)
+	if ev.GetAssetType() == asset.Spot && direction.IsNegative() {
+		// spot holdings cannot be shorted, only sales of tracked
+		// purchases complete a trade
+		if !t.position.IsPositive() {
+			return nil
+		}
+		amount = decimal.Min(amount, t.position)
+	}
+	t.addFill(direction, amount, price, ev.GetExchangeFee())
+	return nil
+}
+
+// addFill updates the open trade with a fill, completing the trade when the
+// position is closed or reversed
+func (t *tracker) addFill(direction, amount, price, fee decimal.Decimal) {
+	if t.position.IsZero() || t.position.Sign() == direction.Sign() {
+		size := t.position.Abs()
+		t.entryPrice = t.entryPrice.Mul(size).Add(price.Mul(amount)).Div(size.Add(amount))
+		t.position = t.position.Add(direction.Mul(amount))
+		t.tradeCost = t.tradeCost.Add(price.Mul(amount))
+		t.tradePNL = t.tradePNL.Sub(fee)
+		return
+	}
+	closed := decimal.Min(amount, t.position.Abs())
+	t.tradePNL = t.tradePNL.Add(price.Sub(t.entryPrice).Mul(closed).Mul(decimal.NewFromInt(int64(t.position.Sign())))).Sub(fee)
+	t.position = t.position.Add(direction.Mul(amount))
+	if t.position.Sign() == -direction.Sign() {
+		// the trade remains partially open
+		return
+	}
+	if t.tradeCost.IsPositive() {
+		t.returns = append(t.returns, t.tradePNL.Div(t.tradeCost))
+	}
+	t.entryPrice = decimal.Zero
+	t.tradeCost = decimal.Zero
+	t.tradePNL = decimal.Zero
+	if remaining := amount.Sub(closed); remaining.IsPositive() {
+		// the position has reversed, opening a new trade
+		t.entryPrice = price
+		t.tradeCost = price.Mul(remaining)
+	}
+}
+
+// applyModel returns the portion of available funds the sizing model allows
+// the order to use, appending the sizing decision to the order's reasons
+func (s *Size) applyModel(o *order.Order, amountAvailable decimal.Decimal) (decimal.Decimal, error) {
+	var fraction decimal.Decimal
+	var reason string
+	switch s.Model.Name {
+	case FixedFractional:
+		fraction = s.Model.Fraction
+		reason = fmt.Sprintf("%v sizing", FixedFractional)
+	case VolatilityTarget:
+		volatility, err := s.getTracker(o).volatility(s.Model.VolatilityMeasure, s.Model.VolatilityPeriod)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		fraction = decimal.Min(s.Model.TargetVolatility.Div(volatility), s.maximumFraction())
+		reason = fmt.Sprintf("%v sizing with %v volatility of %v%% against a target of %v%%",
+			VolatilityTarget,
+			s.Model.VolatilityMeasure,
+			volatility.Mul(oneHundred).Round(2),
+			s.Model.TargetVolatility.Mul(oneHundred).Round(2))
+	case Kelly, HalfKelly:
+		var err error
+		fraction, reason, err = s.kellyFraction(s.getTracker(o))
+		if err != nil {
+			return decimal.Zero, err
+		}
+	case RiskParity:
+		volatility, err := s.getTracker(o).volatility(s.Model.VolatilityMeasure, s.Model.VolatilityPeriod)
+		if err != nil {
+			return decimal.Zero, err
+		}
+		weight, currencies := s.riskParityWeight(volatility)
+		fraction = s.Model.Fraction
+		if fraction.IsZero() {
+			fraction = decimal.NewFromInt(1)
+		}
+		fraction = decimal.Min(fraction.Mul(weight), s.maximumFraction())
+		reason = fmt.Sprintf("%v sizing with %v volatility of %v%% weighted %v%% across %v currencies",
+			RiskParity,
+			s.Model.VolatilityMeasure,
+			volatility.Mul(oneHundred).Round(2),
+			weight.Mul(oneHundred).Round(2),
+			currencies)
+	default:
+		return decimal.Zero, fmt.Errorf("%w '%v'", errUnknownModel, s.Model.Name)
+	}
+	sizingFunds := amountAvailable.Mul(fraction)
+	o.AppendReasonf("%v using %v%% of available funds %v",
+		reason,
+		fraction.Mul(oneHundred).Round(2),
+		sizingFunds.Round(8))
+	return sizingFunds, nil
+}
+
+// kellyFraction calculates the Kelly criterion from the tracker's recent
+// trades, falling back to the fixed fraction until enough trades are made
+func (s *Size) kellyFraction(t *tracker) (fraction decimal.Decimal, reason string, err error) {
+	returns := t.returns
+	if s.Model.KellyWindow > 0 && int64(len(returns)) > s.Model.KellyWindow {
+		returns = returns[int64(len(returns))-s.Model.KellyWindow:]
+	}
+	minimumTrades := s.Model.KellyMinimumTrades
+	if minimumTrades < 1 {
+		minimumTrades = 1
+	}
+	if int64(len(returns)) < minimumTrades {
+		return s.Model.Fraction, fmt.Sprintf("%v sizing with fixed fraction as %v of %v required trades have completed", s.Model.Name, len(returns), minimumTrades), nil
+	}
+	var wins, totalWin, totalLoss decimal.Decimal
+	for i := range returns {
+		if returns[i].IsPositive() {
+			wins = wins.Add(decimal.NewFromInt(1))
+			totalWin = totalWin.Add(returns[i])
+		} else {
+			totalLoss = totalLoss.Add(returns[i].Abs())
+		}
+	}
+	trades := decimal.NewFromInt(int64(len(returns)))
+	winRate := wins.Div(trades)
+	losses := trades.Sub(wins)
+	var winLossRatio decimal.Decimal
+	switch {
+	case wins.IsZero():
+		fraction = decimal.Zero
+	case totalLoss.IsZero():
+		// no losing trades, the criterion is unbounded
+		fraction = s.maximumFraction()
+	default:
+		// f = W - (1 - W) / R
+		winLossRatio = totalWin.Div(wins).Div(totalLoss.Div(losses))
+		fraction = winRate.Sub(decimal.NewFromInt(1).Sub(winRate).Div(winLossRatio))
+	}
+	if s.Model.Name == HalfKelly {
+		fraction = fraction.Div(decimal.NewFromInt(2))
+	}
+	if !fraction.IsPositive() {
+		return decimal.Zero, "", fmt.Errorf("%w, win rate %v%% over %v trades", errNoEdge, winRate.Mul(oneHundred).Round(2), len(returns))
+	}
+	fraction = decimal.Min(fraction, s.maximumFraction())
+	return fraction, fmt.Sprintf("%v sizing with win rate %v%% and win/loss ratio %v over %v trades",
+		s.Model.Name,
+		winRate.Mul(oneHundred).Round(2),
+		winLossRatio.Round(4),
+		len(returns)), nil
+}
+
+// riskParityWeight returns the inverse volatility weight of a currency
+// against all tracked currencies with enough data to calculate volatility
+func (s *Size) riskParityWeight(volatility decimal.Decimal) (weight decimal.Decimal, currencies int) {
+	var totalInverse decimal.Decimal
+	for _, exchangeMap := range s.trackers {
+		for _, assetMap := range exchangeMap {
+			for _, baseMap := range assetMap {
+				for _, t := range baseMap {
+					v, err := t.volatility(s.Model.VolatilityMeasure, s.Model.VolatilityPeriod)
+					if err != nil {
+						continue
+					}
+					totalInverse = totalInverse.Add(decimal.NewFromInt(1).Div(v))
+					currencies++
+				}
+			}
+		}
+	}
+	if totalInverse.IsZero() {
+		return decimal.Zero, currencies
+	}
+	return decimal.NewFromInt(1).Div(volatility).Div(totalInverse), currencies
+}
+
+// volatility calculates the annualised volatility of the tracked candles
+func (t *tracker) volatility(measure string, period int64) (decimal.Decimal, error) {
+	if period <= 0 || int64(len(t.closes)) <= period || t.interval.Duration() <= 0 {
+		return decimal.Zero, fmt.Errorf("%w, %v of %v candles", errNotEnoughData, len(t.closes), period+1)
+	}
+	var perInterval float64
+	switch measure {
+	case RealisedVolatility:
+		returns := make([]float64, 0, period)
+		for i := len(t.closes) - int(period); i < len(t.closes); i++ {
+			if !t.closes[i-1].IsPositive() || !t.closes[i].IsPositive() {
+				return decimal.Zero, fmt.Errorf("%w, invalid close price", errNotEnoughData)
+			}
+			returns = append(returns, math.Log(t.closes[i].Div(t.closes[i-1]).InexactFloat64()))
+		}
+		var err error
+		perInterval, err = gctmath.SampleStandardDeviation(returns)
+		if err != nil {
+			return decimal.Zero, err
+		}
+	case ATRVolatility:
+		var total decimal.Decimal
+		for i := len(t.closes) - int(period); i < len(t.closes); i++ {
+			prevClose := t.closes[i-1]
+			total = total.Add(decimal.Max(t.highs[i].Sub(t.lows[i]), t.highs[i].Sub(prevClose).Abs(), t.lows[i].Sub(prevClose).Abs()))
+		}
+		latest := t.closes[len(t.closes)-1]
+		if !latest.IsPositive() {
+			return decimal.Zero, fmt.Errorf("%w, invalid close price", errNotEnoughData)
+		}
+		perInterval = total.Div(decimal.NewFromInt(period)).Div(latest).InexactFloat64()
+	default:
+		return decimal.Zero, fmt.Errorf("%w '%v'", errUnknownMeasure, measure)
+	}
+	if perInterval <= 0 || math.IsNaN(perInterval) {
+		return decimal.Zero, fmt.Errorf("%w, volatility is zero", errNotEnoughData)
+	}
+	intervalsPerYear := float64(gctkline.OneYear.Duration()) / float64(t.interval.Duration())
+	return decimal.NewFromFloat(perInterval * math.Sqrt(intervalsPerYear)), nil
+}
+
+func (s *Size) maximumFraction() decimal.Decimal {
+	if s.Model.MaximumFraction.IsPositive() {
+		return s.Model.MaximumFraction
+	}
+	return decimal.NewFromInt(1)
+}
+
+func (s *Size) usesVolatility() bool {
+	return s.Model.Name == VolatilityTarget || s.Model.Name == RiskParity
+}
+
+// getTracker returns the tracker for the event's currency, creating it when
+// it does not exist
+func (s *Size) getTracker(ev common.Event) *tracker {
+	if s.trackers == nil {
+		s.trackers = make(map[string]map[asset.Item]map[*currency.Item]map[*currency.Item]*tracker)
+	}
+	exchangeMap, ok := s.trackers[ev.GetExchange()]
+	if !ok {
+		exchangeMap = make(map[asset.Item]map[*currency.Item]map[*currency.Item]*tracker)
+		s.trackers[ev.GetExchange()] = exchangeMap
+	}
+	assetMap, ok := exchangeMap[ev.GetAssetType()]
+	if !ok {
+		assetMap = make(map[*currency.Item]map[*currency.Item]*tracker)
+		exchangeMap[ev.GetAssetType()] = assetMap
+	}
+	baseMap, ok := assetMap[ev.Pair().Base.Item]
+	if !ok {
+		baseMap = make(map[*currency.Item]*tracker)
+		assetMap[ev.Pair().Base.Item] = baseMap
+	}
+	t, ok := baseMap[ev.Pair().Quote.Item]
+	if !ok {
+		t = &tracker{}
+		baseMap[ev.Pair().Quote.Item] = t
+	}
+	return t
+}
+
+// increasesExposure returns whether an order direction opens or increases
+// a position, which sizing models are applied to
+func increasesExposure(direction gctorder.Side) bool {
+	switch direction {
+	case gctorder.Buy, gctorder.Bid, gctorder.Long, gctorder.Short:
+		return true
+	}
+	return false
+}
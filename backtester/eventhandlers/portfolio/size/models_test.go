@@ -0,0 +1,369 @@
+package size
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/order"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var testTime = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func newBase(pair currency.Pair, offset int64) *event.Base {
+	return &event.Base{
+		Offset:         offset,
+		Exchange:       "binance",
+		Time:           testTime.Add(gctkline.OneDay.Duration() * time.Duration(offset)),
+		Interval:       gctkline.OneDay,
+		CurrencyPair:   pair,
+		UnderlyingPair: pair,
+		AssetType:      asset.Spot,
+	}
+}
+
+// trackCloses tracks a candle for each close price with a high and low
+// spread of one either side of the close
+func trackCloses(t *testing.T, s *Size, pair currency.Pair, closes ...float64) {
+	t.Helper()
+	for i := range closes {
+		c := decimal.NewFromFloat(closes[i])
+		err := s.TrackData(&kline.Kline{
+			Base:  newBase(pair, int64(i)),
+			Close: c,
+			High:  c.Add(decimal.NewFromInt(1)),
+			Low:   c.Sub(decimal.NewFromInt(1)),
+		})
+		if !errors.Is(err, nil) {
+			t.Fatalf("received: %v, expected: %v", err, nil)
+		}
+	}
+}
+
+func newFill(pair currency.Pair, direction gctorder.Side, amount, price int64) *fill.Fill {
+	return &fill.Fill{
+		Base:          newBase(pair, 0),
+		Direction:     direction,
+		Amount:        decimal.NewFromInt(amount),
+		PurchasePrice: decimal.NewFromInt(price),
+	}
+}
+
+func TestTrackData(t *testing.T) {
+	t.Parallel()
+	s := &Size{}
+	err := s.TrackData(nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
+	}
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	trackCloses(t, s, pair, 100)
+	if s.trackers != nil {
+		t.Error("expected no tracking without a volatility model")
+	}
+
+	s.Model = Model{Name: VolatilityTarget, VolatilityPeriod: 2}
+	trackCloses(t, s, pair, 100, 101, 102, 103)
+	tr := s.getTracker(&kline.Kline{Base: newBase(pair, 0)})
+	if len(tr.closes) != 3 {
+		t.Fatalf("received: %v, expected: %v", len(tr.closes), 3)
+	}
+	if !tr.closes[2].Equal(decimal.NewFromInt(103)) {
+		t.Errorf("received: %v, expected: %v", tr.closes[2], 103)
+	}
+
+	// repeated candles are ignored
+	err = s.TrackData(&kline.Kline{Base: newBase(pair, 3), Close: decimal.NewFromInt(1337)})
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if !tr.closes[2].Equal(decimal.NewFromInt(103)) {
+		t.Errorf("received: %v, expected: %v", tr.closes[2], 103)
+	}
+}
+
+func TestTrackFill(t *testing.T) {
+	t.Parallel()
+	s := &Size{}
+	err := s.TrackFill(nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received: %v, expected: %v", err, common.ErrNilEvent)
+	}
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	err = s.TrackFill(newFill(pair, gctorder.Buy, 1, 100))
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	if s.trackers != nil {
+		t.Error("expected no tracking without a kelly model")
+	}
+
+	s.Model.Name = Kelly
+	// selling untracked spot holdings does not open a trade
+	err = s.TrackFill(newFill(pair, gctorder.Sell, 1, 100))
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	tr := s.getTracker(&kline.Kline{Base: newBase(pair, 0)})
+	if !tr.position.IsZero() {
+		t.Errorf("received: %v, expected: %v", tr.position, 0)
+	}
+
+	for _, f := range []*fill.Fill{
+		newFill(pair, gctorder.Buy, 1, 100),
+		newFill(pair, gctorder.Buy, 1, 200),
+		newFill(pair, gctorder.Sell, 1, 300),
+		newFill(pair, gctorder.Sell, 5, 150),
+	} {
+		err = s.TrackFill(f)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received: %v, expected: %v", err, nil)
+		}
+	}
+	// bought 2 at an average of 150 and sold 1 at 300 and 1 at 150
+	if len(tr.returns) != 1 {
+		t.Fatalf("received: %v, expected: %v", len(tr.returns), 1)
+	}
+	if !tr.returns[0].Equal(decimal.NewFromFloat(0.5)) {
+		t.Errorf("received: %v, expected: %v", tr.returns[0], 0.5)
+	}
+	if !tr.position.IsZero() {
+		t.Errorf("received: %v, expected: %v", tr.position, 0)
+	}
+
+	// futures positions can reverse
+	futures := newFill(pair, gctorder.Short, 2, 100)
+	futures.AssetType = asset.USDTMarginedFutures
+	err = s.TrackFill(futures)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	futures = newFill(pair, gctorder.Long, 3, 110)
+	futures.AssetType = asset.USDTMarginedFutures
+	err = s.TrackFill(futures)
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	tr = s.getTracker(futures)
+	if len(tr.returns) != 1 {
+		t.Fatalf("received: %v, expected: %v", len(tr.returns), 1)
+	}
+	if !tr.returns[0].Equal(decimal.NewFromFloat(-0.1)) {
+		t.Errorf("received: %v, expected: %v", tr.returns[0], -0.1)
+	}
+	if !tr.position.Equal(decimal.NewFromInt(1)) || !tr.entryPrice.Equal(decimal.NewFromInt(110)) {
+		t.Errorf("received: %v at %v, expected: 1 at 110", tr.position, tr.entryPrice)
+	}
+}
+
+func TestVolatility(t *testing.T) {
+	t.Parallel()
+	s := &Size{Model: Model{Name: RiskParity, VolatilityPeriod: 3}}
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	trackCloses(t, s, pair, 100, 110, 100)
+	tr := s.getTracker(&kline.Kline{Base: newBase(pair, 0)})
+	_, err := tr.volatility(RealisedVolatility, 3)
+	if !errors.Is(err, errNotEnoughData) {
+		t.Errorf("received: %v, expected: %v", err, errNotEnoughData)
+	}
+
+	err = s.TrackData(&kline.Kline{
+		Base:  newBase(pair, 3),
+		Close: decimal.NewFromInt(110),
+		High:  decimal.NewFromInt(111),
+		Low:   decimal.NewFromInt(109),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	_, err = tr.volatility("bad", 3)
+	if !errors.Is(err, errUnknownMeasure) {
+		t.Errorf("received: %v, expected: %v", err, errUnknownMeasure)
+	}
+	realised, err := tr.volatility(RealisedVolatility, 3)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	// daily log returns of ±9.53% annualised
+	if realised.LessThan(decimal.NewFromFloat(2.1)) || realised.GreaterThan(decimal.NewFromFloat(2.2)) {
+		t.Errorf("received: %v, expected roughly 2.15", realised)
+	}
+	atr, err := tr.volatility(ATRVolatility, 3)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	// true range of 11 against a close of 110 annualised
+	if atr.LessThan(decimal.NewFromFloat(1.9)) || atr.GreaterThan(decimal.NewFromFloat(1.92)) {
+		t.Errorf("received: %v, expected roughly 1.91", atr)
+	}
+}
+
+func TestApplyModel(t *testing.T) {
+	t.Parallel()
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	funds := decimal.NewFromInt(1000)
+	o := &order.Order{Base: newBase(pair, 0), Direction: gctorder.Buy}
+
+	s := &Size{Model: Model{Name: "bad"}}
+	_, err := s.applyModel(o, funds)
+	if !errors.Is(err, errUnknownModel) {
+		t.Errorf("received: %v, expected: %v", err, errUnknownModel)
+	}
+
+	s.Model = Model{Name: FixedFractional, Fraction: decimal.NewFromFloat(0.1)}
+	sized, err := s.applyModel(o, funds)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !sized.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received: %v, expected: %v", sized, 100)
+	}
+	if len(o.Reasons) != 1 || !strings.HasPrefix(o.Reasons[0], FixedFractional) {
+		t.Errorf("expected sizing decision to be reported, received %v", o.Reasons)
+	}
+
+	s.Model = Model{
+		Name:              VolatilityTarget,
+		TargetVolatility:  decimal.NewFromFloat(0.5),
+		VolatilityMeasure: RealisedVolatility,
+		VolatilityPeriod:  3,
+	}
+	_, err = s.applyModel(o, funds)
+	if !errors.Is(err, errNotEnoughData) {
+		t.Errorf("received: %v, expected: %v", err, errNotEnoughData)
+	}
+	trackCloses(t, s, pair, 100, 110, 100, 110)
+	sized, err = s.applyModel(o, funds)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	// 50% target against roughly 215% volatility
+	if sized.LessThan(decimal.NewFromInt(225)) || sized.GreaterThan(decimal.NewFromInt(240)) {
+		t.Errorf("received: %v, expected roughly 232", sized)
+	}
+	s.Model.TargetVolatility = decimal.NewFromInt(10)
+	s.Model.MaximumFraction = decimal.NewFromFloat(0.5)
+	sized, err = s.applyModel(o, funds)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !sized.Equal(decimal.NewFromInt(500)) {
+		t.Errorf("received: %v, expected: %v", sized, 500)
+	}
+
+	s.Model.Name = RiskParity
+	s.Model.MaximumFraction = decimal.Zero
+	eth := currency.NewPair(currency.ETH, currency.USDT)
+	trackCloses(t, s, eth, 100, 105, 100, 105)
+	sized, err = s.applyModel(o, funds)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	// BTC is roughly twice as volatile as ETH, so receives a third of funds
+	if sized.LessThan(decimal.NewFromInt(320)) || sized.GreaterThan(decimal.NewFromInt(345)) {
+		t.Errorf("received: %v, expected roughly 333", sized)
+	}
+
+	s.Model = Model{Name: HalfKelly, Fraction: decimal.NewFromFloat(0.2), KellyMinimumTrades: 2}
+	sized, err = s.applyModel(o, funds)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !sized.Equal(decimal.NewFromInt(200)) {
+		t.Errorf("received: %v, expected: %v", sized, 200)
+	}
+}
+
+func TestKellyFraction(t *testing.T) {
+	t.Parallel()
+	s := &Size{Model: Model{Name: Kelly, Fraction: decimal.NewFromFloat(0.1), KellyWindow: 4, KellyMinimumTrades: 4}}
+	tr := &tracker{}
+	fraction, _, err := s.kellyFraction(tr)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !fraction.Equal(decimal.NewFromFloat(0.1)) {
+		t.Errorf("received: %v, expected: %v", fraction, 0.1)
+	}
+
+	// an old losing trade outside of the window is ignored
+	tr.returns = []decimal.Decimal{
+		decimal.NewFromInt(-1),
+		decimal.NewFromFloat(0.2),
+		decimal.NewFromFloat(0.2),
+		decimal.NewFromFloat(0.2),
+		decimal.NewFromFloat(-0.1),
+	}
+	fraction, _, err = s.kellyFraction(tr)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	// W = 0.75, R = 2, f = 0.75 - 0.25 / 2
+	if !fraction.Equal(decimal.NewFromFloat(0.625)) {
+		t.Errorf("received: %v, expected: %v", fraction, 0.625)
+	}
+
+	s.Model.Name = HalfKelly
+	fraction, _, err = s.kellyFraction(tr)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !fraction.Equal(decimal.NewFromFloat(0.3125)) {
+		t.Errorf("received: %v, expected: %v", fraction, 0.3125)
+	}
+
+	tr.returns = []decimal.Decimal{
+		decimal.NewFromFloat(0.1),
+		decimal.NewFromFloat(-0.2),
+		decimal.NewFromFloat(-0.2),
+		decimal.NewFromFloat(-0.2),
+	}
+	_, _, err = s.kellyFraction(tr)
+	if !errors.Is(err, errNoEdge) {
+		t.Errorf("received: %v, expected: %v", err, errNoEdge)
+	}
+}
+
+func TestSizeOrderWithModel(t *testing.T) {
+	t.Parallel()
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	s := &Size{Model: Model{Name: FixedFractional, Fraction: decimal.NewFromFloat(0.5)}}
+	o := &order.Order{
+		Base:       newBase(pair, 0),
+		Direction:  gctorder.Buy,
+		ClosePrice: decimal.NewFromInt(10),
+	}
+	cs := &exchange.Settings{}
+	sized, _, err := s.SizeOrder(o, decimal.NewFromInt(100), cs)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !sized.Amount.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received: %v, expected: %v", sized.Amount, 5)
+	}
+
+	// reducing positions uses all available funds
+	o = &order.Order{
+		Base:       newBase(pair, 0),
+		Direction:  gctorder.Sell,
+		ClosePrice: decimal.NewFromInt(10),
+	}
+	sized, _, err = s.SizeOrder(o, decimal.NewFromInt(10), cs)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: %v, expected: %v", err, nil)
+	}
+	if !sized.Amount.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received: %v, expected: %v", sized.Amount, 10)
+	}
+}
@@ -65,6 +65,13 @@ func (s *Size) SizeOrder(o order.Event, amountAvailable decimal.Decimal, cs *exc
 		return retOrder, estFee, nil
 	}
 
+	if s.Model.Name != "" && increasesExposure(retOrder.Direction) {
+		var err error
+		amountAvailable, err = s.applyModel(retOrder, amountAvailable)
+		if err != nil {
+			return nil, decimal.Zero, err
+		}
+	}
 	amount, estFee, err := s.calculateAmount(retOrder.Direction, retOrder.ClosePrice, amountAvailable, cs, o)
 	if err != nil {
 		return nil, decimal.Zero, err
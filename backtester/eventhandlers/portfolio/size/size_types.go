@@ -2,18 +2,103 @@ package size
 
 import (
 	"errors"
+	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// Sizing models which can be selected to size orders which open or
+// increase positions
+const (
+	// FixedFractional sizes orders using a fixed fraction of available funds
+	FixedFractional = "fixed-fractional"
+	// VolatilityTarget scales the fraction of available funds so that the
+	// annualised volatility of the position matches a target volatility
+	VolatilityTarget = "volatility-target"
+	// Kelly sizes orders with the Kelly criterion, using the win rate and
+	// win/loss ratio of the currency's recent trades
+	Kelly = "kelly"
+	// HalfKelly sizes orders with half of the Kelly criterion
+	HalfKelly = "half-kelly"
+	// RiskParity allocates available funds in inverse proportion to each
+	// currency's volatility so that each currency contributes equal risk
+	RiskParity = "risk-parity"
+)
+
+// Volatility measures used by the VolatilityTarget and RiskParity models
+const (
+	// ATRVolatility measures volatility as the average true range
+	// relative to the latest close price
+	ATRVolatility = "atr"
+	// RealisedVolatility measures volatility as the standard deviation
+	// of close price log returns
+	RealisedVolatility = "realised"
 )
 
 var (
 	errNoFunds         = errors.New("no funds available")
 	errLessThanMinimum = errors.New("sized amount less than minimum")
 	errCannotAllocate  = errors.New("portfolio manager cannot allocate funds for an order")
+	errUnknownModel    = errors.New("unknown sizing model")
+	errUnknownMeasure  = errors.New("unknown volatility measure")
+	errNotEnoughData   = errors.New("not enough data to calculate volatility")
+	errNoEdge          = errors.New("kelly criterion found no edge in recent trades")
 )
 
 // Size contains buy and sell side rules
 type Size struct {
 	BuySide  exchange.MinMax
 	SellSide exchange.MinMax
+	// Model is the sizing model applied to orders which open or increase
+	// positions. An unset model sizes orders using all available funds
+	Model    Model
+	trackers map[string]map[asset.Item]map[*currency.Item]map[*currency.Item]*tracker
+}
+
+// Model holds the settings of a sizing model. Fractions are expressed as
+// decimals, eg 0.1 is 10%
+type Model struct {
+	Name string
+	// Fraction is the fraction of available funds used by the
+	// FixedFractional model and by Kelly models until enough trades have
+	// been made. It scales the allocation of the RiskParity model
+	Fraction decimal.Decimal
+	// MaximumFraction caps the fraction of available funds used by the
+	// VolatilityTarget, Kelly and RiskParity models. Defaults to 1
+	MaximumFraction decimal.Decimal
+	// TargetVolatility is the annualised volatility targeted by the
+	// VolatilityTarget model
+	TargetVolatility  decimal.Decimal
+	VolatilityMeasure string
+	VolatilityPeriod  int64
+	// KellyWindow is the number of recent trades used to calculate the
+	// win rate and win/loss ratio
+	KellyWindow int64
+	// KellyMinimumTrades is the number of completed trades required before
+	// the Kelly criterion is used
+	KellyMinimumTrades int64
+}
+
+// tracker holds the candles and trade results of a currency which sizing
+// models are calculated from
+type tracker struct {
+	interval   gctkline.Interval
+	lastCandle time.Time
+	highs      []decimal.Decimal
+	lows       []decimal.Decimal
+	closes     []decimal.Decimal
+
+	// position is the signed base amount of the open trade, it is used to
+	// determine when a trade has been completed
+	position   decimal.Decimal
+	entryPrice decimal.Decimal
+	tradeCost  decimal.Decimal
+	tradePNL   decimal.Decimal
+	// returns holds the profit or loss of each completed trade relative
+	// to its cost
+	returns []decimal.Decimal
 }
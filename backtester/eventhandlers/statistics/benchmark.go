@@ -0,0 +1,270 @@
+package statistics
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// minimumBenchmarkPeriods is the amount of aligned values required to
+// compare a strategy against a benchmark
+const minimumBenchmarkPeriods = 3
+
+// CompareBenchmark compares the returns of the strategy's equity curve
+// against the returns of the benchmark over the same candles
+func (s *Statistic) CompareBenchmark() (*BenchmarkResults, error) {
+	if s.BenchmarkSettings == nil {
+		return nil, fmt.Errorf("%w benchmark settings", gctcommon.ErrNilPointer)
+	}
+	curve, err := s.GetEquityCurve()
+	if err != nil {
+		return nil, err
+	}
+	name, series, err := s.benchmarkSeries()
+	if err != nil {
+		return nil, err
+	}
+	strategy, benchmark := alignBenchmark(curve, series)
+	if len(strategy) < minimumBenchmarkPeriods {
+		return nil, fmt.Errorf("%w, %v aligned benchmark values, %v required", errNotEnoughReturns, len(strategy), minimumBenchmarkPeriods)
+	}
+	strategyReturns, benchmarkReturns := make([]float64, 0, len(strategy)-1), make([]float64, 0, len(strategy)-1)
+	for i := 1; i < len(strategy); i++ {
+		if strategy[i-1] == 0 || benchmark[i-1] == 0 {
+			continue
+		}
+		strategyReturns = append(strategyReturns, strategy[i]/strategy[i-1]-1)
+		benchmarkReturns = append(benchmarkReturns, benchmark[i]/benchmark[i-1]-1)
+	}
+	if len(strategyReturns) < minimumBenchmarkPeriods-1 {
+		return nil, fmt.Errorf("%w, %v benchmark returns", errNotEnoughReturns, len(strategyReturns))
+	}
+	var periodsPerYear float64
+	if s.CandleInterval > 0 {
+		periodsPerYear = s.CandleInterval.IntervalsPerYear()
+	}
+	riskFreeRate := s.riskFreeRatePerCandle()
+
+	meanStrategy, err := gctmath.ArithmeticMean(strategyReturns)
+	if err != nil {
+		return nil, err
+	}
+	meanBenchmark, err := gctmath.ArithmeticMean(benchmarkReturns)
+	if err != nil {
+		return nil, err
+	}
+	var covariance, strategyVariance, benchmarkVariance float64
+	differences := make([]float64, len(strategyReturns))
+	for i := range strategyReturns {
+		covariance += (strategyReturns[i] - meanStrategy) * (benchmarkReturns[i] - meanBenchmark)
+		strategyVariance += (strategyReturns[i] - meanStrategy) * (strategyReturns[i] - meanStrategy)
+		benchmarkVariance += (benchmarkReturns[i] - meanBenchmark) * (benchmarkReturns[i] - meanBenchmark)
+		differences[i] = strategyReturns[i] - benchmarkReturns[i]
+	}
+	var beta, correlation float64
+	if benchmarkVariance > 0 {
+		beta = covariance / benchmarkVariance
+		if strategyVariance > 0 {
+			correlation = covariance / math.Sqrt(strategyVariance*benchmarkVariance)
+		}
+	}
+	alpha := ((meanStrategy - riskFreeRate) - beta*(meanBenchmark-riskFreeRate)) * periodsPerYear * 100
+	differenceDeviation, err := gctmath.SampleStandardDeviation(differences)
+	if err != nil {
+		return nil, err
+	}
+	var informationRatio float64
+	if differenceDeviation > 0 {
+		var meanDifference float64
+		meanDifference, err = gctmath.ArithmeticMean(differences)
+		if err != nil {
+			return nil, err
+		}
+		informationRatio = meanDifference / differenceDeviation * math.Sqrt(periodsPerYear)
+	}
+	strategyReturn := (strategy[len(strategy)-1]/strategy[0] - 1) * 100
+	benchmarkReturn := (benchmark[len(benchmark)-1]/benchmark[0] - 1) * 100
+	return &BenchmarkResults{
+		Name:             name,
+		Periods:          len(strategyReturns),
+		StrategyReturn:   decimal.NewFromFloat(strategyReturn),
+		BenchmarkReturn:  decimal.NewFromFloat(benchmarkReturn),
+		Alpha:            decimal.NewFromFloat(alpha),
+		Beta:             decimal.NewFromFloat(beta),
+		Correlation:      decimal.NewFromFloat(correlation),
+		TrackingError:    decimal.NewFromFloat(differenceDeviation * math.Sqrt(periodsPerYear) * 100),
+		InformationRatio: decimal.NewFromFloat(informationRatio),
+		BeatBenchmark:    strategyReturn > benchmarkReturn,
+	}, nil
+}
+
+// benchmarkSeries returns the name and values of the benchmark
+func (s *Statistic) benchmarkSeries() (string, []ValueAtTime, error) {
+	switch s.BenchmarkSettings.Type {
+	case BenchmarkPair:
+		b := s.BenchmarkSettings
+		stats, ok := s.ExchangeAssetPairStatistics[strings.ToLower(b.Exchange)][b.Asset][b.Pair.Base.Item][b.Pair.Quote.Item]
+		if !ok {
+			return "", nil, fmt.Errorf("%w %v %v %v", errBenchmarkNotFound, b.Exchange, b.Asset, b.Pair)
+		}
+		series := make([]ValueAtTime, len(stats.Events))
+		for i := range stats.Events {
+			series[i] = ValueAtTime{Time: stats.Events[i].Time, Value: stats.Events[i].ClosePrice}
+		}
+		return fmt.Sprintf("%v %v %v", b.Exchange, b.Asset, b.Pair), series, nil
+	case BenchmarkBasket:
+		return "equal-weight basket", s.basketSeries(), nil
+	case BenchmarkCSV:
+		name := s.BenchmarkSettings.Name
+		if name == "" {
+			name = BenchmarkCSV
+		}
+		return name, s.BenchmarkSettings.Values, nil
+	default:
+		return "", nil, fmt.Errorf("%w type '%v'", errInvalidBenchmark, s.BenchmarkSettings.Type)
+	}
+}
+
+// basketSeries creates an index starting at 100 which grows by the average
+// close price return of every currency pair in the run at each candle
+func (s *Statistic) basketSeries() []ValueAtTime {
+	returnSums := make(map[int64]float64)
+	returnCounts := make(map[int64]int)
+	times := make(map[int64]time.Time)
+	for _, exchangeMap := range s.ExchangeAssetPairStatistics {
+		for _, assetMap := range exchangeMap {
+			for _, baseMap := range assetMap {
+				for _, stats := range baseMap {
+					for i := range stats.Events {
+						t := stats.Events[i].Time.UnixNano()
+						times[t] = stats.Events[i].Time
+						if i == 0 || stats.Events[i-1].ClosePrice.IsZero() {
+							continue
+						}
+						returnSums[t] += stats.Events[i].ClosePrice.Div(stats.Events[i-1].ClosePrice).Sub(decimal.NewFromInt(1)).InexactFloat64()
+						returnCounts[t]++
+					}
+				}
+			}
+		}
+	}
+	keys := make([]int64, 0, len(times))
+	for k := range times {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i] < keys[j]
+	})
+	series := make([]ValueAtTime, len(keys))
+	value := 100.0
+	for i := range keys {
+		if returnCounts[keys[i]] > 0 {
+			value *= 1 + returnSums[keys[i]]/float64(returnCounts[keys[i]])
+		}
+		series[i] = ValueAtTime{Time: times[keys[i]], Value: decimal.NewFromFloat(value)}
+	}
+	return series
+}
+
+// alignBenchmark matches each value of the equity curve with the latest
+// benchmark value at or before its time. Equity values before the first
+// benchmark value are skipped
+func alignBenchmark(curve, series []ValueAtTime) (strategy, benchmark []float64) {
+	sorted := make([]ValueAtTime, len(series))
+	copy(sorted, series)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+	j := -1
+	for i := range curve {
+		for j+1 < len(sorted) && !sorted[j+1].Time.After(curve[i].Time) {
+			j++
+		}
+		if j < 0 {
+			continue
+		}
+		strategy = append(strategy, curve[i].Value.InexactFloat64())
+		benchmark = append(benchmark, sorted[j].Value.InexactFloat64())
+	}
+	return strategy, benchmark
+}
+
+// LoadBenchmarkCSV loads benchmark values from a CSV file. Each row
+// contains a unix timestamp in seconds followed by the benchmark value.
+// A header row is skipped
+func LoadBenchmarkCSV(path string) ([]ValueAtTime, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		err = f.Close()
+		if err != nil {
+			log.Errorln(common.Statistics, err)
+		}
+	}()
+	reader := csv.NewReader(f)
+	var resp []ValueAtTime
+	var record []string
+	var timestamp int64
+	var value decimal.Decimal
+	for row := 0; ; row++ {
+		record, err = reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(record) < 2 {
+			return nil, fmt.Errorf("%w, row %v has %v columns, expected 2", errInvalidBenchmark, row+1, len(record))
+		}
+		timestamp, err = strconv.ParseInt(strings.TrimSpace(record[0]), 10, 64)
+		if err != nil {
+			if row == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("%w, row %v timestamp %v", errInvalidBenchmark, row+1, err)
+		}
+		value, err = decimal.NewFromString(strings.TrimSpace(record[1]))
+		if err != nil {
+			return nil, fmt.Errorf("%w, row %v value %v", errInvalidBenchmark, row+1, err)
+		}
+		resp = append(resp, ValueAtTime{Time: time.Unix(timestamp, 0).UTC(), Value: value})
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%w, no values in %v", errInvalidBenchmark, path)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Time.Before(resp[j].Time)
+	})
+	return resp, nil
+}
+
+// PrintResults outputs the benchmark comparison to the command line
+func (b *BenchmarkResults) PrintResults() {
+	log.Infoln(common.Statistics, common.CMDColours.H1+"------------------Benchmark----------------------------------"+common.CMDColours.Default)
+	log.Infof(common.Statistics, "Benchmark: %v over %v candles", b.Name, b.Periods)
+	log.Infof(common.Statistics, "Strategy return: %s%%", convert.DecimalToHumanFriendlyString(b.StrategyReturn, 2, ".", ","))
+	log.Infof(common.Statistics, "Benchmark return: %s%%", convert.DecimalToHumanFriendlyString(b.BenchmarkReturn, 2, ".", ","))
+	log.Infof(common.Statistics, "Alpha: %s%%", convert.DecimalToHumanFriendlyString(b.Alpha, 2, ".", ","))
+	log.Infof(common.Statistics, "Beta: %s", convert.DecimalToHumanFriendlyString(b.Beta.Round(4), 4, ".", ","))
+	log.Infof(common.Statistics, "Correlation: %s", convert.DecimalToHumanFriendlyString(b.Correlation.Round(4), 4, ".", ","))
+	log.Infof(common.Statistics, "Tracking error: %s%%", convert.DecimalToHumanFriendlyString(b.TrackingError, 2, ".", ","))
+	log.Infof(common.Statistics, "Information ratio: %s", convert.DecimalToHumanFriendlyString(b.InformationRatio.Round(4), 4, ".", ","))
+	log.Infof(common.Statistics, "Did the strategy beat the benchmark: %v", b.BeatBenchmark)
+}
@@ -0,0 +1,146 @@
+package statistics
+
+import (
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestCompareBenchmark(t *testing.T) {
+	t.Parallel()
+	s := riskMetricsTestStatistic(t, 100, 110, 99, 120)
+	_, err := s.CompareBenchmark()
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	s.BenchmarkSettings = &BenchmarkSettings{Type: "bad"}
+	_, err = s.CompareBenchmark()
+	if !errors.Is(err, errInvalidBenchmark) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidBenchmark)
+	}
+
+	s.BenchmarkSettings = &BenchmarkSettings{
+		Type:     BenchmarkPair,
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.ETH, currency.USDT),
+	}
+	_, err = s.CompareBenchmark()
+	if !errors.Is(err, errBenchmarkNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errBenchmarkNotFound)
+	}
+
+	// the strategy's value matches the close price so it
+	// perfectly tracks the pair benchmark
+	s.BenchmarkSettings.Pair = currency.NewPair(currency.BTC, currency.USDT)
+	b, err := s.CompareBenchmark()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(b.Beta.InexactFloat64()-1) > 1e-9 || math.Abs(b.Correlation.InexactFloat64()-1) > 1e-9 {
+		t.Errorf("received beta '%v' correlation '%v' expected '1' '1'", b.Beta, b.Correlation)
+	}
+	if !b.TrackingError.IsZero() || b.BeatBenchmark || b.Periods != 3 {
+		t.Errorf("received '%+v' expected no tracking error over 3 periods", b)
+	}
+
+	s.BenchmarkSettings = &BenchmarkSettings{Type: BenchmarkBasket}
+	b, err = s.CompareBenchmark()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(b.BenchmarkReturn.InexactFloat64()-20) > 1e-9 {
+		t.Errorf("received '%v' expected '20'", b.BenchmarkReturn)
+	}
+
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.BenchmarkSettings = &BenchmarkSettings{
+		Type: BenchmarkCSV,
+		Values: []ValueAtTime{
+			{Time: tt, Value: decimal.NewFromInt(100)},
+			{Time: tt.AddDate(0, 0, 3), Value: decimal.NewFromInt(100)},
+		},
+	}
+	b, err = s.CompareBenchmark()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !b.BenchmarkReturn.IsZero() || !b.BeatBenchmark || b.Name != BenchmarkCSV {
+		t.Errorf("received '%+v' expected a flat benchmark to be beaten", b)
+	}
+
+	s.BenchmarkSettings.Values = s.BenchmarkSettings.Values[1:]
+	_, err = s.CompareBenchmark()
+	if !errors.Is(err, errNotEnoughReturns) {
+		t.Errorf("received '%v' expected '%v'", err, errNotEnoughReturns)
+	}
+}
+
+func TestAlignBenchmark(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	curve := []ValueAtTime{
+		{Time: tt, Value: decimal.NewFromInt(1)},
+		{Time: tt.Add(time.Hour), Value: decimal.NewFromInt(2)},
+		{Time: tt.Add(time.Hour * 2), Value: decimal.NewFromInt(3)},
+	}
+	series := []ValueAtTime{
+		{Time: tt.Add(time.Minute * 90), Value: decimal.NewFromInt(20)},
+		{Time: tt.Add(time.Minute * 30), Value: decimal.NewFromInt(10)},
+	}
+	strategy, benchmark := alignBenchmark(curve, series)
+	if len(strategy) != 2 || strategy[0] != 2 || strategy[1] != 3 {
+		t.Errorf("received '%v' expected '[2 3]'", strategy)
+	}
+	if len(benchmark) != 2 || benchmark[0] != 10 || benchmark[1] != 20 {
+		t.Errorf("received '%v' expected '[10 20]'", benchmark)
+	}
+}
+
+func TestLoadBenchmarkCSV(t *testing.T) {
+	t.Parallel()
+	_, err := LoadBenchmarkCSV(filepath.Join(t.TempDir(), "missing.csv"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("received '%v' expected '%v'", err, os.ErrNotExist)
+	}
+
+	path := filepath.Join(t.TempDir(), "benchmark.csv")
+	err = os.WriteFile(path, []byte("timestamp,value\n1577923200,110\n1577836800,100\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, err := LoadBenchmarkCSV(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 || !values[0].Value.Equal(decimal.NewFromInt(100)) || !values[0].Time.Equal(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("received '%+v' expected sorted values", values)
+	}
+
+	err = os.WriteFile(path, []byte("1577836800,100\nbad,110\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadBenchmarkCSV(path)
+	if !errors.Is(err, errInvalidBenchmark) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidBenchmark)
+	}
+
+	err = os.WriteFile(path, []byte("timestamp,value\n"), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadBenchmarkCSV(path)
+	if !errors.Is(err, errInvalidBenchmark) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidBenchmark)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
@@ -30,7 +31,14 @@ func (c *CurrencyPairStatistic) CalculateResults(riskFreeRate decimal.Decimal) e
 			c.SellOrders++
 		}
 	}
+	c.ExitsTriggered = nil
 	for i := range c.Events {
+		if fe := c.Events[i].FillEvent; fe != nil && fe.GetExitRule() != "" && common.CanTransact(fe.GetDirection()) {
+			if c.ExitsTriggered == nil {
+				c.ExitsTriggered = make(map[string]int64)
+			}
+			c.ExitsTriggered[fe.GetExitRule()]++
+		}
 		price := c.Events[i].ClosePrice
 		if (price.LessThan(c.LowestClosePrice.Value) || !c.LowestClosePrice.Set) && !price.IsZero() {
 			c.LowestClosePrice.Value = price
@@ -122,6 +130,7 @@ func (c *CurrencyPairStatistic) CalculateResults(riskFreeRate decimal.Decimal) e
 	if last.PNL != nil {
 		c.UnrealisedPNL = last.PNL.GetUnrealisedPNL().PNL
 		c.RealisedPNL = last.PNL.GetRealisedPNL().PNL
+		c.FundingPayments = last.PNL.GetFundingPayments().PNL
 	}
 	return errs
 }
@@ -70,6 +70,9 @@ func CalculateFundingStatistics(funds funding.IFundingManager, currStats map[str
 		LowestHoldingValue:  ValueAtTime{},
 		RiskFreeRate:        riskFreeRate,
 	}
+	for i := range report.Items {
+		usdStats.USDBorrowCosts = usdStats.USDBorrowCosts.Add(report.Items[i].USDBorrowCosts)
+	}
 
 	for i := range report.USDTotalsOverTime {
 		if usdStats.HighestHoldingValue.Value.LessThan(report.USDTotalsOverTime[i].USDValue) {
@@ -0,0 +1,273 @@
+package statistics
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// RunMonteCarlo resamples the returns of the strategy run to produce
+// distributions of final returns and maximum drawdowns. Returns are either
+// bootstrapped, sampled with replacement, or shuffled into a new order.
+// Trade fees and slippage can be randomly perturbed in each simulation to
+// measure how sensitive the strategy is to trading costs
+func (s *Statistic) RunMonteCarlo() (*MonteCarloResults, error) {
+	if s.MonteCarloSettings == nil {
+		return nil, fmt.Errorf("%w monte carlo settings", gctcommon.ErrNilPointer)
+	}
+	settings := s.MonteCarloSettings
+	if settings.Simulations <= 0 {
+		return nil, fmt.Errorf("%w simulations must be greater than zero", errInvalidMonteCarloSettings)
+	}
+	if !settings.ConfidenceLevel.IsPositive() || settings.ConfidenceLevel.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+		return nil, fmt.Errorf("%w confidence level must be greater than 0 and less than 100", errInvalidMonteCarloSettings)
+	}
+	steps, err := s.monteCarloSteps()
+	if err != nil {
+		return nil, err
+	}
+	seed := settings.Seed
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	resp := &MonteCarloResults{
+		Method:               "shuffle",
+		Sample:               "returns",
+		Simulations:          settings.Simulations,
+		SampleSize:           len(steps),
+		Seed:                 seed,
+		ConfidenceLevel:      settings.ConfidenceLevel,
+		RuinThresholdPercent: settings.RuinThresholdPercent,
+	}
+	if settings.Bootstrap {
+		resp.Method = "bootstrap"
+	}
+	if settings.ResampleTrades {
+		resp.Sample = "trades"
+	}
+
+	ruinValue := 1 - settings.RuinThresholdPercent.InexactFloat64()/100
+	feePerturbation := settings.FeePerturbationPercent.InexactFloat64() / 100
+	slippagePerturbation := settings.SlippagePerturbationPercent.InexactFloat64() / 100
+	r := rand.New(rand.NewSource(seed)) //nolint:gosec // not used for security purposes
+	finalReturns := make([]float64, settings.Simulations)
+	drawdowns := make([]float64, settings.Simulations)
+	sampled := make([]monteCarloStep, len(steps))
+	var ruined int64
+	for i := range finalReturns {
+		if settings.Bootstrap {
+			for j := range sampled {
+				sampled[j] = steps[r.Intn(len(steps))]
+			}
+		} else {
+			for j, k := range r.Perm(len(steps)) {
+				sampled[j] = steps[k]
+			}
+		}
+		for j := range sampled {
+			// scale each cost by a random factor between 1-p and 1+p
+			if sampled[j].fee != 0 && feePerturbation != 0 {
+				sampled[j].growth -= sampled[j].fee * (r.Float64()*2 - 1) * feePerturbation
+			}
+			if sampled[j].slippage != 0 && slippagePerturbation != 0 {
+				sampled[j].growth -= sampled[j].slippage * (r.Float64()*2 - 1) * slippagePerturbation
+			}
+		}
+		var lowest float64
+		finalReturns[i], drawdowns[i], lowest = simulateMonteCarloPath(sampled)
+		if lowest <= ruinValue {
+			ruined++
+		}
+	}
+	resp.RiskOfRuin = decimal.NewFromInt(ruined).Div(decimal.NewFromInt(settings.Simulations)).Mul(decimal.NewFromInt(100))
+
+	originalReturn, originalDrawdown, _ := simulateMonteCarloPath(steps)
+	confidence := settings.ConfidenceLevel.InexactFloat64() / 100
+	resp.FinalReturn = describeMonteCarloDistribution(finalReturns, originalReturn, confidence)
+	resp.MaxDrawdown = describeMonteCarloDistribution(drawdowns, originalDrawdown, confidence)
+	return resp, nil
+}
+
+// monteCarloSteps returns the returns of the strategy run's equity curve
+// along with the trading costs paid during each return. When resampling
+// trades, candle returns are combined into the returns between each trade
+func (s *Statistic) monteCarloSteps() ([]monteCarloStep, error) {
+	curve, err := s.GetEquityCurve()
+	if err != nil {
+		return nil, err
+	}
+	fees := make(map[int64]float64)
+	slippage := make(map[int64]float64)
+	for _, exchangeMap := range s.ExchangeAssetPairStatistics {
+		for _, assetMap := range exchangeMap {
+			for _, baseMap := range assetMap {
+				for _, stats := range baseMap {
+					for i := range stats.Events {
+						f := stats.Events[i].FillEvent
+						if f == nil || !common.CanTransact(f.GetDirection()) || f.GetAmount().IsZero() {
+							continue
+						}
+						t := stats.Events[i].Time.UnixNano()
+						fees[t] += f.GetExchangeFee().InexactFloat64()
+						slippage[t] += f.GetPurchasePrice().Sub(f.GetClosePrice()).Abs().Mul(f.GetAmount()).InexactFloat64()
+					}
+				}
+			}
+		}
+	}
+	var steps []monteCarloStep
+	for i := 1; i < len(curve); i++ {
+		previous := curve[i-1].Value.InexactFloat64()
+		if previous <= 0 {
+			continue
+		}
+		t := curve[i].Time.UnixNano()
+		fee, hasFee := fees[t]
+		slip, hasSlippage := slippage[t]
+		steps = append(steps, monteCarloStep{
+			growth:   curve[i].Value.InexactFloat64() / previous,
+			fee:      fee / previous,
+			slippage: slip / previous,
+			hasTrade: hasFee || hasSlippage,
+		})
+	}
+	if s.MonteCarloSettings.ResampleTrades {
+		steps = combineMonteCarloTrades(steps)
+	}
+	if len(steps) < 2 {
+		return nil, fmt.Errorf("%w, %v returns to sample", errNotEnoughMonteCarloData, len(steps))
+	}
+	return steps, nil
+}
+
+// combineMonteCarloTrades compounds candle returns into the returns
+// between each trade. Costs are converted to fractions of the value at the
+// start of the combined return
+func combineMonteCarloTrades(steps []monteCarloStep) []monteCarloStep {
+	var resp []monteCarloStep
+	current := monteCarloStep{growth: 1}
+	for i := range steps {
+		current.fee += steps[i].fee * current.growth
+		current.slippage += steps[i].slippage * current.growth
+		current.growth *= steps[i].growth
+		if steps[i].hasTrade {
+			current.hasTrade = true
+			resp = append(resp, current)
+			current = monteCarloStep{growth: 1}
+		}
+	}
+	if current.growth != 1 || current.hasTrade {
+		resp = append(resp, current)
+	}
+	return resp
+}
+
+// simulateMonteCarloPath compounds the steps from a value of one, returning
+// the final return and maximum drawdown as percentages and the lowest value
+func simulateMonteCarloPath(steps []monteCarloStep) (finalReturn, maxDrawdown, lowest float64) {
+	value, highest := 1.0, 1.0
+	lowest = 1
+	for i := range steps {
+		value = math.Max(value*steps[i].growth, 0)
+		if value > highest {
+			highest = value
+		}
+		if value < lowest {
+			lowest = value
+		}
+		if drawdown := (value - highest) / highest; drawdown < maxDrawdown {
+			maxDrawdown = drawdown
+		}
+	}
+	return (value - 1) * 100, maxDrawdown * 100, lowest
+}
+
+// describeMonteCarloDistribution summarises the values and calculates the
+// bounds of the confidence interval centred on the median
+func describeMonteCarloDistribution(values []float64, original, confidence float64) MonteCarloDistribution {
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	var sum float64
+	for i := range sorted {
+		sum += sorted[i]
+	}
+	tail := (1 - confidence) / 2
+	return MonteCarloDistribution{
+		Original:   decimal.NewFromFloat(original),
+		Mean:       decimal.NewFromFloat(sum / float64(len(sorted))),
+		Median:     decimal.NewFromFloat(percentile(sorted, 0.5)),
+		Minimum:    decimal.NewFromFloat(sorted[0]),
+		Maximum:    decimal.NewFromFloat(sorted[len(sorted)-1]),
+		LowerBound: decimal.NewFromFloat(percentile(sorted, tail)),
+		UpperBound: decimal.NewFromFloat(percentile(sorted, 1-tail)),
+		Histogram:  createHistogram(sorted, monteCarloHistogramBins),
+	}
+}
+
+// percentile linearly interpolates the value at the fraction
+// of the sorted values
+func percentile(sorted []float64, fraction float64) float64 {
+	if len(sorted) == 1 {
+		return sorted[0]
+	}
+	position := fraction * float64(len(sorted)-1)
+	lower := int(math.Floor(position))
+	upper := int(math.Ceil(position))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(position-float64(lower))
+}
+
+// createHistogram counts sorted values into equally sized bins
+func createHistogram(sorted []float64, bins int) []HistogramBin {
+	lowest, highest := sorted[0], sorted[len(sorted)-1]
+	if lowest == highest {
+		return []HistogramBin{
+			{
+				Minimum: decimal.NewFromFloat(lowest),
+				Maximum: decimal.NewFromFloat(highest),
+				Count:   int64(len(sorted)),
+			},
+		}
+	}
+	width := (highest - lowest) / float64(bins)
+	resp := make([]HistogramBin, bins)
+	for i := range resp {
+		resp[i].Minimum = decimal.NewFromFloat(lowest + width*float64(i))
+		resp[i].Maximum = decimal.NewFromFloat(lowest + width*float64(i+1))
+	}
+	for i := range sorted {
+		bin := int((sorted[i] - lowest) / width)
+		if bin >= bins {
+			bin = bins - 1
+		}
+		resp[bin].Count++
+	}
+	return resp
+}
+
+// PrintResults outputs the monte carlo distributions to the command line
+func (m *MonteCarloResults) PrintResults() {
+	log.Infoln(common.Statistics, common.CMDColours.H1+"------------------Monte Carlo--------------------------------"+common.CMDColours.Default)
+	log.Infof(common.Statistics, "%v %v simulations of %v %v with seed %v", m.Simulations, m.Method, m.SampleSize, m.Sample, m.Seed)
+	log.Infof(common.Statistics, "Risk of ruin: %s%% of simulations lost %s%%", convert.DecimalToHumanFriendlyString(m.RiskOfRuin, 2, ".", ","), m.RuinThresholdPercent)
+	m.FinalReturn.printResults("Final return", m.ConfidenceLevel)
+	m.MaxDrawdown.printResults("Max drawdown", m.ConfidenceLevel)
+}
+
+func (d *MonteCarloDistribution) printResults(name string, confidenceLevel decimal.Decimal) {
+	log.Infof(common.Statistics, "%s original: %s%%, median: %s%%, %s%% confidence interval: %s%% to %s%%",
+		name,
+		convert.DecimalToHumanFriendlyString(d.Original, 2, ".", ","),
+		convert.DecimalToHumanFriendlyString(d.Median, 2, ".", ","),
+		confidenceLevel,
+		convert.DecimalToHumanFriendlyString(d.LowerBound, 2, ".", ","),
+		convert.DecimalToHumanFriendlyString(d.UpperBound, 2, ".", ","))
+}
@@ -0,0 +1,207 @@
+package statistics
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func monteCarloTestStatistic(t *testing.T, values ...int64) *Statistic {
+	t.Helper()
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	cps := &CurrencyPairStatistic{}
+	for i := range values {
+		cps.Events = append(cps.Events, DataAtOffset{
+			Time:     tt.Add(time.Hour * time.Duration(i)),
+			Holdings: holdings.Holding{TotalValue: decimal.NewFromInt(values[i])},
+		})
+	}
+	// a trade on the second candle paying a fee of 1 and slippage of 2
+	cps.Events[1].FillEvent = &fill.Fill{
+		Base:          &event.Base{Time: cps.Events[1].Time},
+		Direction:     gctorder.Buy,
+		Amount:        decimal.NewFromInt(1),
+		ClosePrice:    decimal.NewFromInt(10),
+		PurchasePrice: decimal.NewFromInt(12),
+		ExchangeFee:   decimal.NewFromInt(1),
+	}
+	return &Statistic{
+		ExchangeAssetPairStatistics: map[string]map[asset.Item]map[*currency.Item]map[*currency.Item]*CurrencyPairStatistic{
+			testExchange: {
+				asset.Spot: {
+					cp.Base.Item: {
+						cp.Quote.Item: cps,
+					},
+				},
+			},
+		},
+		MonteCarloSettings: &MonteCarloSettings{
+			Simulations:          100,
+			Seed:                 1337,
+			ConfidenceLevel:      decimal.NewFromInt(90),
+			RuinThresholdPercent: decimal.NewFromInt(10),
+		},
+	}
+}
+
+func TestRunMonteCarlo(t *testing.T) {
+	t.Parallel()
+	s := &Statistic{}
+	_, err := s.RunMonteCarlo()
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	s.MonteCarloSettings = &MonteCarloSettings{}
+	_, err = s.RunMonteCarlo()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidMonteCarloSettings)
+	}
+	s.MonteCarloSettings.Simulations = 1
+	_, err = s.RunMonteCarlo()
+	if !errors.Is(err, errInvalidMonteCarloSettings) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidMonteCarloSettings)
+	}
+
+	s = monteCarloTestStatistic(t, 100, 110)
+	_, err = s.RunMonteCarlo()
+	if !errors.Is(err, errNotEnoughMonteCarloData) {
+		t.Errorf("received '%v' expected '%v'", err, errNotEnoughMonteCarloData)
+	}
+
+	s = monteCarloTestStatistic(t, 100, 110, 88, 99, 120)
+	resp, err := s.RunMonteCarlo()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.Method != "shuffle" || resp.Sample != "returns" || resp.SampleSize != 4 {
+		t.Errorf("received '%v %v %v' expected 'shuffle returns 4'", resp.Method, resp.Sample, resp.SampleSize)
+	}
+	// shuffling returns without perturbing costs cannot change the final return
+	if !resp.FinalReturn.Minimum.Round(8).Equal(decimal.NewFromInt(20)) ||
+		!resp.FinalReturn.Maximum.Round(8).Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received '%v' to '%v' expected '20'", resp.FinalReturn.Minimum, resp.FinalReturn.Maximum)
+	}
+	if !resp.MaxDrawdown.Original.Round(8).Equal(decimal.NewFromInt(-20)) {
+		t.Errorf("received '%v' expected '%v'", resp.MaxDrawdown.Original, -20)
+	}
+	if !resp.RiskOfRuin.IsPositive() {
+		t.Error("expected some shuffled orders to lose more than 10%")
+	}
+
+	s.MonteCarloSettings.FeePerturbationPercent = decimal.NewFromInt(50)
+	s.MonteCarloSettings.SlippagePerturbationPercent = decimal.NewFromInt(50)
+	resp, err = s.RunMonteCarlo()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.FinalReturn.Minimum.Equal(resp.FinalReturn.Maximum) {
+		t.Error("expected perturbed costs to change final returns")
+	}
+	repeat, err := s.RunMonteCarlo()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !repeat.FinalReturn.Mean.Equal(resp.FinalReturn.Mean) {
+		t.Errorf("received '%v' expected seeded simulations to repeat '%v'", repeat.FinalReturn.Mean, resp.FinalReturn.Mean)
+	}
+
+	s.MonteCarloSettings.Bootstrap = true
+	s.MonteCarloSettings.ResampleTrades = true
+	resp, err = s.RunMonteCarlo()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.Method != "bootstrap" || resp.Sample != "trades" || resp.SampleSize != 2 {
+		t.Errorf("received '%v %v %v' expected 'bootstrap trades 2'", resp.Method, resp.Sample, resp.SampleSize)
+	}
+	var count int64
+	for i := range resp.FinalReturn.Histogram {
+		count += resp.FinalReturn.Histogram[i].Count
+	}
+	if count != 100 {
+		t.Errorf("received '%v' expected '%v'", count, 100)
+	}
+}
+
+func TestCombineMonteCarloTrades(t *testing.T) {
+	t.Parallel()
+	steps := combineMonteCarloTrades([]monteCarloStep{
+		{growth: 1.1},
+		{growth: 0.5, fee: 0.1, hasTrade: true},
+		{growth: 2},
+	})
+	if len(steps) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(steps), 2)
+	}
+	if math.Abs(steps[0].growth-0.55) > 1e-9 {
+		t.Errorf("received '%v' expected '%v'", steps[0].growth, 0.55)
+	}
+	// the fee was 10% of the value after the first 10% gain
+	if math.Abs(steps[0].fee-0.11) > 1e-9 {
+		t.Errorf("received '%v' expected '%v'", steps[0].fee, 0.11)
+	}
+	if steps[1].growth != 2 || steps[1].hasTrade {
+		t.Errorf("received '%+v' expected trailing growth of 2 without a trade", steps[1])
+	}
+}
+
+func TestSimulateMonteCarloPath(t *testing.T) {
+	t.Parallel()
+	finalReturn, maxDrawdown, lowest := simulateMonteCarloPath([]monteCarloStep{
+		{growth: 2},
+		{growth: 0.25},
+		{growth: 3},
+	})
+	if finalReturn != 50 {
+		t.Errorf("received '%v' expected '%v'", finalReturn, 50)
+	}
+	if maxDrawdown != -75 {
+		t.Errorf("received '%v' expected '%v'", maxDrawdown, -75)
+	}
+	if lowest != 0.5 {
+		t.Errorf("received '%v' expected '%v'", lowest, 0.5)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	t.Parallel()
+	if p := percentile([]float64{5}, 0.9); p != 5 {
+		t.Errorf("received '%v' expected '%v'", p, 5)
+	}
+	sorted := []float64{0, 10, 20, 30}
+	if p := percentile(sorted, 0.5); p != 15 {
+		t.Errorf("received '%v' expected '%v'", p, 15)
+	}
+	if p := percentile(sorted, 1); p != 30 {
+		t.Errorf("received '%v' expected '%v'", p, 30)
+	}
+}
+
+func TestCreateHistogram(t *testing.T) {
+	t.Parallel()
+	bins := createHistogram([]float64{1, 1}, 10)
+	if len(bins) != 1 || bins[0].Count != 2 {
+		t.Errorf("received '%+v' expected a single bin of 2", bins)
+	}
+	bins = createHistogram([]float64{0, 1, 2, 10}, 5)
+	if len(bins) != 5 {
+		t.Fatalf("received '%v' expected '%v'", len(bins), 5)
+	}
+	if bins[0].Count != 2 || bins[1].Count != 1 || bins[4].Count != 1 {
+		t.Errorf("received '%+v' expected counts of 2, 1, 0, 0, 1", bins)
+	}
+	if !bins[4].Maximum.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '%v'", bins[4].Maximum, 10)
+	}
+}
@@ -238,6 +238,32 @@ func (c *CurrencyPairStatistic) PrintResults(e string, a asset.Item, p currency.
 	}
 
 	log.Infof(common.CurrencyStatistics, "%s Total orders: %s", sep, convert.IntToHumanFriendlyString(c.TotalOrders, ","))
+	if len(c.ExitsTriggered) > 0 {
+		rules := make([]string, 0, len(c.ExitsTriggered))
+		for rule := range c.ExitsTriggered {
+			rules = append(rules, rule)
+		}
+		sort.Strings(rules)
+		for i := range rules {
+			log.Infof(common.CurrencyStatistics, "%s Positions closed by %v exit rule: %s", sep, rules[i], convert.IntToHumanFriendlyString(c.ExitsTriggered[rules[i]], ","))
+		}
+	}
+	if len(c.UnfilledOrders) > 0 {
+		log.Infof(common.CurrencyStatistics, "%s Unfilled orders: %s", sep, convert.IntToHumanFriendlyString(int64(len(c.UnfilledOrders)), ","))
+		for i := range c.UnfilledOrders {
+			log.Infof(common.CurrencyStatistics, "%s %v %v %v order %v for %v placed at %v",
+				sep,
+				c.UnfilledOrders[i].Status,
+				c.UnfilledOrders[i].Type,
+				c.UnfilledOrders[i].Side,
+				c.UnfilledOrders[i].ID,
+				c.UnfilledOrders[i].Amount,
+				c.UnfilledOrders[i].Placed)
+			if c.UnfilledOrders[i].Reason != "" {
+				log.Infof(common.CurrencyStatistics, "%s Reason: %v", sep, c.UnfilledOrders[i].Reason)
+			}
+		}
+	}
 
 	log.Infoln(common.CurrencyStatistics, common.CMDColours.H2+"------------------Max Drawdown-------------------------------"+common.CMDColours.Default)
 	log.Infof(common.CurrencyStatistics, "%s Highest Price of drawdown: %s at %v", sep, convert.DecimalToHumanFriendlyString(c.MaxDrawdown.Highest.Value, 8, ".", ","), c.MaxDrawdown.Highest.Time)
@@ -301,6 +327,9 @@ func (c *CurrencyPairStatistic) PrintResults(e string, a asset.Item, p currency.
 		realised = last.PNL.GetRealisedPNL()
 		log.Infof(common.CurrencyStatistics, "%s Final Unrealised PNL: %s", sep, convert.DecimalToHumanFriendlyString(unrealised.PNL, 8, ".", ","))
 		log.Infof(common.CurrencyStatistics, "%s Final Realised PNL: %s", sep, convert.DecimalToHumanFriendlyString(realised.PNL, 8, ".", ","))
+		if funding := last.PNL.GetFundingPayments(); !funding.PNL.IsZero() {
+			log.Infof(common.CurrencyStatistics, "%s Total Funding Payments: %s %s", sep, convert.DecimalToHumanFriendlyString(funding.PNL, 8, ".", ","), funding.Currency)
+		}
 	}
 }
 
@@ -345,6 +374,19 @@ func (f *FundingStatistics) PrintResults(wasAnyDataMissing bool) error {
 			if spotResults[i].ReportItem.TransferFee.GreaterThan(decimal.Zero) {
 				log.Infof(common.FundingStatistics, "%s Transfer fee: %s", sep, convert.DecimalToHumanFriendlyString(spotResults[i].ReportItem.TransferFee, 8, ".", ","))
 			}
+			if !spotResults[i].ReportItem.BorrowCosts.IsZero() || !spotResults[i].ReportItem.Borrowed.IsZero() {
+				log.Infof(common.FundingStatistics, "%s Borrow costs: %s", sep, convert.DecimalToHumanFriendlyString(spotResults[i].ReportItem.BorrowCosts, 8, ".", ","))
+				log.Infof(common.FundingStatistics, "%s Outstanding borrowed: %s", sep, convert.DecimalToHumanFriendlyString(spotResults[i].ReportItem.Borrowed, 8, ".", ","))
+			}
+			if spotResults[i].ReportItem.MarginLiquidations > 0 {
+				log.Warnf(common.FundingStatistics, "%s Margin liquidations: %v", sep, spotResults[i].ReportItem.MarginLiquidations)
+			}
+			if !spotResults[i].ReportItem.TransferFeesPaid.IsZero() {
+				log.Infof(common.FundingStatistics, "%s Transfer fees paid: %s", sep, convert.DecimalToHumanFriendlyString(spotResults[i].ReportItem.TransferFeesPaid, 8, ".", ","))
+			}
+			if !spotResults[i].ReportItem.InTransit.IsZero() {
+				log.Warnf(common.FundingStatistics, "%s Funds still in transit: %s", sep, convert.DecimalToHumanFriendlyString(spotResults[i].ReportItem.InTransit, 8, ".", ","))
+			}
 			if i != len(spotResults)-1 {
 				log.Infoln(common.FundingStatistics, "")
 			}
@@ -391,6 +433,9 @@ func (f *FundingStatistics) PrintResults(wasAnyDataMissing bool) error {
 	log.Infof(common.FundingStatistics, "%s Did strategy beat the benchmark: %v", sep, f.TotalUSDStatistics.DidStrategyBeatTheMarket)
 	log.Infof(common.FundingStatistics, "%s Highest funds: $%s at %v", sep, convert.DecimalToHumanFriendlyString(f.TotalUSDStatistics.HighestHoldingValue.Value, 8, ".", ","), f.TotalUSDStatistics.HighestHoldingValue.Time)
 	log.Infof(common.FundingStatistics, "%s Lowest funds: $%s at %v", sep, convert.DecimalToHumanFriendlyString(f.TotalUSDStatistics.LowestHoldingValue.Value, 8, ".", ","), f.TotalUSDStatistics.LowestHoldingValue.Time)
+	if !f.TotalUSDStatistics.USDBorrowCosts.IsZero() {
+		log.Infof(common.FundingStatistics, "%s Borrow costs: $%s", sep, convert.DecimalToHumanFriendlyString(f.TotalUSDStatistics.USDBorrowCosts, 8, ".", ","))
+	}
 
 	log.Infoln(common.FundingStatistics, common.CMDColours.H3+"------------------Ratios------------------------------------------------"+common.CMDColours.Default)
 	log.Infoln(common.FundingStatistics, common.CMDColours.H4+"------------------Rates-------------------------------------------------"+common.CMDColours.Default)
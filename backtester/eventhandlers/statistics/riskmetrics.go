@@ -0,0 +1,331 @@
+package statistics
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// CalculateRiskMetrics calculates the value at risk, conditional value at
+// risk, omega ratio, ulcer index, exposure time and the monthly and annual
+// returns of the strategy's equity curve, along with the winning and losing
+// streaks of its closed trades
+func (s *Statistic) CalculateRiskMetrics() (*RiskMetrics, error) {
+	curve, err := s.GetEquityCurve()
+	if err != nil {
+		return nil, err
+	}
+	returns := equityReturns(curve)
+	if len(returns) == 0 {
+		return nil, fmt.Errorf("%w, %v values in equity curve", errNotEnoughReturns, len(curve))
+	}
+	confidence := s.ValueAtRiskConfidence
+	if confidence.IsZero() {
+		confidence = decimal.NewFromInt(defaultValueAtRiskConfidence)
+	}
+	resp := &RiskMetrics{
+		ConfidenceLevel: confidence,
+		UlcerIndex:      ulcerIndex(curve),
+		ExposureTime:    s.exposureTime(),
+		AnnualReturns:   annualReturns(curve),
+	}
+	resp.OmegaRatio, resp.ShowInfiniteOmegaRatio = omegaRatio(returns, s.riskFreeRatePerCandle())
+	resp.ValueAtRisk, resp.ConditionalValueAtRisk = valueAtRisk(returns, confidence.InexactFloat64()/100)
+	resp.LongestWinningStreak, resp.LongestLosingStreak = streaks(s.closedTradeResults())
+	return resp, nil
+}
+
+// equityReturns calculates the return of each candle of an equity curve
+func equityReturns(curve []ValueAtTime) []float64 {
+	resp := make([]float64, 0, len(curve))
+	for i := 1; i < len(curve); i++ {
+		if curve[i-1].Value.IsZero() {
+			continue
+		}
+		resp = append(resp, curve[i].Value.Div(curve[i-1].Value).Sub(decimal.NewFromInt(1)).InexactFloat64())
+	}
+	return resp
+}
+
+// riskFreeRatePerCandle converts the annual risk free rate to a rate
+// for each candle interval
+func (s *Statistic) riskFreeRatePerCandle() float64 {
+	if s.CandleInterval <= 0 {
+		return 0
+	}
+	return s.RiskFreeRate.InexactFloat64() / s.CandleInterval.IntervalsPerYear()
+}
+
+// valueAtRisk calculates the historical value at risk and conditional
+// value at risk of returns as positive percentages
+func valueAtRisk(returns []float64, confidence float64) (valueAtRisk, conditionalValueAtRisk decimal.Decimal) {
+	sorted := make([]float64, len(returns))
+	copy(sorted, returns)
+	sort.Float64s(sorted)
+	threshold := percentile(sorted, 1-confidence)
+	var tailSum float64
+	var tailCount int
+	for i := range sorted {
+		if sorted[i] > threshold {
+			break
+		}
+		tailSum += sorted[i]
+		tailCount++
+	}
+	valueAtRisk = decimal.NewFromFloat(math.Max(-threshold, 0) * 100)
+	if tailCount > 0 {
+		conditionalValueAtRisk = decimal.NewFromFloat(math.Max(-tailSum/float64(tailCount), 0) * 100)
+	}
+	return valueAtRisk, conditionalValueAtRisk
+}
+
+// omegaRatio divides the sum of returns above the threshold by the sum of
+// returns below it. The ratio is infinite when there are gains without any
+// losses, in which case zero is returned with infinite set
+func omegaRatio(returns []float64, threshold float64) (ratio decimal.Decimal, infinite bool) {
+	var gains, losses float64
+	for i := range returns {
+		if returns[i] > threshold {
+			gains += returns[i] - threshold
+		} else {
+			losses += threshold - returns[i]
+		}
+	}
+	if losses == 0 {
+		return decimal.Zero, gains > 0
+	}
+	return decimal.NewFromFloat(gains / losses), false
+}
+
+// ulcerIndex is the root mean square of the percentage drawdown from the
+// highest value at each point of the equity curve
+func ulcerIndex(curve []ValueAtTime) decimal.Decimal {
+	var peak, sumSquares float64
+	var count int
+	for i := range curve {
+		v := curve[i].Value.InexactFloat64()
+		if v > peak {
+			peak = v
+		}
+		if peak == 0 {
+			continue
+		}
+		drawdown := (v - peak) / peak * 100
+		sumSquares += drawdown * drawdown
+		count++
+	}
+	if count == 0 {
+		return decimal.Zero
+	}
+	return decimal.NewFromFloat(math.Sqrt(sumSquares / float64(count)))
+}
+
+// closedTrade is the result of a trade once its position has been closed
+type closedTrade struct {
+	closed time.Time
+	result decimal.Decimal
+}
+
+// closedTradeResults returns the result of each closed trade across all
+// currency pairs in the order they were closed. A spot trade is closed once
+// its base holdings return to zero and its result is the change in the total
+// value of the pair's holdings since it was opened. A futures trade is closed
+// once its position is closed or liquidated and its result is the position's
+// realised PNL
+func (s *Statistic) closedTradeResults() []float64 {
+	var trades []closedTrade
+	for _, exchangeMap := range s.ExchangeAssetPairStatistics {
+		for a, assetMap := range exchangeMap {
+			for _, baseMap := range assetMap {
+				for _, stats := range baseMap {
+					if a.IsFutures() {
+						trades = append(trades, futuresClosedTrades(stats.Events)...)
+					} else {
+						trades = append(trades, spotClosedTrades(stats.Events)...)
+					}
+				}
+			}
+		}
+	}
+	sort.SliceStable(trades, func(i, j int) bool {
+		return trades[i].closed.Before(trades[j].closed)
+	})
+	resp := make([]float64, len(trades))
+	for i := range trades {
+		resp[i] = trades[i].result.InexactFloat64()
+	}
+	return resp
+}
+
+// spotClosedTrades returns the trades which opened and closed a
+// spot position
+func spotClosedTrades(events []DataAtOffset) []closedTrade {
+	var resp []closedTrade
+	var open bool
+	var openValue decimal.Decimal
+	for i := range events {
+		hasPosition := !events[i].Holdings.BaseSize.IsZero()
+		switch {
+		case !open && hasPosition:
+			open = true
+			// holdings reflect the opening fill, so the value before it
+			// is taken from the previous event
+			openValue = events[i].Holdings.TotalInitialValue
+			if i > 0 {
+				openValue = events[i-1].Holdings.TotalValue
+			}
+		case open && !hasPosition:
+			open = false
+			resp = append(resp, closedTrade{
+				closed: events[i].Time,
+				result: events[i].Holdings.TotalValue.Sub(openValue),
+			})
+		}
+	}
+	return resp
+}
+
+// futuresClosedTrades returns the trades which opened and closed a
+// futures position
+func futuresClosedTrades(events []DataAtOffset) []closedTrade {
+	var resp []closedTrade
+	var open bool
+	for i := range events {
+		if events[i].PNL == nil {
+			continue
+		}
+		switch events[i].PNL.GetPositionStatus() {
+		case gctorder.Open:
+			open = true
+		case gctorder.Closed, gctorder.Liquidated:
+			if !open {
+				continue
+			}
+			open = false
+			resp = append(resp, closedTrade{
+				closed: events[i].Time,
+				result: events[i].PNL.GetRealisedPNL().PNL,
+			})
+		}
+	}
+	return resp
+}
+
+// streaks returns the most consecutive positive and negative results
+func streaks(returns []float64) (longestWinning, longestLosing int64) {
+	var winning, losing int64
+	for i := range returns {
+		switch {
+		case returns[i] > 0:
+			winning++
+			losing = 0
+		case returns[i] < 0:
+			losing++
+			winning = 0
+		default:
+			winning, losing = 0, 0
+		}
+		if winning > longestWinning {
+			longestWinning = winning
+		}
+		if losing > longestLosing {
+			longestLosing = losing
+		}
+	}
+	return longestWinning, longestLosing
+}
+
+// annualReturns calculates the return of each calendar month and year of an
+// equity curve. Each period's return is measured from the final value of
+// the previous period, or the first value of the curve
+func annualReturns(curve []ValueAtTime) []AnnualReturns {
+	if len(curve) == 0 {
+		return nil
+	}
+	var resp []AnnualReturns
+	monthBase := curve[0].Value
+	yearBase := curve[0].Value
+	for i := range curve {
+		t := curve[i].Time.UTC()
+		if len(resp) == 0 || resp[len(resp)-1].Year != t.Year() {
+			if len(resp) > 0 {
+				yearBase = curve[i-1].Value
+			}
+			resp = append(resp, AnnualReturns{Year: t.Year()})
+		}
+		if i > 0 && !sameMonth(curve[i-1].Time.UTC(), t) {
+			monthBase = curve[i-1].Value
+		}
+		year := &resp[len(resp)-1]
+		if !monthBase.IsZero() {
+			year.Months[t.Month()-1] = PeriodReturn{
+				Return: curve[i].Value.Sub(monthBase).Div(monthBase).Mul(decimal.NewFromInt(100)),
+				Set:    true,
+			}
+		}
+		if !yearBase.IsZero() {
+			year.Total = curve[i].Value.Sub(yearBase).Div(yearBase).Mul(decimal.NewFromInt(100))
+		}
+	}
+	return resp
+}
+
+func sameMonth(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.Month() == b.Month()
+}
+
+// exposureTime is the percentage of candles in which any currency
+// pair held a spot balance or an open futures position
+func (s *Statistic) exposureTime() decimal.Decimal {
+	exposed := make(map[int64]bool)
+	for _, exchangeMap := range s.ExchangeAssetPairStatistics {
+		for _, assetMap := range exchangeMap {
+			for _, baseMap := range assetMap {
+				for _, stats := range baseMap {
+					for i := range stats.Events {
+						t := stats.Events[i].Time.UnixNano()
+						hasPosition := !stats.Events[i].Holdings.BaseSize.IsZero() ||
+							(stats.Events[i].PNL != nil && stats.Events[i].PNL.GetPositionStatus() == gctorder.Open)
+						exposed[t] = exposed[t] || hasPosition
+					}
+				}
+			}
+		}
+	}
+	if len(exposed) == 0 {
+		return decimal.Zero
+	}
+	var count int64
+	for _, v := range exposed {
+		if v {
+			count++
+		}
+	}
+	return decimal.NewFromInt(count).Div(decimal.NewFromInt(int64(len(exposed)))).Mul(decimal.NewFromInt(100))
+}
+
+// PrintResults outputs the risk metrics to the command line
+func (r *RiskMetrics) PrintResults() {
+	log.Infoln(common.Statistics, common.CMDColours.H1+"------------------Risk Metrics-------------------------------"+common.CMDColours.Default)
+	log.Infof(common.Statistics, "%s%% value at risk: %s%%", r.ConfidenceLevel, convert.DecimalToHumanFriendlyString(r.ValueAtRisk, 2, ".", ","))
+	log.Infof(common.Statistics, "%s%% conditional value at risk: %s%%", r.ConfidenceLevel, convert.DecimalToHumanFriendlyString(r.ConditionalValueAtRisk, 2, ".", ","))
+	if r.ShowInfiniteOmegaRatio {
+		log.Infoln(common.Statistics, "Omega ratio: ∞")
+	} else {
+		log.Infof(common.Statistics, "Omega ratio: %s", convert.DecimalToHumanFriendlyString(r.OmegaRatio.Round(4), 4, ".", ","))
+	}
+	log.Infof(common.Statistics, "Ulcer index: %s", convert.DecimalToHumanFriendlyString(r.UlcerIndex.Round(4), 4, ".", ","))
+	log.Infof(common.Statistics, "Exposure time: %s%%", convert.DecimalToHumanFriendlyString(r.ExposureTime, 2, ".", ","))
+	log.Infof(common.Statistics, "Longest winning streak: %v trades", r.LongestWinningStreak)
+	log.Infof(common.Statistics, "Longest losing streak: %v trades", r.LongestLosingStreak)
+	for i := range r.AnnualReturns {
+		log.Infof(common.Statistics, "%v return: %s%%", r.AnnualReturns[i].Year, convert.DecimalToHumanFriendlyString(r.AnnualReturns[i].Total, 2, ".", ","))
+	}
+}
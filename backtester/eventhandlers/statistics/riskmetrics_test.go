@@ -0,0 +1,205 @@
+package statistics
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func riskMetricsTestStatistic(t *testing.T, values ...int64) *Statistic {
+	t.Helper()
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	cps := &CurrencyPairStatistic{}
+	for i := range values {
+		cps.Events = append(cps.Events, DataAtOffset{
+			Time:       tt.AddDate(0, 0, i),
+			ClosePrice: decimal.NewFromInt(values[i]),
+			Holdings:   holdings.Holding{TotalValue: decimal.NewFromInt(values[i])},
+		})
+	}
+	return &Statistic{
+		CandleInterval: gctkline.OneDay,
+		ExchangeAssetPairStatistics: map[string]map[asset.Item]map[*currency.Item]map[*currency.Item]*CurrencyPairStatistic{
+			testExchange: {
+				asset.Spot: {
+					cp.Base.Item: {
+						cp.Quote.Item: cps,
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCalculateRiskMetrics(t *testing.T) {
+	t.Parallel()
+	s := riskMetricsTestStatistic(t, 100)
+	_, err := s.CalculateRiskMetrics()
+	if !errors.Is(err, errNotEnoughReturns) {
+		t.Errorf("received '%v' expected '%v'", err, errNotEnoughReturns)
+	}
+
+	s = riskMetricsTestStatistic(t, 100, 110, 99, 99, 120)
+	// a single losing trade is opened at 110 and closed at 99
+	s.ExchangeAssetPairStatistics[testExchange][asset.Spot][currency.BTC.Item][currency.USDT.Item].Events[1].Holdings.BaseSize = decimal.NewFromInt(1)
+	r, err := s.CalculateRiskMetrics()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.ConfidenceLevel.Equal(decimal.NewFromInt(defaultValueAtRiskConfidence)) {
+		t.Errorf("received '%v' expected '%v'", r.ConfidenceLevel, defaultValueAtRiskConfidence)
+	}
+	if !r.ExposureTime.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received '%v' expected '%v'", r.ExposureTime, 20)
+	}
+	if r.LongestWinningStreak != 0 || r.LongestLosingStreak != 1 {
+		t.Errorf("received '%v' '%v' expected '0' '1'", r.LongestWinningStreak, r.LongestLosingStreak)
+	}
+	if !r.ValueAtRisk.IsPositive() || r.ConditionalValueAtRisk.LessThan(r.ValueAtRisk) {
+		t.Errorf("received value at risk '%v' and conditional value at risk '%v'", r.ValueAtRisk, r.ConditionalValueAtRisk)
+	}
+	if len(r.AnnualReturns) != 1 || !r.AnnualReturns[0].Total.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received '%+v' expected a single year returning 20%%", r.AnnualReturns)
+	}
+}
+
+func TestValueAtRisk(t *testing.T) {
+	t.Parallel()
+	returns := []float64{0.05, -0.1, 0.02, -0.02, 0.01}
+	v, cv := valueAtRisk(returns, 0.75)
+	if math.Abs(v.InexactFloat64()-2) > 1e-9 || math.Abs(cv.InexactFloat64()-6) > 1e-9 {
+		t.Errorf("received '%v' '%v' expected '2' '6'", v, cv)
+	}
+	// returns at the threshold are profitable so there is no value at risk
+	v, _ = valueAtRisk(returns, 0.5)
+	if !v.IsZero() {
+		t.Errorf("received '%v' expected '0'", v)
+	}
+}
+
+func TestOmegaRatio(t *testing.T) {
+	t.Parallel()
+	r, infinite := omegaRatio([]float64{0.1, 0.2}, 0)
+	if !r.IsZero() || !infinite {
+		t.Errorf("received '%v' '%v' expected '0' 'true'", r, infinite)
+	}
+	r, infinite = omegaRatio([]float64{0, 0}, 0)
+	if !r.IsZero() || infinite {
+		t.Errorf("received '%v' '%v' expected '0' 'false'", r, infinite)
+	}
+	r, infinite = omegaRatio([]float64{0.3, -0.1}, 0)
+	if math.Abs(r.InexactFloat64()-3) > 1e-9 || infinite {
+		t.Errorf("received '%v' '%v' expected '3' 'false'", r, infinite)
+	}
+}
+
+func TestUlcerIndex(t *testing.T) {
+	t.Parallel()
+	if u := ulcerIndex(nil); !u.IsZero() {
+		t.Errorf("received '%v' expected '0'", u)
+	}
+	u := ulcerIndex([]ValueAtTime{
+		{Value: decimal.NewFromInt(100)},
+		{Value: decimal.NewFromInt(90)},
+	})
+	// drawdowns of 0% and 10%
+	if math.Abs(u.InexactFloat64()-math.Sqrt(50)) > 1e-9 {
+		t.Errorf("received '%v' expected '%v'", u, math.Sqrt(50))
+	}
+}
+
+func TestStreaks(t *testing.T) {
+	t.Parallel()
+	w, l := streaks([]float64{0.1, 0.1, 0, 0.1, -0.1, -0.1, -0.1, 0.2})
+	if w != 2 || l != 3 {
+		t.Errorf("received '%v' '%v' expected '2' '3'", w, l)
+	}
+}
+
+func TestClosedTradeResults(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	// two winning spot trades, with the price moving against the
+	// position while it is held
+	spot := riskMetricsTestStatistic(t, 100, 110, 105, 120, 120, 130, 125)
+	events := spot.ExchangeAssetPairStatistics[testExchange][asset.Spot][currency.BTC.Item][currency.USDT.Item].Events
+	for _, i := range []int{1, 2, 5} {
+		events[i].Holdings.BaseSize = decimal.NewFromInt(1)
+	}
+	results := spot.closedTradeResults()
+	if len(results) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(results), 2)
+	}
+	if results[0] != 20 || results[1] != 5 {
+		t.Errorf("received '%v' expected '[20 5]'", results)
+	}
+
+	pnl := func(status gctorder.Status, realised int64) *portfolio.PNLSummary {
+		return &portfolio.PNLSummary{Result: gctorder.PNLResult{Status: status, RealisedPNL: decimal.NewFromInt(realised)}}
+	}
+	futures := &CurrencyPairStatistic{
+		Events: []DataAtOffset{
+			{Time: tt},
+			{Time: tt.Add(time.Hour), PNL: pnl(gctorder.Open, 0)},
+			{Time: tt.Add(time.Hour * 2), PNL: pnl(gctorder.Closed, -5)},
+			{Time: tt.Add(time.Hour * 3), PNL: pnl(gctorder.Closed, -5)},
+			{Time: tt.Add(time.Hour * 4), PNL: pnl(gctorder.Open, 0)},
+			{Time: tt.Add(time.Hour * 5), PNL: pnl(gctorder.Liquidated, -10)},
+		},
+	}
+	spot.ExchangeAssetPairStatistics[testExchange][asset.Futures] = map[*currency.Item]map[*currency.Item]*CurrencyPairStatistic{
+		currency.BTC.Item: {currency.USDT.Item: futures},
+	}
+	results = spot.closedTradeResults()
+	if len(results) != 4 {
+		t.Fatalf("received '%v' expected '%v'", len(results), 4)
+	}
+	// trades are ordered by when they closed across all pairs
+	if results[0] != -5 || results[1] != -10 || results[2] != 20 || results[3] != 5 {
+		t.Errorf("received '%v' expected '[-5 -10 20 5]'", results)
+	}
+	w, l := streaks(results)
+	if w != 2 || l != 2 {
+		t.Errorf("received '%v' '%v' expected '2' '2'", w, l)
+	}
+}
+
+func TestAnnualReturns(t *testing.T) {
+	t.Parallel()
+	if annualReturns(nil) != nil {
+		t.Error("expected nil")
+	}
+	resp := annualReturns([]ValueAtTime{
+		{Time: time.Date(2020, 11, 30, 0, 0, 0, 0, time.UTC), Value: decimal.NewFromInt(100)},
+		{Time: time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC), Value: decimal.NewFromInt(110)},
+		{Time: time.Date(2021, 1, 31, 0, 0, 0, 0, time.UTC), Value: decimal.NewFromInt(99)},
+	})
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '2'", len(resp))
+	}
+	if !resp[0].Months[10].Set || !resp[0].Months[10].Return.IsZero() {
+		t.Errorf("received '%+v' expected a set zero return", resp[0].Months[10])
+	}
+	if !resp[0].Months[11].Return.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '10'", resp[0].Months[11].Return)
+	}
+	if resp[0].Months[0].Set {
+		t.Error("expected January 2020 to be unset")
+	}
+	if !resp[0].Total.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '10'", resp[0].Total)
+	}
+	if !resp[1].Months[0].Return.Equal(decimal.NewFromInt(-10)) || !resp[1].Total.Equal(decimal.NewFromInt(-10)) {
+		t.Errorf("received '%v' '%v' expected '-10' '-10'", resp[1].Months[0].Return, resp[1].Total)
+	}
+}
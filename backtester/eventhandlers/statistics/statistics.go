@@ -7,6 +7,7 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
@@ -32,6 +33,7 @@ func (s *Statistic) Reset() error {
 	s.StartDate = time.Time{}
 	s.EndDate = time.Time{}
 	s.CandleInterval = 0
+	s.Seed = 0
 	s.RiskFreeRate = decimal.Zero
 	s.ExchangeAssetPairStatistics = make(map[string]map[asset.Item]map[*currency.Item]map[*currency.Item]*CurrencyPairStatistic)
 	s.CurrencyStatistics = nil
@@ -47,6 +49,8 @@ func (s *Statistic) Reset() error {
 	s.FundingStatistics = nil
 	s.FundManager = nil
 	s.HasCollateral = false
+	s.MonteCarloSettings = nil
+	s.MonteCarlo = nil
 	return nil
 }
 
@@ -181,6 +185,31 @@ func (s *Statistic) AddPNLForTime(pnl *portfolio.PNLSummary) error {
 	return fmt.Errorf("%v %v %v %w %v", pnl.Exchange, pnl.Asset, pnl.Pair, errNoDataAtOffset, pnl.Offset)
 }
 
+// AddUnfilledOrders stores resting orders which were not filled against
+// their exchange asset pair statistics, replacing any previously stored
+func (s *Statistic) AddUnfilledOrders(orders []exchange.RestingOrder) error {
+	if s.ExchangeAssetPairStatistics == nil {
+		return errExchangeAssetPairStatsUnset
+	}
+	for _, assetMap := range s.ExchangeAssetPairStatistics {
+		for _, baseMap := range assetMap {
+			for _, quoteMap := range baseMap {
+				for _, stats := range quoteMap {
+					stats.UnfilledOrders = nil
+				}
+			}
+		}
+	}
+	for i := range orders {
+		lookup := s.ExchangeAssetPairStatistics[orders[i].Exchange][orders[i].Asset][orders[i].Pair.Base.Item][orders[i].Pair.Quote.Item]
+		if lookup == nil {
+			return fmt.Errorf("%w for %v %v %v to set unfilled orders", errCurrencyStatisticsUnset, orders[i].Exchange, orders[i].Asset, orders[i].Pair)
+		}
+		lookup.UnfilledOrders = append(lookup.UnfilledOrders, orders[i])
+	}
+	return nil
+}
+
 // AddComplianceSnapshotForTime adds the compliance snapshot to the statistics at the time period
 func (s *Statistic) AddComplianceSnapshotForTime(c *compliance.Snapshot, e common.Event) error {
 	if c == nil {
@@ -271,6 +300,28 @@ func (s *Statistic) CalculateAllResults() error {
 	if err != nil {
 		return err
 	}
+	if s.MonteCarloSettings != nil {
+		s.MonteCarlo, err = s.RunMonteCarlo()
+		if err != nil {
+			log.Errorf(common.Statistics, "Could not run monte carlo analysis: %v", err)
+		} else {
+			s.MonteCarlo.PrintResults()
+		}
+	}
+	s.RiskMetrics, err = s.CalculateRiskMetrics()
+	if err != nil {
+		log.Errorf(common.Statistics, "Could not calculate risk metrics: %v", err)
+	} else {
+		s.RiskMetrics.PrintResults()
+	}
+	if s.BenchmarkSettings != nil {
+		s.Benchmark, err = s.CompareBenchmark()
+		if err != nil {
+			log.Errorf(common.Statistics, "Could not compare against benchmark: %v", err)
+		} else {
+			s.Benchmark.PrintResults()
+		}
+	}
 	if currCount > 1 {
 		s.BiggestDrawdown = s.GetTheBiggestDrawdownAcrossCurrencies(finalResults)
 		s.BestMarketMovement = s.GetBestMarketPerformer(finalResults)
@@ -281,6 +332,80 @@ func (s *Statistic) CalculateAllResults() error {
 	return nil
 }
 
+// GetPerformanceSummary returns the headline figures of the strategy run.
+// Total USD statistics are used when available, otherwise the statistics of
+// the only currency pair in the run are used
+func (s *Statistic) GetPerformanceSummary() (*PerformanceSummary, error) {
+	if s.hasUSDTotals() {
+		usdStats := s.FundingStatistics.TotalUSDStatistics
+		return &PerformanceSummary{
+			SharpeRatio:  usdStats.ArithmeticRatios.SharpeRatio,
+			SortinoRatio: usdStats.ArithmeticRatios.SortinoRatio,
+			CalmarRatio:  usdStats.ArithmeticRatios.CalmarRatio,
+			TotalReturn:  usdStats.HoldingValueDifference,
+			MaxDrawdown:  usdStats.MaxDrawdown.DrawdownPercent,
+		}, nil
+	}
+	stats, err := s.getOnlyCurrencyPairStatistic()
+	if err != nil {
+		return nil, err
+	}
+	if stats.ArithmeticRatios == nil {
+		return nil, fmt.Errorf("%w, results have not been calculated", errNoPerformanceSummary)
+	}
+	return &PerformanceSummary{
+		SharpeRatio:  stats.ArithmeticRatios.SharpeRatio,
+		SortinoRatio: stats.ArithmeticRatios.SortinoRatio,
+		CalmarRatio:  stats.ArithmeticRatios.CalmarRatio,
+		TotalReturn:  stats.StrategyMovement,
+		MaxDrawdown:  stats.MaxDrawdown.DrawdownPercent,
+	}, nil
+}
+
+// GetEquityCurve returns the value of the strategy's holdings over time.
+// Total USD values are used when available, otherwise the holdings value of
+// the only currency pair in the run is used
+func (s *Statistic) GetEquityCurve() ([]ValueAtTime, error) {
+	if s.hasUSDTotals() {
+		return s.FundingStatistics.TotalUSDStatistics.HoldingValues, nil
+	}
+	stats, err := s.getOnlyCurrencyPairStatistic()
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]ValueAtTime, 0, len(stats.Events))
+	for i := range stats.Events {
+		resp = append(resp, ValueAtTime{
+			Time:  stats.Events[i].Time,
+			Value: stats.Events[i].Holdings.TotalValue,
+		})
+	}
+	return resp, nil
+}
+
+func (s *Statistic) hasUSDTotals() bool {
+	return s.FundingStatistics != nil &&
+		s.FundingStatistics.TotalUSDStatistics != nil &&
+		s.FundingStatistics.TotalUSDStatistics.ArithmeticRatios != nil
+}
+
+func (s *Statistic) getOnlyCurrencyPairStatistic() (*CurrencyPairStatistic, error) {
+	var stats []*CurrencyPairStatistic
+	for _, exchangeMap := range s.ExchangeAssetPairStatistics {
+		for _, assetMap := range exchangeMap {
+			for _, baseMap := range assetMap {
+				for _, cps := range baseMap {
+					stats = append(stats, cps)
+				}
+			}
+		}
+	}
+	if len(stats) != 1 {
+		return nil, fmt.Errorf("%w, USD tracking is required when there are %v currency pairs", errNoPerformanceSummary, len(stats))
+	}
+	return stats[0], nil
+}
+
 // GetBestMarketPerformer returns the best final market movement
 func (s *Statistic) GetBestMarketPerformer(results []FinalResultsHolder) *FinalResultsHolder {
 	var result FinalResultsHolder
@@ -8,6 +8,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
@@ -981,3 +982,162 @@ func TestAddPNLForTime(t *testing.T) {
 		t.Errorf("received %v expected %v", err, nil)
 	}
 }
+
+func TestAddUnfilledOrders(t *testing.T) {
+	t.Parallel()
+	s := &Statistic{}
+	err := s.AddUnfilledOrders(nil)
+	if !errors.Is(err, errExchangeAssetPairStatsUnset) {
+		t.Errorf("received %v expected %v", err, errExchangeAssetPairStatsUnset)
+	}
+
+	s.ExchangeAssetPairStatistics = make(map[string]map[asset.Item]map[*currency.Item]map[*currency.Item]*CurrencyPairStatistic)
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	orders := []exchange.RestingOrder{
+		{
+			Exchange: testExchange,
+			Asset:    asset.Spot,
+			Pair:     p,
+			Type:     gctorder.Limit,
+			Side:     gctorder.Buy,
+			Status:   gctorder.Expired,
+		},
+	}
+	err = s.AddUnfilledOrders(orders)
+	if !errors.Is(err, errCurrencyStatisticsUnset) {
+		t.Errorf("received %v expected %v", err, errCurrencyStatisticsUnset)
+	}
+
+	err = s.SetEventForOffset(&kline.Kline{
+		Base: &event.Base{
+			Exchange:     testExchange,
+			Time:         time.Now(),
+			Interval:     gctkline.OneDay,
+			CurrencyPair: p,
+			AssetType:    asset.Spot,
+			Offset:       1,
+		},
+		Open:   eleet,
+		Close:  eleet,
+		Low:    eleet,
+		High:   eleet,
+		Volume: eleet,
+	})
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	err = s.AddUnfilledOrders(orders)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	err = s.AddUnfilledOrders(orders)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if len(s.ExchangeAssetPairStatistics[testExchange][asset.Spot][p.Base.Item][p.Quote.Item].UnfilledOrders) != 1 {
+		t.Error("expected unfilled orders to be replaced")
+	}
+}
+
+func TestGetPerformanceSummary(t *testing.T) {
+	t.Parallel()
+	s := &Statistic{}
+	_, err := s.GetPerformanceSummary()
+	if !errors.Is(err, errNoPerformanceSummary) {
+		t.Errorf("received '%v' expected '%v'", err, errNoPerformanceSummary)
+	}
+
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	cps := &CurrencyPairStatistic{}
+	s.ExchangeAssetPairStatistics = map[string]map[asset.Item]map[*currency.Item]map[*currency.Item]*CurrencyPairStatistic{
+		testExchange: {
+			asset.Spot: {
+				cp.Base.Item: {
+					cp.Quote.Item: cps,
+				},
+			},
+		},
+	}
+	_, err = s.GetPerformanceSummary()
+	if !errors.Is(err, errNoPerformanceSummary) {
+		t.Errorf("received '%v' expected '%v'", err, errNoPerformanceSummary)
+	}
+
+	cps.ArithmeticRatios = &Ratios{SharpeRatio: decimal.NewFromInt(1)}
+	cps.StrategyMovement = decimal.NewFromInt(5)
+	cps.MaxDrawdown.DrawdownPercent = decimal.NewFromInt(-10)
+	summary, err := s.GetPerformanceSummary()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !summary.SharpeRatio.Equal(decimal.NewFromInt(1)) ||
+		!summary.TotalReturn.Equal(decimal.NewFromInt(5)) ||
+		!summary.MaxDrawdown.Equal(decimal.NewFromInt(-10)) {
+		t.Errorf("received '%+v' expected currency pair figures", summary)
+	}
+
+	s.FundingStatistics = &FundingStatistics{
+		TotalUSDStatistics: &TotalFundingStatistics{
+			ArithmeticRatios:       &Ratios{SortinoRatio: decimal.NewFromInt(2)},
+			HoldingValueDifference: decimal.NewFromInt(20),
+		},
+	}
+	summary, err = s.GetPerformanceSummary()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !summary.SortinoRatio.Equal(decimal.NewFromInt(2)) ||
+		!summary.TotalReturn.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("received '%+v' expected USD figures", summary)
+	}
+}
+
+func TestGetEquityCurve(t *testing.T) {
+	t.Parallel()
+	s := &Statistic{}
+	_, err := s.GetEquityCurve()
+	if !errors.Is(err, errNoPerformanceSummary) {
+		t.Errorf("received '%v' expected '%v'", err, errNoPerformanceSummary)
+	}
+
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+	cps := &CurrencyPairStatistic{
+		Events: []DataAtOffset{
+			{Time: tt, Holdings: holdings.Holding{TotalValue: decimal.NewFromInt(100)}},
+			{Time: tt.Add(time.Hour), Holdings: holdings.Holding{TotalValue: decimal.NewFromInt(110)}},
+		},
+	}
+	s.ExchangeAssetPairStatistics = map[string]map[asset.Item]map[*currency.Item]map[*currency.Item]*CurrencyPairStatistic{
+		testExchange: {
+			asset.Spot: {
+				cp.Base.Item: {
+					cp.Quote.Item: cps,
+				},
+			},
+		},
+	}
+	curve, err := s.GetEquityCurve()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(curve) != 2 || !curve[1].Value.Equal(decimal.NewFromInt(110)) || !curve[1].Time.Equal(tt.Add(time.Hour)) {
+		t.Errorf("received '%v' expected holdings values", curve)
+	}
+
+	s.FundingStatistics = &FundingStatistics{
+		TotalUSDStatistics: &TotalFundingStatistics{
+			ArithmeticRatios: &Ratios{},
+			HoldingValues: []ValueAtTime{
+				{Time: tt, Value: decimal.NewFromInt(1000)},
+			},
+		},
+	}
+	curve, err = s.GetEquityCurve()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(curve) != 1 || !curve[0].Value.Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("received '%v' expected USD holding values", curve)
+	}
+}
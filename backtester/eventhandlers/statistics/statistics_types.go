@@ -7,6 +7,7 @@ import (
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
@@ -29,8 +30,35 @@ var (
 	errNoRelevantStatsFound        = errors.New("no relevant currency pair statistics found")
 	errReceivedNoData              = errors.New("received no data")
 	errNoDataAtOffset              = errors.New("no data found at offset")
+	errNoPerformanceSummary        = errors.New("cannot summarise performance")
+	errNotEnoughMonteCarloData     = errors.New("not enough data to run a monte carlo analysis")
+	errInvalidMonteCarloSettings   = errors.New("invalid monte carlo settings")
+	errNotEnoughReturns            = errors.New("not enough returns to calculate risk metrics")
+	errInvalidBenchmark            = errors.New("invalid benchmark")
+	errBenchmarkNotFound           = errors.New("benchmark currency statistics not found")
 )
 
+// Benchmark types a strategy's returns can be compared against
+const (
+	// BenchmarkPair compares against the close prices of a currency
+	// pair loaded in the run
+	BenchmarkPair = "pair"
+	// BenchmarkBasket compares against an equal-weight basket of all
+	// currency pairs loaded in the run, rebalanced every candle
+	BenchmarkBasket = "basket"
+	// BenchmarkCSV compares against a series of values loaded from a
+	// CSV file
+	BenchmarkCSV = "csv"
+)
+
+// defaultValueAtRiskConfidence is the confidence level percentage used
+// for value at risk when one is not set
+const defaultValueAtRiskConfidence = 95
+
+// monteCarloHistogramBins is the amount of bins used to
+// chart the distribution of monte carlo results
+const monteCarloHistogramBins = 20
+
 // Statistic holds all statistical information for a backtester run, from drawdowns to ratios.
 // Any currency specific information is handled in currencystatistics
 type Statistic struct {
@@ -41,6 +69,7 @@ type Statistic struct {
 	StartDate                   time.Time                                                                              `json:"start-date"`
 	EndDate                     time.Time                                                                              `json:"end-date"`
 	CandleInterval              gctkline.Interval                                                                      `json:"candle-interval"`
+	Seed                        int64                                                                                  `json:"seed"`
 	RiskFreeRate                decimal.Decimal                                                                        `json:"risk-free-rate"`
 	ExchangeAssetPairStatistics map[string]map[asset.Item]map[*currency.Item]map[*currency.Item]*CurrencyPairStatistic `json:"exchange-asset-pair-statistics"`
 	CurrencyStatistics          []*CurrencyPairStatistic                                                               `json:"currency-statistics"`
@@ -56,6 +85,12 @@ type Statistic struct {
 	FundingStatistics           *FundingStatistics                                                                     `json:"funding-statistics"`
 	FundManager                 funding.IFundingManager                                                                `json:"-"`
 	HasCollateral               bool                                                                                   `json:"has-collateral"`
+	MonteCarloSettings          *MonteCarloSettings                                                                    `json:"-"`
+	MonteCarlo                  *MonteCarloResults                                                                     `json:"monte-carlo,omitempty"`
+	ValueAtRiskConfidence       decimal.Decimal                                                                        `json:"-"`
+	RiskMetrics                 *RiskMetrics                                                                           `json:"risk-metrics,omitempty"`
+	BenchmarkSettings           *BenchmarkSettings                                                                     `json:"-"`
+	Benchmark                   *BenchmarkResults                                                                      `json:"benchmark,omitempty"`
 }
 
 // FinalResultsHolder holds important stats about a currency's performance
@@ -78,7 +113,10 @@ type Handler interface {
 	Reset() error
 	Serialise() (string, error)
 	AddPNLForTime(*portfolio.PNLSummary) error
+	AddUnfilledOrders([]exchange.RestingOrder) error
 	CreateLog(common.Event) (string, error)
+	GetPerformanceSummary() (*PerformanceSummary, error)
+	GetEquityCurve() ([]ValueAtTime, error)
 }
 
 // Results holds some statistics on results
@@ -163,6 +201,7 @@ type CurrencyPairStatistic struct {
 	StrategyMovement             decimal.Decimal `json:"strategy-movement"`
 	UnrealisedPNL                decimal.Decimal `json:"unrealised-pnl"`
 	RealisedPNL                  decimal.Decimal `json:"realised-pnl"`
+	FundingPayments              decimal.Decimal `json:"funding-payments"`
 	CompoundAnnualGrowthRate     decimal.Decimal `json:"compound-annual-growth-rate"`
 	TotalAssetValue              decimal.Decimal `json:"total-asset-value"`
 	TotalFees                    decimal.Decimal `json:"total-fees"`
@@ -172,13 +211,16 @@ type CurrencyPairStatistic struct {
 
 	Events []DataAtOffset `json:"-"`
 
-	MaxDrawdown           Swing               `json:"max-drawdown,omitempty"`
-	HighestCommittedFunds ValueAtTime         `json:"highest-committed-funds"`
-	GeometricRatios       *Ratios             `json:"geometric-ratios"`
-	ArithmeticRatios      *Ratios             `json:"arithmetic-ratios"`
-	InitialHoldings       holdings.Holding    `json:"initial-holdings-holdings"`
-	FinalHoldings         holdings.Holding    `json:"final-holdings"`
-	FinalOrders           compliance.Snapshot `json:"final-orders"`
+	MaxDrawdown           Swing                   `json:"max-drawdown,omitempty"`
+	HighestCommittedFunds ValueAtTime             `json:"highest-committed-funds"`
+	GeometricRatios       *Ratios                 `json:"geometric-ratios"`
+	ArithmeticRatios      *Ratios                 `json:"arithmetic-ratios"`
+	InitialHoldings       holdings.Holding        `json:"initial-holdings-holdings"`
+	FinalHoldings         holdings.Holding        `json:"final-holdings"`
+	FinalOrders           compliance.Snapshot     `json:"final-orders"`
+	UnfilledOrders        []exchange.RestingOrder `json:"unfilled-orders"`
+	// ExitsTriggered counts the positions closed by each portfolio exit rule
+	ExitsTriggered map[string]int64 `json:"exits-triggered,omitempty"`
 }
 
 // Ratios stores all the ratios used for statistics
@@ -189,6 +231,150 @@ type Ratios struct {
 	CalmarRatio      decimal.Decimal `json:"calmar-ratio"`
 }
 
+// PerformanceSummary holds the headline figures of a strategy run
+// so that runs can be compared against each other
+type PerformanceSummary struct {
+	SharpeRatio  decimal.Decimal `json:"sharpe-ratio"`
+	SortinoRatio decimal.Decimal `json:"sortino-ratio"`
+	CalmarRatio  decimal.Decimal `json:"calmar-ratio"`
+	TotalReturn  decimal.Decimal `json:"total-return"`
+	MaxDrawdown  decimal.Decimal `json:"max-drawdown"`
+}
+
+// MonteCarloSettings configures the resampling of a strategy run's returns
+type MonteCarloSettings struct {
+	Simulations int64
+	// Bootstrap samples returns with replacement, otherwise
+	// returns are shuffled into a new order
+	Bootstrap bool
+	// ResampleTrades samples the returns between each trade,
+	// otherwise the returns of each candle are sampled
+	ResampleTrades              bool
+	Seed                        int64
+	ConfidenceLevel             decimal.Decimal
+	RuinThresholdPercent        decimal.Decimal
+	SlippagePerturbationPercent decimal.Decimal
+	FeePerturbationPercent      decimal.Decimal
+}
+
+// MonteCarloResults holds the distribution of returns and drawdowns
+// across all monte carlo simulations
+type MonteCarloResults struct {
+	Method               string                 `json:"method"`
+	Sample               string                 `json:"sample"`
+	Simulations          int64                  `json:"simulations"`
+	SampleSize           int                    `json:"sample-size"`
+	Seed                 int64                  `json:"seed"`
+	ConfidenceLevel      decimal.Decimal        `json:"confidence-level"`
+	RuinThresholdPercent decimal.Decimal        `json:"ruin-threshold-percent"`
+	RiskOfRuin           decimal.Decimal        `json:"risk-of-ruin"`
+	FinalReturn          MonteCarloDistribution `json:"final-return"`
+	MaxDrawdown          MonteCarloDistribution `json:"max-drawdown"`
+}
+
+// MonteCarloDistribution describes the spread of a percentage across
+// simulations. Original is the value of the strategy run itself
+type MonteCarloDistribution struct {
+	Original   decimal.Decimal `json:"original"`
+	Mean       decimal.Decimal `json:"mean"`
+	Median     decimal.Decimal `json:"median"`
+	Minimum    decimal.Decimal `json:"minimum"`
+	Maximum    decimal.Decimal `json:"maximum"`
+	LowerBound decimal.Decimal `json:"lower-bound"`
+	UpperBound decimal.Decimal `json:"upper-bound"`
+	Histogram  []HistogramBin  `json:"histogram"`
+}
+
+// HistogramBin counts the values between a minimum and maximum
+type HistogramBin struct {
+	Minimum decimal.Decimal `json:"minimum"`
+	Maximum decimal.Decimal `json:"maximum"`
+	Count   int64           `json:"count"`
+}
+
+// monteCarloStep is a sampled return along with the fee and slippage
+// costs paid during it, as fractions of the value at its start
+type monteCarloStep struct {
+	growth   float64
+	fee      float64
+	slippage float64
+	hasTrade bool
+}
+
+// RiskMetrics holds measures of the risk taken by the strategy, calculated
+// from the returns of each candle of its equity curve and from its closed
+// trades. Percentages are expressed as whole numbers, eg 5 is 5%
+type RiskMetrics struct {
+	ConfidenceLevel decimal.Decimal `json:"confidence-level"`
+	// ValueAtRisk is the loss of a candle which is not exceeded
+	// at the confidence level
+	ValueAtRisk decimal.Decimal `json:"value-at-risk"`
+	// ConditionalValueAtRisk is the average loss of the candles
+	// which exceed the value at risk
+	ConditionalValueAtRisk decimal.Decimal `json:"conditional-value-at-risk"`
+	// OmegaRatio is the sum of returns above the risk free rate
+	// divided by the sum of returns below it
+	OmegaRatio decimal.Decimal `json:"omega-ratio"`
+	// ShowInfiniteOmegaRatio is set when there are no returns below the
+	// risk free rate, so the omega ratio is infinite
+	ShowInfiniteOmegaRatio bool `json:"show-infinite-omega-ratio"`
+	// UlcerIndex is the root mean square of the drawdown at each candle
+	UlcerIndex decimal.Decimal `json:"ulcer-index"`
+	// ExposureTime is the percentage of candles with an open position
+	ExposureTime decimal.Decimal `json:"exposure-time"`
+	// LongestWinningStreak and LongestLosingStreak are the most
+	// consecutive closed trades with a profit and a loss
+	LongestWinningStreak int64           `json:"longest-winning-streak"`
+	LongestLosingStreak  int64           `json:"longest-losing-streak"`
+	AnnualReturns        []AnnualReturns `json:"annual-returns"`
+}
+
+// AnnualReturns holds the returns of each month of a year along
+// with the return of the year
+type AnnualReturns struct {
+	Year   int              `json:"year"`
+	Months [12]PeriodReturn `json:"months"`
+	Total  decimal.Decimal  `json:"total"`
+}
+
+// PeriodReturn is the percentage return of a period. Set is false
+// when the strategy did not run during the period
+type PeriodReturn struct {
+	Return decimal.Decimal `json:"return"`
+	Set    bool            `json:"set"`
+}
+
+// BenchmarkSettings determine what the strategy's returns are compared
+// against. Name and Values are only used by BenchmarkCSV
+type BenchmarkSettings struct {
+	Type     string
+	Name     string
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	Values   []ValueAtTime
+}
+
+// BenchmarkResults compares the strategy's returns against a benchmark.
+// Percentages are expressed as whole numbers, eg 5 is 5%
+type BenchmarkResults struct {
+	Name            string          `json:"name"`
+	Periods         int             `json:"periods"`
+	StrategyReturn  decimal.Decimal `json:"strategy-return"`
+	BenchmarkReturn decimal.Decimal `json:"benchmark-return"`
+	// Alpha is the annualised return of the strategy in excess of the
+	// return explained by its beta to the benchmark
+	Alpha decimal.Decimal `json:"alpha"`
+	// Beta is the sensitivity of the strategy's returns to the benchmark's
+	Beta        decimal.Decimal `json:"beta"`
+	Correlation decimal.Decimal `json:"correlation"`
+	// TrackingError is the annualised standard deviation of the
+	// difference between strategy and benchmark returns
+	TrackingError    decimal.Decimal `json:"tracking-error"`
+	InformationRatio decimal.Decimal `json:"information-ratio"`
+	BeatBenchmark    bool            `json:"beat-benchmark"`
+}
+
 // Swing holds a drawdown
 type Swing struct {
 	Highest          ValueAtTime     `json:"highest"`
@@ -261,4 +447,5 @@ type TotalFundingStatistics struct {
 	DidStrategyBeatTheMarket bool            `json:"did-strategy-beat-the-market"`
 	DidStrategyMakeProfit    bool            `json:"did-strategy-make-profit"`
 	HoldingValueDifference   decimal.Decimal `json:"holding-value-difference"`
+	USDBorrowCosts           decimal.Decimal `json:"usd-borrow-costs"`
 }
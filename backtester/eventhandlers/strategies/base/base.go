@@ -1,6 +1,11 @@
 package base
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
@@ -51,3 +56,53 @@ func (s *Strategy) SetSimultaneousProcessing(b bool) {
 func (s *Strategy) CloseAllPositions([]holdings.Holding, []data.Event) ([]signal.Event, error) {
 	return nil, gctcommon.ErrFunctionNotSupported
 }
+
+// Get returns the described custom setting matching the key
+func (s *CustomSettingsSchema) Get(key string) (*CustomSetting, error) {
+	for i := range s.Settings {
+		if s.Settings[i].Key == key {
+			return &s.Settings[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w %v", ErrCustomSettingNotFound, key)
+}
+
+// Parse converts text into the value type a strategy receives when
+// its custom settings are read from a config file
+func (c *CustomSetting) Parse(value string) (interface{}, error) {
+	if len(c.Options) > 0 {
+		var found bool
+		for i := range c.Options {
+			if strings.EqualFold(c.Options[i], value) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("%w %v must be one of %v", ErrInvalidCustomSettings, c.Key, strings.Join(c.Options, ", "))
+		}
+	}
+	switch c.Type {
+	case CustomSettingNumber:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w %v must be a number: %v", ErrInvalidCustomSettings, c.Key, value)
+		}
+		return f, nil
+	case CustomSettingBool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w %v must be true or false: %v", ErrInvalidCustomSettings, c.Key, value)
+		}
+		return b, nil
+	case CustomSettingDuration:
+		if _, err := time.ParseDuration(value); err != nil {
+			return nil, fmt.Errorf("%w %v must be a duration such as 30s: %v", ErrInvalidCustomSettings, c.Key, value)
+		}
+		return value, nil
+	case CustomSettingString:
+		return value, nil
+	default:
+		return nil, fmt.Errorf("%w %v has unknown type %v", ErrInvalidCustomSettings, c.Key, c.Type)
+	}
+}
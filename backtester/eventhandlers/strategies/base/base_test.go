@@ -88,3 +88,84 @@ func TestCloseAllPositions(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrFunctionNotSupported)
 	}
 }
+
+func TestCustomSettingsSchemaGet(t *testing.T) {
+	t.Parallel()
+	s := CustomSettingsSchema{
+		Settings: []CustomSetting{{Key: "rsi-high", Type: CustomSettingNumber}},
+	}
+	_, err := s.Get("rsi-low")
+	if !errors.Is(err, ErrCustomSettingNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrCustomSettingNotFound)
+	}
+	setting, err := s.Get("rsi-high")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if setting.Type != CustomSettingNumber {
+		t.Errorf("received '%v' expected '%v'", setting.Type, CustomSettingNumber)
+	}
+}
+
+func TestCustomSettingParse(t *testing.T) {
+	t.Parallel()
+	c := CustomSetting{Key: "test", Type: CustomSettingNumber}
+	_, err := c.Parse("seventy")
+	if !errors.Is(err, ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, ErrInvalidCustomSettings)
+	}
+	v, err := c.Parse("70")
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if v != 70.0 {
+		t.Errorf("received '%v' expected '%v'", v, 70.0)
+	}
+
+	c.Type = CustomSettingBool
+	_, err = c.Parse("maybe")
+	if !errors.Is(err, ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, ErrInvalidCustomSettings)
+	}
+	v, err = c.Parse("true")
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if v != true {
+		t.Errorf("received '%v' expected '%v'", v, true)
+	}
+
+	c.Type = CustomSettingDuration
+	_, err = c.Parse("soon")
+	if !errors.Is(err, ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, ErrInvalidCustomSettings)
+	}
+	v, err = c.Parse("30s")
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if v != "30s" {
+		t.Errorf("received '%v' expected '%v'", v, "30s")
+	}
+
+	c.Type = CustomSettingString
+	c.Options = []string{"limit", "market"}
+	_, err = c.Parse("stop")
+	if !errors.Is(err, ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, ErrInvalidCustomSettings)
+	}
+	v, err = c.Parse("Market")
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if v != "Market" {
+		t.Errorf("received '%v' expected '%v'", v, "Market")
+	}
+
+	c.Type = "colour"
+	c.Options = nil
+	_, err = c.Parse("red")
+	if !errors.Is(err, ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, ErrInvalidCustomSettings)
+	}
+}
@@ -18,4 +18,40 @@ var (
 	ErrTooMuchBadData = errors.New("backtesting cannot continue as there is too much invalid data. Please review your dataset")
 	// ErrNoDataToProcess is returned when simultaneous signal processing is enabled, but no events are passed in
 	ErrNoDataToProcess = errors.New("no kline data to process")
+	// ErrCustomSettingNotFound is returned when a custom setting key is not described by a strategy's schema
+	ErrCustomSettingNotFound = errors.New("custom setting not found")
 )
+
+// Custom setting types describe the value a custom setting accepts
+const (
+	CustomSettingNumber   = "number"
+	CustomSettingString   = "string"
+	CustomSettingBool     = "bool"
+	CustomSettingDuration = "duration"
+)
+
+// CustomSetting describes a single custom setting a strategy accepts
+type CustomSetting struct {
+	Key         string
+	Type        string
+	Description string
+	// Default is the value used when the setting is unset. It holds
+	// the same type a decoded config file would provide
+	Default  interface{}
+	Required bool
+	// Options are the only values accepted when set
+	Options []string
+}
+
+// CustomSettingsSchema describes the custom settings a strategy accepts
+// so that they can be listed and validated before a strategy is run
+type CustomSettingsSchema struct {
+	Settings []CustomSetting
+	// AdditionalSettings is true when settings which are not described
+	// are passed to the strategy rather than rejected
+	AdditionalSettings bool
+	// Verifiable is true when SetCustomSettings has no side effects, such
+	// as reading files or connecting to another process, so settings
+	// can be checked by applying them to a new instance of the strategy
+	Verifiable bool
+}
@@ -300,6 +300,17 @@ func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) erro
 	return nil
 }
 
+// CustomSettingsSchema describes the custom settings the cash and carry strategy accepts
+func (s *Strategy) CustomSettingsSchema() base.CustomSettingsSchema {
+	return base.CustomSettingsSchema{
+		Settings: []base.CustomSetting{
+			{Key: openShortDistancePercentageString, Type: base.CustomSettingNumber, Description: "Opens a short futures position when the futures price is this percentage above the spot price"},
+			{Key: closeShortDistancePercentageString, Type: base.CustomSettingNumber, Description: "Closes the short futures position when the futures price is within this percentage of the spot price"},
+		},
+		Verifiable: true,
+	}
+}
+
 // SetDefaults sets default values for overridable custom settings
 func (s *Strategy) SetDefaults() {
 	s.openShortDistancePercentage = decimal.Zero
@@ -91,5 +91,12 @@ func (s *Strategy) SetCustomSettings(_ map[string]interface{}) error {
 	return base.ErrCustomSettingsUnsupported
 }
 
+// CustomSettingsSchema describes the custom settings the dollar cost average strategy accepts
+func (s *Strategy) CustomSettingsSchema() base.CustomSettingsSchema {
+	return base.CustomSettingsSchema{
+		Verifiable: true,
+	}
+}
+
 // SetDefaults not required for DCA
 func (s *Strategy) SetDefaults() {}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"net"
+	"sync"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/external/strategyrpc"
+	"google.golang.org/grpc"
+)
+
+// meanReversion is an example strategy process. It buys when the close price
+// is below the average close of the history provided by the backtester by more
+// than the configured threshold and sells when it is above it
+type meanReversion struct {
+	strategyrpc.UnimplementedStrategyServiceServer
+	m          sync.Mutex
+	thresholds map[string]decimal.Decimal
+}
+
+func main() {
+	var address string
+	flag.StringVar(&address, "address", "localhost:9055", "the address to serve the strategy on")
+	flag.Parse()
+
+	lis, err := net.Listen("tcp", address)
+	if err != nil {
+		log.Fatal(err)
+	}
+	s := grpc.NewServer()
+	strategyrpc.RegisterStrategyServiceServer(s, &meanReversion{
+		thresholds: make(map[string]decimal.Decimal),
+	})
+	log.Printf("serving external strategy on %v", address)
+	if err = s.Serve(lis); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// Describe returns the details of the strategy
+func (m *meanReversion) Describe(context.Context, *strategyrpc.DescribeRequest) (*strategyrpc.DescribeResponse, error) {
+	return &strategyrpc.DescribeResponse{
+		Name:                           "mean-reversion",
+		Description:                    "buys below and sells above the average close price",
+		SupportsSimultaneousProcessing: true,
+	}, nil
+}
+
+// Initialise stores the threshold custom setting for the backtesting run
+func (m *meanReversion) Initialise(_ context.Context, req *strategyrpc.InitialiseRequest) (*strategyrpc.InitialiseResponse, error) {
+	settings := struct {
+		Threshold float64 `json:"threshold"`
+	}{}
+	if err := json.Unmarshal([]byte(req.CustomSettings), &settings); err != nil {
+		return nil, err
+	}
+	m.m.Lock()
+	m.thresholds[req.SessionId] = decimal.NewFromFloat(settings.Threshold)
+	m.m.Unlock()
+	return &strategyrpc.InitialiseResponse{}, nil
+}
+
+// OnSignal returns a signal for a single data event
+func (m *meanReversion) OnSignal(_ context.Context, req *strategyrpc.OnSignalRequest) (*strategyrpc.OnSignalResponse, error) {
+	return &strategyrpc.OnSignalResponse{Signal: m.createSignal(req.SessionId, req.Data)}, nil
+}
+
+// OnSimultaneousSignals returns a signal for every data event
+func (m *meanReversion) OnSimultaneousSignals(_ context.Context, req *strategyrpc.OnSimultaneousSignalsRequest) (*strategyrpc.OnSimultaneousSignalsResponse, error) {
+	resp := &strategyrpc.OnSimultaneousSignalsResponse{
+		Signals: make([]*strategyrpc.Signal, len(req.Data)),
+	}
+	for i := range req.Data {
+		resp.Signals[i] = m.createSignal(req.SessionId, req.Data[i])
+	}
+	return resp, nil
+}
+
+// Teardown removes the threshold of the finished backtesting run
+func (m *meanReversion) Teardown(_ context.Context, req *strategyrpc.TeardownRequest) (*strategyrpc.TeardownResponse, error) {
+	m.m.Lock()
+	delete(m.thresholds, req.SessionId)
+	m.m.Unlock()
+	return &strategyrpc.TeardownResponse{}, nil
+}
+
+func (m *meanReversion) createSignal(sessionID string, d *strategyrpc.StrategyData) *strategyrpc.Signal {
+	sig := &strategyrpc.Signal{
+		Exchange: d.Latest.Exchange,
+		Asset:    d.Latest.Asset,
+		Base:     d.Latest.Base,
+		Quote:    d.Latest.Quote,
+	}
+	if len(d.History) == 0 {
+		sig.Reasons = append(sig.Reasons, "not enough history")
+		return sig
+	}
+	total := decimal.Zero
+	for i := range d.History {
+		total = total.Add(decimal.RequireFromString(d.History[i].Close))
+	}
+	average := total.Div(decimal.NewFromInt(int64(len(d.History))))
+	m.m.Lock()
+	threshold := m.thresholds[sessionID]
+	m.m.Unlock()
+	closePrice := decimal.RequireFromString(d.Latest.Close)
+	switch {
+	case closePrice.LessThan(average.Mul(decimal.NewFromInt(1).Sub(threshold))):
+		sig.Direction = "BUY"
+	case closePrice.GreaterThan(average.Mul(decimal.NewFromInt(1).Add(threshold))):
+		sig.Direction = "SELL"
+	}
+	sig.Reasons = append(sig.Reasons, "close "+closePrice.String()+" average "+average.StringFixed(2))
+	return sig
+}
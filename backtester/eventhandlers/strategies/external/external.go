@@ -0,0 +1,660 @@
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/external/strategyrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Name returns the name of the strategy
+func (s *Strategy) Name() string {
+	return Name
+}
+
+// Description provides a nice overview of the strategy
+// be it definition of terms or to highlight its purpose
+func (s *Strategy) Description() string {
+	if s.remoteDescription != "" {
+		return description + ". " + s.remoteDescription
+	}
+	return description
+}
+
+// OnSignal handles a data event and returns what action the strategy believes should occur
+// For external, this means sending the data event to the strategy process
+// and converting its response into a signal
+func (s *Strategy) OnSignal(d data.Handler, f funding.IFundingTransferer, p portfolio.Handler) (signal.Event, error) {
+	if d == nil {
+		return nil, common.ErrNilEvent
+	}
+	if f == nil {
+		return nil, fmt.Errorf("%w missing funding transferred", gctcommon.ErrNilPointer)
+	}
+	if p == nil {
+		return nil, fmt.Errorf("%w missing portfolio handler", gctcommon.ErrNilPointer)
+	}
+	err := s.ensureInitialised()
+	if err != nil {
+		return nil, err
+	}
+	es, sd, err := s.prepareEvent(d, f, p)
+	if err != nil {
+		return nil, err
+	}
+	if sd == nil {
+		return es, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	resp, err := s.client.OnSignal(ctx, &strategyrpc.OnSignalRequest{
+		SessionId: s.sessionID,
+		Data:      sd,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.Signal == nil {
+		return nil, errNoSignal
+	}
+	err = applySignal(es, resp.Signal)
+	if err != nil {
+		return nil, err
+	}
+	return es, nil
+}
+
+// SupportsSimultaneousProcessing highlights whether the strategy can handle multiple currency calculation
+// Support is ultimately determined by the strategy process when the strategy is initialised
+func (s *Strategy) SupportsSimultaneousProcessing() bool {
+	return true
+}
+
+// OnSimultaneousSignals analyses multiple data points simultaneously, allowing flexibility
+// in allowing a strategy to only place an order for X currency if Y currency's price is Z
+func (s *Strategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundingTransferer, p portfolio.Handler) ([]signal.Event, error) {
+	if len(d) == 0 {
+		return nil, base.ErrNoDataToProcess
+	}
+	if f == nil {
+		return nil, fmt.Errorf("%w missing funding transferred", gctcommon.ErrNilPointer)
+	}
+	if p == nil {
+		return nil, fmt.Errorf("%w missing portfolio handler", gctcommon.ErrNilPointer)
+	}
+	err := s.ensureInitialised()
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]signal.Event, 0, len(d))
+	var pending []*signal.Signal
+	req := &strategyrpc.OnSimultaneousSignalsRequest{SessionId: s.sessionID}
+	for i := range d {
+		var es *signal.Signal
+		var sd *strategyrpc.StrategyData
+		es, sd, err = s.prepareEvent(d[i], f, p)
+		if err != nil {
+			return nil, err
+		}
+		if sd == nil {
+			resp = append(resp, es)
+			continue
+		}
+		pending = append(pending, es)
+		req.Data = append(req.Data, sd)
+	}
+	if len(pending) == 0 {
+		return resp, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	signals, err := s.client.OnSimultaneousSignals(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	matched := make([]bool, len(pending))
+	for i := range signals.Signals {
+		var j int
+		j, err = matchSignal(signals.Signals[i], pending)
+		if err != nil {
+			return nil, err
+		}
+		if matched[j] {
+			return nil, fmt.Errorf("%w %v %v %v", errDuplicateSignal, pending[j].Exchange, pending[j].AssetType, pending[j].CurrencyPair)
+		}
+		matched[j] = true
+		err = applySignal(pending[j], signals.Signals[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i := range pending {
+		if !matched[i] {
+			pending[i].SetDirection(order.DoNothing)
+			pending[i].AppendReason("external strategy did not return a signal")
+		}
+		resp = append(resp, pending[i])
+	}
+	return resp, nil
+}
+
+// SetCustomSettings sets the connection details of the strategy process.
+// Settings other than the connection details are sent to the strategy
+// process when it is initialised
+func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) error {
+	settings := make(map[string]interface{}, len(customSettings))
+	for k, v := range customSettings {
+		switch k {
+		case addressKey:
+			address, ok := v.(string)
+			if !ok || address == "" {
+				return fmt.Errorf("%w provided address value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.address = address
+		case timeoutKey:
+			timeout, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("%w provided timeout value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			d, err := time.ParseDuration(timeout)
+			if err != nil || d <= 0 {
+				return fmt.Errorf("%w provided timeout value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.timeout = d
+		case historySizeKey:
+			historySize, ok := v.(float64)
+			if !ok || historySize < 0 {
+				return fmt.Errorf("%w provided history-size value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.historySize = int(historySize)
+		case certificateKey:
+			certificate, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("%w provided tls-certificate value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.certificate = certificate
+		default:
+			settings[k] = v
+		}
+	}
+	s.settings = settings
+	return s.initialise()
+}
+
+// CustomSettingsSchema describes the custom settings the external strategy accepts
+func (s *Strategy) CustomSettingsSchema() base.CustomSettingsSchema {
+	return base.CustomSettingsSchema{
+		Settings: []base.CustomSetting{
+			{Key: addressKey, Type: base.CustomSettingString, Default: defaultAddress, Description: "The address of the strategy process"},
+			{Key: timeoutKey, Type: base.CustomSettingDuration, Default: defaultTimeout.String(), Description: "How long the strategy process can take to respond"},
+			{Key: historySizeKey, Type: base.CustomSettingNumber, Default: 0.0, Description: "The number of previous candles sent with each signal"},
+			{Key: certificateKey, Type: base.CustomSettingString, Description: "The path of a TLS certificate used to connect to the strategy process"},
+		},
+		// other settings are sent to the strategy process
+		AdditionalSettings: true,
+	}
+}
+
+// SetDefaults sets the custom settings to their default values
+func (s *Strategy) SetDefaults() {
+	s.address = defaultAddress
+	s.timeout = defaultTimeout
+	s.historySize = 0
+	s.certificate = ""
+	s.settings = nil
+}
+
+// CloseAllPositions sends the holdings and latest prices to the strategy
+// process, allowing it to determine how positions are closed
+func (s *Strategy) CloseAllPositions(h []holdings.Holding, prices []data.Event) ([]signal.Event, error) {
+	err := s.ensureInitialised()
+	if err != nil {
+		return nil, err
+	}
+	req := &strategyrpc.CloseAllPositionsRequest{
+		SessionId:    s.sessionID,
+		Holdings:     make([]*strategyrpc.Holding, len(h)),
+		LatestPrices: make([]*strategyrpc.DataEvent, len(prices)),
+	}
+	for i := range h {
+		req.Holdings[i] = convertHolding(&h[i])
+	}
+	closeSignals := make([]*signal.Signal, len(prices))
+	signalTime := time.Now().UTC()
+	for i := range prices {
+		req.LatestPrices[i] = convertDataEvent(prices[i])
+		closeSignals[i] = &signal.Signal{
+			Base: &event.Base{
+				Offset:         prices[i].GetOffset() + 1,
+				Exchange:       prices[i].GetExchange(),
+				Time:           signalTime,
+				Interval:       prices[i].GetInterval(),
+				CurrencyPair:   prices[i].Pair(),
+				UnderlyingPair: prices[i].GetUnderlyingPair(),
+				AssetType:      prices[i].GetAssetType(),
+			},
+			OpenPrice:  prices[i].GetOpenPrice(),
+			HighPrice:  prices[i].GetHighPrice(),
+			LowPrice:   prices[i].GetLowPrice(),
+			ClosePrice: prices[i].GetClosePrice(),
+			Volume:     prices[i].GetVolume(),
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	resp, err := s.client.CloseAllPositions(ctx, req)
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil, gctcommon.ErrFunctionNotSupported
+		}
+		return nil, err
+	}
+	signals := make([]signal.Event, len(resp.Signals))
+	for i := range resp.Signals {
+		var j int
+		j, err = matchSignal(resp.Signals[i], closeSignals)
+		if err != nil {
+			return nil, err
+		}
+		sig := *closeSignals[j]
+		b := *sig.Base
+		sig.Base = &b
+		err = applySignal(&sig, resp.Signals[i])
+		if err != nil {
+			return nil, err
+		}
+		signals[i] = &sig
+	}
+	return signals, nil
+}
+
+// ensureInitialised initialises the strategy process when
+// no custom settings have been provided
+func (s *Strategy) ensureInitialised() error {
+	if s.client != nil {
+		return nil
+	}
+	if s.address == "" {
+		return errNotInitialised
+	}
+	return s.initialise()
+}
+
+// initialise connects to the strategy process, verifies that it supports the
+// processing mode of the run and starts a new session. Any previous session
+// is torn down first
+func (s *Strategy) initialise() error {
+	err := s.Close()
+	if err != nil {
+		return err
+	}
+	conn, err := dial(s.address, s.certificate)
+	if err != nil {
+		return err
+	}
+	err = s.startSession(strategyrpc.NewStrategyServiceClient(conn))
+	if err != nil {
+		if closeErr := conn.Close(); closeErr != nil {
+			return fmt.Errorf("%w, could not close connection %v", err, closeErr)
+		}
+		return err
+	}
+	s.conn = conn
+	return nil
+}
+
+// startSession describes the strategy process and initialises a new session
+func (s *Strategy) startSession(client strategyrpc.StrategyServiceClient) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	details, err := client.Describe(ctx, &strategyrpc.DescribeRequest{})
+	if err != nil {
+		return fmt.Errorf("could not describe external strategy at %v %w", s.address, err)
+	}
+	if s.UsingSimultaneousProcessing() && !details.SupportsSimultaneousProcessing {
+		return fmt.Errorf("external strategy '%v' %w", details.Name, base.ErrSimultaneousProcessingNotSupported)
+	}
+	settings, err := json.Marshal(s.settings)
+	if err != nil {
+		return err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return err
+	}
+	_, err = client.Initialise(ctx, &strategyrpc.InitialiseRequest{
+		SessionId:                 id.String(),
+		UseSimultaneousProcessing: s.UsingSimultaneousProcessing(),
+		CustomSettings:            string(settings),
+	})
+	if err != nil {
+		return fmt.Errorf("could not initialise external strategy '%v' %w", details.Name, err)
+	}
+	s.sessionID = id.String()
+	s.remoteDescription = details.Description
+	if details.Name != "" && details.Description != "" {
+		s.remoteDescription = details.Name + ": " + details.Description
+	} else if details.Name != "" {
+		s.remoteDescription = details.Name
+	}
+	s.client = client
+	return nil
+}
+
+// Close tears down the session with the strategy process and closes the
+// connection to it. Strategy processes which do not implement Teardown
+// are still disconnected
+func (s *Strategy) Close() error {
+	if s.conn == nil {
+		return nil
+	}
+	var err error
+	if s.client != nil && s.sessionID != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+		_, err = s.client.Teardown(ctx, &strategyrpc.TeardownRequest{SessionId: s.sessionID})
+		cancel()
+		if status.Code(err) == codes.Unimplemented {
+			err = nil
+		}
+		if err != nil {
+			err = fmt.Errorf("could not tear down external strategy session %v %w", s.sessionID, err)
+		}
+	}
+	closeErr := s.conn.Close()
+	s.conn = nil
+	s.client = nil
+	s.sessionID = ""
+	if err != nil {
+		return err
+	}
+	return closeErr
+}
+
+// dial returns a new connection to the strategy process. Connections are
+// established lazily by gRPC and are owned by the strategy instance
+func dial(address, certificate string) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if certificate != "" {
+		var err error
+		creds, err = credentials.NewClientTLSFromFile(certificate, "")
+		if err != nil {
+			return nil, fmt.Errorf("%w %v", base.ErrInvalidCustomSettings, err)
+		}
+	}
+	return grpc.Dial(address, grpc.WithTransportCredentials(creds))
+}
+
+// prepareEvent creates the base signal for a data event along with the data
+// to send to the strategy process. Missing data and liquidated exchanges
+// are handled without the strategy process and return no strategy data
+func (s *Strategy) prepareEvent(d data.Handler, f funding.IFundingTransferer, p portfolio.Handler) (*signal.Signal, *strategyrpc.StrategyData, error) {
+	es, err := s.GetBaseData(d)
+	if err != nil {
+		return nil, nil, err
+	}
+	latest, err := d.Latest()
+	if err != nil {
+		return nil, nil, err
+	}
+	es.SetPrice(latest.GetClosePrice())
+
+	hasDataAtTime, err := d.HasDataAtTime(latest.GetTime())
+	if err != nil {
+		return nil, nil, err
+	}
+	if !hasDataAtTime {
+		es.SetDirection(order.MissingData)
+		es.AppendReasonf("missing data at %v, cannot perform any actions", latest.GetTime())
+		return &es, nil, nil
+	}
+	if f.HasExchangeBeenLiquidated(&es) {
+		es.SetDirection(order.DoNothing)
+		es.AppendReason("cannot transact, has been liquidated")
+		return &es, nil, nil
+	}
+
+	isLastEvent, err := d.IsLastEvent()
+	if err != nil {
+		return nil, nil, err
+	}
+	sd := &strategyrpc.StrategyData{
+		Latest:      convertDataEvent(latest),
+		IsLastEvent: isLastEvent,
+	}
+	if s.historySize > 0 {
+		var history data.Events
+		history, err = d.History()
+		if err != nil {
+			return nil, nil, err
+		}
+		for len(history) > 0 && !history[len(history)-1].GetTime().Before(latest.GetTime()) {
+			history = history[:len(history)-1]
+		}
+		if len(history) > s.historySize {
+			history = history[len(history)-s.historySize:]
+		}
+		sd.History = make([]*strategyrpc.DataEvent, len(history))
+		for i := range history {
+			sd.History[i] = convertDataEvent(history[i])
+		}
+	}
+	holding, err := p.ViewHoldingAtTimePeriod(latest)
+	if err == nil {
+		sd.Holding = convertHolding(holding)
+	}
+	sd.Funding, err = fundingBalances(f, latest)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &es, sd, nil
+}
+
+// fundingBalances returns the funds available to the data event
+func fundingBalances(f funding.IFundingTransferer, ev data.Event) ([]*strategyrpc.FundingBalance, error) {
+	pairFunds, err := f.GetFundingForEvent(ev)
+	if err != nil {
+		return nil, err
+	}
+	if ev.GetAssetType().IsFutures() {
+		var collateral funding.ICollateralReader
+		collateral, err = pairFunds.FundReader().GetCollateralReader()
+		if err != nil {
+			return nil, err
+		}
+		return []*strategyrpc.FundingBalance{
+			{Currency: collateral.CollateralCurrency().String(), Available: collateral.AvailableFunds().String()},
+			{Currency: collateral.ContractCurrency().String(), Available: collateral.CurrentHoldings().String()},
+		}, nil
+	}
+	pair, err := pairFunds.FundReader().GetPairReader()
+	if err != nil {
+		return nil, err
+	}
+	return []*strategyrpc.FundingBalance{
+		{Currency: ev.Pair().Base.String(), Available: pair.BaseAvailable().String()},
+		{Currency: ev.Pair().Quote.String(), Available: pair.QuoteAvailable().String()},
+	}, nil
+}
+
+// convertDataEvent converts a data event for the strategy process
+func convertDataEvent(ev data.Event) *strategyrpc.DataEvent {
+	return &strategyrpc.DataEvent{
+		Exchange:        ev.GetExchange(),
+		Asset:           ev.GetAssetType().String(),
+		Base:            ev.Pair().Base.String(),
+		Quote:           ev.Pair().Quote.String(),
+		UnderlyingBase:  ev.GetUnderlyingPair().Base.String(),
+		UnderlyingQuote: ev.GetUnderlyingPair().Quote.String(),
+		Interval:        ev.GetInterval().Short(),
+		Time:            timestamppb.New(ev.GetTime()),
+		Offset:          ev.GetOffset(),
+		Open:            ev.GetOpenPrice().String(),
+		High:            ev.GetHighPrice().String(),
+		Low:             ev.GetLowPrice().String(),
+		Close:           ev.GetClosePrice().String(),
+		Volume:          ev.GetVolume().String(),
+	}
+}
+
+// convertHolding converts a holding for the strategy process
+func convertHolding(h *holdings.Holding) *strategyrpc.Holding {
+	return &strategyrpc.Holding{
+		Exchange:          h.Exchange,
+		Asset:             h.Asset.String(),
+		Base:              h.Pair.Base.String(),
+		Quote:             h.Pair.Quote.String(),
+		Timestamp:         timestamppb.New(h.Timestamp),
+		Offset:            h.Offset,
+		BaseInitialFunds:  h.BaseInitialFunds.String(),
+		BaseSize:          h.BaseSize.String(),
+		BaseValue:         h.BaseValue.String(),
+		QuoteInitialFunds: h.QuoteInitialFunds.String(),
+		QuoteSize:         h.QuoteSize.String(),
+		CommittedFunds:    h.CommittedFunds.String(),
+		BoughtAmount:      h.BoughtAmount.String(),
+		SoldAmount:        h.SoldAmount.String(),
+		TotalValue:        h.TotalValue.String(),
+		TotalFees:         h.TotalFees.String(),
+		IsLiquidated:      h.IsLiquidated,
+	}
+}
+
+// matchSignal returns the index of the signal matching the exchange,
+// asset and pair of the strategy process' signal
+func matchSignal(sig *strategyrpc.Signal, signals []*signal.Signal) (int, error) {
+	if sig == nil {
+		return 0, errNoSignal
+	}
+	a, err := asset.New(sig.Asset)
+	if err != nil {
+		return 0, fmt.Errorf("%w %v", errUnmatchedSignal, err)
+	}
+	pair, err := currency.NewPairFromStrings(sig.Base, sig.Quote)
+	if err != nil {
+		return 0, fmt.Errorf("%w %v", errUnmatchedSignal, err)
+	}
+	for i := range signals {
+		if strings.EqualFold(signals[i].Exchange, sig.Exchange) &&
+			signals[i].AssetType == a &&
+			signals[i].CurrencyPair.Equal(pair) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("%w %v %v %v", errUnmatchedSignal, sig.Exchange, sig.Asset, pair)
+}
+
+// applySignal sets the decision of the strategy process onto the signal
+func applySignal(es *signal.Signal, sig *strategyrpc.Signal) error {
+	direction, err := parseDirection(sig.Direction)
+	if err != nil {
+		return err
+	}
+	es.SetDirection(direction)
+	amount, err := parseDecimal(sig.Amount)
+	if err != nil {
+		return err
+	}
+	if amount.GreaterThan(decimal.Zero) {
+		es.SetAmount(amount)
+	}
+	es.BuyLimit, err = parseDecimal(sig.BuyLimit)
+	if err != nil {
+		return err
+	}
+	es.SellLimit, err = parseDecimal(sig.SellLimit)
+	if err != nil {
+		return err
+	}
+	es.MatchesOrderAmount = sig.MatchOrderAmount
+	if sig.CollateralCurrency != "" {
+		es.CollateralCurrency = currency.NewCode(sig.CollateralCurrency)
+	}
+	if sig.OrderType != "" {
+		es.OrderType, err = order.StringToOrderType(sig.OrderType)
+		if err != nil {
+			return err
+		}
+	}
+	es.LimitPrice, err = parseDecimal(sig.LimitPrice)
+	if err != nil {
+		return err
+	}
+	es.TriggerPrice, err = parseDecimal(sig.TriggerPrice)
+	if err != nil {
+		return err
+	}
+	if sig.GoodTillTime != nil {
+		es.GoodTillTime = sig.GoodTillTime.AsTime()
+	}
+	es.ClientOrderID = sig.ClientOrderId
+	es.CancelOrderIDs = sig.CancelOrderIds
+	if len(sig.Reasons) == 0 {
+		es.AppendReasonf("external strategy signalled %v", direction)
+	}
+	for i := range sig.Reasons {
+		es.AppendReason(sig.Reasons[i])
+	}
+	return nil
+}
+
+// parseDirection converts the direction of a strategy process' signal.
+// An empty direction does nothing
+func parseDirection(direction string) (order.Side, error) {
+	direction = strings.ToUpper(strings.TrimSpace(direction))
+	switch direction {
+	case "", order.DoNothing.String():
+		return order.DoNothing, nil
+	case order.ClosePosition.String():
+		return order.ClosePosition, nil
+	}
+	side, err := order.StringToOrderSide(direction)
+	if err != nil {
+		return order.UnknownSide, fmt.Errorf("%w %v", errInvalidDirection, err)
+	}
+	switch side {
+	case order.Bid:
+		return order.Buy, nil
+	case order.Ask:
+		return order.Sell, nil
+	case order.AnySide:
+		return order.UnknownSide, fmt.Errorf("%w '%v'", errInvalidDirection, direction)
+	}
+	return side, nil
+}
+
+// parseDecimal converts a decimal string, an empty string is zero
+func parseDecimal(s string) (decimal.Decimal, error) {
+	if s == "" {
+		return decimal.Zero, nil
+	}
+	d, err := decimal.NewFromString(s)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("%w '%v'", errInvalidDecimal, s)
+	}
+	return d, nil
+}
@@ -0,0 +1,625 @@
+package external
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	datakline "github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/external/strategyrpc"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	eventkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	testExchange = "binance"
+	testCandles  = 5
+)
+
+var (
+	testPair  = currency.NewPair(currency.BTC, currency.USDT)
+	testStart = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// testServer is a strategy process which responds with preset signals
+type testServer struct {
+	strategyrpc.UnimplementedStrategyServiceServer
+	m                     sync.Mutex
+	simultaneous          bool
+	signals               []*strategyrpc.Signal
+	closeAllUnimplemented bool
+	teardownUnimplemented bool
+	initialise            *strategyrpc.InitialiseRequest
+	teardowns             []string
+	onSignal              *strategyrpc.OnSignalRequest
+	onSimultaneous        *strategyrpc.OnSimultaneousSignalsRequest
+}
+
+func (s *testServer) Describe(context.Context, *strategyrpc.DescribeRequest) (*strategyrpc.DescribeResponse, error) {
+	return &strategyrpc.DescribeResponse{
+		Name:                           "test",
+		Description:                    "responds with preset signals",
+		SupportsSimultaneousProcessing: s.simultaneous,
+	}, nil
+}
+
+func (s *testServer) Initialise(_ context.Context, req *strategyrpc.InitialiseRequest) (*strategyrpc.InitialiseResponse, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.initialise = req
+	return &strategyrpc.InitialiseResponse{}, nil
+}
+
+func (s *testServer) OnSignal(_ context.Context, req *strategyrpc.OnSignalRequest) (*strategyrpc.OnSignalResponse, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.onSignal = req
+	if len(s.signals) == 0 {
+		return &strategyrpc.OnSignalResponse{}, nil
+	}
+	return &strategyrpc.OnSignalResponse{Signal: s.signals[0]}, nil
+}
+
+func (s *testServer) OnSimultaneousSignals(_ context.Context, req *strategyrpc.OnSimultaneousSignalsRequest) (*strategyrpc.OnSimultaneousSignalsResponse, error) {
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.onSimultaneous = req
+	return &strategyrpc.OnSimultaneousSignalsResponse{Signals: s.signals}, nil
+}
+
+func (s *testServer) CloseAllPositions(ctx context.Context, req *strategyrpc.CloseAllPositionsRequest) (*strategyrpc.CloseAllPositionsResponse, error) {
+	if s.closeAllUnimplemented {
+		return s.UnimplementedStrategyServiceServer.CloseAllPositions(ctx, req)
+	}
+	return &strategyrpc.CloseAllPositionsResponse{Signals: s.signals}, nil
+}
+
+func (s *testServer) Teardown(ctx context.Context, req *strategyrpc.TeardownRequest) (*strategyrpc.TeardownResponse, error) {
+	if s.teardownUnimplemented {
+		return s.UnimplementedStrategyServiceServer.Teardown(ctx, req)
+	}
+	s.m.Lock()
+	defer s.m.Unlock()
+	s.teardowns = append(s.teardowns, req.SessionId)
+	return &strategyrpc.TeardownResponse{}, nil
+}
+
+// startTestServer serves the strategy process on a random local port
+func startTestServer(t *testing.T, srv *testServer) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer()
+	strategyrpc.RegisterStrategyServiceServer(s, srv)
+	go func() {
+		if err := s.Serve(lis); err != nil {
+			t.Error(err)
+		}
+	}()
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
+// newTestStrategy connects a new strategy to the test server
+func newTestStrategy(t *testing.T, srv *testServer, simultaneous bool, settings map[string]interface{}) *Strategy {
+	t.Helper()
+	if settings == nil {
+		settings = make(map[string]interface{})
+	}
+	settings[addressKey] = startTestServer(t, srv)
+	s := &Strategy{}
+	s.SetSimultaneousProcessing(simultaneous)
+	s.SetDefaults()
+	err := s.SetCustomSettings(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := s.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+	return s
+}
+
+// fakeFunds overrides default implementation
+type fakeFunds struct {
+	funding.FundManager
+	hasBeenLiquidated bool
+}
+
+// HasExchangeBeenLiquidated overrides default implementation
+func (f *fakeFunds) HasExchangeBeenLiquidated(common.Event) bool {
+	return f.hasBeenLiquidated
+}
+
+// GetFundingForEvent overrides default implementation
+func (f *fakeFunds) GetFundingForEvent(common.Event) (funding.IFundingPair, error) {
+	return fakePair{}, nil
+}
+
+// fakePair provides fixed spot funding
+type fakePair struct {
+	funding.IFundReserver
+	funding.IFundReleaser
+	funding.IPairReader
+}
+
+func (f fakePair) FundReader() funding.IFundReader             { return f }
+func (f fakePair) FundReserver() funding.IFundReserver         { return f.IFundReserver }
+func (f fakePair) FundReleaser() funding.IFundReleaser         { return f.IFundReleaser }
+func (f fakePair) GetPairReader() (funding.IPairReader, error) { return f, nil }
+func (f fakePair) BaseAvailable() decimal.Decimal              { return decimal.NewFromInt(2) }
+func (f fakePair) QuoteAvailable() decimal.Decimal             { return decimal.NewFromInt(1000) }
+func (f fakePair) BaseBorrowed() decimal.Decimal               { return decimal.Zero }
+func (f fakePair) QuoteBorrowed() decimal.Decimal              { return decimal.Zero }
+func (f fakePair) BaseBorrowable() decimal.Decimal             { return decimal.Zero }
+func (f fakePair) QuoteBorrowable() decimal.Decimal            { return decimal.Zero }
+func (f fakePair) GetCollateralReader() (funding.ICollateralReader, error) {
+	return nil, gctcommon.ErrFunctionNotSupported
+}
+
+// portfolerino overrides default implementation
+type portfolerino struct {
+	portfolio.Portfolio
+}
+
+// ViewHoldingAtTimePeriod overrides default implementation
+func (p *portfolerino) ViewHoldingAtTimePeriod(common.Event) (*holdings.Holding, error) {
+	return &holdings.Holding{BaseSize: decimal.NewFromInt(2), TotalValue: decimal.NewFromInt(1337)}, nil
+}
+
+// newTestData returns a data handler which has processed all but the last
+// test candle
+func newTestData(t *testing.T, p currency.Pair) *datakline.DataFromKline {
+	t.Helper()
+	d := &datakline.DataFromKline{
+		Base: &data.Base{},
+		Item: &gctkline.Item{
+			Exchange: testExchange,
+			Asset:    asset.Spot,
+			Pair:     p,
+			Interval: gctkline.OneDay,
+		},
+	}
+	events := make([]data.Event, testCandles)
+	for i := range events {
+		price := decimal.NewFromInt(int64(100 + i))
+		events[i] = &eventkline.Kline{
+			Base: &event.Base{
+				Offset:       int64(i + 1),
+				Exchange:     testExchange,
+				Time:         testStart.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+				Interval:     gctkline.OneDay,
+				CurrencyPair: p,
+				AssetType:    asset.Spot,
+			},
+			Open:   price,
+			Close:  price,
+			Low:    price,
+			High:   price,
+			Volume: decimal.NewFromInt(1),
+		}
+		d.Item.Candles = append(d.Item.Candles, gctkline.Candle{
+			Time:   events[i].GetTime(),
+			Open:   price.InexactFloat64(),
+			High:   price.InexactFloat64(),
+			Low:    price.InexactFloat64(),
+			Close:  price.InexactFloat64(),
+			Volume: 1,
+		})
+	}
+	var err error
+	d.RangeHolder, err = gctkline.CalculateCandleDateRanges(testStart, testStart.AddDate(0, 0, testCandles), gctkline.OneDay, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.RangeHolder.SetHasDataFromCandles(d.Item.Candles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.SetStream(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < testCandles-1; i++ {
+		_, err = d.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return d
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if n := s.Name(); n != Name {
+		t.Errorf("received '%v' expected '%v'", n, Name)
+	}
+}
+
+func TestDescription(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if n := s.Description(); n != description {
+		t.Errorf("received '%v' expected '%v'", n, description)
+	}
+	s.remoteDescription = "test"
+	if n := s.Description(); n != description+". test" {
+		t.Errorf("received '%v' expected '%v'", n, description+". test")
+	}
+}
+
+func TestSupportsSimultaneousProcessing(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if !s.SupportsSimultaneousProcessing() {
+		t.Error("expected true")
+	}
+}
+
+func TestSetDefaults(t *testing.T) {
+	t.Parallel()
+	s := Strategy{historySize: 5}
+	s.SetDefaults()
+	if s.address != defaultAddress {
+		t.Errorf("received '%v' expected '%v'", s.address, defaultAddress)
+	}
+	if s.timeout != defaultTimeout {
+		t.Errorf("received '%v' expected '%v'", s.timeout, defaultTimeout)
+	}
+	if s.historySize != 0 {
+		t.Errorf("received '%v' expected '%v'", s.historySize, 0)
+	}
+}
+
+func TestSetCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	s.SetDefaults()
+	for k, v := range map[string]interface{}{
+		addressKey:     "",
+		timeoutKey:     "-1s",
+		historySizeKey: -1.0,
+		certificateKey: 1337,
+	} {
+		err := s.SetCustomSettings(map[string]interface{}{k: v})
+		if !errors.Is(err, base.ErrInvalidCustomSettings) {
+			t.Errorf("%v received '%v' expected '%v'", k, err, base.ErrInvalidCustomSettings)
+		}
+	}
+
+	srv := &testServer{}
+	s = newTestStrategy(t, srv, false, map[string]interface{}{
+		timeoutKey:     "5s",
+		historySizeKey: 2.0,
+		"rsi-period":   14.0,
+	})
+	if s.timeout != 5*time.Second || s.historySize != 2 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", s.timeout, s.historySize, 5*time.Second, 2)
+	}
+	if s.sessionID == "" || srv.initialise.SessionId != s.sessionID {
+		t.Errorf("received '%v' expected session '%v'", srv.initialise.SessionId, s.sessionID)
+	}
+	if srv.initialise.CustomSettings != `{"rsi-period":14}` {
+		t.Errorf("received '%v' expected '%v'", srv.initialise.CustomSettings, `{"rsi-period":14}`)
+	}
+	if s.remoteDescription != "test: responds with preset signals" {
+		t.Errorf("received '%v' expected '%v'", s.remoteDescription, "test: responds with preset signals")
+	}
+
+	s = &Strategy{}
+	s.SetSimultaneousProcessing(true)
+	s.SetDefaults()
+	err := s.SetCustomSettings(map[string]interface{}{addressKey: startTestServer(t, srv)})
+	if !errors.Is(err, base.ErrSimultaneousProcessingNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrSimultaneousProcessingNotSupported)
+	}
+	if s.conn != nil {
+		t.Errorf("received '%v' expected '%v'", s.conn, nil)
+	}
+}
+
+func TestOnSignal(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	_, err := s.OnSignal(nil, nil, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	d := newTestData(t, testPair)
+	_, err = s.OnSignal(d, nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = s.OnSignal(d, &fakeFunds{}, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = s.OnSignal(d, &fakeFunds{}, &portfolerino{})
+	if !errors.Is(err, errNotInitialised) {
+		t.Errorf("received '%v' expected '%v'", err, errNotInitialised)
+	}
+
+	srv := &testServer{
+		signals: []*strategyrpc.Signal{
+			{
+				Direction: gctorder.Buy.String(),
+				Amount:    "1.5",
+				Reasons:   []string{"test"},
+			},
+		},
+	}
+	s = newTestStrategy(t, srv, false, map[string]interface{}{historySizeKey: 2.0})
+	resp, err := s.OnSignal(d, &fakeFunds{}, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != gctorder.Buy {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), gctorder.Buy)
+	}
+	if !resp.GetAmount().Equal(decimal.NewFromFloat(1.5)) {
+		t.Errorf("received '%v' expected '%v'", resp.GetAmount(), 1.5)
+	}
+	req := srv.onSignal
+	if req.SessionId != s.sessionID {
+		t.Errorf("received '%v' expected '%v'", req.SessionId, s.sessionID)
+	}
+	if req.Data.Latest.Close != "103" || req.Data.Latest.Base != "BTC" {
+		t.Errorf("received '%v' expected the latest BTC candle", req.Data.Latest)
+	}
+	if len(req.Data.History) != 2 || req.Data.History[1].Close != "102" {
+		t.Errorf("received '%v' expected two previous candles", req.Data.History)
+	}
+	if req.Data.Holding == nil || req.Data.Holding.BaseSize != "2" {
+		t.Errorf("received '%v' expected holding base size of 2", req.Data.Holding)
+	}
+	if len(req.Data.Funding) != 2 || req.Data.Funding[1].Available != "1000" {
+		t.Errorf("received '%v' expected quote funding of 1000", req.Data.Funding)
+	}
+
+	srv.signals = nil
+	_, err = s.OnSignal(d, &fakeFunds{}, &portfolerino{})
+	if !errors.Is(err, errNoSignal) {
+		t.Errorf("received '%v' expected '%v'", err, errNoSignal)
+	}
+
+	srv.onSignal = nil
+	resp, err = s.OnSignal(d, &fakeFunds{hasBeenLiquidated: true}, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != gctorder.DoNothing {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), gctorder.DoNothing)
+	}
+	if srv.onSignal != nil {
+		t.Error("expected liquidated exchange to not be sent to the strategy process")
+	}
+}
+
+func TestOnSimultaneousSignals(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	_, err := s.OnSimultaneousSignals(nil, nil, nil)
+	if !errors.Is(err, base.ErrNoDataToProcess) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrNoDataToProcess)
+	}
+	ethPair := currency.NewPair(currency.ETH, currency.USDT)
+	d := []data.Handler{newTestData(t, testPair), newTestData(t, ethPair)}
+	_, err = s.OnSimultaneousSignals(d, nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	btcSignal := &strategyrpc.Signal{
+		Exchange:  testExchange,
+		Asset:     asset.Spot.String(),
+		Base:      "btc",
+		Quote:     "usdt",
+		Direction: gctorder.Sell.String(),
+	}
+	srv := &testServer{
+		simultaneous: true,
+		signals:      []*strategyrpc.Signal{btcSignal},
+	}
+	s = newTestStrategy(t, srv, true, nil)
+	resp, err := s.OnSimultaneousSignals(d, &fakeFunds{}, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if resp[0].GetDirection() != gctorder.Sell {
+		t.Errorf("received '%v' expected '%v'", resp[0].GetDirection(), gctorder.Sell)
+	}
+	if resp[1].GetDirection() != gctorder.DoNothing {
+		t.Errorf("received '%v' expected '%v'", resp[1].GetDirection(), gctorder.DoNothing)
+	}
+	if len(srv.onSimultaneous.Data) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(srv.onSimultaneous.Data), 2)
+	}
+
+	srv.signals = []*strategyrpc.Signal{btcSignal, btcSignal}
+	_, err = s.OnSimultaneousSignals(d, &fakeFunds{}, &portfolerino{})
+	if !errors.Is(err, errDuplicateSignal) {
+		t.Errorf("received '%v' expected '%v'", err, errDuplicateSignal)
+	}
+
+	srv.signals = []*strategyrpc.Signal{{Exchange: testExchange, Asset: asset.Spot.String(), Base: "LTC", Quote: "USDT"}}
+	_, err = s.OnSimultaneousSignals(d, &fakeFunds{}, &portfolerino{})
+	if !errors.Is(err, errUnmatchedSignal) {
+		t.Errorf("received '%v' expected '%v'", err, errUnmatchedSignal)
+	}
+}
+
+func TestCloseAllPositions(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	_, err := s.CloseAllPositions(nil, nil)
+	if !errors.Is(err, errNotInitialised) {
+		t.Errorf("received '%v' expected '%v'", err, errNotInitialised)
+	}
+
+	srv := &testServer{closeAllUnimplemented: true}
+	s = newTestStrategy(t, srv, false, nil)
+	_, err = s.CloseAllPositions(nil, nil)
+	if !errors.Is(err, gctcommon.ErrFunctionNotSupported) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrFunctionNotSupported)
+	}
+
+	srv = &testServer{
+		signals: []*strategyrpc.Signal{
+			{
+				Exchange:  testExchange,
+				Asset:     asset.Spot.String(),
+				Base:      "BTC",
+				Quote:     "USDT",
+				Direction: gctorder.ClosePosition.String(),
+				Amount:    "2",
+			},
+		},
+	}
+	s = newTestStrategy(t, srv, false, nil)
+	latest, err := newTestData(t, testPair).Latest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.CloseAllPositions([]holdings.Holding{{Exchange: testExchange, Asset: asset.Spot, Pair: testPair, BaseSize: decimal.NewFromInt(2)}}, []data.Event{latest})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 1)
+	}
+	if resp[0].GetDirection() != gctorder.ClosePosition || !resp[0].GetAmount().Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp[0].GetDirection(), resp[0].GetAmount(), gctorder.ClosePosition, 2)
+	}
+	if resp[0].GetOffset() != latest.GetOffset()+1 {
+		t.Errorf("received '%v' expected '%v'", resp[0].GetOffset(), latest.GetOffset()+1)
+	}
+}
+
+func TestClose(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	err := s.Close()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+
+	srv := &testServer{}
+	s = newTestStrategy(t, srv, false, nil)
+	sessionID := s.sessionID
+	// initialising again tears down the previous session
+	err = s.SetCustomSettings(map[string]interface{}{addressKey: s.address})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(srv.teardowns) != 1 || srv.teardowns[0] != sessionID {
+		t.Errorf("received '%v' expected '%v'", srv.teardowns, []string{sessionID})
+	}
+	sessionID = s.sessionID
+	err = s.Close()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(srv.teardowns) != 2 || srv.teardowns[1] != sessionID {
+		t.Errorf("received '%v' expected '%v'", srv.teardowns, sessionID)
+	}
+	if s.conn != nil || s.client != nil || s.sessionID != "" {
+		t.Errorf("received '%v' '%v' '%v' expected the connection to be closed", s.conn, s.client, s.sessionID)
+	}
+	err = s.Close()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+
+	s = newTestStrategy(t, &testServer{teardownUnimplemented: true}, false, nil)
+	err = s.Close()
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if s.conn != nil {
+		t.Errorf("received '%v' expected '%v'", s.conn, nil)
+	}
+}
+
+func TestApplySignal(t *testing.T) {
+	t.Parallel()
+	es := &signal.Signal{Base: &event.Base{}}
+	err := applySignal(es, &strategyrpc.Signal{Direction: "ANY"})
+	if !errors.Is(err, errInvalidDirection) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidDirection)
+	}
+	err = applySignal(es, &strategyrpc.Signal{Amount: "one"})
+	if !errors.Is(err, errInvalidDecimal) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidDecimal)
+	}
+
+	gtt := time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC)
+	err = applySignal(es, &strategyrpc.Signal{
+		Direction:      "bid",
+		OrderType:      gctorder.Limit.String(),
+		LimitPrice:     "1337",
+		GoodTillTime:   timestamppb.New(gtt),
+		ClientOrderId:  "1",
+		CancelOrderIds: []string{"0"},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if es.GetDirection() != gctorder.Buy {
+		t.Errorf("received '%v' expected '%v'", es.GetDirection(), gctorder.Buy)
+	}
+	if es.OrderType != gctorder.Limit || !es.LimitPrice.Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' '%v' expected a limit order at 1337", es.OrderType, es.LimitPrice)
+	}
+	if !es.GoodTillTime.Equal(gtt) || es.ClientOrderID != "1" || len(es.CancelOrderIDs) != 1 {
+		t.Errorf("received '%v' '%v' '%v' expected resting order details", es.GoodTillTime, es.ClientOrderID, es.CancelOrderIDs)
+	}
+}
+
+func TestParseDirection(t *testing.T) {
+	t.Parallel()
+	for k, v := range map[string]gctorder.Side{
+		"":               gctorder.DoNothing,
+		"do nothing":     gctorder.DoNothing,
+		"close position": gctorder.ClosePosition,
+		"ask":            gctorder.Sell,
+		"SHORT":          gctorder.Short,
+	} {
+		side, err := parseDirection(k)
+		if !errors.Is(err, nil) {
+			t.Errorf("received '%v' expected '%v'", err, nil)
+		}
+		if side != v {
+			t.Errorf("received '%v' expected '%v'", side, v)
+		}
+	}
+	_, err := parseDirection("sideways")
+	if !errors.Is(err, errInvalidDirection) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidDirection)
+	}
+}
@@ -0,0 +1,48 @@
+package external
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/external/strategyrpc"
+	"google.golang.org/grpc"
+)
+
+const (
+	// Name is the strategy name
+	Name           = "external"
+	addressKey     = "address"
+	timeoutKey     = "timeout"
+	historySizeKey = "history-size"
+	certificateKey = "tls-certificate"
+	description    = `Proxies every data event to a strategy running in a separate process over gRPC. Data events, holdings and funding are sent to the strategy process, which responds with signals, allowing strategies to be written in any language`
+
+	defaultAddress = "localhost:9055"
+	defaultTimeout = 30 * time.Second
+)
+
+var (
+	errNotInitialised   = errors.New("external strategy has not been initialised")
+	errNoSignal         = errors.New("external strategy did not return a signal")
+	errUnmatchedSignal  = errors.New("external strategy returned a signal which does not match any data event")
+	errDuplicateSignal  = errors.New("external strategy returned more than one signal for a data event")
+	errInvalidDirection = errors.New("invalid signal direction")
+	errInvalidDecimal   = errors.New("invalid decimal value")
+)
+
+// Strategy is an implementation of the Handler interface
+// which defers all decision making to a strategy process
+// reached over gRPC
+type Strategy struct {
+	base.Strategy
+	address           string
+	certificate       string
+	timeout           time.Duration
+	historySize       int
+	settings          map[string]interface{}
+	sessionID         string
+	remoteDescription string
+	conn              *grpc.ClientConn
+	client            strategyrpc.StrategyServiceClient
+}
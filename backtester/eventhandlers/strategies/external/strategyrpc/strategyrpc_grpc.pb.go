@@ -0,0 +1,289 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: strategyrpc.proto
+
+package strategyrpc
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// StrategyServiceClient is the client API for StrategyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StrategyServiceClient interface {
+	Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error)
+	Initialise(ctx context.Context, in *InitialiseRequest, opts ...grpc.CallOption) (*InitialiseResponse, error)
+	OnSignal(ctx context.Context, in *OnSignalRequest, opts ...grpc.CallOption) (*OnSignalResponse, error)
+	OnSimultaneousSignals(ctx context.Context, in *OnSimultaneousSignalsRequest, opts ...grpc.CallOption) (*OnSimultaneousSignalsResponse, error)
+	CloseAllPositions(ctx context.Context, in *CloseAllPositionsRequest, opts ...grpc.CallOption) (*CloseAllPositionsResponse, error)
+	// Teardown is called once the backtesting run has stopped, allowing
+	// the strategy process to release anything held for the session
+	Teardown(ctx context.Context, in *TeardownRequest, opts ...grpc.CallOption) (*TeardownResponse, error)
+}
+
+type strategyServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStrategyServiceClient(cc grpc.ClientConnInterface) StrategyServiceClient {
+	return &strategyServiceClient{cc}
+}
+
+func (c *strategyServiceClient) Describe(ctx context.Context, in *DescribeRequest, opts ...grpc.CallOption) (*DescribeResponse, error) {
+	out := new(DescribeResponse)
+	err := c.cc.Invoke(ctx, "/strategyrpc.StrategyService/Describe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strategyServiceClient) Initialise(ctx context.Context, in *InitialiseRequest, opts ...grpc.CallOption) (*InitialiseResponse, error) {
+	out := new(InitialiseResponse)
+	err := c.cc.Invoke(ctx, "/strategyrpc.StrategyService/Initialise", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strategyServiceClient) OnSignal(ctx context.Context, in *OnSignalRequest, opts ...grpc.CallOption) (*OnSignalResponse, error) {
+	out := new(OnSignalResponse)
+	err := c.cc.Invoke(ctx, "/strategyrpc.StrategyService/OnSignal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strategyServiceClient) OnSimultaneousSignals(ctx context.Context, in *OnSimultaneousSignalsRequest, opts ...grpc.CallOption) (*OnSimultaneousSignalsResponse, error) {
+	out := new(OnSimultaneousSignalsResponse)
+	err := c.cc.Invoke(ctx, "/strategyrpc.StrategyService/OnSimultaneousSignals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strategyServiceClient) CloseAllPositions(ctx context.Context, in *CloseAllPositionsRequest, opts ...grpc.CallOption) (*CloseAllPositionsResponse, error) {
+	out := new(CloseAllPositionsResponse)
+	err := c.cc.Invoke(ctx, "/strategyrpc.StrategyService/CloseAllPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *strategyServiceClient) Teardown(ctx context.Context, in *TeardownRequest, opts ...grpc.CallOption) (*TeardownResponse, error) {
+	out := new(TeardownResponse)
+	err := c.cc.Invoke(ctx, "/strategyrpc.StrategyService/Teardown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StrategyServiceServer is the server API for StrategyService service.
+// All implementations must embed UnimplementedStrategyServiceServer
+// for forward compatibility
+type StrategyServiceServer interface {
+	Describe(context.Context, *DescribeRequest) (*DescribeResponse, error)
+	Initialise(context.Context, *InitialiseRequest) (*InitialiseResponse, error)
+	OnSignal(context.Context, *OnSignalRequest) (*OnSignalResponse, error)
+	OnSimultaneousSignals(context.Context, *OnSimultaneousSignalsRequest) (*OnSimultaneousSignalsResponse, error)
+	CloseAllPositions(context.Context, *CloseAllPositionsRequest) (*CloseAllPositionsResponse, error)
+	// Teardown is called once the backtesting run has stopped, allowing
+	// the strategy process to release anything held for the session
+	Teardown(context.Context, *TeardownRequest) (*TeardownResponse, error)
+	mustEmbedUnimplementedStrategyServiceServer()
+}
+
+// UnimplementedStrategyServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedStrategyServiceServer struct {
+}
+
+func (UnimplementedStrategyServiceServer) Describe(context.Context, *DescribeRequest) (*DescribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Describe not implemented")
+}
+func (UnimplementedStrategyServiceServer) Initialise(context.Context, *InitialiseRequest) (*InitialiseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Initialise not implemented")
+}
+func (UnimplementedStrategyServiceServer) OnSignal(context.Context, *OnSignalRequest) (*OnSignalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnSignal not implemented")
+}
+func (UnimplementedStrategyServiceServer) OnSimultaneousSignals(context.Context, *OnSimultaneousSignalsRequest) (*OnSimultaneousSignalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnSimultaneousSignals not implemented")
+}
+func (UnimplementedStrategyServiceServer) CloseAllPositions(context.Context, *CloseAllPositionsRequest) (*CloseAllPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAllPositions not implemented")
+}
+func (UnimplementedStrategyServiceServer) Teardown(context.Context, *TeardownRequest) (*TeardownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Teardown not implemented")
+}
+func (UnimplementedStrategyServiceServer) mustEmbedUnimplementedStrategyServiceServer() {}
+
+// UnsafeStrategyServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StrategyServiceServer will
+// result in compilation errors.
+type UnsafeStrategyServiceServer interface {
+	mustEmbedUnimplementedStrategyServiceServer()
+}
+
+func RegisterStrategyServiceServer(s grpc.ServiceRegistrar, srv StrategyServiceServer) {
+	s.RegisterService(&StrategyService_ServiceDesc, srv)
+}
+
+func _StrategyService_Describe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyServiceServer).Describe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strategyrpc.StrategyService/Describe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyServiceServer).Describe(ctx, req.(*DescribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrategyService_Initialise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitialiseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyServiceServer).Initialise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strategyrpc.StrategyService/Initialise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyServiceServer).Initialise(ctx, req.(*InitialiseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrategyService_OnSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnSignalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyServiceServer).OnSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strategyrpc.StrategyService/OnSignal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyServiceServer).OnSignal(ctx, req.(*OnSignalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrategyService_OnSimultaneousSignals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OnSimultaneousSignalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyServiceServer).OnSimultaneousSignals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strategyrpc.StrategyService/OnSimultaneousSignals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyServiceServer).OnSimultaneousSignals(ctx, req.(*OnSimultaneousSignalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrategyService_CloseAllPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAllPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyServiceServer).CloseAllPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strategyrpc.StrategyService/CloseAllPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyServiceServer).CloseAllPositions(ctx, req.(*CloseAllPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StrategyService_Teardown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TeardownRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StrategyServiceServer).Teardown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/strategyrpc.StrategyService/Teardown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StrategyServiceServer).Teardown(ctx, req.(*TeardownRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StrategyService_ServiceDesc is the grpc.ServiceDesc for StrategyService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StrategyService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "strategyrpc.StrategyService",
+	HandlerType: (*StrategyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Describe",
+			Handler:    _StrategyService_Describe_Handler,
+		},
+		{
+			MethodName: "Initialise",
+			Handler:    _StrategyService_Initialise_Handler,
+		},
+		{
+			MethodName: "OnSignal",
+			Handler:    _StrategyService_OnSignal_Handler,
+		},
+		{
+			MethodName: "OnSimultaneousSignals",
+			Handler:    _StrategyService_OnSimultaneousSignals_Handler,
+		},
+		{
+			MethodName: "CloseAllPositions",
+			Handler:    _StrategyService_CloseAllPositions_Handler,
+		},
+		{
+			MethodName: "Teardown",
+			Handler:    _StrategyService_Teardown_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "strategyrpc.proto",
+}
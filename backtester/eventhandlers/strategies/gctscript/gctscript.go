@@ -0,0 +1,444 @@
+package gctscript
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/loader"
+)
+
+// Name returns the name of the strategy
+func (s *Strategy) Name() string {
+	return Name
+}
+
+// Description provides a nice overview of the strategy
+// be it definition of terms or to highlight its purpose
+func (s *Strategy) Description() string {
+	return description
+}
+
+// OnSignal handles a data event and returns what action the strategy believes should occur
+// For gctscript, this means running the script and converting any order it submits
+// into a signal
+func (s *Strategy) OnSignal(d data.Handler, f funding.IFundingTransferer, p portfolio.Handler) (signal.Event, error) {
+	return s.runScript(d, []data.Handler{d}, f, p)
+}
+
+// SupportsSimultaneousProcessing highlights whether the strategy can handle multiple currency calculation
+// When using simultaneous processing, the script is run once per data event and can
+// access the data of all other currencies being processed
+func (s *Strategy) SupportsSimultaneousProcessing() bool {
+	return true
+}
+
+// OnSimultaneousSignals analyses multiple data points simultaneously, allowing flexibility
+// in allowing a strategy to only place an order for X currency if Y currency's price is Z
+func (s *Strategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundingTransferer, p portfolio.Handler) ([]signal.Event, error) {
+	if len(d) == 0 {
+		return nil, base.ErrNoDataToProcess
+	}
+	var resp []signal.Event
+	var errs error
+	for i := range d {
+		sigEvent, err := s.runScript(d[i], d, f, p)
+		if err != nil {
+			latest, latestErr := d[i].Latest()
+			if latestErr != nil {
+				return nil, latestErr
+			}
+			errs = gctcommon.AppendError(errs, fmt.Errorf("%v %v %v %w",
+				latest.GetExchange(),
+				latest.GetAssetType(),
+				latest.Pair(),
+				err))
+		} else {
+			resp = append(resp, sigEvent)
+		}
+	}
+	return resp, errs
+}
+
+// SetCustomSettings loads and compiles the script. Settings other than the
+// script path and timeout are made available to the script
+func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) error {
+	settings := make(map[string]interface{}, len(customSettings))
+	for k, v := range customSettings {
+		switch k {
+		case scriptKey:
+			path, ok := v.(string)
+			if !ok || path == "" {
+				return fmt.Errorf("%w provided script value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.scriptPath = path
+		case scriptTimeoutKey:
+			timeout, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("%w provided script-timeout value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			d, err := time.ParseDuration(timeout)
+			if err != nil || d <= 0 {
+				return fmt.Errorf("%w provided script-timeout value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.scriptTimeout = d
+		default:
+			settings[k] = v
+		}
+	}
+	if s.scriptPath == "" {
+		return fmt.Errorf("%w %v", base.ErrInvalidCustomSettings, errNoScript)
+	}
+	s.settings = settings
+	return s.compile()
+}
+
+// CustomSettingsSchema describes the custom settings the gctscript strategy accepts
+func (s *Strategy) CustomSettingsSchema() base.CustomSettingsSchema {
+	return base.CustomSettingsSchema{
+		Settings: []base.CustomSetting{
+			{Key: scriptKey, Type: base.CustomSettingString, Required: true, Description: "The path of the GoCryptoTrader script to run"},
+			{Key: scriptTimeoutKey, Type: base.CustomSettingDuration, Default: defaultScriptTimeout.String(), Description: "How long the script can run for each signal"},
+		},
+		// other settings are made available to the script
+		AdditionalSettings: true,
+	}
+}
+
+// SetDefaults sets the custom settings to their default values
+func (s *Strategy) SetDefaults() {
+	s.scriptTimeout = defaultScriptTimeout
+}
+
+// compile loads the script and compiles it with the GCT modules. Exchange
+// module calls are answered by the backtester and withdrawals and file
+// access are not permitted. The database module is excluded as it can
+// read data after the data event being processed
+func (s *Strategy) compile() error {
+	if filepath.Ext(s.scriptPath) != gctcommon.GctExt {
+		s.scriptPath += gctcommon.GctExt
+	}
+	code, err := os.ReadFile(s.scriptPath)
+	if err != nil {
+		return err
+	}
+	limiter, err := modules.NewLimiter([]string{
+		modules.CapabilityMarketData,
+		modules.CapabilityAccount,
+		modules.CapabilityTrade,
+	}, 0, 0, 0)
+	if err != nil {
+		return err
+	}
+	s.wrapper = &backtestWrapper{}
+	s.scriptCtx = &gct.Context{}
+	s.scriptCtx.SetWrapper(s.wrapper)
+	s.scriptCtx.Value = map[string]tengo.Object{
+		"script": &tengo.String{Value: filepath.Base(s.scriptPath)},
+	}
+
+	script := tengo.NewScript(code)
+	err = script.Add(scriptCtxVar, s.scriptCtx)
+	if err != nil {
+		return err
+	}
+	moduleMap := loader.GetLimitedModuleMap(limiter)
+	moduleMap.Remove(dbModule)
+	moduleMap.AddBuiltinModule(exchangeModule, s.exchangeModule(limiter))
+	script.SetImports(moduleMap)
+	s.compiled, err = script.Compile()
+	if err != nil {
+		return fmt.Errorf("%v %w", s.scriptPath, err)
+	}
+	return nil
+}
+
+// runScript runs the script against the current data event and converts the
+// order it submitted, if any, into a signal
+func (s *Strategy) runScript(d data.Handler, handlers []data.Handler, f funding.IFundingTransferer, p portfolio.Handler) (signal.Event, error) {
+	if d == nil {
+		return nil, common.ErrNilEvent
+	}
+	if f == nil {
+		return nil, fmt.Errorf("%w missing funding transferred", gctcommon.ErrNilPointer)
+	}
+	if p == nil {
+		return nil, fmt.Errorf("%w missing portfolio handler", gctcommon.ErrNilPointer)
+	}
+	if s.compiled == nil {
+		return nil, errNoScript
+	}
+	es, err := s.GetBaseData(d)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := d.Latest()
+	if err != nil {
+		return nil, err
+	}
+	es.SetPrice(latest.GetClosePrice())
+
+	hasDataAtTime, err := d.HasDataAtTime(latest.GetTime())
+	if err != nil {
+		return nil, err
+	}
+	if !hasDataAtTime {
+		es.SetDirection(order.MissingData)
+		es.AppendReasonf("missing data at %v, cannot perform any actions", latest.GetTime())
+		return &es, nil
+	}
+	if f.HasExchangeBeenLiquidated(&es) {
+		es.SetDirection(order.DoNothing)
+		es.AppendReason("cannot transact, has been liquidated")
+		return &es, nil
+	}
+
+	s.wrapper.handlers = handlers
+	s.wrapper.current = d
+	s.wrapper.funds = f
+	s.wrapper.portfolio = p
+	s.wrapper.orders = nil
+	s.wrapper.cancels = nil
+	s.scriptCtx.Value[backtestCtxKey], err = s.backtestDetails(d, latest)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.scriptTimeout)
+	defer cancel()
+	err = s.compiled.RunContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%v %w", filepath.Base(s.scriptPath), err)
+	}
+
+	if len(s.wrapper.cancels) > 0 {
+		es.CancelOrderIDs = s.wrapper.cancels
+		es.AppendReasonf("script cancelled orders %v", strings.Join(s.wrapper.cancels, ", "))
+	}
+	switch len(s.wrapper.orders) {
+	case 0:
+		es.SetDirection(order.DoNothing)
+		es.AppendReason("script did not submit an order")
+	case 1:
+		submit := s.wrapper.orders[0]
+		switch submit.Side {
+		case order.Bid:
+			es.SetDirection(order.Buy)
+		case order.Ask:
+			es.SetDirection(order.Sell)
+		default:
+			es.SetDirection(submit.Side)
+		}
+		if submit.Amount > 0 {
+			es.SetAmount(decimal.NewFromFloat(submit.Amount))
+		}
+		es.AppendReasonf("script submitted %v %v order", submit.Type, submit.Side)
+		setRestingOrderDetails(&es, submit)
+	default:
+		return nil, fmt.Errorf("%w, received %v", errMultipleOrders, len(s.wrapper.orders))
+	}
+	return &es, nil
+}
+
+// setRestingOrderDetails converts a script's limit, stop or stop-limit order
+// into a resting order on the signal. Scripts can only provide a single price,
+// so it is used as the trigger price of stop orders when none is set
+func setRestingOrderDetails(es *signal.Signal, submit *order.Submit) {
+	switch submit.Type {
+	case order.Market, order.UnknownType:
+		return
+	case order.Limit, order.Stop, order.StopLimit:
+	default:
+		es.AppendReasonf("%v orders are not simulated, it will be filled at the close price", submit.Type)
+		return
+	}
+	es.OrderType = submit.Type
+	es.ClientOrderID = submit.ClientID
+	if es.ClientOrderID == "" {
+		es.ClientOrderID = submit.ClientOrderID
+	}
+	if submit.Type != order.Stop {
+		es.LimitPrice = decimal.NewFromFloat(submit.Price)
+	}
+	if submit.Type != order.Limit {
+		es.TriggerPrice = decimal.NewFromFloat(submit.TriggerPrice)
+		if es.TriggerPrice.IsZero() {
+			es.TriggerPrice = decimal.NewFromFloat(submit.Price)
+		}
+	}
+}
+
+// backtestDetails returns the data event, holdings, funding and custom
+// settings for the script to access via ctx.backtest
+func (s *Strategy) backtestDetails(d data.Handler, latest data.Event) (tengo.Object, error) {
+	isLastEvent, err := d.IsLastEvent()
+	if err != nil {
+		return nil, err
+	}
+	settings, err := tengo.FromInterface(s.settings)
+	if err != nil {
+		return nil, err
+	}
+	details := map[string]tengo.Object{
+		"exchange":   &tengo.String{Value: latest.GetExchange()},
+		"asset":      &tengo.String{Value: latest.GetAssetType().String()},
+		"pair":       &tengo.String{Value: latest.Pair().Format(scriptPairFormat).String()},
+		"interval":   &tengo.String{Value: latest.GetInterval().Short()},
+		"time":       &tengo.Time{Value: latest.GetTime()},
+		"offset":     &tengo.Int{Value: latest.GetOffset()},
+		"open":       &tengo.Float{Value: latest.GetOpenPrice().InexactFloat64()},
+		"high":       &tengo.Float{Value: latest.GetHighPrice().InexactFloat64()},
+		"low":        &tengo.Float{Value: latest.GetLowPrice().InexactFloat64()},
+		"close":      &tengo.Float{Value: latest.GetClosePrice().InexactFloat64()},
+		"volume":     &tengo.Float{Value: latest.GetVolume().InexactFloat64()},
+		"last_event": tengo.FalseValue,
+		"settings":   settings,
+		"holdings":   &tengo.Map{Value: map[string]tengo.Object{}},
+		"funding":    &tengo.Map{Value: map[string]tengo.Object{}},
+	}
+	if isLastEvent {
+		details["last_event"] = tengo.TrueValue
+	}
+
+	holding, err := s.wrapper.portfolio.ViewHoldingAtTimePeriod(latest)
+	if err == nil {
+		details["holdings"] = &tengo.Map{Value: map[string]tengo.Object{
+			"base_size":       &tengo.Float{Value: holding.BaseSize.InexactFloat64()},
+			"base_value":      &tengo.Float{Value: holding.BaseValue.InexactFloat64()},
+			"quote_size":      &tengo.Float{Value: holding.QuoteSize.InexactFloat64()},
+			"committed_funds": &tengo.Float{Value: holding.CommittedFunds.InexactFloat64()},
+			"total_value":     &tengo.Float{Value: holding.TotalValue.InexactFloat64()},
+			"total_fees":      &tengo.Float{Value: holding.TotalFees.InexactFloat64()},
+			"bought_amount":   &tengo.Float{Value: holding.BoughtAmount.InexactFloat64()},
+			"sold_amount":     &tengo.Float{Value: holding.SoldAmount.InexactFloat64()},
+		}}
+	}
+
+	balances, err := fundingBalances(s.wrapper.funds, latest)
+	if err != nil {
+		return nil, err
+	}
+	funds := make(map[string]tengo.Object, len(balances))
+	for i := range balances {
+		funds[balances[i].Currency.String()] = &tengo.Float{Value: balances[i].Free}
+	}
+	details["funding"] = &tengo.Map{Value: funds}
+	return &tengo.Map{Value: details}, nil
+}
+
+// fundingBalances returns the funds available to the data event as balances
+func fundingBalances(f funding.IFundingTransferer, ev data.Event) ([]account.Balance, error) {
+	pairFunds, err := f.GetFundingForEvent(ev)
+	if err != nil {
+		return nil, err
+	}
+	if ev.GetAssetType().IsFutures() {
+		var collateral funding.ICollateralReader
+		collateral, err = pairFunds.FundReader().GetCollateralReader()
+		if err != nil {
+			return nil, err
+		}
+		available := collateral.AvailableFunds().InexactFloat64()
+		contracts := collateral.CurrentHoldings().InexactFloat64()
+		return []account.Balance{
+			{Currency: collateral.CollateralCurrency(), Total: available, Free: available},
+			{Currency: collateral.ContractCurrency(), Total: contracts, Free: contracts},
+		}, nil
+	}
+	pair, err := pairFunds.FundReader().GetPairReader()
+	if err != nil {
+		return nil, err
+	}
+	baseAvailable := pair.BaseAvailable().InexactFloat64()
+	quoteAvailable := pair.QuoteAvailable().InexactFloat64()
+	return []account.Balance{
+		{Currency: ev.Pair().Base, Total: baseAvailable, Free: baseAvailable},
+		{Currency: ev.Pair().Quote, Total: quoteAvailable, Free: quoteAvailable},
+	}, nil
+}
+
+// exchangeModule returns the GCT exchange module with the functions that do
+// not accept a script context bound to the backtesting data
+func (s *Strategy) exchangeModule(limiter *modules.Limiter) map[string]tengo.Object {
+	mod := gct.LimitedModule(exchangeModule, limiter)
+	resp := make(map[string]tengo.Object, len(mod))
+	for k, v := range mod {
+		resp[k] = v
+	}
+	resp[exchangesFunc] = &tengo.UserFunction{Name: exchangesFunc, Value: s.exchanges}
+	resp[pairsFunc] = &tengo.UserFunction{Name: pairsFunc, Value: s.pairs}
+	resp[depositAddressFunc] = &tengo.UserFunction{Name: depositAddressFunc, Value: s.depositAddress}
+	return resp
+}
+
+// exchanges returns the exchanges being backtested
+func (s *Strategy) exchanges(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 1 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	exchanges := s.wrapper.Exchanges(true)
+	resp := &tengo.Array{Value: make([]tengo.Object, len(exchanges))}
+	for i := range exchanges {
+		resp.Value[i] = &tengo.String{Value: exchanges[i]}
+	}
+	return resp, nil
+}
+
+// pairs returns the pairs being backtested for an exchange and asset
+func (s *Strategy) pairs(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 3 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	exch, ok := tengo.ToString(args[0])
+	if !ok {
+		return nil, tengo.ErrInvalidArgumentType{Name: "exchange", Expected: "string", Found: args[0].TypeName()}
+	}
+	assetStr, ok := tengo.ToString(args[2])
+	if !ok {
+		return nil, tengo.ErrInvalidArgumentType{Name: "asset", Expected: "string", Found: args[2].TypeName()}
+	}
+	a, err := asset.New(assetStr)
+	if err != nil {
+		return scriptError(err), nil
+	}
+	pairs, err := s.wrapper.Pairs(exch, true, a)
+	if err != nil {
+		return scriptError(err), nil
+	}
+	resp := &tengo.Array{Value: make([]tengo.Object, len(*pairs))}
+	for i := range *pairs {
+		resp.Value[i] = &tengo.String{Value: (*pairs)[i].String()}
+	}
+	return resp, nil
+}
+
+// depositAddress is not supported when backtesting
+func (s *Strategy) depositAddress(args ...tengo.Object) (tengo.Object, error) {
+	if len(args) != 3 {
+		return nil, tengo.ErrWrongNumArguments
+	}
+	return scriptError(fmt.Errorf("deposit address %w", errUnsupportedInBacktest)), nil
+}
+
+// scriptError returns the error as a tengo error object, matching how the GCT
+// modules surface errors to scripts
+func scriptError(err error) tengo.Object {
+	return &tengo.Error{Value: &tengo.String{Value: err.Error()}}
+}
@@ -0,0 +1,614 @@
+package gctscript
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	datakline "github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	eventkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	testExchange = "binance"
+	testCandles  = 5
+)
+
+var (
+	testPair  = currency.NewPair(currency.BTC, currency.USDT)
+	testStart = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// fakeFunds overrides default implementation
+type fakeFunds struct {
+	funding.FundManager
+	hasBeenLiquidated bool
+}
+
+// HasExchangeBeenLiquidated overrides default implementation
+func (f *fakeFunds) HasExchangeBeenLiquidated(common.Event) bool {
+	return f.hasBeenLiquidated
+}
+
+// GetFundingForEvent overrides default implementation
+func (f *fakeFunds) GetFundingForEvent(common.Event) (funding.IFundingPair, error) {
+	return fakePair{}, nil
+}
+
+// fakePair provides fixed spot funding
+type fakePair struct {
+	funding.IFundReserver
+	funding.IFundReleaser
+	funding.IPairReader
+}
+
+func (f fakePair) FundReader() funding.IFundReader             { return f }
+func (f fakePair) FundReserver() funding.IFundReserver         { return f.IFundReserver }
+func (f fakePair) FundReleaser() funding.IFundReleaser         { return f.IFundReleaser }
+func (f fakePair) GetPairReader() (funding.IPairReader, error) { return f, nil }
+func (f fakePair) BaseAvailable() decimal.Decimal              { return decimal.NewFromInt(2) }
+func (f fakePair) QuoteAvailable() decimal.Decimal             { return decimal.NewFromInt(1000) }
+func (f fakePair) BaseBorrowed() decimal.Decimal               { return decimal.Zero }
+func (f fakePair) QuoteBorrowed() decimal.Decimal              { return decimal.Zero }
+func (f fakePair) BaseBorrowable() decimal.Decimal             { return decimal.Zero }
+func (f fakePair) QuoteBorrowable() decimal.Decimal            { return decimal.Zero }
+func (f fakePair) GetCollateralReader() (funding.ICollateralReader, error) {
+	return nil, gctcommon.ErrFunctionNotSupported
+}
+
+// portfolerino overrides default implementation
+type portfolerino struct {
+	portfolio.Portfolio
+}
+
+// ViewHoldingAtTimePeriod overrides default implementation
+func (p *portfolerino) ViewHoldingAtTimePeriod(common.Event) (*holdings.Holding, error) {
+	return &holdings.Holding{BaseSize: decimal.NewFromInt(2), TotalValue: decimal.NewFromInt(1337)}, nil
+}
+
+// newTestData returns a data handler which has processed all but the last
+// test candle
+func newTestData(t *testing.T, a asset.Item, p currency.Pair) *datakline.DataFromKline {
+	t.Helper()
+	d := &datakline.DataFromKline{
+		Base: &data.Base{},
+		Item: &gctkline.Item{
+			Exchange: testExchange,
+			Asset:    a,
+			Pair:     p,
+			Interval: gctkline.OneDay,
+		},
+	}
+	events := make([]data.Event, testCandles)
+	for i := range events {
+		price := decimal.NewFromInt(int64(100 + i))
+		events[i] = &eventkline.Kline{
+			Base: &event.Base{
+				Offset:       int64(i + 1),
+				Exchange:     testExchange,
+				Time:         testStart.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+				Interval:     gctkline.OneDay,
+				CurrencyPair: p,
+				AssetType:    a,
+			},
+			Open:   price,
+			Close:  price,
+			Low:    price,
+			High:   price,
+			Volume: decimal.NewFromInt(1),
+		}
+	}
+	for i := range events {
+		d.Item.Candles = append(d.Item.Candles, gctkline.Candle{
+			Time:   events[i].GetTime(),
+			Open:   events[i].GetOpenPrice().InexactFloat64(),
+			High:   events[i].GetHighPrice().InexactFloat64(),
+			Low:    events[i].GetLowPrice().InexactFloat64(),
+			Close:  events[i].GetClosePrice().InexactFloat64(),
+			Volume: events[i].GetVolume().InexactFloat64(),
+		})
+	}
+	var err error
+	d.RangeHolder, err = gctkline.CalculateCandleDateRanges(testStart, testStart.AddDate(0, 0, testCandles), gctkline.OneDay, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.RangeHolder.SetHasDataFromCandles(d.Item.Candles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.SetStream(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < testCandles-1; i++ {
+		_, err = d.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return d
+}
+
+// loadTestScript writes the script to a temporary file and loads it into a
+// new strategy
+func loadTestScript(t *testing.T, script string, settings map[string]interface{}) *Strategy {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "test.gct")
+	err := os.WriteFile(path, []byte(script), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if settings == nil {
+		settings = make(map[string]interface{})
+	}
+	settings[scriptKey] = path
+	s := &Strategy{}
+	s.SetDefaults()
+	err = s.SetCustomSettings(settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if n := s.Name(); n != Name {
+		t.Errorf("received '%v' expected '%v'", n, Name)
+	}
+}
+
+func TestDescription(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if n := s.Description(); n != description {
+		t.Errorf("received '%v' expected '%v'", n, description)
+	}
+}
+
+func TestSupportsSimultaneousProcessing(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if !s.SupportsSimultaneousProcessing() {
+		t.Error("expected true")
+	}
+}
+
+func TestSetDefaults(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	s.SetDefaults()
+	if s.scriptTimeout != defaultScriptTimeout {
+		t.Errorf("received '%v' expected '%v'", s.scriptTimeout, defaultScriptTimeout)
+	}
+}
+
+func TestSetCustomSettings(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	err := s.SetCustomSettings(nil)
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+	err = s.SetCustomSettings(map[string]interface{}{scriptKey: 1337.0})
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+	err = s.SetCustomSettings(map[string]interface{}{scriptKey: "test", scriptTimeoutKey: "soon"})
+	if !errors.Is(err, base.ErrInvalidCustomSettings) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrInvalidCustomSettings)
+	}
+	err = s.SetCustomSettings(map[string]interface{}{scriptKey: "non-existent"})
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("received '%v' expected '%v'", err, os.ErrNotExist)
+	}
+
+	s = *loadTestScript(t, `x := 1`, map[string]interface{}{scriptTimeoutKey: "5s", "threshold": 5.0})
+	if s.scriptTimeout != 5*time.Second {
+		t.Errorf("received '%v' expected '%v'", s.scriptTimeout, 5*time.Second)
+	}
+	if s.settings["threshold"] != 5.0 {
+		t.Errorf("received '%v' expected '%v'", s.settings["threshold"], 5.0)
+	}
+	if _, ok := s.settings[scriptKey]; ok {
+		t.Error("expected script path to be excluded from script settings")
+	}
+
+	path := filepath.Join(t.TempDir(), "broken.gct")
+	err = os.WriteFile(path, []byte(`x := `), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.SetCustomSettings(map[string]interface{}{scriptKey: path})
+	if err == nil {
+		t.Error("expected compilation error")
+	}
+
+	// database data is not limited to the data event being processed
+	err = os.WriteFile(path, []byte(`db := import("db")`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.SetCustomSettings(map[string]interface{}{scriptKey: path})
+	if err == nil || !strings.Contains(err.Error(), "module 'db' not found") {
+		t.Errorf("received '%v' expected db module not found", err)
+	}
+}
+
+func TestOnSignal(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	_, err := s.OnSignal(nil, nil, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	d := newTestData(t, asset.Spot, testPair)
+	f := &fakeFunds{}
+	p := &portfolerino{}
+	_, err = s.OnSignal(d, nil, p)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = s.OnSignal(d, f, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = s.OnSignal(d, f, p)
+	if !errors.Is(err, errNoScript) {
+		t.Errorf("received '%v' expected '%v'", err, errNoScript)
+	}
+
+	s = *loadTestScript(t, `x := 1`, nil)
+	sig, err := s.OnSignal(d, f, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig.GetDirection() != gctorder.DoNothing {
+		t.Errorf("received '%v' expected '%v'", sig.GetDirection(), gctorder.DoNothing)
+	}
+
+	f.hasBeenLiquidated = true
+	sig, err = s.OnSignal(d, f, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig.GetDirection() != gctorder.DoNothing || !strings.Contains(sig.GetConcatReasons(), "liquidated") {
+		t.Errorf("received '%v' expected liquidation reason", sig.GetConcatReasons())
+	}
+}
+
+func TestOnSignalScriptAccess(t *testing.T) {
+	t.Parallel()
+	// buys the settings amount when the latest close is above the
+	// average close, using the same calls a live script would make
+	s := loadTestScript(t, `
+exch := import("exchange")
+t := import("times")
+bt := ctx.backtest
+
+end := t.now()
+start := t.add(end, -t.hour*24*30)
+data := exch.ohlcv(ctx, "binance", "BTC-USDT", "-", "SPOT", start, end, "1d")
+if is_error(data) {
+	error(data)
+}
+ticker := exch.ticker(ctx, "binance", "BTC-USDT", "-", "SPOT")
+account := exch.accountinfo(ctx, "binance", "SPOT")
+if is_error(account) {
+	error(account)
+}
+total := 0.0
+for c in data.candles {
+	total += c[4]
+}
+if len(data.candles) == 4 && ticker.last > total/len(data.candles) &&
+	bt.holdings.base_size == 2.0 && bt.funding.USDT == 1000.0 &&
+	bt.close == ticker.last {
+	exch.ordersubmit(ctx, "binance", "BTC-USDT", "-", "LIMIT", "BUY", 1, bt.settings.amount, "", "SPOT")
+}
+`, map[string]interface{}{"amount": 0.5})
+	sig, err := s.OnSignal(newTestData(t, asset.Spot, testPair), &fakeFunds{}, &portfolerino{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig.GetDirection() != gctorder.Buy {
+		t.Fatalf("received '%v' expected '%v', reasons: %v", sig.GetDirection(), gctorder.Buy, sig.GetConcatReasons())
+	}
+	if !sig.GetAmount().Equal(decimal.NewFromFloat(0.5)) {
+		t.Errorf("received '%v' expected '%v'", sig.GetAmount(), 0.5)
+	}
+}
+
+func TestOnSignalOrders(t *testing.T) {
+	t.Parallel()
+	d := newTestData(t, asset.Spot, testPair)
+	s := loadTestScript(t, `
+exch := import("exchange")
+exch.ordersubmit(ctx, "binance", "BTC-USDT", "-", "MARKET", "ASK", 0, 0, "", "SPOT")
+`, nil)
+	sig, err := s.OnSignal(d, &fakeFunds{}, &portfolerino{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig.GetDirection() != gctorder.Sell {
+		t.Errorf("received '%v' expected '%v'", sig.GetDirection(), gctorder.Sell)
+	}
+	if !sig.GetAmount().IsZero() {
+		t.Errorf("received '%v' expected '%v'", sig.GetAmount(), 0)
+	}
+
+	s = loadTestScript(t, `
+exch := import("exchange")
+exch.ordersubmit(ctx, "binance", "BTC-USDT", "-", "MARKET", "BUY", 0, 1, "", "SPOT")
+exch.ordersubmit(ctx, "binance", "BTC-USDT", "-", "MARKET", "BUY", 0, 1, "", "SPOT")
+`, nil)
+	_, err = s.OnSignal(d, &fakeFunds{}, &portfolerino{})
+	if !errors.Is(err, errMultipleOrders) {
+		t.Errorf("received '%v' expected '%v'", err, errMultipleOrders)
+	}
+
+	s = loadTestScript(t, `
+exch := import("exchange")
+result := exch.ordersubmit(ctx, "binance", "ETH-USDT", "-", "MARKET", "BUY", 0, 1, "", "SPOT")
+if !is_error(result) {
+	error("expected order mismatch")
+}
+`, nil)
+	sig, err = s.OnSignal(d, &fakeFunds{}, &portfolerino{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sig.GetDirection() != gctorder.DoNothing {
+		t.Errorf("received '%v' expected '%v'", sig.GetDirection(), gctorder.DoNothing)
+	}
+
+	path := filepath.Join(t.TempDir(), "os.gct")
+	err = os.WriteFile(path, []byte(`os := import("os")`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.SetCustomSettings(map[string]interface{}{scriptKey: path})
+	if err == nil {
+		t.Error("expected file access to be denied")
+	}
+
+	s = loadTestScript(t, `
+exch := import("exchange")
+exch.withdrawcrypto(ctx, "binance", "BTC", "address", "", 1, 0, "")
+`, nil)
+	_, err = s.OnSignal(d, &fakeFunds{}, &portfolerino{})
+	if err == nil {
+		t.Error("expected withdrawals to be denied")
+	}
+}
+
+func TestOnSimultaneousSignals(t *testing.T) {
+	t.Parallel()
+	s := loadTestScript(t, `
+exch := import("exchange")
+bt := ctx.backtest
+if len(exch.pairs(bt.exchange, true, bt.asset)) == 2 && bt.pair == "BTC-USDT" {
+	exch.ordersubmit(ctx, bt.exchange, bt.pair, "-", "MARKET", "BUY", 0, 1, "", bt.asset)
+}
+`, nil)
+	_, err := s.OnSimultaneousSignals(nil, &fakeFunds{}, &portfolerino{})
+	if !errors.Is(err, base.ErrNoDataToProcess) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrNoDataToProcess)
+	}
+	d := []data.Handler{
+		newTestData(t, asset.Spot, testPair),
+		newTestData(t, asset.Spot, currency.NewPair(currency.ETH, currency.USDT)),
+	}
+	resp, err := s.OnSimultaneousSignals(d, &fakeFunds{}, &portfolerino{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	if resp[0].GetDirection() != gctorder.Buy {
+		t.Errorf("received '%v' expected '%v'", resp[0].GetDirection(), gctorder.Buy)
+	}
+	if resp[1].GetDirection() != gctorder.DoNothing {
+		t.Errorf("received '%v' expected '%v'", resp[1].GetDirection(), gctorder.DoNothing)
+	}
+}
+
+func TestOHLCV(t *testing.T) {
+	t.Parallel()
+	d := newTestData(t, asset.Spot, testPair)
+	w := &backtestWrapper{handlers: []data.Handler{d}, current: d}
+	_, err := w.OHLCV(context.Background(), "bitfinex", testPair, asset.Spot, testStart, testStart, gctkline.OneDay)
+	if !errors.Is(err, errDataNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errDataNotFound)
+	}
+
+	// requesting the future returns only candles up to the latest processed
+	resp, err := w.OHLCV(context.Background(), testExchange, testPair, asset.Spot, testStart, testStart.AddDate(1, 0, 0), gctkline.OneDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Candles) != testCandles-1 {
+		t.Errorf("received '%v' expected '%v'", len(resp.Candles), testCandles-1)
+	}
+
+	resp, err = w.OHLCV(context.Background(), testExchange, testPair, asset.Spot, testStart, testStart.Add(gctkline.OneDay.Duration()), gctkline.OneDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Candles) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(resp.Candles), 2)
+	}
+
+	resp, err = w.OHLCV(context.Background(), testExchange, testPair, asset.Spot, testStart, testStart.AddDate(1, 0, 0), gctkline.TwoDay)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Candles) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(resp.Candles), 2)
+	}
+
+	_, err = w.OHLCV(context.Background(), testExchange, testPair, asset.Spot, testStart, testStart.AddDate(1, 0, 0), gctkline.OneHour)
+	if !errors.Is(err, errUnsupportedInterval) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedInterval)
+	}
+}
+
+func TestSubmitOrder(t *testing.T) {
+	t.Parallel()
+	w := &backtestWrapper{}
+	_, err := w.SubmitOrder(context.Background(), nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	submit := &gctorder.Submit{
+		Exchange:  testExchange,
+		Pair:      testPair,
+		AssetType: asset.Spot,
+		Side:      gctorder.Buy,
+		Type:      gctorder.Market,
+		Amount:    1,
+	}
+	_, err = w.SubmitOrder(context.Background(), submit)
+	if !errors.Is(err, errDataNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errDataNotFound)
+	}
+	w.current = newTestData(t, asset.Spot, testPair)
+	resp, err := w.SubmitOrder(context.Background(), submit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.OrderID == "" {
+		t.Error("expected order ID")
+	}
+	submit.AssetType = asset.Futures
+	_, err = w.SubmitOrder(context.Background(), submit)
+	if !errors.Is(err, errOrderMismatch) {
+		t.Errorf("received '%v' expected '%v'", err, errOrderMismatch)
+	}
+	if len(w.orders) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(w.orders), 1)
+	}
+}
+
+func TestCancelOrder(t *testing.T) {
+	t.Parallel()
+	w := &backtestWrapper{}
+	_, err := w.CancelOrder(context.Background(), testExchange, "", testPair, asset.Spot)
+	if !errors.Is(err, gctorder.ErrOrderIDNotSet) {
+		t.Errorf("received '%v' expected '%v'", err, gctorder.ErrOrderIDNotSet)
+	}
+	_, err = w.CancelOrder(context.Background(), testExchange, "1", testPair, asset.Spot)
+	if !errors.Is(err, errDataNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errDataNotFound)
+	}
+	w.current = newTestData(t, asset.Spot, testPair)
+	_, err = w.CancelOrder(context.Background(), testExchange, "1", testPair, asset.Futures)
+	if !errors.Is(err, errOrderMismatch) {
+		t.Errorf("received '%v' expected '%v'", err, errOrderMismatch)
+	}
+	cancelled, err := w.CancelOrder(context.Background(), testExchange, "1", currency.EMPTYPAIR, asset.Empty)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !cancelled {
+		t.Error("expected cancellation")
+	}
+	if len(w.cancels) != 1 || w.cancels[0] != "1" {
+		t.Errorf("received '%v' expected '%v'", w.cancels, []string{"1"})
+	}
+}
+
+func TestSetRestingOrderDetails(t *testing.T) {
+	t.Parallel()
+	es := &signal.Signal{}
+	setRestingOrderDetails(es, &gctorder.Submit{Type: gctorder.Market, Price: 1337})
+	if es.OrderType != gctorder.UnknownType {
+		t.Errorf("received '%v' expected '%v'", es.OrderType, gctorder.UnknownType)
+	}
+	setRestingOrderDetails(es, &gctorder.Submit{Type: gctorder.Limit, Price: 1337, ClientID: "1"})
+	if es.OrderType != gctorder.Limit {
+		t.Errorf("received '%v' expected '%v'", es.OrderType, gctorder.Limit)
+	}
+	if !es.LimitPrice.Equal(decimal.NewFromInt(1337)) || !es.TriggerPrice.IsZero() {
+		t.Errorf("received limit '%v' trigger '%v'", es.LimitPrice, es.TriggerPrice)
+	}
+	if es.ClientOrderID != "1" {
+		t.Errorf("received '%v' expected '%v'", es.ClientOrderID, "1")
+	}
+	es = &signal.Signal{}
+	setRestingOrderDetails(es, &gctorder.Submit{Type: gctorder.Stop, Price: 1337})
+	if !es.TriggerPrice.Equal(decimal.NewFromInt(1337)) || !es.LimitPrice.IsZero() {
+		t.Errorf("received limit '%v' trigger '%v'", es.LimitPrice, es.TriggerPrice)
+	}
+	es = &signal.Signal{}
+	setRestingOrderDetails(es, &gctorder.Submit{Type: gctorder.StopLimit, Price: 1337, TriggerPrice: 1336})
+	if !es.TriggerPrice.Equal(decimal.NewFromInt(1336)) || !es.LimitPrice.Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received limit '%v' trigger '%v'", es.LimitPrice, es.TriggerPrice)
+	}
+}
+
+func TestUnsupportedWrapperFunctions(t *testing.T) {
+	t.Parallel()
+	w := &backtestWrapper{}
+	if _, err := w.Orderbook(context.Background(), testExchange, testPair, asset.Spot); !errors.Is(err, errUnsupportedInBacktest) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedInBacktest)
+	}
+	if _, err := w.QueryOrder(context.Background(), testExchange, "1", testPair, asset.Spot); !errors.Is(err, errUnsupportedInBacktest) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedInBacktest)
+	}
+	if _, err := w.DepositAddress(testExchange, "", currency.BTC); !errors.Is(err, errUnsupportedInBacktest) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedInBacktest)
+	}
+	if _, err := w.WithdrawalCryptoFunds(context.Background(), nil); !errors.Is(err, errUnsupportedInBacktest) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedInBacktest)
+	}
+	if _, err := w.WithdrawalFiatFunds(context.Background(), "", nil); !errors.Is(err, errUnsupportedInBacktest) {
+		t.Errorf("received '%v' expected '%v'", err, errUnsupportedInBacktest)
+	}
+}
+
+func TestAccountInformation(t *testing.T) {
+	t.Parallel()
+	d := newTestData(t, asset.Spot, testPair)
+	w := &backtestWrapper{handlers: []data.Handler{d}, current: d}
+	_, err := w.AccountInformation(context.Background(), testExchange, asset.Spot)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	w.funds = &fakeFunds{}
+	_, err = w.AccountInformation(context.Background(), testExchange, asset.Futures)
+	if !errors.Is(err, errDataNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errDataNotFound)
+	}
+	resp, err := w.AccountInformation(context.Background(), testExchange, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Accounts) != 1 || len(resp.Accounts[0].Currencies) != 2 {
+		t.Fatalf("unexpected account information %+v", resp)
+	}
+	if resp.Accounts[0].Currencies[1].Free != 1000 {
+		t.Errorf("received '%v' expected '%v'", resp.Accounts[0].Currencies[1].Free, 1000)
+	}
+}
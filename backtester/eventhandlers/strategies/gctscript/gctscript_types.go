@@ -0,0 +1,74 @@
+package gctscript
+
+import (
+	"errors"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
+)
+
+const (
+	// Name is the strategy name
+	Name             = "gctscript"
+	scriptKey        = "script"
+	scriptTimeoutKey = "script-timeout"
+	description      = `Runs a GoCryptoTrader script (.gct) on every data event. Exchange module calls made by the script are answered by the backtester, with order submissions converted into signals, allowing a script prototyped live to be backtested unchanged`
+
+	// scriptCtxVar is the name of the context variable available to scripts
+	scriptCtxVar = "ctx"
+	// backtestCtxKey holds the backtesting details on the script context
+	backtestCtxKey = "backtest"
+
+	defaultScriptTimeout = 30 * time.Second
+
+	exchangeModule     = "exchange"
+	dbModule           = "db"
+	exchangesFunc      = "exchanges"
+	pairsFunc          = "pairs"
+	depositAddressFunc = "depositaddress"
+)
+
+var (
+	errNoScript              = errors.New("no script loaded, set the 'script' custom setting")
+	errMultipleOrders        = errors.New("script submitted more than one order for a data event")
+	errOrderMismatch         = errors.New("order does not match the data event being processed")
+	errDataNotFound          = errors.New("no backtesting data found")
+	errUnsupportedInterval   = errors.New("interval cannot be derived from backtesting data")
+	errUnsupportedInBacktest = errors.New("not supported when backtesting")
+
+	// scriptPairFormat matches the delimiter scripts commonly pass to exchange
+	// module functions
+	scriptPairFormat = currency.PairFormat{Delimiter: currency.DashDelimiter, Uppercase: true}
+)
+
+// Strategy is an implementation of the Handler interface
+// which defers all decision making to a gctscript
+type Strategy struct {
+	base.Strategy
+	scriptPath    string
+	scriptTimeout time.Duration
+	settings      map[string]interface{}
+	compiled      *tengo.Compiled
+	scriptCtx     *gct.Context
+	wrapper       *backtestWrapper
+}
+
+// backtestWrapper implements the gctscript exchange wrapper interface
+// using backtesting data, funding and portfolio holdings. It only exposes
+// data up to and including the event being processed
+type backtestWrapper struct {
+	handlers  []data.Handler
+	current   data.Handler
+	funds     funding.IFundingTransferer
+	portfolio portfolio.Handler
+	orders    []*order.Submit
+	cancels   []string
+	submitted int
+}
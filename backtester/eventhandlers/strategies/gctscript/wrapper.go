@@ -0,0 +1,278 @@
+package gctscript
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
+)
+
+// Exchanges returns the exchanges being backtested
+func (w *backtestWrapper) Exchanges(bool) []string {
+	var resp []string
+	for i := range w.handlers {
+		exch, _, _, err := w.handlers[i].GetDetails()
+		if err != nil || gctcommon.StringDataCompareInsensitive(resp, exch) {
+			continue
+		}
+		resp = append(resp, exch)
+	}
+	return resp
+}
+
+// IsEnabled returns whether the exchange is being backtested
+func (w *backtestWrapper) IsEnabled(exch string) bool {
+	return gctcommon.StringDataCompareInsensitive(w.Exchanges(true), exch)
+}
+
+// Orderbook is not supported as the backtester does not replay orderbooks
+func (w *backtestWrapper) Orderbook(context.Context, string, currency.Pair, asset.Item) (*orderbook.Base, error) {
+	return nil, fmt.Errorf("orderbook %w", errUnsupportedInBacktest)
+}
+
+// Ticker returns a ticker derived from the latest candle
+func (w *backtestWrapper) Ticker(_ context.Context, exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
+	d, err := w.getHandler(exch, pair, item)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := d.Latest()
+	if err != nil {
+		return nil, err
+	}
+	return &ticker.Price{
+		Last:         latest.GetClosePrice().InexactFloat64(),
+		High:         latest.GetHighPrice().InexactFloat64(),
+		Low:          latest.GetLowPrice().InexactFloat64(),
+		Open:         latest.GetOpenPrice().InexactFloat64(),
+		Close:        latest.GetClosePrice().InexactFloat64(),
+		Volume:       latest.GetVolume().InexactFloat64(),
+		Pair:         latest.Pair(),
+		ExchangeName: latest.GetExchange(),
+		AssetType:    latest.GetAssetType(),
+		LastUpdated:  latest.GetTime(),
+	}, nil
+}
+
+// Pairs returns the pairs being backtested for the exchange and asset
+func (w *backtestWrapper) Pairs(exch string, _ bool, item asset.Item) (*currency.Pairs, error) {
+	var resp currency.Pairs
+	for i := range w.handlers {
+		e, a, p, err := w.handlers[i].GetDetails()
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(e, exch) && a == item {
+			resp = resp.Add(p)
+		}
+	}
+	if len(resp) == 0 {
+		return nil, fmt.Errorf("%w for %v %v", errDataNotFound, exch, item)
+	}
+	return &resp, nil
+}
+
+// QueryOrder is not supported as orders are converted into signals
+func (w *backtestWrapper) QueryOrder(context.Context, string, string, currency.Pair, asset.Item) (*order.Detail, error) {
+	return nil, fmt.Errorf("order query %w", errUnsupportedInBacktest)
+}
+
+// SubmitOrder records the order so that it can be converted into a signal
+// once the script has finished running. Only orders for the data event being
+// processed are accepted
+func (w *backtestWrapper) SubmitOrder(_ context.Context, submit *order.Submit) (*order.SubmitResponse, error) {
+	if submit == nil {
+		return nil, fmt.Errorf("%w order submission", gctcommon.ErrNilPointer)
+	}
+	if w.current == nil {
+		return nil, errDataNotFound
+	}
+	exch, a, p, err := w.current.GetDetails()
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(submit.Exchange, exch) || submit.AssetType != a || !submit.Pair.Equal(p) {
+		return nil, fmt.Errorf("%w, received %v %v %v expected %v %v %v",
+			errOrderMismatch, submit.Exchange, submit.AssetType, submit.Pair, exch, a, p)
+	}
+	if submit.Amount < 0 {
+		return nil, fmt.Errorf("%w, amount cannot be negative", errOrderMismatch)
+	}
+	w.submitted++
+	orderID := Name + "-" + strconv.Itoa(w.submitted)
+	if submit.ClientID == "" && submit.ClientOrderID == "" {
+		// allows resting orders to be cancelled by the returned order ID
+		submit.ClientOrderID = orderID
+	}
+	w.orders = append(w.orders, submit)
+	return submit.DeriveSubmitResponse(orderID)
+}
+
+// CancelOrder records the order ID so that resting orders matching the ID or
+// client order ID are cancelled when the signal is processed. Only orders for
+// the data event being processed can be cancelled
+func (w *backtestWrapper) CancelOrder(_ context.Context, exch, orderID string, pair currency.Pair, item asset.Item) (bool, error) {
+	if orderID == "" {
+		return false, order.ErrOrderIDNotSet
+	}
+	if w.current == nil {
+		return false, errDataNotFound
+	}
+	e, a, p, err := w.current.GetDetails()
+	if err != nil {
+		return false, err
+	}
+	if !strings.EqualFold(exch, e) ||
+		(item != asset.Empty && item != a) ||
+		(!pair.IsEmpty() && !pair.Equal(p)) {
+		return false, fmt.Errorf("%w, received %v %v %v expected %v %v %v",
+			errOrderMismatch, exch, item, pair, e, a, p)
+	}
+	w.cancels = append(w.cancels, orderID)
+	return true, nil
+}
+
+// AccountInformation returns the backtesting funds available to the
+// exchange and asset
+func (w *backtestWrapper) AccountInformation(_ context.Context, exch string, item asset.Item) (account.Holdings, error) {
+	if w.funds == nil {
+		return account.Holdings{}, fmt.Errorf("%w funding", gctcommon.ErrNilPointer)
+	}
+	var balances []account.Balance
+	for i := range w.handlers {
+		e, a, _, err := w.handlers[i].GetDetails()
+		if err != nil {
+			return account.Holdings{}, err
+		}
+		if !strings.EqualFold(e, exch) || a != item {
+			continue
+		}
+		latest, err := w.handlers[i].Latest()
+		if err != nil {
+			return account.Holdings{}, err
+		}
+		fundBalances, err := fundingBalances(w.funds, latest)
+		if err != nil {
+			return account.Holdings{}, err
+		}
+		for j := range fundBalances {
+			if !containsBalance(balances, fundBalances[j].Currency) {
+				balances = append(balances, fundBalances[j])
+			}
+		}
+	}
+	if len(balances) == 0 {
+		return account.Holdings{}, fmt.Errorf("%w for %v %v", errDataNotFound, exch, item)
+	}
+	return account.Holdings{
+		Exchange: exch,
+		Accounts: []account.SubAccount{{
+			AssetType:  item,
+			Currencies: balances,
+		}},
+	}, nil
+}
+
+// DepositAddress is not supported when backtesting
+func (w *backtestWrapper) DepositAddress(string, string, currency.Code) (*deposit.Address, error) {
+	return nil, fmt.Errorf("deposit address %w", errUnsupportedInBacktest)
+}
+
+// WithdrawalFiatFunds is not supported when backtesting
+func (w *backtestWrapper) WithdrawalFiatFunds(context.Context, string, *withdraw.Request) (string, error) {
+	return "", fmt.Errorf("withdrawal %w", errUnsupportedInBacktest)
+}
+
+// WithdrawalCryptoFunds is not supported when backtesting
+func (w *backtestWrapper) WithdrawalCryptoFunds(context.Context, *withdraw.Request) (string, error) {
+	return "", fmt.Errorf("withdrawal %w", errUnsupportedInBacktest)
+}
+
+// OHLCV returns the candles processed so far within the requested range.
+// As scripts commonly request history relative to the current time, a range
+// ending after the latest candle is shifted to end at the latest candle,
+// preventing any look-ahead. Intervals larger than the backtesting interval
+// are built from the backtesting candles
+func (w *backtestWrapper) OHLCV(_ context.Context, exch string, pair currency.Pair, item asset.Item, start, end time.Time, interval kline.Interval) (*kline.Item, error) {
+	d, err := w.getHandler(exch, pair, item)
+	if err != nil {
+		return nil, err
+	}
+	history, err := d.History()
+	if err != nil {
+		return nil, err
+	}
+	if len(history) == 0 {
+		return nil, fmt.Errorf("%w for %v %v %v", errDataNotFound, exch, item, pair)
+	}
+	latest := history[len(history)-1]
+	if end.After(latest.GetTime()) {
+		start = latest.GetTime().Add(-end.Sub(start))
+		end = latest.GetTime()
+	}
+	resp := &kline.Item{
+		Exchange:       latest.GetExchange(),
+		Pair:           latest.Pair(),
+		UnderlyingPair: latest.GetUnderlyingPair(),
+		Asset:          latest.GetAssetType(),
+		Interval:       latest.GetInterval(),
+	}
+	for i := range history {
+		t := history[i].GetTime()
+		if t.Before(start) || t.After(end) {
+			continue
+		}
+		resp.Candles = append(resp.Candles, kline.Candle{
+			Time:   t,
+			Open:   history[i].GetOpenPrice().InexactFloat64(),
+			High:   history[i].GetHighPrice().InexactFloat64(),
+			Low:    history[i].GetLowPrice().InexactFloat64(),
+			Close:  history[i].GetClosePrice().InexactFloat64(),
+			Volume: history[i].GetVolume().InexactFloat64(),
+		})
+	}
+	if interval == resp.Interval {
+		return resp, nil
+	}
+	converted, err := resp.ConvertToNewInterval(interval)
+	if err != nil {
+		return nil, fmt.Errorf("%w %v from %v: %v", errUnsupportedInterval, interval, resp.Interval, err)
+	}
+	return converted, nil
+}
+
+// getHandler returns the data handler for the exchange, asset and pair
+func (w *backtestWrapper) getHandler(exch string, pair currency.Pair, item asset.Item) (data.Handler, error) {
+	for i := range w.handlers {
+		e, a, p, err := w.handlers[i].GetDetails()
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(e, exch) && a == item && p.Equal(pair) {
+			return w.handlers[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%w for %v %v %v", errDataNotFound, exch, item, pair)
+}
+
+func containsBalance(balances []account.Balance, code currency.Code) bool {
+	for i := range balances {
+		if balances[i].Currency.Equal(code) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,544 @@
+package grid
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Name returns the name of the strategy
+func (s *Strategy) Name() string {
+	return Name
+}
+
+// Description provides a nice overview of the strategy
+// be it definition of terms or to highlight its purpose
+func (s *Strategy) Description() string {
+	return description
+}
+
+// OnSignal handles a data event and returns what action the strategy believes should occur
+// For grid, this means placing a buy order at the nearest unfilled level below the price
+// and a sell order one level above the lowest level which has been bought
+func (s *Strategy) OnSignal(d data.Handler, f funding.IFundingTransferer, p portfolio.Handler) (signal.Event, error) {
+	if d == nil {
+		return nil, common.ErrNilEvent
+	}
+	if p == nil {
+		return nil, fmt.Errorf("%w missing portfolio handler", gctcommon.ErrNilPointer)
+	}
+	es, err := s.GetBaseData(d)
+	if err != nil {
+		return nil, err
+	}
+	latest, err := d.Latest()
+	if err != nil {
+		return nil, err
+	}
+	es.SetPrice(latest.GetClosePrice())
+
+	hasDataAtTime, err := d.HasDataAtTime(latest.GetTime())
+	if err != nil {
+		return nil, err
+	}
+	if !hasDataAtTime {
+		es.SetDirection(gctorder.MissingData)
+		es.AppendReasonf("missing data at %v, cannot perform any actions", latest.GetTime())
+		return &es, nil
+	}
+	if f != nil && f.HasExchangeBeenLiquidated(&es) {
+		es.SetDirection(gctorder.DoNothing)
+		es.AppendReason("cannot transact, has been liquidated")
+		return &es, nil
+	}
+	if latest.GetAssetType() != asset.Spot {
+		return nil, fmt.Errorf("%w %v, grid only supports spot", gctcommon.ErrFunctionNotSupported, latest.GetAssetType())
+	}
+
+	g, err := s.getGrid(latest)
+	if err != nil {
+		return nil, err
+	}
+	holding, err := p.ViewHoldingAtTimePeriod(latest)
+	if err == nil {
+		g.trackFills(holding)
+	}
+	s.update(g, latest, &es)
+	g.lastClose = latest.GetClosePrice()
+	return &es, nil
+}
+
+// SupportsSimultaneousProcessing highlights whether the strategy can handle multiple currency calculation
+// Each exchange, asset and pair has its own grid, so they can be processed together
+func (s *Strategy) SupportsSimultaneousProcessing() bool {
+	return true
+}
+
+// OnSimultaneousSignals analyses multiple data points simultaneously, allowing flexibility
+// in allowing a strategy to only place an order for X currency if Y currency's price is Z
+// For grid, each exchange, asset and pair is traded independently using OnSignal
+func (s *Strategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundingTransferer, p portfolio.Handler) ([]signal.Event, error) {
+	if len(d) == 0 {
+		return nil, base.ErrNoDataToProcess
+	}
+	resp := make([]signal.Event, 0, len(d))
+	var errs error
+	for i := range d {
+		sigEvent, err := s.OnSignal(d[i], f, p)
+		if err != nil {
+			errs = gctcommon.AppendError(errs, err)
+		} else {
+			resp = append(resp, sigEvent)
+		}
+	}
+	return resp, errs
+}
+
+// SetCustomSettings allows a user to modify the grid in their config
+func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) error {
+	for k, v := range customSettings {
+		switch k {
+		case lowerBoundKey, upperBoundKey, rangePercentKey, levelSizeKey, stopOutPercentKey, levelsKey:
+			value, ok := v.(float64)
+			if !ok || value < 0 {
+				return fmt.Errorf("%w provided %v value could not be parsed: %v", base.ErrInvalidCustomSettings, k, v)
+			}
+			switch k {
+			case lowerBoundKey:
+				s.lowerBound = decimal.NewFromFloat(value)
+			case upperBoundKey:
+				s.upperBound = decimal.NewFromFloat(value)
+			case rangePercentKey:
+				if value == 0 || value >= 1 {
+					return fmt.Errorf("%w %v must be between 0 and 1: %v", base.ErrInvalidCustomSettings, k, v)
+				}
+				s.rangePercent = decimal.NewFromFloat(value)
+			case levelSizeKey:
+				s.levelSize = decimal.NewFromFloat(value)
+			case stopOutPercentKey:
+				if value >= 1 {
+					return fmt.Errorf("%w %v must be less than 1: %v", base.ErrInvalidCustomSettings, k, v)
+				}
+				s.stopOutPercent = decimal.NewFromFloat(value)
+			case levelsKey:
+				if value < 2 || value != math.Trunc(value) {
+					return fmt.Errorf("%w %v: %v", base.ErrInvalidCustomSettings, errInvalidLevels, v)
+				}
+				s.levels = int(value)
+			}
+		case spacingKey:
+			spacing, ok := v.(string)
+			spacing = strings.ToLower(spacing)
+			if !ok || (spacing != arithmetic && spacing != geometric) {
+				return fmt.Errorf("%w %v: %v", base.ErrInvalidCustomSettings, errInvalidSpacing, v)
+			}
+			s.spacing = spacing
+		case orderTypeKey:
+			orderType, ok := v.(string)
+			orderType = strings.ToLower(orderType)
+			if !ok || (orderType != limitOrders && orderType != marketOrders) {
+				return fmt.Errorf("%w %v: %v", base.ErrInvalidCustomSettings, errInvalidOrder, v)
+			}
+			s.orderType = orderType
+		case recenterKey:
+			recenter, ok := v.(bool)
+			if !ok {
+				return fmt.Errorf("%w provided %v value could not be parsed: %v", base.ErrInvalidCustomSettings, k, v)
+			}
+			s.recenter = recenter
+		default:
+			return fmt.Errorf("%w unrecognised custom setting key %v with value %v. Cannot apply", base.ErrInvalidCustomSettings, k, v)
+		}
+	}
+	if s.lowerBound.IsZero() != s.upperBound.IsZero() ||
+		(!s.lowerBound.IsZero() && s.lowerBound.GreaterThanOrEqual(s.upperBound)) {
+		return fmt.Errorf("%w %v", base.ErrInvalidCustomSettings, errInvalidBounds)
+	}
+	return nil
+}
+
+// CustomSettingsSchema describes the custom settings the grid strategy accepts
+func (s *Strategy) CustomSettingsSchema() base.CustomSettingsSchema {
+	return base.CustomSettingsSchema{
+		Settings: []base.CustomSetting{
+			{Key: lowerBoundKey, Type: base.CustomSettingNumber, Description: "The lowest level of the grid. Must be set alongside upper-bound. When unset, the grid is built around the first price using range-percent"},
+			{Key: upperBoundKey, Type: base.CustomSettingNumber, Description: "The highest level of the grid"},
+			{Key: rangePercentKey, Type: base.CustomSettingNumber, Default: float64(defaultRangePercent), Description: "When bounds are unset, how far above and below the first price the grid extends"},
+			{Key: levelsKey, Type: base.CustomSettingNumber, Default: float64(defaultLevels), Description: "The number of price levels in the grid, including the bounds. Must be at least 2"},
+			{Key: spacingKey, Type: base.CustomSettingString, Default: arithmetic, Options: []string{arithmetic, geometric}, Description: "Places levels an equal price or an equal percentage apart"},
+			{Key: levelSizeKey, Type: base.CustomSettingNumber, Description: "The base currency amount bought at each level. When unset, orders are sized by the portfolio settings"},
+			{Key: orderTypeKey, Type: base.CustomSettingString, Default: limitOrders, Options: []string{limitOrders, marketOrders}, Description: "Places resting limit orders at each level or market orders when a level is crossed"},
+			{Key: recenterKey, Type: base.CustomSettingBool, Default: false, Description: "Rebuilds the grid around the price when the price leaves the grid while the grid holds no inventory"},
+			{Key: stopOutPercentKey, Type: base.CustomSettingNumber, Description: "Stops trading the currency pair when the price falls this far below the lower bound"},
+		},
+		Verifiable: true,
+	}
+}
+
+// PlacesRestingOrders returns whether the grid places resting limit orders
+// rather than market orders
+func (s *Strategy) PlacesRestingOrders() bool {
+	return s.orderType == limitOrders
+}
+
+// SetDefaults sets the custom settings to their default values
+func (s *Strategy) SetDefaults() {
+	s.lowerBound = decimal.Zero
+	s.upperBound = decimal.Zero
+	s.rangePercent = decimal.NewFromFloat(defaultRangePercent)
+	s.levels = defaultLevels
+	s.spacing = arithmetic
+	s.levelSize = decimal.Zero
+	s.orderType = limitOrders
+	s.recenter = false
+	s.stopOutPercent = decimal.Zero
+}
+
+// CloseAllPositions is this strategy's implementation on how to
+// unwind all positions in the event of a closure. Grid orders are
+// cancelled and spot holdings are sold
+func (s *Strategy) CloseAllPositions(h []holdings.Holding, prices []data.Event) ([]signal.Event, error) {
+	var resp []signal.Event
+	signalTime := time.Now().UTC()
+	for i := range h {
+		for j := range prices {
+			if prices[j].GetExchange() != h[i].Exchange ||
+				prices[j].GetAssetType() != h[i].Asset ||
+				!prices[j].Pair().Equal(h[i].Pair) {
+				continue
+			}
+			sig := &signal.Signal{
+				Base: &event.Base{
+					Offset:         h[i].Offset + 1,
+					Exchange:       h[i].Exchange,
+					Time:           signalTime,
+					Interval:       prices[j].GetInterval(),
+					CurrencyPair:   h[i].Pair,
+					UnderlyingPair: prices[j].GetUnderlyingPair(),
+					AssetType:      h[i].Asset,
+					Reasons:        []string{"closing position on close"},
+				},
+				OpenPrice:  prices[j].GetOpenPrice(),
+				HighPrice:  prices[j].GetHighPrice(),
+				LowPrice:   prices[j].GetLowPrice(),
+				ClosePrice: prices[j].GetClosePrice(),
+				Volume:     prices[j].GetVolume(),
+				Amount:     h[i].BaseSize,
+				Direction:  gctorder.ClosePosition,
+			}
+			if g, ok := s.grids[gridKey(prices[j])]; ok {
+				sig.CancelOrderIDs = g.cancelOrders()
+			}
+			if h[i].BaseSize.IsZero() {
+				if len(sig.CancelOrderIDs) == 0 {
+					continue
+				}
+				sig.Direction = gctorder.DoNothing
+			}
+			resp = append(resp, sig)
+		}
+	}
+	return resp, nil
+}
+
+// getGrid returns the grid for the event's exchange, asset and pair, creating
+// it around the event's close price when bounds have not been configured
+func (s *Strategy) getGrid(ev data.Event) (*grid, error) {
+	key := gridKey(ev)
+	if g, ok := s.grids[key]; ok {
+		return g, nil
+	}
+	lower, upper := s.lowerBound, s.upperBound
+	if lower.IsZero() {
+		closePrice := ev.GetClosePrice()
+		lower = closePrice.Mul(decimal.NewFromInt(1).Sub(s.rangePercent))
+		upper = closePrice.Mul(decimal.NewFromInt(1).Add(s.rangePercent))
+	}
+	levels, err := s.calculateLevels(lower, upper)
+	if err != nil {
+		return nil, err
+	}
+	g := &grid{
+		levels:    levels,
+		inventory: make([]decimal.Decimal, len(levels)-1),
+		lastClose: ev.GetClosePrice(),
+	}
+	if s.grids == nil {
+		s.grids = make(map[string]*grid)
+	}
+	s.grids[key] = g
+	return g, nil
+}
+
+// calculateLevels returns the grid's price levels from lowest to highest
+func (s *Strategy) calculateLevels(lower, upper decimal.Decimal) ([]decimal.Decimal, error) {
+	if lower.LessThanOrEqual(decimal.Zero) || lower.GreaterThanOrEqual(upper) {
+		return nil, fmt.Errorf("%w lower %v upper %v", errInvalidBounds, lower, upper)
+	}
+	if s.levels < 2 {
+		return nil, errInvalidLevels
+	}
+	levels := make([]decimal.Decimal, s.levels)
+	steps := int64(s.levels - 1)
+	switch s.spacing {
+	case arithmetic:
+		step := upper.Sub(lower).Div(decimal.NewFromInt(steps))
+		for i := range levels {
+			levels[i] = lower.Add(step.Mul(decimal.NewFromInt(int64(i))))
+		}
+	case geometric:
+		ratio := math.Pow(upper.Div(lower).InexactFloat64(), 1/float64(steps))
+		for i := range levels {
+			levels[i] = lower.Mul(decimal.NewFromFloat(math.Pow(ratio, float64(i))))
+		}
+	default:
+		return nil, fmt.Errorf("%w: %v", errInvalidSpacing, s.spacing)
+	}
+	levels[len(levels)-1] = upper
+	return levels, nil
+}
+
+// update assesses the grid against the latest price and sets the signal to
+// place at most one new grid order, cancelling grid orders which are no longer needed
+func (s *Strategy) update(g *grid, latest data.Event, es *signal.Signal) {
+	es.SetDirection(gctorder.DoNothing)
+	if g.stoppedOut {
+		es.AppendReason("grid has stopped out")
+		return
+	}
+	closePrice := latest.GetClosePrice()
+	lower, upper := g.levels[0], g.levels[len(g.levels)-1]
+	if !s.stopOutPercent.IsZero() &&
+		closePrice.LessThan(lower.Mul(decimal.NewFromInt(1).Sub(s.stopOutPercent))) {
+		s.stopOut(g, es)
+		return
+	}
+	if s.recenter && g.inventoryAmount().IsZero() &&
+		(closePrice.LessThan(lower) || closePrice.GreaterThan(upper)) {
+		s.recenterGrid(g, closePrice, es)
+	}
+	if s.orderType == marketOrders {
+		s.placeMarketOrder(g, latest, es)
+		return
+	}
+	s.placeLimitOrder(g, latest, es)
+}
+
+// trackFills attributes the amounts bought and sold since the last event
+// to the grid's orders. Sales which were not made by the grid, such as
+// those from exit rules, reduce the inventory of the highest cells first
+func (g *grid) trackFills(h *holdings.Holding) {
+	bought := h.BoughtAmount.Sub(g.lastBought)
+	sold := h.SoldAmount.Sub(g.lastSold)
+	g.lastBought = h.BoughtAmount
+	g.lastSold = h.SoldAmount
+	if bought.GreaterThan(decimal.Zero) && g.buyOrder != nil {
+		g.inventory[g.buyOrder.cell] = g.inventory[g.buyOrder.cell].Add(bought)
+		g.buyOrder = nil
+	}
+	if sold.GreaterThan(decimal.Zero) && g.sellOrder != nil {
+		sold = sold.Sub(g.inventory[g.sellOrder.cell])
+		g.inventory[g.sellOrder.cell] = decimal.Zero
+		g.sellOrder = nil
+	}
+	for i := len(g.inventory) - 1; i >= 0 && sold.GreaterThan(decimal.Zero); i-- {
+		reduction := decimal.Min(sold, g.inventory[i])
+		g.inventory[i] = g.inventory[i].Sub(reduction)
+		sold = sold.Sub(reduction)
+	}
+}
+
+// placeLimitOrder keeps a resting limit buy at the highest unfilled level below
+// the price and a resting limit sell for the lowest filled cell. Only one order can be
+// placed per event, so the missing order nearest to the price is placed first
+func (s *Strategy) placeLimitOrder(g *grid, latest data.Event, es *signal.Signal) {
+	closePrice := latest.GetClosePrice()
+	// orders which were traded through without filling were not accepted
+	// by the exchange, so they are no longer tracked
+	if g.buyOrder != nil && g.buyOrder.placed.Before(latest.GetTime()) &&
+		latest.GetLowPrice().LessThan(g.buyOrder.price) {
+		es.AppendReasonf("buy order %v was not filled", g.buyOrder.clientOrderID)
+		g.buyOrder = nil
+	}
+	if g.sellOrder != nil && g.sellOrder.placed.Before(latest.GetTime()) &&
+		latest.GetHighPrice().GreaterThan(g.sellOrder.price) {
+		es.AppendReasonf("sell order %v was not filled", g.sellOrder.clientOrderID)
+		g.sellOrder = nil
+	}
+
+	buyCell := -1
+	for i := len(g.inventory) - 1; i >= 0; i-- {
+		if g.inventory[i].IsZero() && g.levels[i].LessThan(closePrice) {
+			buyCell = i
+			break
+		}
+	}
+	sellCell := -1
+	for i := range g.inventory {
+		if !g.inventory[i].IsZero() {
+			sellCell = i
+			break
+		}
+	}
+	if g.buyOrder != nil && g.buyOrder.cell != buyCell {
+		es.CancelOrderIDs = append(es.CancelOrderIDs, g.buyOrder.clientOrderID)
+		g.buyOrder = nil
+	}
+	if g.sellOrder != nil && g.sellOrder.cell != sellCell {
+		es.CancelOrderIDs = append(es.CancelOrderIDs, g.sellOrder.clientOrderID)
+		g.sellOrder = nil
+	}
+
+	placeBuy := buyCell >= 0 && g.buyOrder == nil
+	placeSell := sellCell >= 0 && g.sellOrder == nil
+	if placeBuy && placeSell {
+		// place the order which is most likely to fill first
+		buyDistance := closePrice.Sub(g.levels[buyCell])
+		sellDistance := g.levels[sellCell+1].Sub(closePrice)
+		if sellDistance.LessThan(buyDistance) {
+			placeBuy = false
+		} else {
+			placeSell = false
+		}
+	}
+	switch {
+	case placeBuy:
+		g.buyOrder = s.newOrder(g, es, latest, buyCell, gctorder.Buy, g.levels[buyCell], s.levelSize)
+	case placeSell:
+		g.sellOrder = s.newOrder(g, es, latest, sellCell, gctorder.Sell, g.levels[sellCell+1], g.inventory[sellCell])
+	default:
+		es.AppendReason("grid orders are in place")
+	}
+}
+
+// placeMarketOrder buys at market when the price crosses down through an unfilled
+// level and sells at market when the price reaches the level above a filled cell
+func (s *Strategy) placeMarketOrder(g *grid, latest data.Event, es *signal.Signal) {
+	// market orders fill on the event they are placed, so any
+	// order which has not been tracked as a fill was not successful
+	g.buyOrder = nil
+	g.sellOrder = nil
+	closePrice := latest.GetClosePrice()
+	for i := range g.inventory {
+		if !g.inventory[i].IsZero() && closePrice.GreaterThanOrEqual(g.levels[i+1]) {
+			g.sellOrder = s.newOrder(g, es, latest, i, gctorder.Sell, decimal.Zero, g.inventory[i])
+			return
+		}
+	}
+	for i := len(g.inventory) - 1; i >= 0; i-- {
+		if g.inventory[i].IsZero() &&
+			g.levels[i].GreaterThanOrEqual(closePrice) &&
+			g.levels[i].LessThan(g.lastClose) {
+			g.buyOrder = s.newOrder(g, es, latest, i, gctorder.Buy, decimal.Zero, s.levelSize)
+			return
+		}
+	}
+	es.AppendReason("no grid level crossed")
+}
+
+// newOrder sets the signal to place a grid order for a cell. A zero price places a market order
+func (s *Strategy) newOrder(g *grid, es *signal.Signal, latest data.Event, cell int, side gctorder.Side, price, amount decimal.Decimal) *gridOrder {
+	s.orderCount++
+	o := &gridOrder{
+		cell:          cell,
+		side:          side,
+		price:         price,
+		placed:        latest.GetTime(),
+		clientOrderID: fmt.Sprintf("%v-%v-%v-%v", Name, side.Lower(), cell, s.orderCount),
+	}
+	es.SetDirection(side)
+	es.SetAmount(amount)
+	if !price.IsZero() {
+		es.OrderType = gctorder.Limit
+		es.LimitPrice = price
+		es.ClientOrderID = o.clientOrderID
+		es.AppendReasonf("%v limit order at level %v for cell %v of %v", side.Lower(), price, cell, len(g.inventory))
+	} else {
+		es.AppendReasonf("%v market order for cell %v of %v at %v", side.Lower(), cell, len(g.inventory), latest.GetClosePrice())
+	}
+	return o
+}
+
+// stopOut cancels the grid's orders, sells the grid's inventory
+// and stops the grid from placing any further orders
+func (s *Strategy) stopOut(g *grid, es *signal.Signal) {
+	es.CancelOrderIDs = g.cancelOrders()
+	amount := g.inventoryAmount()
+	for i := range g.inventory {
+		g.inventory[i] = decimal.Zero
+	}
+	g.stoppedOut = true
+	es.AppendReasonf("price fell more than %v below the grid lower bound %v, stopping out", s.stopOutPercent, g.levels[0])
+	if amount.IsZero() {
+		return
+	}
+	es.SetDirection(gctorder.Sell)
+	es.SetAmount(amount)
+}
+
+// recenterGrid rebuilds the grid around the price keeping the grid's width for
+// arithmetic spacing and its ratio for geometric spacing
+func (s *Strategy) recenterGrid(g *grid, closePrice decimal.Decimal, es *signal.Signal) {
+	var lower, upper decimal.Decimal
+	oldLower, oldUpper := g.levels[0], g.levels[len(g.levels)-1]
+	switch s.spacing {
+	case geometric:
+		halfRatio := decimal.NewFromFloat(math.Sqrt(oldUpper.Div(oldLower).InexactFloat64()))
+		lower = closePrice.Div(halfRatio)
+		upper = closePrice.Mul(halfRatio)
+	default:
+		halfWidth := oldUpper.Sub(oldLower).Div(decimal.NewFromInt(2))
+		lower = closePrice.Sub(halfWidth)
+		upper = closePrice.Add(halfWidth)
+	}
+	levels, err := s.calculateLevels(lower, upper)
+	if err != nil {
+		es.AppendReasonf("could not recenter grid: %v", err)
+		return
+	}
+	es.CancelOrderIDs = g.cancelOrders()
+	g.levels = levels
+	es.AppendReasonf("recentered grid from %v-%v to %v-%v", oldLower, oldUpper, lower, upper)
+}
+
+// cancelOrders returns the client order IDs of the grid's
+// resting orders and stops tracking them
+func (g *grid) cancelOrders() []string {
+	var ids []string
+	if g.buyOrder != nil && !g.buyOrder.price.IsZero() {
+		ids = append(ids, g.buyOrder.clientOrderID)
+	}
+	if g.sellOrder != nil && !g.sellOrder.price.IsZero() {
+		ids = append(ids, g.sellOrder.clientOrderID)
+	}
+	g.buyOrder = nil
+	g.sellOrder = nil
+	return ids
+}
+
+// inventoryAmount returns the total amount held by the grid
+func (g *grid) inventoryAmount() decimal.Decimal {
+	total := decimal.Zero
+	for i := range g.inventory {
+		total = total.Add(g.inventory[i])
+	}
+	return total
+}
+
+func gridKey(ev common.Event) string {
+	return fmt.Sprintf("%v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+}
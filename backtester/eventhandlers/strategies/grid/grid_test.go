@@ -0,0 +1,537 @@
+package grid
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	datakline "github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	eventkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const testExchange = "binance"
+
+var (
+	testPair  = currency.NewPair(currency.BTC, currency.USDT)
+	testStart = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// fakeFunds overrides default implementation
+type fakeFunds struct {
+	funding.FundManager
+	hasBeenLiquidated bool
+}
+
+// HasExchangeBeenLiquidated overrides default implementation
+func (f *fakeFunds) HasExchangeBeenLiquidated(common.Event) bool {
+	return f.hasBeenLiquidated
+}
+
+// portfolerino overrides default implementation
+type portfolerino struct {
+	portfolio.Portfolio
+	holding holdings.Holding
+}
+
+// ViewHoldingAtTimePeriod overrides default implementation
+func (p *portfolerino) ViewHoldingAtTimePeriod(common.Event) (*holdings.Holding, error) {
+	h := p.holding
+	return &h, nil
+}
+
+// candle is a test candle's open, high, low and close prices
+type candle [4]float64
+
+// newTestData returns a data handler for the candles which
+// has not yet processed any of them
+func newTestData(t *testing.T, p currency.Pair, candles []candle) *datakline.DataFromKline {
+	t.Helper()
+	d := &datakline.DataFromKline{
+		Base: &data.Base{},
+		Item: &gctkline.Item{
+			Exchange: testExchange,
+			Asset:    asset.Spot,
+			Pair:     p,
+			Interval: gctkline.OneDay,
+		},
+	}
+	events := make([]data.Event, len(candles))
+	for i := range candles {
+		events[i] = &eventkline.Kline{
+			Base: &event.Base{
+				Offset:       int64(i + 1),
+				Exchange:     testExchange,
+				Time:         testStart.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+				Interval:     gctkline.OneDay,
+				CurrencyPair: p,
+				AssetType:    asset.Spot,
+			},
+			Open:   decimal.NewFromFloat(candles[i][0]),
+			High:   decimal.NewFromFloat(candles[i][1]),
+			Low:    decimal.NewFromFloat(candles[i][2]),
+			Close:  decimal.NewFromFloat(candles[i][3]),
+			Volume: decimal.NewFromInt(1),
+		}
+		d.Item.Candles = append(d.Item.Candles, gctkline.Candle{
+			Time:   events[i].GetTime(),
+			Open:   candles[i][0],
+			High:   candles[i][1],
+			Low:    candles[i][2],
+			Close:  candles[i][3],
+			Volume: 1,
+		})
+	}
+	var err error
+	d.RangeHolder, err = gctkline.CalculateCandleDateRanges(testStart, testStart.AddDate(0, 0, len(candles)), gctkline.OneDay, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.RangeHolder.SetHasDataFromCandles(d.Item.Candles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.SetStream(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// newTestStrategy returns a strategy with a grid of 90, 95, 100, 105 and 110
+func newTestStrategy(t *testing.T, settings map[string]interface{}) *Strategy {
+	t.Helper()
+	s := &Strategy{}
+	s.SetDefaults()
+	customSettings := map[string]interface{}{
+		lowerBoundKey: 90.0,
+		upperBoundKey: 110.0,
+		levelsKey:     5.0,
+		levelSizeKey:  1.0,
+	}
+	for k, v := range settings {
+		customSettings[k] = v
+	}
+	err := s.SetCustomSettings(customSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+// nextSignal moves the data to the next candle and returns the strategy's signal
+func nextSignal(t *testing.T, s *Strategy, d data.Handler, p portfolio.Handler) *signal.Signal {
+	t.Helper()
+	_, err := d.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.OnSignal(d, &fakeFunds{}, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig, ok := resp.(*signal.Signal)
+	if !ok {
+		t.Fatal("expected signal")
+	}
+	return sig
+}
+
+func expectOrder(t *testing.T, sig *signal.Signal, side gctorder.Side, orderType gctorder.Type, price, amount float64) {
+	t.Helper()
+	if sig.GetDirection() != side {
+		t.Fatalf("received '%v' expected '%v'", sig.GetDirection(), side)
+	}
+	if sig.GetOrderType() != orderType {
+		t.Errorf("received '%v' expected '%v'", sig.GetOrderType(), orderType)
+	}
+	if !sig.GetLimitPrice().Equal(decimal.NewFromFloat(price)) {
+		t.Errorf("received '%v' expected '%v'", sig.GetLimitPrice(), price)
+	}
+	if !sig.GetAmount().Equal(decimal.NewFromFloat(amount)) {
+		t.Errorf("received '%v' expected '%v'", sig.GetAmount(), amount)
+	}
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if n := s.Name(); n != Name {
+		t.Errorf("received '%v' expected '%v'", n, Name)
+	}
+}
+
+func TestDescription(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if n := s.Description(); n != description {
+		t.Errorf("received '%v' expected '%v'", n, description)
+	}
+}
+
+func TestSupportsSimultaneousProcessing(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if !s.SupportsSimultaneousProcessing() {
+		t.Error("expected true")
+	}
+}
+
+func TestSetDefaults(t *testing.T) {
+	t.Parallel()
+	s := Strategy{recenter: true}
+	s.SetDefaults()
+	if s.levels != defaultLevels {
+		t.Errorf("received '%v' expected '%v'", s.levels, defaultLevels)
+	}
+	if !s.rangePercent.Equal(decimal.NewFromFloat(defaultRangePercent)) {
+		t.Errorf("received '%v' expected '%v'", s.rangePercent, defaultRangePercent)
+	}
+	if s.spacing != arithmetic || s.orderType != limitOrders || s.recenter {
+		t.Errorf("received '%v' '%v' '%v' expected '%v' '%v' '%v'", s.spacing, s.orderType, s.recenter, arithmetic, limitOrders, false)
+	}
+}
+
+func TestSetCustomSettings(t *testing.T) {
+	t.Parallel()
+	for _, settings := range []map[string]interface{}{
+		{"lol": 1.0},
+		{levelsKey: 1.0},
+		{levelsKey: 2.5},
+		{levelsKey: "5"},
+		{rangePercentKey: 1.0},
+		{stopOutPercentKey: -0.1},
+		{spacingKey: "logarithmic"},
+		{orderTypeKey: "stop"},
+		{recenterKey: "true"},
+		{lowerBoundKey: 100.0},
+		{lowerBoundKey: 100.0, upperBoundKey: 90.0},
+	} {
+		s := &Strategy{}
+		s.SetDefaults()
+		err := s.SetCustomSettings(settings)
+		if !errors.Is(err, base.ErrInvalidCustomSettings) {
+			t.Errorf("%v received '%v' expected '%v'", settings, err, base.ErrInvalidCustomSettings)
+		}
+	}
+
+	s := newTestStrategy(t, map[string]interface{}{
+		spacingKey:        "Geometric",
+		orderTypeKey:      "MARKET",
+		recenterKey:       true,
+		stopOutPercentKey: 0.05,
+	})
+	if s.spacing != geometric || s.orderType != marketOrders || !s.recenter {
+		t.Errorf("received '%v' '%v' '%v' expected '%v' '%v' '%v'", s.spacing, s.orderType, s.recenter, geometric, marketOrders, true)
+	}
+	if s.PlacesRestingOrders() {
+		t.Errorf("received '%v' expected '%v'", s.PlacesRestingOrders(), false)
+	}
+	if s.levels != 5 || !s.lowerBound.Equal(decimal.NewFromInt(90)) || !s.stopOutPercent.Equal(decimal.NewFromFloat(0.05)) {
+		t.Errorf("received '%v' '%v' '%v' expected '%v' '%v' '%v'", s.levels, s.lowerBound, s.stopOutPercent, 5, 90, 0.05)
+	}
+}
+
+func TestCalculateLevels(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	s.SetDefaults()
+	_, err := s.calculateLevels(decimal.NewFromInt(10), decimal.NewFromInt(10))
+	if !errors.Is(err, errInvalidBounds) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidBounds)
+	}
+
+	s.levels = 3
+	levels, err := s.calculateLevels(decimal.NewFromInt(100), decimal.NewFromInt(200))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	for i, expected := range []int64{100, 150, 200} {
+		if !levels[i].Equal(decimal.NewFromInt(expected)) {
+			t.Errorf("received '%v' expected '%v'", levels[i], expected)
+		}
+	}
+
+	s.spacing = geometric
+	levels, err = s.calculateLevels(decimal.NewFromInt(100), decimal.NewFromInt(400))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	for i, expected := range []int64{100, 200, 400} {
+		if !levels[i].Round(8).Equal(decimal.NewFromInt(expected)) {
+			t.Errorf("received '%v' expected '%v'", levels[i], expected)
+		}
+	}
+}
+
+func TestOnSignal(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, nil)
+	_, err := s.OnSignal(nil, nil, nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+	d := newTestData(t, testPair, []candle{{100, 100, 100, 100}})
+	_, err = s.OnSignal(d, nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = d.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.OnSignal(d, &fakeFunds{hasBeenLiquidated: true}, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if resp.GetDirection() != gctorder.DoNothing {
+		t.Errorf("received '%v' expected '%v'", resp.GetDirection(), gctorder.DoNothing)
+	}
+}
+
+func TestOnSignalLimitOrders(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, nil)
+	d := newTestData(t, testPair, []candle{
+		{100, 100, 100, 100},
+		{100, 100, 94, 96},
+		{96, 97, 95.5, 96},
+		{96, 101, 96, 100},
+		{100, 100, 99, 99},
+	})
+	p := &portfolerino{}
+
+	// buy at the highest level below the price
+	sig := nextSignal(t, s, d, p)
+	expectOrder(t, sig, gctorder.Buy, gctorder.Limit, 95, 1)
+	buyID := sig.GetClientOrderID()
+	if buyID == "" {
+		t.Error("expected client order ID")
+	}
+
+	// the buy filled, the sell is nearer to the price than the next buy
+	p.holding.BoughtAmount = decimal.NewFromInt(1)
+	sig = nextSignal(t, s, d, p)
+	expectOrder(t, sig, gctorder.Sell, gctorder.Limit, 100, 1)
+
+	// the next buy is placed as the sell rests
+	sig = nextSignal(t, s, d, p)
+	expectOrder(t, sig, gctorder.Buy, gctorder.Limit, 90, 1)
+	staleBuyID := sig.GetClientOrderID()
+
+	// the sell filled, so the buy is moved back up to the emptied cell
+	p.holding.SoldAmount = decimal.NewFromInt(1)
+	sig = nextSignal(t, s, d, p)
+	expectOrder(t, sig, gctorder.Buy, gctorder.Limit, 95, 1)
+	if len(sig.GetCancelOrderIDs()) != 1 || sig.GetCancelOrderIDs()[0] != staleBuyID {
+		t.Errorf("received '%v' expected '%v'", sig.GetCancelOrderIDs(), staleBuyID)
+	}
+	if sig.GetClientOrderID() == buyID || sig.GetClientOrderID() == staleBuyID {
+		t.Errorf("received '%v' expected a new client order ID", sig.GetClientOrderID())
+	}
+
+	// nothing changes while the orders rest
+	sig = nextSignal(t, s, d, p)
+	if sig.GetDirection() != gctorder.DoNothing || len(sig.GetCancelOrderIDs()) != 0 {
+		t.Errorf("received '%v' '%v' expected '%v'", sig.GetDirection(), sig.GetCancelOrderIDs(), gctorder.DoNothing)
+	}
+}
+
+func TestOnSignalUnfilledOrder(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, nil)
+	d := newTestData(t, testPair, []candle{
+		{100, 100, 100, 100},
+		{100, 100, 94, 96},
+	})
+	p := &portfolerino{}
+	sig := nextSignal(t, s, d, p)
+	expectOrder(t, sig, gctorder.Buy, gctorder.Limit, 95, 1)
+
+	// the price traded through the buy without it filling, so it is placed again
+	sig = nextSignal(t, s, d, p)
+	expectOrder(t, sig, gctorder.Buy, gctorder.Limit, 95, 1)
+	if len(sig.GetCancelOrderIDs()) != 0 {
+		t.Errorf("received '%v' expected no cancellations", sig.GetCancelOrderIDs())
+	}
+}
+
+func TestOnSignalMarketOrders(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, map[string]interface{}{orderTypeKey: marketOrders})
+	d := newTestData(t, testPair, []candle{
+		{100, 100, 100, 100},
+		{100, 100, 94, 94},
+		{94, 96, 94, 96},
+		{96, 101, 96, 101},
+	})
+	p := &portfolerino{}
+	sig := nextSignal(t, s, d, p)
+	if sig.GetDirection() != gctorder.DoNothing {
+		t.Errorf("received '%v' expected '%v'", sig.GetDirection(), gctorder.DoNothing)
+	}
+
+	// crossing down through 95 buys its cell
+	sig = nextSignal(t, s, d, p)
+	expectOrder(t, sig, gctorder.Buy, gctorder.UnknownType, 0, 1)
+
+	p.holding.BoughtAmount = decimal.NewFromInt(1)
+	sig = nextSignal(t, s, d, p)
+	if sig.GetDirection() != gctorder.DoNothing {
+		t.Errorf("received '%v' expected '%v'", sig.GetDirection(), gctorder.DoNothing)
+	}
+
+	// reaching 100 sells the cell bought at 95
+	sig = nextSignal(t, s, d, p)
+	expectOrder(t, sig, gctorder.Sell, gctorder.UnknownType, 0, 1)
+}
+
+func TestOnSignalStopOut(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, map[string]interface{}{stopOutPercentKey: 0.1})
+	d := newTestData(t, testPair, []candle{
+		{100, 100, 100, 100},
+		{100, 100, 94, 96},
+		{96, 96, 70, 70},
+		{70, 70, 70, 70},
+	})
+	p := &portfolerino{}
+	nextSignal(t, s, d, p)
+	p.holding.BoughtAmount = decimal.NewFromInt(1)
+	sig := nextSignal(t, s, d, p)
+	expectOrder(t, sig, gctorder.Sell, gctorder.Limit, 100, 1)
+	sellID := sig.GetClientOrderID()
+
+	sig = nextSignal(t, s, d, p)
+	expectOrder(t, sig, gctorder.Sell, gctorder.UnknownType, 0, 1)
+	if len(sig.GetCancelOrderIDs()) != 1 || sig.GetCancelOrderIDs()[0] != sellID {
+		t.Errorf("received '%v' expected '%v'", sig.GetCancelOrderIDs(), sellID)
+	}
+
+	sig = nextSignal(t, s, d, p)
+	if sig.GetDirection() != gctorder.DoNothing {
+		t.Errorf("received '%v' expected '%v'", sig.GetDirection(), gctorder.DoNothing)
+	}
+}
+
+func TestOnSignalRecenter(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, map[string]interface{}{recenterKey: true})
+	d := newTestData(t, testPair, []candle{
+		{100, 100, 100, 100},
+		{100, 120, 100, 120},
+	})
+	p := &portfolerino{}
+	sig := nextSignal(t, s, d, p)
+	buyID := sig.GetClientOrderID()
+
+	sig = nextSignal(t, s, d, p)
+	expectOrder(t, sig, gctorder.Buy, gctorder.Limit, 115, 1)
+	if len(sig.GetCancelOrderIDs()) != 1 || sig.GetCancelOrderIDs()[0] != buyID {
+		t.Errorf("received '%v' expected '%v'", sig.GetCancelOrderIDs(), buyID)
+	}
+	g := s.grids[gridKey(sig)]
+	if !g.levels[0].Equal(decimal.NewFromInt(110)) || !g.levels[len(g.levels)-1].Equal(decimal.NewFromInt(130)) {
+		t.Errorf("received '%v' expected '%v'", g.levels, "110-130")
+	}
+}
+
+func TestTrackFills(t *testing.T) {
+	t.Parallel()
+	g := &grid{
+		inventory: []decimal.Decimal{decimal.NewFromInt(1), decimal.NewFromInt(1), decimal.NewFromInt(2)},
+		buyOrder:  &gridOrder{cell: 0},
+		sellOrder: &gridOrder{cell: 1},
+	}
+	g.trackFills(&holdings.Holding{BoughtAmount: decimal.NewFromInt(1), SoldAmount: decimal.NewFromInt(2)})
+	// the remaining sale was not made by the grid and reduces the highest cell
+	for i, expected := range []int64{2, 0, 1} {
+		if !g.inventory[i].Equal(decimal.NewFromInt(expected)) {
+			t.Errorf("received '%v' expected '%v'", g.inventory[i], expected)
+		}
+	}
+	if g.buyOrder != nil || g.sellOrder != nil {
+		t.Error("expected filled orders to be cleared")
+	}
+}
+
+func TestOnSimultaneousSignals(t *testing.T) {
+	t.Parallel()
+	s := &Strategy{}
+	s.SetDefaults()
+	_, err := s.OnSimultaneousSignals(nil, nil, nil)
+	if !errors.Is(err, base.ErrNoDataToProcess) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrNoDataToProcess)
+	}
+	ethPair := currency.NewPair(currency.ETH, currency.USDT)
+	d := []data.Handler{
+		newTestData(t, testPair, []candle{{100, 100, 100, 100}}),
+		newTestData(t, ethPair, []candle{{10, 10, 10, 10}}),
+	}
+	for i := range d {
+		if _, err = d[i].Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err := s.OnSimultaneousSignals(d, &fakeFunds{}, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 2)
+	}
+	// each pair has its own grid around its first price with a 10% range
+	for i, expected := range []float64{98.88888889, 9.88888889} {
+		if !resp[i].GetLimitPrice().Round(8).Equal(decimal.NewFromFloat(expected)) {
+			t.Errorf("received '%v' expected '%v'", resp[i].GetLimitPrice(), expected)
+		}
+	}
+	if len(s.grids) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(s.grids), 2)
+	}
+}
+
+func TestCloseAllPositions(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, nil)
+	d := newTestData(t, testPair, []candle{{100, 100, 100, 100}})
+	sig := nextSignal(t, s, d, &portfolerino{})
+	latest, err := d.Latest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := s.CloseAllPositions([]holdings.Holding{{Exchange: testExchange, Asset: asset.Spot, Pair: testPair, BaseSize: decimal.NewFromInt(2)}}, []data.Event{latest})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 1)
+	}
+	if resp[0].GetDirection() != gctorder.ClosePosition || !resp[0].GetAmount().Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp[0].GetDirection(), resp[0].GetAmount(), gctorder.ClosePosition, 2)
+	}
+	if ids := resp[0].GetCancelOrderIDs(); len(ids) != 1 || ids[0] != sig.GetClientOrderID() {
+		t.Errorf("received '%v' expected '%v'", ids, sig.GetClientOrderID())
+	}
+
+	resp, err = s.CloseAllPositions([]holdings.Holding{{Exchange: testExchange, Asset: asset.Spot, Pair: testPair}}, []data.Event{latest})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(resp), 0)
+	}
+}
@@ -0,0 +1,84 @@
+package grid
+
+import (
+	"errors"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	// Name is the strategy name
+	Name              = "grid"
+	lowerBoundKey     = "lower-bound"
+	upperBoundKey     = "upper-bound"
+	rangePercentKey   = "range-percent"
+	levelsKey         = "levels"
+	spacingKey        = "spacing"
+	levelSizeKey      = "level-size"
+	orderTypeKey      = "order-type"
+	recenterKey       = "recenter"
+	stopOutPercentKey = "stop-out-percent"
+	description       = `The grid strategy places buy orders at price levels below the current price and sells what was bought at the next level up, profiting from prices oscillating within a range. Levels are spaced arithmetically or geometrically between lower and upper bounds. The grid can be re-centred when the price leaves its bounds and can stop out when the price falls too far below them`
+
+	// arithmetic levels are an equal price apart
+	arithmetic = "arithmetic"
+	// geometric levels are an equal percentage apart
+	geometric = "geometric"
+
+	limitOrders  = "limit"
+	marketOrders = "market"
+
+	defaultLevels       = 10
+	defaultRangePercent = 0.1
+)
+
+var (
+	errInvalidBounds  = errors.New("lower-bound must be greater than zero and less than upper-bound")
+	errInvalidLevels  = errors.New("levels must be at least 2")
+	errInvalidSpacing = errors.New("spacing must be arithmetic or geometric")
+	errInvalidOrder   = errors.New("order-type must be limit or market")
+)
+
+// Strategy is an implementation of the Handler interface
+type Strategy struct {
+	base.Strategy
+	lowerBound     decimal.Decimal
+	upperBound     decimal.Decimal
+	rangePercent   decimal.Decimal
+	levels         int
+	spacing        string
+	levelSize      decimal.Decimal
+	orderType      string
+	recenter       bool
+	stopOutPercent decimal.Decimal
+	grids          map[string]*grid
+	orderCount     int64
+}
+
+// grid tracks the levels, inventory and grid orders of a single
+// exchange, asset and pair
+type grid struct {
+	levels []decimal.Decimal
+	// inventory holds the amount bought for each cell. A cell is the range
+	// between a level and the next level up, its inventory is bought at the
+	// lower level and sold at the upper level
+	inventory  []decimal.Decimal
+	buyOrder   *gridOrder
+	sellOrder  *gridOrder
+	lastBought decimal.Decimal
+	lastSold   decimal.Decimal
+	lastClose  decimal.Decimal
+	stoppedOut bool
+}
+
+// gridOrder is an order placed by the grid for a cell
+type gridOrder struct {
+	cell          int
+	side          gctorder.Side
+	price         decimal.Decimal
+	placed        time.Time
+	clientOrderID string
+}
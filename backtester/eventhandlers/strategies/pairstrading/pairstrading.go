@@ -0,0 +1,634 @@
+package pairstrading
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	gctmath "github.com/thrasher-corp/gocryptotrader/common/math"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Name returns the name of the strategy
+func (s *Strategy) Name() string {
+	return Name
+}
+
+// Description provides a nice overview of the strategy
+// be it definition of terms or to highlight its purpose
+func (s *Strategy) Description() string {
+	return description
+}
+
+// OnSignal handles a data event and returns what action the strategy believes should occur
+// however, this strategy requires the prices of every leg and so only supports simultaneous processing
+func (s *Strategy) OnSignal(data.Handler, funding.IFundingTransferer, portfolio.Handler) (signal.Event, error) {
+	return nil, base.ErrSimultaneousProcessingOnly
+}
+
+// SupportsSimultaneousProcessing this strategy only supports simultaneous signal processing
+func (s *Strategy) SupportsSimultaneousProcessing() bool {
+	return true
+}
+
+// OnSimultaneousSignals analyses multiple data points simultaneously, allowing flexibility
+// in allowing a strategy to only place an order for X currency if Y currency's price is Z
+// For pairs trading, the first leg is regressed against the others to find the hedge ratios
+// and the z-score of the spread determines when all legs are entered and exited together
+func (s *Strategy) OnSimultaneousSignals(d []data.Handler, f funding.IFundingTransferer, p portfolio.Handler) ([]signal.Event, error) {
+	if len(d) == 0 {
+		return nil, base.ErrNoDataToProcess
+	}
+	if f == nil {
+		return nil, fmt.Errorf("%w missing funding transferred", gctcommon.ErrNilPointer)
+	}
+	if p == nil {
+		return nil, fmt.Errorf("%w missing portfolio handler", gctcommon.ErrNilPointer)
+	}
+	legs, err := s.sortLegs(d)
+	if err != nil {
+		return nil, err
+	}
+	response := make([]signal.Event, len(legs))
+	for i := range legs {
+		response[i] = legs[i].signal
+	}
+
+	for i := range legs {
+		var hasDataAtTime bool
+		hasDataAtTime, err = legs[i].data.HasDataAtTime(legs[i].latest.GetTime())
+		if err != nil {
+			return nil, err
+		}
+		if !hasDataAtTime {
+			legs[i].signal.SetDirection(gctorder.MissingData)
+			appendReasonToLegs(legs, fmt.Sprintf("missing data for %v at %v, cannot perform any actions", legs[i].latest.Pair(), legs[i].latest.GetTime()))
+			return response, nil
+		}
+	}
+	for i := range legs {
+		if f.HasExchangeBeenLiquidated(legs[i].signal) {
+			appendReasonToLegs(legs, "cannot transact, has been liquidated")
+			return response, nil
+		}
+	}
+
+	var anyOpen bool
+	for i := range legs {
+		var pos []gctorder.Position
+		pos, err = p.GetPositions(legs[i].latest)
+		if err != nil {
+			return nil, err
+		}
+		if len(pos) > 0 && pos[len(pos)-1].Status == gctorder.Open {
+			legs[i].open = true
+			legs[i].direction = pos[len(pos)-1].LatestDirection
+			anyOpen = true
+		}
+	}
+	isLastEvent, err := legs[0].data.IsLastEvent()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case anyOpen && isLastEvent:
+		closeLegs(legs, "closing position on last event")
+		return response, nil
+	case anyOpen && !legs[0].open:
+		closeLegs(legs, "closing unbalanced legs")
+		return response, nil
+	}
+
+	for i := range legs {
+		var closes []decimal.Decimal
+		closes, err = legs[i].data.StreamClose()
+		if err != nil {
+			return nil, err
+		}
+		if len(closes) < s.lookback {
+			appendReasonToLegs(legs, "not enough data for signal generation")
+			return response, nil
+		}
+		closes = closes[len(closes)-s.lookback:]
+		legs[i].closes = make([]float64, len(closes))
+		for j := range closes {
+			legs[i].closes[j] = closes[j].InexactFloat64()
+		}
+	}
+	sp, err := calculateSpread(legs)
+	if err != nil {
+		appendReasonToLegs(legs, err.Error())
+		return response, nil
+	}
+	zScore := decimal.NewFromFloat(sp.zScore).Round(4)
+	legs[0].signal.AppendReasonf("Spread z-score: %v", zScore)
+	for i := 1; i < len(legs); i++ {
+		legs[i].hedgeRatio = sp.hedgeRatios[i-1]
+		legs[i].signal.AppendReasonf("Spread z-score: %v. Hedge ratio against %v: %v", zScore, legs[0].latest.Pair(), decimal.NewFromFloat(legs[i].hedgeRatio).Round(8))
+	}
+
+	if legs[0].open {
+		if (legs[0].direction == gctorder.Long && zScore.GreaterThanOrEqual(s.exitZScore.Neg())) ||
+			(legs[0].direction == gctorder.Short && zScore.LessThanOrEqual(s.exitZScore)) {
+			closeLegs(legs, fmt.Sprintf("closing position, spread reverted to within %v", s.exitZScore))
+		}
+		return response, nil
+	}
+	if zScore.Abs().LessThan(s.entryZScore) {
+		return response, nil
+	}
+	if s.adfSignificance > 0 {
+		criticalValue, ok := adfCriticalValues[len(legs)][s.adfSignificance]
+		if !ok {
+			return nil, fmt.Errorf("%w, %v legs", errTooManyLegsADF, len(legs))
+		}
+		var statistic float64
+		statistic, err = adfStatistic(sp.residuals, s.adfLags)
+		if err != nil {
+			appendReasonToLegs(legs, err.Error())
+			return response, nil
+		}
+		if statistic > criticalValue {
+			appendReasonToLegs(legs, fmt.Sprintf("spread is not stationary, augmented Dickey-Fuller statistic %v is above the %v%% critical value %v",
+				decimal.NewFromFloat(statistic).Round(4), s.adfSignificance, criticalValue))
+			return response, nil
+		}
+	}
+	if s.minimumCorrelation > 0 {
+		for i := 1; i < len(legs); i++ {
+			var correlation float64
+			correlation, err = latestCorrelation(legs[0].closes, legs[i].closes)
+			if err != nil {
+				return nil, err
+			}
+			if math.Abs(correlation) < s.minimumCorrelation {
+				appendReasonToLegs(legs, fmt.Sprintf("correlation of %v and %v is %v, below minimum %v",
+					legs[0].latest.Pair(), legs[i].latest.Pair(), decimal.NewFromFloat(correlation).Round(4), s.minimumCorrelation))
+				return response, nil
+			}
+		}
+	}
+	for i := range legs {
+		var hasCollateral bool
+		hasCollateral, err = hasAvailableCollateral(f, legs[i].signal)
+		if err != nil {
+			return nil, err
+		}
+		if !hasCollateral {
+			appendReasonToLegs(legs, fmt.Sprintf("no collateral available for %v", legs[i].latest.Pair()))
+			return response, nil
+		}
+	}
+	s.openLegs(legs, zScore.IsNegative())
+	return response, nil
+}
+
+// SetCustomSettings allows a user to modify the spread thresholds in their config
+func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) error {
+	for k, v := range customSettings {
+		switch k {
+		case lookbackKey:
+			lookback, ok := v.(float64)
+			if !ok || lookback < 3 || lookback != math.Trunc(lookback) {
+				return fmt.Errorf("%w provided lookback value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.lookback = int(lookback)
+		case entryZScoreKey:
+			entry, ok := v.(float64)
+			if !ok || entry <= 0 {
+				return fmt.Errorf("%w provided entry-z-score value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.entryZScore = decimal.NewFromFloat(entry)
+		case exitZScoreKey:
+			exit, ok := v.(float64)
+			if !ok || exit < 0 {
+				return fmt.Errorf("%w provided exit-z-score value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.exitZScore = decimal.NewFromFloat(exit)
+		case minimumCorrelationKey:
+			correlation, ok := v.(float64)
+			if !ok || correlation < 0 || correlation > 1 {
+				return fmt.Errorf("%w provided minimum-correlation value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.minimumCorrelation = correlation
+		case dependentPairKey:
+			pair, ok := v.(string)
+			if !ok {
+				return fmt.Errorf("%w provided dependent-pair value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			cp, err := currency.NewPairFromString(pair)
+			if err != nil {
+				return fmt.Errorf("%w provided dependent-pair value could not be parsed: %v", base.ErrInvalidCustomSettings, err)
+			}
+			s.dependentPair = cp
+		case notionalKey:
+			notional, ok := v.(float64)
+			if !ok || notional <= 0 {
+				return fmt.Errorf("%w provided notional value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.notional = decimal.NewFromFloat(notional)
+		case adfSignificanceKey:
+			significance, ok := v.(float64)
+			if !ok || (significance != 0 && significance != 1 && significance != 5 && significance != 10) {
+				return fmt.Errorf("%w provided adf-significance value could not be parsed, must be 0, 1, 5 or 10: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.adfSignificance = significance
+		case adfLagsKey:
+			lags, ok := v.(float64)
+			if !ok || lags < 0 || lags != math.Trunc(lags) {
+				return fmt.Errorf("%w provided adf-lags value could not be parsed: %v", base.ErrInvalidCustomSettings, v)
+			}
+			s.adfLags = int(lags)
+		default:
+			return fmt.Errorf("%w unrecognised custom setting key %v with value %v. Cannot apply", base.ErrInvalidCustomSettings, k, v)
+		}
+	}
+	if s.exitZScore.GreaterThanOrEqual(s.entryZScore) {
+		return fmt.Errorf("%w %v", base.ErrInvalidCustomSettings, errInvalidZScores)
+	}
+	return nil
+}
+
+// CustomSettingsSchema describes the custom settings the pairs trading strategy accepts
+func (s *Strategy) CustomSettingsSchema() base.CustomSettingsSchema {
+	return base.CustomSettingsSchema{
+		Settings: []base.CustomSetting{
+			{Key: lookbackKey, Type: base.CustomSettingNumber, Default: float64(defaultLookback), Description: "The number of candles used to estimate the hedge ratios and the z-score of the spread"},
+			{Key: entryZScoreKey, Type: base.CustomSettingNumber, Default: float64(defaultEntryZScore), Description: "The absolute z-score of the spread at which positions are opened"},
+			{Key: exitZScoreKey, Type: base.CustomSettingNumber, Default: float64(defaultExitZScore), Description: "The absolute z-score of the spread at which positions are closed. Must be less than entry-z-score"},
+			{Key: minimumCorrelationKey, Type: base.CustomSettingNumber, Description: "When set, positions are only opened when the absolute correlation between the dependent contract and every other contract is at least this value"},
+			{Key: dependentPairKey, Type: base.CustomSettingString, Description: "The currency pair regressed against the others. When unset, the first currency pair is used"},
+			{Key: notionalKey, Type: base.CustomSettingNumber, Default: float64(defaultNotional), Description: "The quote currency value of the dependent contract traded when opening a position"},
+			{Key: adfSignificanceKey, Type: base.CustomSettingNumber, Default: float64(defaultADFSignificance), Description: "The significance percentage, 1, 5 or 10, at which an augmented Dickey-Fuller test must find the spread stationary before positions are opened. 0 disables the test"},
+			{Key: adfLagsKey, Type: base.CustomSettingNumber, Default: float64(defaultADFLags), Description: "The number of lagged differences of the spread included in the augmented Dickey-Fuller test"},
+		},
+		Verifiable: true,
+	}
+}
+
+// SetDefaults sets the custom settings to their default values
+func (s *Strategy) SetDefaults() {
+	s.lookback = defaultLookback
+	s.entryZScore = decimal.NewFromInt(defaultEntryZScore)
+	s.exitZScore = decimal.NewFromFloat(defaultExitZScore)
+	s.minimumCorrelation = 0
+	s.dependentPair = currency.EMPTYPAIR
+	s.notional = decimal.NewFromInt(defaultNotional)
+	s.adfSignificance = defaultADFSignificance
+	s.adfLags = defaultADFLags
+}
+
+// CloseAllPositions is this strategy's implementation on how to
+// unwind all positions in the event of a closure
+func (s *Strategy) CloseAllPositions(h []holdings.Holding, prices []data.Event) ([]signal.Event, error) {
+	var resp []signal.Event
+	signalTime := time.Now().UTC()
+	for i := range h {
+		if !h[i].Asset.IsFutures() {
+			continue
+		}
+		for j := range prices {
+			if prices[j].GetExchange() != h[i].Exchange ||
+				prices[j].GetAssetType() != h[i].Asset ||
+				!prices[j].Pair().Equal(h[i].Pair) {
+				continue
+			}
+			resp = append(resp, &signal.Signal{
+				Base: &event.Base{
+					Offset:         h[i].Offset + 1,
+					Exchange:       h[i].Exchange,
+					Time:           signalTime,
+					Interval:       prices[j].GetInterval(),
+					CurrencyPair:   h[i].Pair,
+					UnderlyingPair: prices[j].GetUnderlyingPair(),
+					AssetType:      h[i].Asset,
+					Reasons:        []string{"closing position on close"},
+				},
+				OpenPrice:  prices[j].GetOpenPrice(),
+				HighPrice:  prices[j].GetHighPrice(),
+				LowPrice:   prices[j].GetLowPrice(),
+				ClosePrice: prices[j].GetClosePrice(),
+				Volume:     prices[j].GetVolume(),
+				Amount:     h[i].BaseSize,
+				Direction:  gctorder.ClosePosition,
+			})
+		}
+	}
+	return resp, nil
+}
+
+// sortLegs creates a leg for each data handler. Legs are sorted so their
+// order is consistent between events, with the dependent leg first
+func (s *Strategy) sortLegs(d []data.Handler) ([]leg, error) {
+	if len(d) < 2 {
+		return nil, errNotEnoughLegs
+	}
+	legs := make([]leg, len(d))
+	for i := range d {
+		latest, err := d[i].Latest()
+		if err != nil {
+			return nil, err
+		}
+		if !latest.GetAssetType().IsFutures() {
+			return nil, fmt.Errorf("%w, received '%v'", errFuturesOnly, latest.GetAssetType())
+		}
+		sig, err := s.GetBaseData(d[i])
+		if err != nil {
+			return nil, err
+		}
+		sig.SetPrice(latest.GetClosePrice())
+		sig.SetDirection(gctorder.DoNothing)
+		legs[i] = leg{
+			data:   d[i],
+			latest: latest,
+			signal: &sig,
+		}
+	}
+	sort.Slice(legs, func(i, j int) bool {
+		return legKey(legs[i].latest) < legKey(legs[j].latest)
+	})
+	if s.dependentPair.IsEmpty() {
+		return legs, nil
+	}
+	for i := range legs {
+		if legs[i].latest.Pair().Equal(s.dependentPair) {
+			legs[0], legs[i] = legs[i], legs[0]
+			return legs, nil
+		}
+	}
+	return nil, fmt.Errorf("%w %v", errDependentNotSet, s.dependentPair)
+}
+
+// openLegs enters the spread. When the spread is below its mean the dependent
+// leg is bought and the other legs sold by their hedge ratios, and vice versa
+func (s *Strategy) openLegs(legs []leg, longSpread bool) {
+	dependentAmount := s.notional.Div(legs[0].latest.GetClosePrice())
+	for i := range legs {
+		long := longSpread
+		amount := dependentAmount
+		if i > 0 {
+			// a negative hedge ratio moves with the dependent leg rather than against it
+			long = longSpread == (legs[i].hedgeRatio < 0)
+			amount = dependentAmount.Mul(decimal.NewFromFloat(math.Abs(legs[i].hedgeRatio)))
+		}
+		legs[i].signal.SetAmount(amount)
+		if long {
+			legs[i].signal.SetDirection(gctorder.Long)
+		} else {
+			legs[i].signal.SetDirection(gctorder.Short)
+		}
+		if longSpread {
+			legs[i].signal.AppendReason("entering long spread, spread is below its mean")
+		} else {
+			legs[i].signal.AppendReason("entering short spread, spread is above its mean")
+		}
+	}
+}
+
+// closeLegs closes every leg with an open position
+func closeLegs(legs []leg, reason string) {
+	for i := range legs {
+		if !legs[i].open {
+			continue
+		}
+		legs[i].signal.SetDirection(gctorder.ClosePosition)
+		legs[i].signal.AppendReason(reason)
+	}
+}
+
+func appendReasonToLegs(legs []leg, reason string) {
+	for i := range legs {
+		legs[i].signal.AppendReason(reason)
+	}
+}
+
+// calculateSpread regresses the dependent leg's closes against the other legs'
+// closes to find the hedge ratios, then returns the z-score of the latest
+// residual of the regression, which is the spread between the legs
+func calculateSpread(legs []leg) (*spread, error) {
+	y := legs[0].closes
+	x := make([][]float64, len(legs)-1)
+	for i := 1; i < len(legs); i++ {
+		x[i-1] = legs[i].closes
+	}
+	intercept, hedgeRatios, err := ordinaryLeastSquares(y, x)
+	if err != nil {
+		return nil, err
+	}
+	residuals := make([]float64, len(y))
+	var sumSquares float64
+	for i := range y {
+		residuals[i] = y[i] - intercept
+		for j := range x {
+			residuals[i] -= hedgeRatios[j] * x[j][i]
+		}
+		sumSquares += residuals[i] * residuals[i]
+	}
+	// the residuals of a regression with an intercept have a mean of zero
+	stdDev := math.Sqrt(sumSquares / float64(len(residuals)))
+	if stdDev == 0 {
+		return nil, fmt.Errorf("%w, spread has no deviation", errSingularMatrix)
+	}
+	return &spread{
+		intercept:   intercept,
+		hedgeRatios: hedgeRatios,
+		residuals:   residuals,
+		zScore:      residuals[len(residuals)-1] / stdDev,
+	}, nil
+}
+
+// ordinaryLeastSquares returns the intercept and coefficients of a linear
+// regression of y against each series of x. The series are centred on their
+// means and the normal equations are solved with Gaussian elimination
+func ordinaryLeastSquares(y []float64, x [][]float64) (intercept float64, coefficients []float64, err error) {
+	k := len(x)
+	if len(y) <= k+1 {
+		return 0, nil, fmt.Errorf("%w, %v observations for %v series", errSingularMatrix, len(y), k)
+	}
+	yMean, err := gctmath.ArithmeticMean(y)
+	if err != nil {
+		return 0, nil, err
+	}
+	xMeans := make([]float64, k)
+	for i := range x {
+		if len(x[i]) != len(y) {
+			return 0, nil, fmt.Errorf("%w, series lengths differ", errSingularMatrix)
+		}
+		xMeans[i], err = gctmath.ArithmeticMean(x[i])
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	// normal equations XᵀX b = Xᵀy
+	xtx := make([][]float64, k)
+	xty := make([]float64, k)
+	for i := range xtx {
+		xtx[i] = make([]float64, k)
+		for j := 0; j < k; j++ {
+			for n := range y {
+				xtx[i][j] += (x[i][n] - xMeans[i]) * (x[j][n] - xMeans[j])
+			}
+		}
+		for n := range y {
+			xty[i] += (x[i][n] - xMeans[i]) * (y[n] - yMean)
+		}
+	}
+	coefficients, _, err = solveNormalEquations(xtx, xty)
+	if err != nil {
+		return 0, nil, err
+	}
+	intercept = yMean
+	for i := range coefficients {
+		intercept -= coefficients[i] * xMeans[i]
+	}
+	return intercept, coefficients, nil
+}
+
+// solveNormalEquations solves XᵀX b = Xᵀy for b with Gauss-Jordan
+// elimination, also returning the inverse of XᵀX which scales the
+// variance of each coefficient
+func solveNormalEquations(xtx [][]float64, xty []float64) (coefficients []float64, inverse [][]float64, err error) {
+	k := len(xtx)
+	// augmented matrix [XᵀX | I | Xᵀy]
+	m := make([][]float64, k)
+	for i := range m {
+		m[i] = make([]float64, 2*k+1)
+		copy(m[i], xtx[i])
+		m[i][k+i] = 1
+		m[i][2*k] = xty[i]
+	}
+	for col := 0; col < k; col++ {
+		pivot := col
+		for row := col + 1; row < k; row++ {
+			if math.Abs(m[row][col]) > math.Abs(m[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(m[pivot][col]) < 1e-12 {
+			return nil, nil, errSingularMatrix
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		divisor := m[col][col]
+		for j := col; j <= 2*k; j++ {
+			m[col][j] /= divisor
+		}
+		for row := 0; row < k; row++ {
+			if row == col {
+				continue
+			}
+			factor := m[row][col]
+			for j := col; j <= 2*k; j++ {
+				m[row][j] -= factor * m[col][j]
+			}
+		}
+	}
+	coefficients = make([]float64, k)
+	inverse = make([][]float64, k)
+	for i := range m {
+		coefficients[i] = m[i][2*k]
+		inverse[i] = m[i][k : 2*k]
+	}
+	return coefficients, inverse, nil
+}
+
+// adfStatistic returns the augmented Dickey-Fuller t-statistic of the
+// residuals of the hedge ratio regression, as used by the Engle-Granger
+// cointegration test. The change in the spread is regressed against the
+// previous spread and the given number of lagged changes, without a constant
+// as the residuals have a mean of zero. The more negative the statistic,
+// the more strongly the spread reverts to its mean
+func adfStatistic(residuals []float64, lags int) (float64, error) {
+	if len(residuals) < 2 {
+		return 0, fmt.Errorf("%w, %v spread observations", errSingularMatrix, len(residuals))
+	}
+	changes := make([]float64, len(residuals)-1)
+	for i := range changes {
+		changes[i] = residuals[i+1] - residuals[i]
+	}
+	k := lags + 1
+	observations := len(changes) - lags
+	if observations <= k {
+		return 0, fmt.Errorf("%w, %v observations for an augmented Dickey-Fuller test with %v lags", errSingularMatrix, observations, lags)
+	}
+	y := make([]float64, observations)
+	x := make([][]float64, k)
+	for i := range x {
+		x[i] = make([]float64, observations)
+	}
+	for n := range y {
+		t := n + lags
+		y[n] = changes[t]
+		x[0][n] = residuals[t]
+		for lag := 1; lag <= lags; lag++ {
+			x[lag][n] = changes[t-lag]
+		}
+	}
+	xtx := make([][]float64, k)
+	xty := make([]float64, k)
+	for i := range xtx {
+		xtx[i] = make([]float64, k)
+		for j := range xtx[i] {
+			for n := range y {
+				xtx[i][j] += x[i][n] * x[j][n]
+			}
+		}
+		for n := range y {
+			xty[i] += x[i][n] * y[n]
+		}
+	}
+	coefficients, inverse, err := solveNormalEquations(xtx, xty)
+	if err != nil {
+		return 0, err
+	}
+	var sumSquares float64
+	for n := range y {
+		residual := y[n]
+		for i := range coefficients {
+			residual -= coefficients[i] * x[i][n]
+		}
+		sumSquares += residual * residual
+	}
+	standardError := math.Sqrt(sumSquares / float64(observations-k) * inverse[0][0])
+	if standardError == 0 {
+		return math.Inf(-1), nil
+	}
+	return coefficients[0] / standardError, nil
+}
+
+// latestCorrelation returns the correlation coefficient of the closes over the lookback
+func latestCorrelation(a, b []float64) (float64, error) {
+	correlation, err := (&gctkline.OHLC{Close: a}).GetCorrelationCoefficient(&gctkline.OHLC{Close: b}, int64(len(a)))
+	if err != nil {
+		return 0, err
+	}
+	return correlation[len(correlation)-1], nil
+}
+
+// hasAvailableCollateral checks that the leg can be traded with futures collateral
+func hasAvailableCollateral(f funding.IFundingTransferer, ev *signal.Signal) (bool, error) {
+	funds, err := f.GetFundingForEvent(ev)
+	if err != nil {
+		return false, err
+	}
+	collateral, err := funds.FundReader().GetCollateralReader()
+	if err != nil {
+		return false, fmt.Errorf("%w %v %v %v: %v", errFuturesOnly, ev.GetExchange(), ev.GetAssetType(), ev.Pair(), err)
+	}
+	return collateral.AvailableFunds().GreaterThan(decimal.Zero), nil
+}
+
+func legKey(ev data.Event) string {
+	return fmt.Sprintf("%v %v %v", ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+}
@@ -0,0 +1,601 @@
+package pairstrading
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	datakline "github.com/thrasher-corp/gocryptotrader/backtester/data/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	eventkline "github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const testExchange = "binance"
+
+var (
+	btcPair   = currency.NewPair(currency.BTC, currency.USDT)
+	ethPair   = currency.NewPair(currency.ETH, currency.USDT)
+	testStart = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// fakeFunds overrides default implementation
+type fakeFunds struct {
+	funding.FundManager
+	hasBeenLiquidated bool
+	collateral        decimal.Decimal
+}
+
+// HasExchangeBeenLiquidated overrides default implementation
+func (f *fakeFunds) HasExchangeBeenLiquidated(common.Event) bool {
+	return f.hasBeenLiquidated
+}
+
+// GetFundingForEvent overrides default implementation
+func (f *fakeFunds) GetFundingForEvent(common.Event) (funding.IFundingPair, error) {
+	return fakePair{collateral: f.collateral}, nil
+}
+
+// fakePair provides fixed collateral funding
+type fakePair struct {
+	funding.IFundReserver
+	funding.IFundReleaser
+	collateral decimal.Decimal
+}
+
+func (f fakePair) FundReader() funding.IFundReader             { return f }
+func (f fakePair) FundReserver() funding.IFundReserver         { return f.IFundReserver }
+func (f fakePair) FundReleaser() funding.IFundReleaser         { return f.IFundReleaser }
+func (f fakePair) GetPairReader() (funding.IPairReader, error) { return nil, funding.ErrNotPair }
+func (f fakePair) GetCollateralReader() (funding.ICollateralReader, error) {
+	return fakeCollateral{available: f.collateral}, nil
+}
+
+// fakeCollateral provides fixed available collateral
+type fakeCollateral struct {
+	funding.ICollateralReader
+	available decimal.Decimal
+}
+
+func (f fakeCollateral) AvailableFunds() decimal.Decimal { return f.available }
+
+// portfolerino overrides default implementation
+type portfolerino struct {
+	portfolio.Portfolio
+	positions map[string]gctorder.Side
+}
+
+// GetPositions overrides default implementation
+func (p *portfolerino) GetPositions(e common.Event) ([]gctorder.Position, error) {
+	side, ok := p.positions[e.Pair().String()]
+	if !ok {
+		return nil, nil
+	}
+	return []gctorder.Position{{Status: gctorder.Open, LatestDirection: side}}, nil
+}
+
+// newTestData returns a futures data handler for the closes which has
+// processed the given number of candles
+func newTestData(t *testing.T, p currency.Pair, a asset.Item, closes []float64, processed int) *datakline.DataFromKline {
+	t.Helper()
+	d := &datakline.DataFromKline{
+		Base: &data.Base{},
+		Item: &gctkline.Item{
+			Exchange: testExchange,
+			Asset:    a,
+			Pair:     p,
+			Interval: gctkline.OneDay,
+		},
+	}
+	events := make([]data.Event, len(closes))
+	for i := range closes {
+		price := decimal.NewFromFloat(closes[i])
+		events[i] = &eventkline.Kline{
+			Base: &event.Base{
+				Offset:         int64(i + 1),
+				Exchange:       testExchange,
+				Time:           testStart.Add(gctkline.OneDay.Duration() * time.Duration(i)),
+				Interval:       gctkline.OneDay,
+				CurrencyPair:   p,
+				UnderlyingPair: p,
+				AssetType:      a,
+			},
+			Open:   price,
+			Close:  price,
+			Low:    price,
+			High:   price,
+			Volume: decimal.NewFromInt(1),
+		}
+		d.Item.Candles = append(d.Item.Candles, gctkline.Candle{
+			Time:   events[i].GetTime(),
+			Open:   closes[i],
+			High:   closes[i],
+			Low:    closes[i],
+			Close:  closes[i],
+			Volume: 1,
+		})
+	}
+	var err error
+	d.RangeHolder, err = gctkline.CalculateCandleDateRanges(testStart, testStart.AddDate(0, 0, len(closes)), gctkline.OneDay, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.RangeHolder.SetHasDataFromCandles(d.Item.Candles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = d.SetStream(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < processed; i++ {
+		_, err = d.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return d
+}
+
+// testCandles is the amount of candles of test data, the final candle
+// is left unprocessed so the last event is not reached
+const testCandles = 32
+
+// testNoise returns a repeatable sequence of noise between -1 and 1
+func testNoise() func() float64 {
+	seed := uint32(1)
+	return func() float64 {
+		seed = seed*1103515245 + 12345
+		return float64(seed>>16&0x7fff)/16383.5 - 1
+	}
+}
+
+// spreadData returns ETH prices which follow a random walk and BTC prices
+// which track double the ETH prices with some stationary noise, apart from
+// the final processed candle which deviates by the offset
+func spreadData(t *testing.T, offset float64) []data.Handler {
+	t.Helper()
+	noise := testNoise()
+	eth := make([]float64, testCandles)
+	btc := make([]float64, testCandles)
+	price := 100.0
+	for i := range eth {
+		price += noise() * 3
+		eth[i] = price
+		btc[i] = 2*eth[i] + 5 + noise()*2
+	}
+	btc[testCandles-2] = 2*eth[testCandles-2] + 5 + offset
+	return []data.Handler{
+		newTestData(t, ethPair, asset.USDTMarginedFutures, eth, testCandles-1),
+		newTestData(t, btcPair, asset.USDTMarginedFutures, btc, testCandles-1),
+	}
+}
+
+// randomWalkData returns ETH and BTC prices which follow independent random
+// walks, so the spread between them is not stationary. The final processed
+// BTC candle deviates by the offset
+func randomWalkData(t *testing.T, offset float64) []data.Handler {
+	t.Helper()
+	noise := testNoise()
+	eth := make([]float64, testCandles)
+	btc := make([]float64, testCandles)
+	ethPrice, btcPrice := 100.0, 200.0
+	for i := range eth {
+		ethPrice += noise() * 3
+		btcPrice += noise() * 6
+		eth[i] = ethPrice
+		btc[i] = btcPrice
+	}
+	btc[testCandles-2] += offset
+	return []data.Handler{
+		newTestData(t, ethPair, asset.USDTMarginedFutures, eth, testCandles-1),
+		newTestData(t, btcPair, asset.USDTMarginedFutures, btc, testCandles-1),
+	}
+}
+
+func newTestStrategy(t *testing.T, settings map[string]interface{}) *Strategy {
+	t.Helper()
+	s := &Strategy{}
+	s.SetDefaults()
+	// without lagged changes the stationarity test has enough power
+	// to find the noise of the test data stationary
+	customSettings := map[string]interface{}{lookbackKey: 30.0, adfLagsKey: 0.0}
+	for k, v := range settings {
+		customSettings[k] = v
+	}
+	err := s.SetCustomSettings(customSettings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func expectDirections(t *testing.T, resp []signal.Event, directions ...gctorder.Side) {
+	t.Helper()
+	if len(resp) != len(directions) {
+		t.Fatalf("received '%v' expected '%v'", len(resp), len(directions))
+	}
+	for i := range resp {
+		if resp[i].GetDirection() != directions[i] {
+			t.Errorf("%v received '%v' expected '%v'. Reasons: %v", resp[i].Pair(), resp[i].GetDirection(), directions[i], resp[i].GetReasons())
+		}
+	}
+}
+
+func TestName(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if n := s.Name(); n != Name {
+		t.Errorf("received '%v' expected '%v'", n, Name)
+	}
+}
+
+func TestDescription(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if n := s.Description(); n != description {
+		t.Errorf("received '%v' expected '%v'", n, description)
+	}
+}
+
+func TestSupportsSimultaneousProcessing(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	if !s.SupportsSimultaneousProcessing() {
+		t.Error("expected true")
+	}
+}
+
+func TestOnSignal(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	_, err := s.OnSignal(nil, nil, nil)
+	if !errors.Is(err, base.ErrSimultaneousProcessingOnly) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrSimultaneousProcessingOnly)
+	}
+}
+
+func TestSetDefaults(t *testing.T) {
+	t.Parallel()
+	s := Strategy{minimumCorrelation: 1}
+	s.SetDefaults()
+	if s.lookback != defaultLookback || s.minimumCorrelation != 0 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", s.lookback, s.minimumCorrelation, defaultLookback, 0)
+	}
+	if !s.entryZScore.Equal(decimal.NewFromInt(defaultEntryZScore)) || !s.exitZScore.Equal(decimal.NewFromFloat(defaultExitZScore)) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", s.entryZScore, s.exitZScore, defaultEntryZScore, defaultExitZScore)
+	}
+	if !s.notional.Equal(decimal.NewFromInt(defaultNotional)) {
+		t.Errorf("received '%v' expected '%v'", s.notional, defaultNotional)
+	}
+	if s.adfSignificance != defaultADFSignificance || s.adfLags != defaultADFLags {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", s.adfSignificance, s.adfLags, defaultADFSignificance, defaultADFLags)
+	}
+}
+
+func TestSetCustomSettings(t *testing.T) {
+	t.Parallel()
+	for _, settings := range []map[string]interface{}{
+		{"lol": 1.0},
+		{lookbackKey: 2.0},
+		{lookbackKey: 10.5},
+		{entryZScoreKey: 0.0},
+		{exitZScoreKey: -1.0},
+		{exitZScoreKey: 3.0},
+		{minimumCorrelationKey: 1.5},
+		{dependentPairKey: 1.0},
+		{dependentPairKey: ""},
+		{notionalKey: "1000"},
+		{adfSignificanceKey: 3.0},
+		{adfSignificanceKey: "5"},
+		{adfLagsKey: -1.0},
+		{adfLagsKey: 1.5},
+	} {
+		s := &Strategy{}
+		s.SetDefaults()
+		err := s.SetCustomSettings(settings)
+		if !errors.Is(err, base.ErrInvalidCustomSettings) {
+			t.Errorf("%v received '%v' expected '%v'", settings, err, base.ErrInvalidCustomSettings)
+		}
+	}
+
+	s := newTestStrategy(t, map[string]interface{}{
+		entryZScoreKey:        2.5,
+		exitZScoreKey:         0.25,
+		minimumCorrelationKey: 0.8,
+		dependentPairKey:      "ETH-USDT",
+		notionalKey:           500.0,
+		adfSignificanceKey:    10.0,
+		adfLagsKey:            2.0,
+	})
+	if s.lookback != 30 || !s.entryZScore.Equal(decimal.NewFromFloat(2.5)) || !s.exitZScore.Equal(decimal.NewFromFloat(0.25)) {
+		t.Errorf("received '%v' '%v' '%v' expected '%v' '%v' '%v'", s.lookback, s.entryZScore, s.exitZScore, 30, 2.5, 0.25)
+	}
+	if s.adfSignificance != 10 || s.adfLags != 2 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", s.adfSignificance, s.adfLags, 10, 2)
+	}
+	if s.minimumCorrelation != 0.8 || !s.dependentPair.Equal(ethPair) || !s.notional.Equal(decimal.NewFromInt(500)) {
+		t.Errorf("received '%v' '%v' '%v' expected '%v' '%v' '%v'", s.minimumCorrelation, s.dependentPair, s.notional, 0.8, ethPair, 500)
+	}
+}
+
+func TestOnSimultaneousSignals(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, nil)
+	_, err := s.OnSimultaneousSignals(nil, nil, nil)
+	if !errors.Is(err, base.ErrNoDataToProcess) {
+		t.Errorf("received '%v' expected '%v'", err, base.ErrNoDataToProcess)
+	}
+	d := spreadData(t, 0)
+	_, err = s.OnSimultaneousSignals(d, nil, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = s.OnSimultaneousSignals(d, &fakeFunds{}, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	_, err = s.OnSimultaneousSignals(d[:1], &fakeFunds{}, &portfolerino{})
+	if !errors.Is(err, errNotEnoughLegs) {
+		t.Errorf("received '%v' expected '%v'", err, errNotEnoughLegs)
+	}
+	spot := newTestData(t, btcPair, asset.Spot, []float64{1}, 1)
+	_, err = s.OnSimultaneousSignals([]data.Handler{d[0], spot}, &fakeFunds{}, &portfolerino{})
+	if !errors.Is(err, errFuturesOnly) {
+		t.Errorf("received '%v' expected '%v'", err, errFuturesOnly)
+	}
+
+	resp, err := s.OnSimultaneousSignals(d, &fakeFunds{hasBeenLiquidated: true}, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.DoNothing, gctorder.DoNothing)
+
+	// the spread has not deviated
+	resp, err = s.OnSimultaneousSignals(d, &fakeFunds{collateral: decimal.NewFromInt(1000)}, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.DoNothing, gctorder.DoNothing)
+
+	s.lookback = testCandles
+	resp, err = s.OnSimultaneousSignals(d, &fakeFunds{collateral: decimal.NewFromInt(1000)}, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.DoNothing, gctorder.DoNothing)
+}
+
+func TestOnSimultaneousSignalsEntry(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, nil)
+	funds := &fakeFunds{collateral: decimal.NewFromInt(1000)}
+
+	// BTC is above its relationship with ETH, so the spread is shorted
+	resp, err := s.OnSimultaneousSignals(spreadData(t, 4), funds, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.Short, gctorder.Long)
+	if !resp[0].Pair().Equal(btcPair) {
+		t.Errorf("received '%v' expected '%v'", resp[0].Pair(), btcPair)
+	}
+	btcAmount := s.notional.Div(resp[0].GetClosePrice())
+	if !resp[0].GetAmount().Equal(btcAmount) {
+		t.Errorf("received '%v' expected '%v'", resp[0].GetAmount(), btcAmount)
+	}
+	// BTC moves twice as much as ETH, so two ETH hedge each BTC
+	if ratio := resp[1].GetAmount().Div(btcAmount).InexactFloat64(); ratio < 1.5 || ratio > 2.5 {
+		t.Errorf("received hedge ratio '%v' expected approximately '%v'", ratio, 2)
+	}
+
+	resp, err = s.OnSimultaneousSignals(spreadData(t, -4), funds, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.Long, gctorder.Short)
+
+	// ETH as the dependent leg is regressed against BTC
+	s.dependentPair = ethPair
+	resp, err = s.OnSimultaneousSignals(spreadData(t, 4), funds, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.Long, gctorder.Short)
+	if !resp[0].Pair().Equal(ethPair) {
+		t.Errorf("received '%v' expected '%v'", resp[0].Pair(), ethPair)
+	}
+
+	s.dependentPair = currency.NewPair(currency.LTC, currency.USDT)
+	_, err = s.OnSimultaneousSignals(spreadData(t, 4), funds, &portfolerino{})
+	if !errors.Is(err, errDependentNotSet) {
+		t.Errorf("received '%v' expected '%v'", err, errDependentNotSet)
+	}
+	s.dependentPair = currency.EMPTYPAIR
+
+	resp, err = s.OnSimultaneousSignals(spreadData(t, 4), &fakeFunds{}, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.DoNothing, gctorder.DoNothing)
+
+	s.minimumCorrelation = 1
+	resp, err = s.OnSimultaneousSignals(spreadData(t, 4), funds, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.DoNothing, gctorder.DoNothing)
+}
+
+func TestOnSimultaneousSignalsStationarity(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, nil)
+	funds := &fakeFunds{collateral: decimal.NewFromInt(1000)}
+
+	// independent random walks deviate from each other without reverting
+	resp, err := s.OnSimultaneousSignals(randomWalkData(t, 30), funds, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.DoNothing, gctorder.DoNothing)
+	if reasons := resp[0].GetReasons(); !strings.Contains(strings.Join(reasons, " "), "spread is not stationary") {
+		t.Errorf("received '%v' expected the spread to not be stationary", reasons)
+	}
+
+	s.adfSignificance = 0
+	resp, err = s.OnSimultaneousSignals(randomWalkData(t, 30), funds, &portfolerino{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.Short, gctorder.Long)
+}
+
+func TestOnSimultaneousSignalsExit(t *testing.T) {
+	t.Parallel()
+	s := newTestStrategy(t, nil)
+	funds := &fakeFunds{collateral: decimal.NewFromInt(1000)}
+	open := &portfolerino{positions: map[string]gctorder.Side{
+		btcPair.String(): gctorder.Short,
+		ethPair.String(): gctorder.Long,
+	}}
+
+	// the spread is still deviated
+	resp, err := s.OnSimultaneousSignals(spreadData(t, 4), funds, open)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.DoNothing, gctorder.DoNothing)
+
+	// the spread has reverted
+	resp, err = s.OnSimultaneousSignals(spreadData(t, 0), funds, open)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.ClosePosition, gctorder.ClosePosition)
+
+	unbalanced := &portfolerino{positions: map[string]gctorder.Side{ethPair.String(): gctorder.Long}}
+	resp, err = s.OnSimultaneousSignals(spreadData(t, 4), funds, unbalanced)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.DoNothing, gctorder.ClosePosition)
+
+	d := spreadData(t, 4)
+	for i := range d {
+		if _, err = d[i].Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err = s.OnSimultaneousSignals(d, funds, open)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	expectDirections(t, resp, gctorder.ClosePosition, gctorder.ClosePosition)
+}
+
+func TestOrdinaryLeastSquares(t *testing.T) {
+	t.Parallel()
+	a := []float64{1, 2, 3, 4, 5, 6}
+	b := []float64{2, 1, 4, 3, 6, 5}
+	y := make([]float64, len(a))
+	for i := range y {
+		y[i] = 3 + 2*a[i] - b[i]
+	}
+	intercept, coefficients, err := ordinaryLeastSquares(y, [][]float64{a, b})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if math.Abs(intercept-3) > 1e-9 || math.Abs(coefficients[0]-2) > 1e-9 || math.Abs(coefficients[1]+1) > 1e-9 {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", intercept, coefficients, 3, []float64{2, -1})
+	}
+
+	_, _, err = ordinaryLeastSquares(y, [][]float64{a, a})
+	if !errors.Is(err, errSingularMatrix) {
+		t.Errorf("received '%v' expected '%v'", err, errSingularMatrix)
+	}
+	_, _, err = ordinaryLeastSquares(y[:2], [][]float64{a[:2]})
+	if !errors.Is(err, errSingularMatrix) {
+		t.Errorf("received '%v' expected '%v'", err, errSingularMatrix)
+	}
+}
+
+func TestADFStatistic(t *testing.T) {
+	t.Parallel()
+	statistic, err := adfStatistic([]float64{1, -0.5, 0.8, -0.2, 0.3, -0.6}, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if math.Abs(statistic+6.337909451066065) > 1e-9 {
+		t.Errorf("received '%v' expected '%v'", statistic, -6.337909451066065)
+	}
+
+	// a spread which flips sign every observation reverts perfectly
+	statistic, err = adfStatistic([]float64{1, -1, 1, -1, 1}, 0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !math.IsInf(statistic, -1) {
+		t.Errorf("received '%v' expected '%v'", statistic, math.Inf(-1))
+	}
+
+	_, err = adfStatistic([]float64{1}, 0)
+	if !errors.Is(err, errSingularMatrix) {
+		t.Errorf("received '%v' expected '%v'", err, errSingularMatrix)
+	}
+	_, err = adfStatistic([]float64{1, -0.5, 0.8, -0.2}, 2)
+	if !errors.Is(err, errSingularMatrix) {
+		t.Errorf("received '%v' expected '%v'", err, errSingularMatrix)
+	}
+}
+
+func TestLatestCorrelation(t *testing.T) {
+	t.Parallel()
+	correlation, err := latestCorrelation([]float64{1, 2, 3, 4}, []float64{2, 4, 6, 8})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if math.Abs(correlation-1) > 1e-9 {
+		t.Errorf("received '%v' expected '%v'", correlation, 1)
+	}
+}
+
+func TestCloseAllPositions(t *testing.T) {
+	t.Parallel()
+	s := Strategy{}
+	d := spreadData(t, 0)
+	var prices []data.Event
+	for i := range d {
+		latest, err := d[i].Latest()
+		if err != nil {
+			t.Fatal(err)
+		}
+		prices = append(prices, latest)
+	}
+	resp, err := s.CloseAllPositions([]holdings.Holding{
+		{Exchange: testExchange, Asset: asset.USDTMarginedFutures, Pair: btcPair, BaseSize: decimal.NewFromInt(1)},
+		{Exchange: testExchange, Asset: asset.Spot, Pair: ethPair},
+	}, prices)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(resp), 1)
+	}
+	if resp[0].GetDirection() != gctorder.ClosePosition || !resp[0].Pair().Equal(btcPair) {
+		t.Errorf("received '%v' '%v' expected '%v' '%v'", resp[0].GetDirection(), resp[0].Pair(), gctorder.ClosePosition, btcPair)
+	}
+}
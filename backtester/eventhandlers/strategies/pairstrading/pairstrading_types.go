@@ -0,0 +1,89 @@
+package pairstrading
+
+import (
+	"errors"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+const (
+	// Name is the strategy name
+	Name                  = "pairs-trading"
+	lookbackKey           = "lookback"
+	entryZScoreKey        = "entry-z-score"
+	exitZScoreKey         = "exit-z-score"
+	minimumCorrelationKey = "minimum-correlation"
+	dependentPairKey      = "dependent-pair"
+	notionalKey           = "notional"
+	adfSignificanceKey    = "adf-significance"
+	adfLagsKey            = "adf-lags"
+	description           = `Pairs trading is a market neutral statistical arbitrage strategy. It estimates the hedge ratios between the prices of two or more contracts over a rolling window and trades the spread between them. Positions are only opened when an augmented Dickey-Fuller test finds the spread to be stationary, meaning the contracts are cointegrated. When the z-score of the spread deviates beyond an entry threshold, the strategy goes long the cheap side of the spread and short the expensive side, exiting when the spread reverts towards its mean`
+
+	defaultLookback        = 30
+	defaultEntryZScore     = 2
+	defaultExitZScore      = 0.5
+	defaultNotional        = 1000
+	defaultADFSignificance = 5
+	defaultADFLags         = 1
+)
+
+// adfCriticalValues are the asymptotic critical values of the Engle-Granger
+// cointegration test for a regression with a constant, indexed by the number
+// of legs and keyed by the significance percentage. They are sourced from
+// MacKinnon (2010) "Critical Values for Cointegration Tests"
+var adfCriticalValues = map[int]map[float64]float64{
+	2: {1: -3.89644, 5: -3.33613, 10: -3.04445},
+	3: {1: -4.29374, 5: -3.74066, 10: -3.45218},
+	4: {1: -4.64332, 5: -4.09600, 10: -3.81020},
+	5: {1: -4.95756, 5: -4.41519, 10: -4.13157},
+	6: {1: -5.24568, 5: -4.70693, 10: -4.42501},
+}
+
+var (
+	errFuturesOnly     = errors.New("can only work with futures")
+	errNotEnoughLegs   = errors.New("at least two currencies are required to trade a spread")
+	errDependentNotSet = errors.New("dependent pair not found in data")
+	errSingularMatrix  = errors.New("cannot estimate hedge ratios, prices are collinear")
+	errInvalidZScores  = errors.New("exit-z-score must be less than entry-z-score")
+	errTooManyLegsADF  = errors.New("no augmented Dickey-Fuller critical values for the number of legs")
+)
+
+// Strategy is an implementation of the Handler interface
+type Strategy struct {
+	base.Strategy
+	lookback           int
+	entryZScore        decimal.Decimal
+	exitZScore         decimal.Decimal
+	minimumCorrelation float64
+	dependentPair      currency.Pair
+	notional           decimal.Decimal
+	// adfSignificance is the significance percentage of the augmented
+	// Dickey-Fuller test of the spread, zero disables the test
+	adfSignificance float64
+	adfLags         int
+}
+
+// leg is a single contract of the spread
+type leg struct {
+	data   data.Handler
+	latest data.Event
+	signal *signal.Signal
+	closes []float64
+	// hedgeRatio is the amount of the leg traded per unit of the dependent leg
+	hedgeRatio float64
+	open       bool
+	direction  gctorder.Side
+}
+
+// spread holds the statistics of the spread for the latest event
+type spread struct {
+	intercept   float64
+	hedgeRatios []float64
+	residuals   []float64
+	zScore      float64
+}
@@ -164,6 +164,18 @@ func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) erro
 	return nil
 }
 
+// CustomSettingsSchema describes the custom settings the RSI strategy accepts
+func (s *Strategy) CustomSettingsSchema() base.CustomSettingsSchema {
+	return base.CustomSettingsSchema{
+		Settings: []base.CustomSetting{
+			{Key: rsiHighKey, Type: base.CustomSettingNumber, Default: 70.0, Description: "Sells when the RSI is at or above this value"},
+			{Key: rsiLowKey, Type: base.CustomSettingNumber, Default: 30.0, Description: "Buys when the RSI is at or below this value"},
+			{Key: rsiPeriodKey, Type: base.CustomSettingNumber, Default: 14.0, Description: "The number of candles used to calculate the RSI"},
+		},
+		Verifiable: true,
+	}
+}
+
 // SetDefaults sets the custom settings to their default values
 func (s *Strategy) SetDefaults() {
 	s.rsiHigh = decimal.NewFromInt(70)
@@ -9,6 +9,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/binancecashandcarry"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/dollarcostaverage"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/external"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/gctscript"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/grid"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/pairstrading"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/rsi"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/top2bottom2"
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -100,5 +104,9 @@ var (
 		new(rsi.Strategy),
 		new(top2bottom2.Strategy),
 		new(binancecashandcarry.Strategy),
+		new(gctscript.Strategy),
+		new(external.Strategy),
+		new(grid.Strategy),
+		new(pairstrading.Strategy),
 	}
 )
@@ -61,6 +61,36 @@ func TestLoadStrategyByName(t *testing.T) {
 	}
 }
 
+func TestCustomSettingsSchemaDefaults(t *testing.T) {
+	t.Parallel()
+	strats := GetSupportedStrategies()
+	for i := range strats {
+		schema := strats[i].CustomSettingsSchema()
+		if !schema.Verifiable {
+			continue
+		}
+		settings := make(map[string]interface{})
+		for j := range schema.Settings {
+			if schema.Settings[j].Default != nil {
+				settings[schema.Settings[j].Key] = schema.Settings[j].Default
+			}
+		}
+		if len(settings) == 0 {
+			// strategies without settings are not sent any
+			continue
+		}
+		s, err := LoadStrategyByName(strats[i].Name(), strats[i].SupportsSimultaneousProcessing())
+		if !errors.Is(err, nil) {
+			t.Fatalf("received: %v, expected: %v", err, nil)
+		}
+		s.SetDefaults()
+		err = s.SetCustomSettings(settings)
+		if !errors.Is(err, nil) {
+			t.Errorf("%v received: %v, expected: %v", strats[i].Name(), err, nil)
+		}
+	}
+}
+
 func TestAddStrategy(t *testing.T) {
 	t.Parallel()
 	err := AddStrategy(nil)
@@ -138,3 +168,8 @@ func (s *customStrategy) SetCustomSettings(map[string]interface{}) error {
 
 // SetDefaults sets default values for overridable custom settings
 func (s *customStrategy) SetDefaults() {}
+
+// CustomSettingsSchema describes the custom settings the strategy accepts
+func (s *customStrategy) CustomSettingsSchema() base.CustomSettingsSchema {
+	return base.CustomSettingsSchema{}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/backtester/data"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
 )
@@ -27,5 +28,19 @@ type Handler interface {
 	SetSimultaneousProcessing(bool)
 	SetCustomSettings(map[string]interface{}) error
 	SetDefaults()
+	CustomSettingsSchema() base.CustomSettingsSchema
 	CloseAllPositions([]holdings.Holding, []data.Event) ([]signal.Event, error)
 }
+
+// RestingOrderPlacer is implemented by strategies which can place resting
+// limit or stop orders. Resting orders are only simulated by the backtester
+// and cannot be placed as real orders
+type RestingOrderPlacer interface {
+	PlacesRestingOrders() bool
+}
+
+// Closer is implemented by strategies which hold resources outside of the
+// backtester, such as connections, which are released once a run stops
+type Closer interface {
+	Close() error
+}
@@ -241,6 +241,18 @@ func (s *Strategy) SetCustomSettings(customSettings map[string]interface{}) erro
 	return nil
 }
 
+// CustomSettingsSchema describes the custom settings the top2bottom2 strategy accepts
+func (s *Strategy) CustomSettingsSchema() base.CustomSettingsSchema {
+	return base.CustomSettingsSchema{
+		Settings: []base.CustomSetting{
+			{Key: mfiHighKey, Type: base.CustomSettingNumber, Default: 70.0, Description: "Sells the top two ranked currencies when their MFI is at or above this value"},
+			{Key: mfiLowKey, Type: base.CustomSettingNumber, Default: 30.0, Description: "Buys the bottom two ranked currencies when their MFI is at or below this value"},
+			{Key: mfiPeriodKey, Type: base.CustomSettingNumber, Default: 14.0, Description: "The number of candles used to calculate the MFI"},
+		},
+		Verifiable: true,
+	}
+}
+
 // SetDefaults sets the custom settings to their default values
 func (s *Strategy) SetDefaults() {
 	s.mfiHigh = decimal.NewFromInt(70)
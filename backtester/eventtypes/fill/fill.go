@@ -77,3 +77,8 @@ func (f *Fill) GetFillDependentEvent() signal.Event {
 func (f *Fill) IsLiquidated() bool {
 	return f.Liquidated
 }
+
+// GetExitRule returns the exit rule which raised the fill
+func (f *Fill) GetExitRule() string {
+	return f.ExitRule
+}
@@ -127,3 +127,11 @@ func TestIsLiquidated(t *testing.T) {
 		t.Error("expected true")
 	}
 }
+
+func TestGetExitRule(t *testing.T) {
+	t.Parallel()
+	f := Fill{ExitRule: "stop-loss"}
+	if f.GetExitRule() != "stop-loss" {
+		t.Errorf("received '%v' expected '%v'", f.GetExitRule(), "stop-loss")
+	}
+}
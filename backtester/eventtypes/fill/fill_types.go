@@ -22,6 +22,7 @@ type Fill struct {
 	Order               *order.Detail   `json:"-"`
 	FillDependentEvent  signal.Event
 	Liquidated          bool
+	ExitRule            string `json:"exit-rule,omitempty"`
 }
 
 // Event holds all functions required to handle a fill event
@@ -41,4 +42,5 @@ type Event interface {
 	GetOrder() *order.Detail
 	GetFillDependentEvent() signal.Event
 	IsLiquidated() bool
+	GetExitRule() string
 }
@@ -1,6 +1,8 @@
 package order
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -103,3 +105,33 @@ func (o *Order) IsLiquidating() bool {
 func (o *Order) GetClosePrice() decimal.Decimal {
 	return o.ClosePrice
 }
+
+// GetOrderType returns the order type
+func (o *Order) GetOrderType() order.Type {
+	return o.OrderType
+}
+
+// GetLimitPrice returns the limit price
+func (o *Order) GetLimitPrice() decimal.Decimal {
+	return o.LimitPrice
+}
+
+// GetTriggerPrice returns the trigger price
+func (o *Order) GetTriggerPrice() decimal.Decimal {
+	return o.TriggerPrice
+}
+
+// GetGoodTillTime returns the time a resting order expires
+func (o *Order) GetGoodTillTime() time.Time {
+	return o.GoodTillTime
+}
+
+// GetClientOrderID returns the client order ID
+func (o *Order) GetClientOrderID() string {
+	return o.ClientOrderID
+}
+
+// GetExitRule returns the exit rule which raised the order
+func (o *Order) GetExitRule() string {
+	return o.ExitRule
+}
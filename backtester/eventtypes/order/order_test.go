@@ -2,6 +2,7 @@ package order
 
 import (
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -169,3 +170,38 @@ func TestIsClosingPosition(t *testing.T) {
 		t.Errorf("received '%v' expected '%v'", k.IsClosingPosition(), true)
 	}
 }
+
+func TestRestingOrderDetails(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	k := Order{
+		OrderType:     gctorder.StopLimit,
+		LimitPrice:    decimal.NewFromInt(1337),
+		TriggerPrice:  decimal.NewFromInt(1338),
+		GoodTillTime:  tt,
+		ClientOrderID: "1337",
+	}
+	if k.GetOrderType() != gctorder.StopLimit {
+		t.Errorf("received '%v' expected '%v'", k.GetOrderType(), gctorder.StopLimit)
+	}
+	if !k.GetLimitPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' expected '%v'", k.GetLimitPrice(), 1337)
+	}
+	if !k.GetTriggerPrice().Equal(decimal.NewFromInt(1338)) {
+		t.Errorf("received '%v' expected '%v'", k.GetTriggerPrice(), 1338)
+	}
+	if !k.GetGoodTillTime().Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", k.GetGoodTillTime(), tt)
+	}
+	if k.GetClientOrderID() != "1337" {
+		t.Errorf("received '%v' expected '%v'", k.GetClientOrderID(), "1337")
+	}
+}
+
+func TestGetExitRule(t *testing.T) {
+	t.Parallel()
+	o := Order{ExitRule: "stop-loss"}
+	if o.GetExitRule() != "stop-loss" {
+		t.Errorf("received '%v' expected '%v'", o.GetExitRule(), "stop-loss")
+	}
+}
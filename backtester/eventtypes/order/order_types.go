@@ -1,6 +1,8 @@
 package order
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -24,6 +26,11 @@ type Order struct {
 	FillDependentEvent  signal.Event
 	ClosingPosition     bool
 	LiquidatingPosition bool
+	LimitPrice          decimal.Decimal
+	TriggerPrice        decimal.Decimal
+	GoodTillTime        time.Time
+	ClientOrderID       string
+	ExitRule            string
 }
 
 // Event inherits common event interfaces along with extra functions related to handling orders
@@ -44,4 +51,10 @@ type Event interface {
 	GetFillDependentEvent() signal.Event
 	IsClosingPosition() bool
 	IsLiquidating() bool
+	GetOrderType() order.Type
+	GetLimitPrice() decimal.Decimal
+	GetTriggerPrice() decimal.Decimal
+	GetGoodTillTime() time.Time
+	GetClientOrderID() string
+	GetExitRule() string
 }
@@ -0,0 +1,10 @@
+package orderbook
+
+import (
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// GetOrderbook returns the state of the orderbook at the close of the candle
+func (o *Orderbook) GetOrderbook() *gctorderbook.Base {
+	return o.Book
+}
@@ -0,0 +1,36 @@
+package orderbook
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+func TestGetOrderbook(t *testing.T) {
+	t.Parallel()
+	book := &gctorderbook.Base{
+		Bids: gctorderbook.Items{{Price: 1336, Amount: 1}},
+		Asks: gctorderbook.Items{{Price: 1338, Amount: 1}},
+	}
+	var ev data.Event = &Orderbook{
+		Kline: &kline.Kline{
+			Base:  &event.Base{},
+			Close: decimal.NewFromInt(1337),
+		},
+		Book: book,
+	}
+	ob, ok := ev.(Event)
+	if !ok {
+		t.Fatal("expected orderbook to implement the orderbook event")
+	}
+	if ob.GetOrderbook() != book {
+		t.Errorf("received '%v' expected '%v'", ob.GetOrderbook(), book)
+	}
+	if !ob.GetClosePrice().Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' expected '%v'", ob.GetClosePrice(), 1337)
+	}
+}
@@ -0,0 +1,19 @@
+package orderbook
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	gctorderbook "github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// Orderbook holds a candle of orderbook mid prices along with the state
+// of the orderbook at the close of the candle
+type Orderbook struct {
+	*kline.Kline
+	Book *gctorderbook.Base
+}
+
+// Event is an orderbook data event
+type Event interface {
+	kline.Event
+	GetOrderbook() *gctorderbook.Base
+}
@@ -1,6 +1,8 @@
 package signal
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -114,6 +116,42 @@ func (s *Signal) MatchOrderAmount() bool {
 	return s.MatchesOrderAmount
 }
 
+// GetOrderType returns the order type
+func (s *Signal) GetOrderType() order.Type {
+	return s.OrderType
+}
+
+// GetLimitPrice returns the limit price
+func (s *Signal) GetLimitPrice() decimal.Decimal {
+	return s.LimitPrice
+}
+
+// GetTriggerPrice returns the trigger price
+func (s *Signal) GetTriggerPrice() decimal.Decimal {
+	return s.TriggerPrice
+}
+
+// GetGoodTillTime returns the time a resting order expires
+func (s *Signal) GetGoodTillTime() time.Time {
+	return s.GoodTillTime
+}
+
+// GetClientOrderID returns the client order ID
+func (s *Signal) GetClientOrderID() string {
+	return s.ClientOrderID
+}
+
+// GetCancelOrderIDs returns the client order IDs of
+// resting orders to cancel
+func (s *Signal) GetCancelOrderIDs() []string {
+	return s.CancelOrderIDs
+}
+
+// GetExitRule returns the exit rule which raised the signal
+func (s *Signal) GetExitRule() string {
+	return s.ExitRule
+}
+
 // ToKline is used to convert a signal event
 // to a data event for the purpose of closing all positions
 // function CloseAllPositions is builds signal data, but
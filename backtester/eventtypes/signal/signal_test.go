@@ -2,6 +2,7 @@ package signal
 
 import (
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -201,3 +202,42 @@ func TestToKline(t *testing.T) {
 		t.Errorf("expected  '%v' received '%v'", "kline event", "signal event")
 	}
 }
+
+func TestRestingOrderDetails(t *testing.T) {
+	t.Parallel()
+	tt := time.Now()
+	s := Signal{
+		OrderType:      gctorder.StopLimit,
+		LimitPrice:     decimal.NewFromInt(1337),
+		TriggerPrice:   decimal.NewFromInt(1338),
+		GoodTillTime:   tt,
+		ClientOrderID:  "1337",
+		CancelOrderIDs: []string{"1337"},
+	}
+	if s.GetOrderType() != gctorder.StopLimit {
+		t.Errorf("received '%v' expected '%v'", s.GetOrderType(), gctorder.StopLimit)
+	}
+	if !s.GetLimitPrice().Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' expected '%v'", s.GetLimitPrice(), 1337)
+	}
+	if !s.GetTriggerPrice().Equal(decimal.NewFromInt(1338)) {
+		t.Errorf("received '%v' expected '%v'", s.GetTriggerPrice(), 1338)
+	}
+	if !s.GetGoodTillTime().Equal(tt) {
+		t.Errorf("received '%v' expected '%v'", s.GetGoodTillTime(), tt)
+	}
+	if s.GetClientOrderID() != "1337" {
+		t.Errorf("received '%v' expected '%v'", s.GetClientOrderID(), "1337")
+	}
+	if ids := s.GetCancelOrderIDs(); len(ids) != 1 || ids[0] != "1337" {
+		t.Errorf("received '%v' expected '%v'", ids, []string{"1337"})
+	}
+}
+
+func TestGetExitRule(t *testing.T) {
+	t.Parallel()
+	s := Signal{ExitRule: "stop-loss"}
+	if s.GetExitRule() != "stop-loss" {
+		t.Errorf("received '%v' expected '%v'", s.GetExitRule(), "stop-loss")
+	}
+}
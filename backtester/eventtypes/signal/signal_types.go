@@ -1,6 +1,8 @@
 package signal
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
@@ -29,6 +31,13 @@ type Event interface {
 	SetAmount(decimal.Decimal)
 	MatchOrderAmount() bool
 	IsNil() bool
+	GetOrderType() order.Type
+	GetLimitPrice() decimal.Decimal
+	GetTriggerPrice() decimal.Decimal
+	GetGoodTillTime() time.Time
+	GetClientOrderID() string
+	GetCancelOrderIDs() []string
+	GetExitRule() string
 }
 
 // Signal contains everything needed for a strategy to raise a signal event
@@ -66,4 +75,24 @@ type Signal struct {
 	// MatchOrderAmount flags to other event handlers
 	// that the order amount must match the set Amount property
 	MatchesOrderAmount bool
+	// OrderType is an optional parameter to place a limit, stop or
+	// stop limit order which rests on the simulated exchange until
+	// it is filled, cancelled or expires. Defaults to a market order
+	OrderType order.Type
+	// LimitPrice is the price of a limit or stop limit order
+	LimitPrice decimal.Decimal
+	// TriggerPrice is the price which triggers a stop or stop limit order
+	TriggerPrice decimal.Decimal
+	// GoodTillTime is an optional time at which a resting order
+	// will expire if it has not been filled
+	GoodTillTime time.Time
+	// ClientOrderID is an optional strategy defined identifier
+	// for a resting order so that it can be cancelled later
+	ClientOrderID string
+	// CancelOrderIDs cancels any resting orders matching the client
+	// order IDs for the signal's exchange, asset and pair
+	CancelOrderIDs []string
+	// ExitRule is set when the signal was raised by the portfolio
+	// manager enforcing an exit rule rather than by the strategy
+	ExitRule string
 }
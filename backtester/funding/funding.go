@@ -119,7 +119,9 @@ func (f *FundManager) CreateSnapshot(t time.Time) error {
 				Time: t,
 			}
 		}
-		iss.Available = f.items[i].available
+		// borrowed funds are owed and do not contribute to value
+		iss.Available = f.items[i].available.Sub(f.items[i].borrowed)
+		iss.InTransit = f.items[i].inTransit
 		if !f.disableUSDTracking {
 			if f.items[i].trackingCandles == nil {
 				continue
@@ -136,7 +138,7 @@ func (f *FundManager) CreateSnapshot(t time.Time) error {
 				}
 			}
 			iss.USDClosePrice = usdClosePrice
-			iss.USDValue = usdClosePrice.Mul(f.items[i].available)
+			iss.USDValue = usdClosePrice.Mul(iss.Available.Add(iss.InTransit))
 		}
 
 		f.items[i].snapshot[t.UnixNano()] = iss
@@ -223,13 +225,15 @@ func (f *FundManager) setUSDCandles(k *kline.DataFromKline, i *Item) error {
 			Close: 1,
 		}
 	}
-	cpy := *k
-	cpy.Item = &usdCandles
-	cpy.Base = &data.Base{}
+	cpy := &kline.DataFromKline{
+		Base:        &data.Base{},
+		Item:        &usdCandles,
+		RangeHolder: k.RangeHolder,
+	}
 	if err := cpy.Load(); err != nil {
 		return err
 	}
-	i.trackingCandles = &cpy
+	i.trackingCandles = cpy
 	return nil
 }
 
@@ -296,15 +300,23 @@ func (f *FundManager) GenerateReport() (*Report, error) {
 	}
 	items := make([]ReportItem, len(f.items))
 	for x := range f.items {
+		// funds in transit still belong to the receiving item
+		finalFunds := f.items[x].available.Sub(f.items[x].borrowed).Add(f.items[x].inTransit)
 		item := ReportItem{
 			Exchange:       f.items[x].exchange,
 			Asset:          f.items[x].asset,
 			Currency:       f.items[x].currency,
 			InitialFunds:   f.items[x].initialFunds,
 			TransferFee:    f.items[x].transferFee,
-			FinalFunds:     f.items[x].available,
+			FinalFunds:     finalFunds,
 			IsCollateral:   f.items[x].isCollateral,
 			AppendedViaAPI: f.items[x].appendedViaAPI,
+
+			Borrowed:           f.items[x].borrowed,
+			BorrowCosts:        f.items[x].borrowCosts,
+			MarginLiquidations: f.items[x].marginLiquidations,
+			TransferFeesPaid:   f.items[x].transferFeesPaid,
+			InTransit:          f.items[x].inTransit,
 		}
 
 		if !f.disableUSDTracking &&
@@ -323,7 +335,8 @@ func (f *FundManager) GenerateReport() (*Report, error) {
 			}
 			if !item.IsCollateral {
 				item.USDInitialFunds = f.items[x].initialFunds.Mul(first.GetClosePrice())
-				item.USDFinalFunds = f.items[x].available.Mul(last.GetClosePrice())
+				item.USDFinalFunds = finalFunds.Mul(last.GetClosePrice())
+				item.USDBorrowCosts = f.items[x].borrowCosts.Mul(last.GetClosePrice())
 			}
 
 			item.USDInitialCostForOne = first.GetClosePrice()
@@ -372,7 +385,7 @@ func (f *FundManager) GenerateReport() (*Report, error) {
 		if f.items[x].initialFunds.IsZero() {
 			item.ShowInfinite = true
 		} else {
-			item.Difference = f.items[x].available.Sub(f.items[x].initialFunds).Div(f.items[x].initialFunds).Mul(decimal.NewFromInt(100))
+			item.Difference = finalFunds.Sub(f.items[x].initialFunds).Div(f.items[x].initialFunds).Mul(decimal.NewFromInt(100))
 		}
 		if f.items[x].pairedWith != nil {
 			item.PairedWith = f.items[x].pairedWith.currency
@@ -390,52 +403,18 @@ func (f *FundManager) GenerateReport() (*Report, error) {
 	}
 
 	report.Items = items
+	report.Transfers = make([]TransferRecord, len(f.transfers))
+	for i := range f.transfers {
+		report.Transfers[i] = *f.transfers[i]
+	}
 	return &report, nil
 }
 
 // Transfer allows transferring funds from one pretend exchange to another
+// using the sender's default transfer fee and delay
 func (f *FundManager) Transfer(amount decimal.Decimal, sender, receiver *Item, inclusiveFee bool) error {
-	if sender == nil || receiver == nil {
-		return gctcommon.ErrNilPointer
-	}
-	if amount.LessThanOrEqual(decimal.Zero) {
-		return errZeroAmountReceived
-	}
-	if inclusiveFee {
-		if sender.available.LessThan(amount) {
-			return fmt.Errorf("%w for %v", errNotEnoughFunds, sender.currency)
-		}
-	} else {
-		if sender.available.LessThan(amount.Add(sender.transferFee)) {
-			return fmt.Errorf("%w for %v", errNotEnoughFunds, sender.currency)
-		}
-	}
-
-	if !sender.currency.Equal(receiver.currency) {
-		return errTransferMustBeSameCurrency
-	}
-	if sender.currency.Equal(receiver.currency) &&
-		sender.exchange == receiver.exchange &&
-		sender.asset == receiver.asset {
-		return fmt.Errorf("%v %v %v %w", sender.exchange, sender.asset, sender.currency, errCannotTransferToSameFunds)
-	}
-
-	sendAmount := amount
-	receiveAmount := amount
-	if inclusiveFee {
-		receiveAmount = amount.Sub(sender.transferFee)
-	} else {
-		sendAmount = amount.Add(sender.transferFee)
-	}
-	err := sender.Reserve(sendAmount)
-	if err != nil {
-		return err
-	}
-	err = receiver.IncreaseAvailable(receiveAmount)
-	if err != nil {
-		return err
-	}
-	return sender.Release(sendAmount, decimal.Zero)
+	_, err := f.transfer(amount, sender, receiver, "", inclusiveFee)
+	return err
 }
 
 // AddItem appends a new funding item. Will reject if exists by exchange asset currency
@@ -508,6 +487,7 @@ func (f *FundManager) getFundingForEAP(exch string, a asset.Item, p currency.Pai
 		if resp.quote == nil {
 			return nil, fmt.Errorf("quote %v %w", p.Quote, ErrFundsNotFound)
 		}
+		resp.margin = f.getSpotMargin(exch, a, p)
 		return &resp, nil
 	}
 
@@ -523,6 +503,7 @@ func (f *FundManager) Liquidate(ev common.Event) error {
 		if f.items[i].exchange == ev.GetExchange() {
 			f.items[i].reserved = decimal.Zero
 			f.items[i].available = decimal.Zero
+			f.items[i].borrowed = decimal.Zero
 			f.items[i].isLiquidated = true
 		}
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
@@ -21,6 +22,9 @@ var (
 	ErrAlreadyExists = errors.New("funding already exists")
 	// ErrUSDTrackingDisabled used when attempting to track USD values when disabled
 	ErrUSDTrackingDisabled = errors.New("USD tracking disabled")
+	// ErrMarginLiquidated is returned when a spot margin pair's margin level
+	// falls below its liquidation margin level
+	ErrMarginLiquidated = errors.New("spot margin liquidated")
 
 	errCannotAllocate             = errors.New("cannot allocate funds")
 	errZeroAmountReceived         = errors.New("amount received less than or equal to zero")
@@ -31,6 +35,11 @@ var (
 	errCannotMatchTrackingToItem  = errors.New("cannot match tracking data to funding items")
 	errNotFutures                 = errors.New("item linking collateral currencies must be a futures asset")
 	errExchangeManagerRequired    = errors.New("exchange manager required")
+	errNotSpot                    = errors.New("spot margin requires a spot asset")
+	errInvalidMargin              = errors.New("invalid spot margin")
+	errTransferChainNotFound      = errors.New("transfer chain not found")
+	errInvalidTransferSettings    = errors.New("invalid transfer settings")
+	errExchangeLevelFundingNeeded = errors.New("transfers require exchange level funding")
 )
 
 // IFundingManager limits funding usage for portfolio event handling
@@ -52,6 +61,11 @@ type IFundingManager interface {
 	HasExchangeBeenLiquidated(handler common.Event) bool
 	RealisePNL(receivingExchange string, receivingAsset asset.Item, receivingCurrency currency.Code, realisedPNL decimal.Decimal) error
 	SetFunding(string, asset.Item, *account.Balance, bool) error
+	SetupSpotMargin(*SpotMarginSetup) error
+	UpdateSpotMargin(common.Event) error
+	RequestTransfer(*TransferRequest) (*TransferRecord, error)
+	ProcessTransfers(time.Time) error
+	GetPendingTransfers() []TransferRecord
 }
 
 // IFundingTransferer allows for funding amounts to be transferred
@@ -61,6 +75,8 @@ type IFundingTransferer interface {
 	Transfer(decimal.Decimal, *Item, *Item, bool) error
 	GetFundingForEvent(common.Event) (IFundingPair, error)
 	HasExchangeBeenLiquidated(handler common.Event) bool
+	RequestTransfer(*TransferRequest) (*TransferRecord, error)
+	GetPendingTransfers() []TransferRecord
 }
 
 // IFundingReader is a simple interface of
@@ -108,6 +124,10 @@ type IPairReader interface {
 	QuoteInitialFunds() decimal.Decimal
 	BaseAvailable() decimal.Decimal
 	QuoteAvailable() decimal.Decimal
+	BaseBorrowed() decimal.Decimal
+	QuoteBorrowed() decimal.Decimal
+	BaseBorrowable() decimal.Decimal
+	QuoteBorrowable() decimal.Decimal
 }
 
 // ICollateralReader is used to read data from
@@ -145,6 +165,9 @@ type FundManager struct {
 	items                     []*Item
 	exchangeManager           *engine.ExchangeManager
 	verbose                   bool
+	spotMargins               []*SpotMargin
+	transfers                 []*TransferRecord
+	latestTime                time.Time
 }
 
 // Item holds funding data per currency item
@@ -163,12 +186,54 @@ type Item struct {
 	isLiquidated      bool
 	appendedViaAPI    bool
 	collateralCandles map[currency.Code]kline.DataFromKline
+	// borrowed is the amount owed from spot margin borrowing, including
+	// interest which has accrued but not been repaid
+	borrowed           decimal.Decimal
+	borrowCosts        decimal.Decimal
+	borrowRates        []margin.Rate
+	borrowRateIndex    int
+	yearlyBorrowRate   decimal.Decimal
+	lastBorrowAccrual  time.Time
+	marginLiquidations int64
+	// transferDelay and transferChains determine how long withdrawals
+	// take to arrive and what they cost
+	transferDelay    time.Duration
+	transferChains   []TransferChain
+	inTransit        decimal.Decimal
+	transferFeesPaid decimal.Decimal
 }
 
 // SpotPair holds two currencies that are associated with each other
 type SpotPair struct {
-	base  *Item
-	quote *Item
+	base   *Item
+	quote  *Item
+	margin *SpotMargin
+}
+
+// SpotMargin holds the borrowing rules of a spot pair along with the
+// latest price used to value its assets and liabilities
+type SpotMargin struct {
+	exchange               string
+	asset                  asset.Item
+	pair                   currency.Pair
+	maximumLeverage        decimal.Decimal
+	liquidationMarginLevel decimal.Decimal
+	latestPrice            decimal.Decimal
+}
+
+// SpotMarginSetup is used to allow borrowing for a spot pair. Borrow rates
+// are used from the time they occur, falling back to the yearly borrow
+// rate when no rate has occurred
+type SpotMarginSetup struct {
+	Exchange               string
+	Asset                  asset.Item
+	Pair                   currency.Pair
+	MaximumLeverage        decimal.Decimal
+	LiquidationMarginLevel decimal.Decimal
+	BaseBorrowRates        []margin.Rate
+	QuoteBorrowRates       []margin.Rate
+	BaseYearlyBorrowRate   decimal.Decimal
+	QuoteYearlyBorrowRate  decimal.Decimal
 }
 
 // CollateralPair consists of a currency pair for a futures contract
@@ -198,6 +263,7 @@ type Report struct {
 	USDTotalsOverTime         []ItemSnapshot
 	InitialFunds              decimal.Decimal
 	FinalFunds                decimal.Decimal
+	Transfers                 []TransferRecord
 }
 
 // ReportItem holds reporting fields
@@ -219,6 +285,12 @@ type ReportItem struct {
 	IsCollateral         bool
 	AppendedViaAPI       bool
 	PairedWith           currency.Code
+	Borrowed             decimal.Decimal
+	BorrowCosts          decimal.Decimal
+	USDBorrowCosts       decimal.Decimal
+	MarginLiquidations   int64
+	TransferFeesPaid     decimal.Decimal
+	InTransit            decimal.Decimal
 }
 
 // ItemSnapshot holds USD values to allow for tracking
@@ -226,6 +298,7 @@ type ReportItem struct {
 type ItemSnapshot struct {
 	Time          time.Time
 	Available     decimal.Decimal
+	InTransit     decimal.Decimal
 	USDClosePrice decimal.Decimal
 	USDValue      decimal.Decimal
 	Breakdown     []CurrencyContribution
@@ -237,3 +310,43 @@ type CurrencyContribution struct {
 	Currency        currency.Code
 	USDContribution decimal.Decimal
 }
+
+// TransferChain holds the withdrawal fee and confirmation delay of
+// withdrawing a currency on a specific chain
+type TransferChain struct {
+	Chain             string
+	WithdrawalFee     decimal.Decimal
+	ConfirmationDelay time.Duration
+}
+
+// TransferRequest is used to move funds of a currency from one
+// exchange to another. When Chain is empty, the sending item's
+// default transfer fee and delay are used
+type TransferRequest struct {
+	SendingExchange   string
+	SendingAsset      asset.Item
+	ReceivingExchange string
+	ReceivingAsset    asset.Item
+	Currency          currency.Code
+	Chain             string
+	Amount            decimal.Decimal
+	InclusiveFee      bool
+}
+
+// TransferRecord tracks funds sent between exchanges. Received funds
+// are unavailable until ArrivesAt
+type TransferRecord struct {
+	SendingExchange   string
+	SendingAsset      asset.Item
+	ReceivingExchange string
+	ReceivingAsset    asset.Item
+	Currency          currency.Code
+	Chain             string
+	Sent              decimal.Decimal
+	Fee               decimal.Decimal
+	Received          decimal.Decimal
+	SentAt            time.Time
+	ArrivesAt         time.Time
+	Completed         bool
+	receiver          *Item
+}
@@ -0,0 +1,254 @@
+package funding
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+)
+
+var hoursPerYear = decimal.NewFromInt(8760)
+
+// SetupSpotMargin allows the base and quote currencies of a spot pair to be
+// borrowed when orders exceed available funds
+func (f *FundManager) SetupSpotMargin(s *SpotMarginSetup) error {
+	if s == nil {
+		return fmt.Errorf("%w spot margin setup", gctcommon.ErrNilPointer)
+	}
+	if s.Asset != asset.Spot {
+		return fmt.Errorf("%v %v %v %w", s.Exchange, s.Asset, s.Pair, errNotSpot)
+	}
+	one := decimal.NewFromInt(1)
+	if s.MaximumLeverage.LessThanOrEqual(one) || s.LiquidationMarginLevel.LessThanOrEqual(one) {
+		return fmt.Errorf("%v %v %v %w maximum leverage and liquidation margin level must be greater than 1", s.Exchange, s.Asset, s.Pair, errInvalidMargin)
+	}
+	if s.BaseYearlyBorrowRate.IsNegative() || s.QuoteYearlyBorrowRate.IsNegative() {
+		return fmt.Errorf("%v %v %v %w borrow rates cannot be negative", s.Exchange, s.Asset, s.Pair, errInvalidMargin)
+	}
+	if f.getSpotMargin(s.Exchange, s.Asset, s.Pair) != nil {
+		return fmt.Errorf("%v %v %v spot margin %w", s.Exchange, s.Asset, s.Pair, ErrAlreadyExists)
+	}
+	fundingPair, err := f.getFundingForEAP(s.Exchange, s.Asset, s.Pair)
+	if err != nil {
+		return err
+	}
+	pair, ok := fundingPair.(*SpotPair)
+	if !ok {
+		return fmt.Errorf("%v %v %v %w", s.Exchange, s.Asset, s.Pair, ErrNotPair)
+	}
+	pair.base.setBorrowRates(s.BaseBorrowRates, s.BaseYearlyBorrowRate)
+	pair.quote.setBorrowRates(s.QuoteBorrowRates, s.QuoteYearlyBorrowRate)
+	f.spotMargins = append(f.spotMargins, &SpotMargin{
+		exchange:               s.Exchange,
+		asset:                  s.Asset,
+		pair:                   s.Pair,
+		maximumLeverage:        s.MaximumLeverage,
+		liquidationMarginLevel: s.LiquidationMarginLevel,
+	})
+	return nil
+}
+
+// UpdateSpotMargin values a spot margin pair at the event's close price and
+// accrues interest on borrowed funds since the last update. When the margin
+// level falls below the liquidation margin level, borrowed funds are repaid
+// from the pair's assets and ErrMarginLiquidated is returned
+func (f *FundManager) UpdateSpotMargin(ev common.Event) error {
+	if ev == nil {
+		return common.ErrNilEvent
+	}
+	m := f.getSpotMargin(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if m == nil {
+		return nil
+	}
+	fundingPair, err := f.getFundingForEAP(ev.GetExchange(), ev.GetAssetType(), ev.Pair())
+	if err != nil {
+		return err
+	}
+	pair, ok := fundingPair.(*SpotPair)
+	if !ok {
+		return fmt.Errorf("%v %v %v %w", ev.GetExchange(), ev.GetAssetType(), ev.Pair(), ErrNotPair)
+	}
+	m.latestPrice = ev.GetClosePrice()
+	pair.base.accrueInterest(ev.GetTime())
+	pair.quote.accrueInterest(ev.GetTime())
+
+	marginLevel := pair.MarginLevel()
+	if marginLevel.IsZero() || marginLevel.GreaterThanOrEqual(m.liquidationMarginLevel) {
+		return nil
+	}
+	pair.liquidateMargin()
+	return fmt.Errorf("%v %v %v %w at margin level %v below %v",
+		ev.GetExchange(),
+		ev.GetAssetType(),
+		ev.Pair(),
+		ErrMarginLiquidated,
+		marginLevel,
+		m.liquidationMarginLevel)
+}
+
+// getSpotMargin returns the spot margin settings of an exchange, asset
+// and pair, or nil if borrowing is not allowed
+func (f *FundManager) getSpotMargin(exch string, a asset.Item, p currency.Pair) *SpotMargin {
+	for i := range f.spotMargins {
+		if f.spotMargins[i].exchange == exch &&
+			f.spotMargins[i].asset == a &&
+			f.spotMargins[i].pair.Equal(p) {
+			return f.spotMargins[i]
+		}
+	}
+	return nil
+}
+
+// BaseBorrowed returns the amount of base currency owed
+func (p *SpotPair) BaseBorrowed() decimal.Decimal {
+	return p.base.borrowed
+}
+
+// QuoteBorrowed returns the amount of quote currency owed
+func (p *SpotPair) QuoteBorrowed() decimal.Decimal {
+	return p.quote.borrowed
+}
+
+// BaseBorrowable returns the amount of base currency which can be
+// borrowed before reaching the maximum leverage
+func (p *SpotPair) BaseBorrowable() decimal.Decimal {
+	if p.margin == nil || !p.margin.latestPrice.IsPositive() {
+		return decimal.Zero
+	}
+	return p.QuoteBorrowable().Div(p.margin.latestPrice)
+}
+
+// QuoteBorrowable returns the amount of quote currency which can be
+// borrowed before reaching the maximum leverage
+func (p *SpotPair) QuoteBorrowable() decimal.Decimal {
+	if p.margin == nil || !p.margin.latestPrice.IsPositive() {
+		return decimal.Zero
+	}
+	assets, liabilities := p.marginValues()
+	equity := assets.Sub(liabilities)
+	borrowable := equity.Mul(p.margin.maximumLeverage.Sub(decimal.NewFromInt(1))).Sub(liabilities)
+	if !borrowable.IsPositive() {
+		return decimal.Zero
+	}
+	return borrowable
+}
+
+// MarginLevel returns the pair's total assets divided by its total
+// liabilities, valued in the quote currency. Zero is returned when
+// nothing is borrowed
+func (p *SpotPair) MarginLevel() decimal.Decimal {
+	if p.margin == nil {
+		return decimal.Zero
+	}
+	assets, liabilities := p.marginValues()
+	if !liabilities.IsPositive() {
+		return decimal.Zero
+	}
+	return assets.Div(liabilities)
+}
+
+// marginValues returns the value of the pair's assets and liabilities
+// in the quote currency at the latest price
+func (p *SpotPair) marginValues() (assets, liabilities decimal.Decimal) {
+	price := p.margin.latestPrice
+	assets = p.base.available.Add(p.base.reserved).Mul(price).
+		Add(p.quote.available).Add(p.quote.reserved)
+	liabilities = p.base.borrowed.Mul(price).Add(p.quote.borrowed)
+	return assets, liabilities
+}
+
+// borrowShortfall borrows the difference between the amount requested
+// and the amount available when it is within the pair's borrowing limit
+func (p *SpotPair) borrowShortfall(i *Item, amount, borrowable decimal.Decimal) {
+	if p.margin == nil || !amount.GreaterThan(i.available) {
+		return
+	}
+	shortfall := amount.Sub(i.available)
+	if shortfall.GreaterThan(borrowable) {
+		return
+	}
+	i.borrowed = i.borrowed.Add(shortfall)
+	i.available = i.available.Add(shortfall)
+}
+
+// liquidateMargin repays all borrowed funds from the pair's assets at the
+// latest price, leaving any remaining equity in the quote currency
+func (p *SpotPair) liquidateMargin() {
+	assets, liabilities := p.marginValues()
+	equity := assets.Sub(liabilities)
+	if equity.IsNegative() {
+		equity = decimal.Zero
+	}
+	p.base.available = decimal.Zero
+	p.base.reserved = decimal.Zero
+	p.base.borrowed = decimal.Zero
+	p.quote.available = equity
+	p.quote.reserved = decimal.Zero
+	p.quote.borrowed = decimal.Zero
+	p.base.marginLiquidations++
+	p.quote.marginLiquidations++
+}
+
+// repayBorrowed uses available funds to repay any borrowed funds
+func (i *Item) repayBorrowed() {
+	if !i.borrowed.IsPositive() || !i.available.IsPositive() {
+		return
+	}
+	repayment := decimal.Min(i.borrowed, i.available)
+	i.borrowed = i.borrowed.Sub(repayment)
+	i.available = i.available.Sub(repayment)
+}
+
+// setBorrowRates stores a copy of the borrow rates in time order
+func (i *Item) setBorrowRates(rates []margin.Rate, yearlyRate decimal.Decimal) {
+	i.borrowRates = make([]margin.Rate, len(rates))
+	copy(i.borrowRates, rates)
+	sort.Slice(i.borrowRates, func(j, k int) bool {
+		return i.borrowRates[j].Time.Before(i.borrowRates[k].Time)
+	})
+	i.borrowRateIndex = 0
+	i.yearlyBorrowRate = yearlyRate
+}
+
+// accrueInterest adds interest to the borrowed amount for the hours
+// since the previous accrual. Items shared between pairs only accrue
+// interest once per time
+func (i *Item) accrueInterest(t time.Time) {
+	if !t.After(i.lastBorrowAccrual) {
+		return
+	}
+	if i.borrowed.IsPositive() && !i.lastBorrowAccrual.IsZero() {
+		hours := decimal.NewFromFloat(t.Sub(i.lastBorrowAccrual).Hours())
+		interest := i.borrowed.Mul(i.hourlyBorrowRate(i.lastBorrowAccrual)).Mul(hours)
+		i.borrowed = i.borrowed.Add(interest)
+		i.borrowCosts = i.borrowCosts.Add(interest)
+	}
+	i.lastBorrowAccrual = t
+}
+
+// hourlyBorrowRate returns the latest borrow rate which occurred at or
+// before the time, falling back to the configured yearly borrow rate
+func (i *Item) hourlyBorrowRate(t time.Time) decimal.Decimal {
+	for i.borrowRateIndex < len(i.borrowRates)-1 &&
+		!i.borrowRates[i.borrowRateIndex+1].Time.After(t) {
+		i.borrowRateIndex++
+	}
+	if len(i.borrowRates) == 0 || i.borrowRates[i.borrowRateIndex].Time.After(t) {
+		return i.yearlyBorrowRate.Div(hoursPerYear)
+	}
+	rate := i.borrowRates[i.borrowRateIndex]
+	switch {
+	case !rate.HourlyBorrowRate.IsZero():
+		return rate.HourlyBorrowRate
+	case !rate.YearlyBorrowRate.IsZero():
+		return rate.YearlyBorrowRate.Div(hoursPerYear)
+	default:
+		return rate.HourlyRate
+	}
+}
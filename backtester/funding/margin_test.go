@@ -0,0 +1,277 @@
+package funding
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/signal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func setupMarginFunding(t *testing.T) *FundManager {
+	t.Helper()
+	baseItem, err := CreateItem(exchName, a, pair.Base, decimal.Zero, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	quoteItem, err := CreateItem(exchName, a, pair.Quote, decimal.NewFromInt(1000), decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	p, err := CreatePair(baseItem, quoteItem)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	f := &FundManager{}
+	err = f.AddPair(p)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = f.SetupSpotMargin(&SpotMarginSetup{
+		Exchange:               exchName,
+		Asset:                  a,
+		Pair:                   pair,
+		MaximumLeverage:        decimal.NewFromInt(3),
+		LiquidationMarginLevel: decimal.NewFromFloat(1.1),
+		BaseYearlyBorrowRate:   decimal.NewFromFloat(0.876),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	return f
+}
+
+func marginEvent(tt time.Time, price int64) common.Event {
+	return &signal.Signal{
+		Base: &event.Base{
+			Exchange:     exchName,
+			Time:         tt,
+			AssetType:    a,
+			CurrencyPair: pair,
+		},
+		ClosePrice: decimal.NewFromInt(price),
+	}
+}
+
+func getMarginPair(t *testing.T, f *FundManager) *SpotPair {
+	t.Helper()
+	fp, err := f.getFundingForEAP(exchName, a, pair)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	p, ok := fp.(*SpotPair)
+	if !ok {
+		t.Fatal("expected spot pair")
+	}
+	if p.margin == nil {
+		t.Fatal("expected spot margin")
+	}
+	return p
+}
+
+func TestSetupSpotMargin(t *testing.T) {
+	t.Parallel()
+	f := &FundManager{}
+	err := f.SetupSpotMargin(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+
+	s := &SpotMarginSetup{
+		Exchange:               exchName,
+		Asset:                  asset.Futures,
+		Pair:                   pair,
+		MaximumLeverage:        decimal.NewFromInt(3),
+		LiquidationMarginLevel: decimal.NewFromFloat(1.1),
+	}
+	err = f.SetupSpotMargin(s)
+	if !errors.Is(err, errNotSpot) {
+		t.Errorf("received '%v' expected '%v'", err, errNotSpot)
+	}
+
+	s.Asset = a
+	s.MaximumLeverage = one
+	err = f.SetupSpotMargin(s)
+	if !errors.Is(err, errInvalidMargin) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidMargin)
+	}
+
+	s.MaximumLeverage = decimal.NewFromInt(3)
+	s.QuoteYearlyBorrowRate = neg
+	err = f.SetupSpotMargin(s)
+	if !errors.Is(err, errInvalidMargin) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidMargin)
+	}
+
+	s.QuoteYearlyBorrowRate = decimal.Zero
+	err = f.SetupSpotMargin(s)
+	if !errors.Is(err, ErrFundsNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrFundsNotFound)
+	}
+
+	f = setupMarginFunding(t)
+	err = f.SetupSpotMargin(s)
+	if !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("received '%v' expected '%v'", err, ErrAlreadyExists)
+	}
+}
+
+func TestSpotMarginShortSelling(t *testing.T) {
+	t.Parallel()
+	f := setupMarginFunding(t)
+	p := getMarginPair(t, f)
+	if p.CanPlaceOrder(gctorder.Sell) {
+		t.Error("expected no borrowing before the pair is valued")
+	}
+
+	tt := time.Now().Truncate(time.Hour)
+	err := f.UpdateSpotMargin(marginEvent(tt, 10))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !p.CanPlaceOrder(gctorder.Sell) {
+		t.Error("expected borrowable base to allow selling")
+	}
+	if !p.BaseBorrowable().Equal(decimal.NewFromInt(200)) {
+		t.Errorf("received '%v' expected '%v'", p.BaseBorrowable(), 200)
+	}
+
+	err = p.Reserve(decimal.NewFromInt(201), gctorder.Sell)
+	if !errors.Is(err, errCannotAllocate) {
+		t.Errorf("received '%v' expected '%v'", err, errCannotAllocate)
+	}
+	err = p.Reserve(decimal.NewFromInt(50), gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !p.BaseBorrowed().Equal(decimal.NewFromInt(50)) {
+		t.Errorf("received '%v' expected '%v'", p.BaseBorrowed(), 50)
+	}
+	err = p.Release(decimal.NewFromInt(50), decimal.Zero, gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = p.IncreaseAvailable(decimal.NewFromInt(500), gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !p.MarginLevel().Equal(decimal.NewFromInt(3)) {
+		t.Errorf("received '%v' expected '%v'", p.MarginLevel(), 3)
+	}
+
+	// 0.876 yearly is 0.0001 hourly
+	err = f.UpdateSpotMargin(marginEvent(tt.Add(time.Hour), 10))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	expectedCost := decimal.NewFromFloat(0.005)
+	if !p.base.borrowCosts.Equal(expectedCost) {
+		t.Errorf("received '%v' expected '%v'", p.base.borrowCosts, expectedCost)
+	}
+
+	// buying back the borrowed base repays it
+	owed := p.BaseBorrowed()
+	err = p.Reserve(owed.Mul(decimal.NewFromInt(10)), gctorder.Buy)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = p.Release(owed.Mul(decimal.NewFromInt(10)), decimal.Zero, gctorder.Buy)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = p.IncreaseAvailable(owed, gctorder.Buy)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !p.BaseBorrowed().IsZero() || !p.BaseAvailable().IsZero() {
+		t.Errorf("received '%v %v' expected '0 0'", p.BaseBorrowed(), p.BaseAvailable())
+	}
+	expectedQuote := decimal.NewFromFloat(999.95)
+	if !p.QuoteAvailable().Equal(expectedQuote) {
+		t.Errorf("received '%v' expected '%v'", p.QuoteAvailable(), expectedQuote)
+	}
+
+	report, err := f.GenerateReport()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	for i := range report.Items {
+		if report.Items[i].Currency.Equal(pair.Base) && !report.Items[i].BorrowCosts.Equal(expectedCost) {
+			t.Errorf("received '%v' expected '%v'", report.Items[i].BorrowCosts, expectedCost)
+		}
+	}
+}
+
+func TestSpotMarginLiquidation(t *testing.T) {
+	t.Parallel()
+	f := setupMarginFunding(t)
+	p := getMarginPair(t, f)
+	tt := time.Now().Truncate(time.Hour)
+	err := f.UpdateSpotMargin(marginEvent(tt, 10))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = p.Reserve(decimal.NewFromInt(150), gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = p.Release(decimal.NewFromInt(150), decimal.Zero, gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	err = p.IncreaseAvailable(decimal.NewFromInt(1500), gctorder.Sell)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+
+	p.base.yearlyBorrowRate = decimal.Zero
+	err = f.UpdateSpotMargin(marginEvent(tt.Add(time.Hour), 15))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+
+	err = f.UpdateSpotMargin(marginEvent(tt.Add(time.Hour*2), 16))
+	if !errors.Is(err, ErrMarginLiquidated) {
+		t.Errorf("received '%v' expected '%v'", err, ErrMarginLiquidated)
+	}
+	if !p.QuoteAvailable().Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v' expected '%v'", p.QuoteAvailable(), 100)
+	}
+	if !p.BaseBorrowed().IsZero() || !p.MarginLevel().IsZero() {
+		t.Errorf("received '%v %v' expected '0 0'", p.BaseBorrowed(), p.MarginLevel())
+	}
+	if p.base.marginLiquidations != 1 {
+		t.Errorf("received '%v' expected '%v'", p.base.marginLiquidations, 1)
+	}
+
+	err = f.UpdateSpotMargin(nil)
+	if !errors.Is(err, common.ErrNilEvent) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilEvent)
+	}
+}
+
+func TestHourlyBorrowRate(t *testing.T) {
+	t.Parallel()
+	tt := time.Now().Truncate(time.Hour)
+	i := &Item{}
+	i.setBorrowRates([]margin.Rate{
+		{Time: tt.Add(time.Hour), HourlyRate: decimal.NewFromFloat(0.2)},
+		{Time: tt, HourlyBorrowRate: decimal.NewFromFloat(0.1)},
+	}, decimal.NewFromInt(8760))
+	if r := i.hourlyBorrowRate(tt.Add(-time.Hour)); !r.Equal(one) {
+		t.Errorf("received '%v' expected '%v'", r, one)
+	}
+	if r := i.hourlyBorrowRate(tt); !r.Equal(decimal.NewFromFloat(0.1)) {
+		t.Errorf("received '%v' expected '%v'", r, 0.1)
+	}
+	if r := i.hourlyBorrowRate(tt.Add(time.Hour * 2)); !r.Equal(decimal.NewFromFloat(0.2)) {
+		t.Errorf("received '%v' expected '%v'", r, 0.2)
+	}
+}
@@ -40,11 +40,14 @@ func (p *SpotPair) QuoteAvailable() decimal.Decimal {
 // Reserve allocates an amount of funds to be used at a later time
 // it prevents multiple events from claiming the same resource
 // changes which currency to affect based on the order side
+// when margin is enabled, any shortfall is borrowed within the borrowing limit
 func (p *SpotPair) Reserve(amount decimal.Decimal, side order.Side) error {
 	switch side {
 	case order.Buy, order.Bid:
+		p.borrowShortfall(p.quote, amount, p.QuoteBorrowable())
 		return p.quote.Reserve(amount)
 	case order.Sell, order.Ask, order.ClosePosition:
+		p.borrowShortfall(p.base, amount, p.BaseBorrowable())
 		return p.base.Reserve(amount)
 	default:
 		return fmt.Errorf("%w for %v %v %v. Unknown side %v",
@@ -57,14 +60,23 @@ func (p *SpotPair) Reserve(amount decimal.Decimal, side order.Side) error {
 }
 
 // Release reduces the amount of funding reserved and adds any difference
-// back to the available amount
+// back to the available amount, which repays any borrowed funds
 // changes which currency to affect based on the order side
 func (p *SpotPair) Release(amount, diff decimal.Decimal, side order.Side) error {
+	var i *Item
 	switch side {
 	case order.Buy, order.Bid:
-		return p.quote.Release(amount, diff)
+		i = p.quote
 	case order.Sell, order.Ask:
-		return p.base.Release(amount, diff)
+		i = p.base
+	}
+	if i != nil {
+		err := i.Release(amount, diff)
+		if err != nil {
+			return err
+		}
+		i.repayBorrowed()
+		return nil
 	}
 	return fmt.Errorf("%w for %v %v %v. Unknown side %v",
 		errCannotAllocate,
@@ -74,14 +86,23 @@ func (p *SpotPair) Release(amount, diff decimal.Decimal, side order.Side) error
 		side)
 }
 
-// IncreaseAvailable adds funding to the available amount
-// changes which currency to affect based on the order side
+// IncreaseAvailable adds funding to the available amount, which repays
+// any borrowed funds. Changes which currency to affect based on the order side
 func (p *SpotPair) IncreaseAvailable(amount decimal.Decimal, side order.Side) error {
+	var i *Item
 	switch side {
 	case order.Buy, order.Bid:
-		return p.base.IncreaseAvailable(amount)
+		i = p.base
 	case order.Sell, order.Ask, order.ClosePosition:
-		return p.quote.IncreaseAvailable(amount)
+		i = p.quote
+	}
+	if i != nil {
+		err := i.IncreaseAvailable(amount)
+		if err != nil {
+			return err
+		}
+		i.repayBorrowed()
+		return nil
 	}
 	return fmt.Errorf("%w for %v %v %v. Unknown side %v",
 		errCannotAllocate,
@@ -92,14 +113,14 @@ func (p *SpotPair) IncreaseAvailable(amount decimal.Decimal, side order.Side) er
 }
 
 // CanPlaceOrder does a > 0 check to see if there are any funds
-// to place an order with
+// to place an order with, including any funds which can be borrowed
 // changes which currency to affect based on the order side
 func (p *SpotPair) CanPlaceOrder(side order.Side) bool {
 	switch side {
 	case order.Buy, order.Bid:
-		return p.quote.CanPlaceOrder()
+		return p.quote.CanPlaceOrder() || p.QuoteBorrowable().IsPositive()
 	case order.Sell, order.Ask, order.ClosePosition:
-		return p.base.CanPlaceOrder()
+		return p.base.CanPlaceOrder() || p.BaseBorrowable().IsPositive()
 	}
 	return false
 }
@@ -111,6 +132,8 @@ func (p *SpotPair) Liquidate() {
 	p.base.reserved = decimal.Zero
 	p.quote.available = decimal.Zero
 	p.quote.reserved = decimal.Zero
+	p.base.borrowed = decimal.Zero
+	p.quote.borrowed = decimal.Zero
 }
 
 // FundReserver returns a fund reserver interface of the pair
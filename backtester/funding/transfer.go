@@ -0,0 +1,204 @@
+package funding
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// SetTransferSettings sets how long withdrawals of the item take to
+// arrive and the withdrawal fee and confirmation delay of each chain
+func (i *Item) SetTransferSettings(delay time.Duration, chains []TransferChain) error {
+	if i == nil {
+		return fmt.Errorf("%w funding item", gctcommon.ErrNilPointer)
+	}
+	if delay < 0 {
+		return fmt.Errorf("%v %v %v %w transfer delay cannot be negative", i.exchange, i.asset, i.currency, errInvalidTransferSettings)
+	}
+	for j := range chains {
+		if chains[j].Chain == "" ||
+			chains[j].WithdrawalFee.IsNegative() ||
+			chains[j].ConfirmationDelay < 0 {
+			return fmt.Errorf("%v %v %v %w chain %q", i.exchange, i.asset, i.currency, errInvalidTransferSettings, chains[j].Chain)
+		}
+	}
+	i.transferDelay = delay
+	i.transferChains = make([]TransferChain, len(chains))
+	copy(i.transferChains, chains)
+	return nil
+}
+
+// transferCosts returns the withdrawal fee and delay of sending funds
+// on a chain. An empty chain uses the item's default settings
+func (i *Item) transferCosts(chain string) (fee decimal.Decimal, delay time.Duration, err error) {
+	if chain == "" {
+		return i.transferFee, i.transferDelay, nil
+	}
+	for j := range i.transferChains {
+		if strings.EqualFold(i.transferChains[j].Chain, chain) {
+			return i.transferChains[j].WithdrawalFee, i.transferChains[j].ConfirmationDelay, nil
+		}
+	}
+	return decimal.Zero, 0, fmt.Errorf("%v %v %v %w %q", i.exchange, i.asset, i.currency, errTransferChainNotFound, chain)
+}
+
+// RequestTransfer sends funds from one exchange to another. Funds leave
+// the sender immediately and are unavailable to the receiver until the
+// transfer's delay has passed
+func (f *FundManager) RequestTransfer(req *TransferRequest) (*TransferRecord, error) {
+	if req == nil {
+		return nil, fmt.Errorf("%w transfer request", gctcommon.ErrNilPointer)
+	}
+	if !f.usingExchangeLevelFunding {
+		return nil, errExchangeLevelFundingNeeded
+	}
+	sender, err := f.getItem(req.SendingExchange, req.SendingAsset, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	receiver, err := f.getItem(req.ReceivingExchange, req.ReceivingAsset, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	record, err := f.transfer(req.Amount, sender, receiver, req.Chain, req.InclusiveFee)
+	if err != nil {
+		return nil, err
+	}
+	cpy := *record
+	return &cpy, nil
+}
+
+// ProcessTransfers credits the receivers of any transfers which have
+// arrived by the time provided
+func (f *FundManager) ProcessTransfers(t time.Time) error {
+	if t.IsZero() {
+		return gctcommon.ErrDateUnset
+	}
+	if t.After(f.latestTime) {
+		f.latestTime = t
+	}
+	for i := range f.transfers {
+		if f.transfers[i].Completed || f.transfers[i].ArrivesAt.After(t) {
+			continue
+		}
+		err := f.transfers[i].complete()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPendingTransfers returns all transfers which have not yet arrived
+func (f *FundManager) GetPendingTransfers() []TransferRecord {
+	var resp []TransferRecord
+	for i := range f.transfers {
+		if !f.transfers[i].Completed {
+			resp = append(resp, *f.transfers[i])
+		}
+	}
+	return resp
+}
+
+// transfer moves funds from the sender, charging the fee of the chain.
+// The receiver is credited immediately when the chain has no delay
+func (f *FundManager) transfer(amount decimal.Decimal, sender, receiver *Item, chain string, inclusiveFee bool) (*TransferRecord, error) {
+	if sender == nil || receiver == nil {
+		return nil, gctcommon.ErrNilPointer
+	}
+	if amount.LessThanOrEqual(decimal.Zero) {
+		return nil, errZeroAmountReceived
+	}
+	fee, delay, err := sender.transferCosts(chain)
+	if err != nil {
+		return nil, err
+	}
+	if inclusiveFee {
+		if sender.available.LessThan(amount) {
+			return nil, fmt.Errorf("%w for %v", errNotEnoughFunds, sender.currency)
+		}
+	} else {
+		if sender.available.LessThan(amount.Add(fee)) {
+			return nil, fmt.Errorf("%w for %v", errNotEnoughFunds, sender.currency)
+		}
+	}
+
+	if !sender.currency.Equal(receiver.currency) {
+		return nil, errTransferMustBeSameCurrency
+	}
+	if sender.exchange == receiver.exchange &&
+		sender.asset == receiver.asset {
+		return nil, fmt.Errorf("%v %v %v %w", sender.exchange, sender.asset, sender.currency, errCannotTransferToSameFunds)
+	}
+
+	sendAmount := amount
+	receiveAmount := amount
+	if inclusiveFee {
+		receiveAmount = amount.Sub(fee)
+	} else {
+		sendAmount = amount.Add(fee)
+	}
+	if receiveAmount.LessThanOrEqual(decimal.Zero) {
+		return nil, fmt.Errorf("%w after %v fee", errZeroAmountReceived, fee)
+	}
+	err = sender.Reserve(sendAmount)
+	if err != nil {
+		return nil, err
+	}
+	err = sender.Release(sendAmount, decimal.Zero)
+	if err != nil {
+		return nil, err
+	}
+	sender.transferFeesPaid = sender.transferFeesPaid.Add(fee)
+
+	record := &TransferRecord{
+		SendingExchange:   sender.exchange,
+		SendingAsset:      sender.asset,
+		ReceivingExchange: receiver.exchange,
+		ReceivingAsset:    receiver.asset,
+		Currency:          sender.currency,
+		Chain:             chain,
+		Sent:              sendAmount,
+		Fee:               fee,
+		Received:          receiveAmount,
+		SentAt:            f.latestTime,
+		ArrivesAt:         f.latestTime.Add(delay),
+		receiver:          receiver,
+	}
+	f.transfers = append(f.transfers, record)
+	receiver.inTransit = receiver.inTransit.Add(receiveAmount)
+	if delay > 0 {
+		return record, nil
+	}
+	return record, record.complete()
+}
+
+// complete makes a transfer's funds available to its receiver,
+// repaying any funds the receiver has borrowed
+func (t *TransferRecord) complete() error {
+	t.receiver.inTransit = t.receiver.inTransit.Sub(t.Received)
+	err := t.receiver.IncreaseAvailable(t.Received)
+	if err != nil {
+		return err
+	}
+	t.receiver.repayBorrowed()
+	t.Completed = true
+	return nil
+}
+
+// getItem returns the funding item of an exchange, asset and currency
+func (f *FundManager) getItem(exch string, a asset.Item, c currency.Code) (*Item, error) {
+	for i := range f.items {
+		if strings.EqualFold(f.items[i].exchange, exch) &&
+			f.items[i].asset == a &&
+			f.items[i].currency.Equal(c) {
+			return f.items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%v %v %v %w", exch, a, c, ErrFundsNotFound)
+}
@@ -0,0 +1,202 @@
+package funding
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
+)
+
+func setupTransferFunding(t *testing.T) (f *FundManager, sender, receiver *Item) {
+	t.Helper()
+	var err error
+	sender, err = CreateItem(exchName, a, base, elite, one)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = sender.SetTransferSettings(time.Hour, []TransferChain{
+		{Chain: "fast", WithdrawalFee: decimal.NewFromInt(2)},
+		{Chain: "slow", WithdrawalFee: decimal.NewFromFloat(0.5), ConfirmationDelay: time.Hour * 3},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	receiver, err = CreateItem("bybit", a, base, decimal.Zero, decimal.Zero)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	f = &FundManager{usingExchangeLevelFunding: true}
+	err = f.AddItem(sender)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	err = f.AddItem(receiver)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	return f, sender, receiver
+}
+
+func TestSetTransferSettings(t *testing.T) {
+	t.Parallel()
+	var i *Item
+	err := i.SetTransferSettings(0, nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	i = &Item{}
+	err = i.SetTransferSettings(-time.Second, nil)
+	if !errors.Is(err, errInvalidTransferSettings) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidTransferSettings)
+	}
+	err = i.SetTransferSettings(time.Second, []TransferChain{{Chain: "bitcoin", WithdrawalFee: neg}})
+	if !errors.Is(err, errInvalidTransferSettings) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidTransferSettings)
+	}
+	err = i.SetTransferSettings(time.Second, []TransferChain{{Chain: "bitcoin", WithdrawalFee: one}})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	fee, delay, err := i.transferCosts("BITCOIN")
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !fee.Equal(one) || delay != 0 {
+		t.Errorf("received '%v %v' expected '%v %v'", fee, delay, one, 0)
+	}
+	_, _, err = i.transferCosts("lightning")
+	if !errors.Is(err, errTransferChainNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errTransferChainNotFound)
+	}
+}
+
+func TestRequestTransfer(t *testing.T) {
+	t.Parallel()
+	f := &FundManager{}
+	_, err := f.RequestTransfer(nil)
+	if !errors.Is(err, gctcommon.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrNilPointer)
+	}
+	req := &TransferRequest{
+		SendingExchange:   exchName,
+		SendingAsset:      a,
+		ReceivingExchange: "bybit",
+		ReceivingAsset:    a,
+		Currency:          base,
+		Amount:            decimal.NewFromInt(100),
+	}
+	_, err = f.RequestTransfer(req)
+	if !errors.Is(err, errExchangeLevelFundingNeeded) {
+		t.Errorf("received '%v' expected '%v'", err, errExchangeLevelFundingNeeded)
+	}
+
+	f, sender, receiver := setupTransferFunding(t)
+	req.Currency = quote
+	_, err = f.RequestTransfer(req)
+	if !errors.Is(err, ErrFundsNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, ErrFundsNotFound)
+	}
+
+	req.Currency = base
+	req.Chain = "lightning"
+	_, err = f.RequestTransfer(req)
+	if !errors.Is(err, errTransferChainNotFound) {
+		t.Errorf("received '%v' expected '%v'", err, errTransferChainNotFound)
+	}
+
+	tt := time.Now().Truncate(time.Hour)
+	err = f.ProcessTransfers(tt)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	req.Chain = "slow"
+	record, err := f.RequestTransfer(req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !record.ArrivesAt.Equal(tt.Add(time.Hour*3)) || record.Completed {
+		t.Errorf("received '%v %v' expected '%v %v'", record.ArrivesAt, record.Completed, tt.Add(time.Hour*3), false)
+	}
+	expectedSent := decimal.NewFromFloat(100.5)
+	if !record.Sent.Equal(expectedSent) || !sender.available.Equal(elite.Sub(expectedSent)) {
+		t.Errorf("received '%v %v' expected '%v %v'", record.Sent, sender.available, expectedSent, elite.Sub(expectedSent))
+	}
+	if !receiver.available.IsZero() || !receiver.inTransit.Equal(req.Amount) {
+		t.Errorf("received '%v %v' expected '%v %v'", receiver.available, receiver.inTransit, 0, req.Amount)
+	}
+	if len(f.GetPendingTransfers()) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(f.GetPendingTransfers()), 1)
+	}
+
+	err = f.ProcessTransfers(tt.Add(time.Hour * 2))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !receiver.available.IsZero() {
+		t.Errorf("received '%v' expected '%v'", receiver.available, 0)
+	}
+	err = f.ProcessTransfers(tt.Add(time.Hour * 3))
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if !receiver.available.Equal(req.Amount) || !receiver.inTransit.IsZero() {
+		t.Errorf("received '%v %v' expected '%v %v'", receiver.available, receiver.inTransit, req.Amount, 0)
+	}
+	if len(f.GetPendingTransfers()) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(f.GetPendingTransfers()), 0)
+	}
+
+	req.Chain = ""
+	req.Amount = decimal.NewFromInt(10)
+	req.InclusiveFee = true
+	record, err = f.RequestTransfer(req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !record.Received.Equal(decimal.NewFromInt(9)) || !record.ArrivesAt.Equal(tt.Add(time.Hour*4)) {
+		t.Errorf("received '%v %v' expected '%v %v'", record.Received, record.ArrivesAt, 9, tt.Add(time.Hour*4))
+	}
+
+	report, err := f.GenerateReport()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(report.Transfers) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(report.Transfers), 2)
+	}
+	for i := range report.Items {
+		if report.Items[i].Exchange == exchName && !report.Items[i].TransferFeesPaid.Equal(decimal.NewFromFloat(1.5)) {
+			t.Errorf("received '%v' expected '%v'", report.Items[i].TransferFeesPaid, 1.5)
+		}
+		if report.Items[i].Exchange == "bybit" && !report.Items[i].FinalFunds.Equal(decimal.NewFromInt(109)) {
+			t.Errorf("received '%v' expected '%v'", report.Items[i].FinalFunds, 109)
+		}
+	}
+}
+
+func TestRequestTransferWithoutDelay(t *testing.T) {
+	t.Parallel()
+	f, _, receiver := setupTransferFunding(t)
+	record, err := f.RequestTransfer(&TransferRequest{
+		SendingExchange:   exchName,
+		SendingAsset:      a,
+		ReceivingExchange: "bybit",
+		ReceivingAsset:    a,
+		Currency:          base,
+		Chain:             "fast",
+		Amount:            decimal.NewFromInt(100),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if !record.Completed || !receiver.available.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("received '%v %v' expected '%v %v'", record.Completed, receiver.available, true, 100)
+	}
+
+	err = f.ProcessTransfers(time.Time{})
+	if !errors.Is(err, gctcommon.ErrDateUnset) {
+		t.Errorf("received '%v' expected '%v'", err, gctcommon.ErrDateUnset)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
@@ -20,7 +21,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/signaler"
 )
 
-var singleTaskStrategyPath, templatePath, outputPath, btConfigDir, strategyPluginPath, pprofURL string
+var singleTaskStrategyPath, templatePath, outputPath, btConfigDir, strategyPluginPath, pprofURL, exportFormats string
 var printLogo, generateReport, darkReport, colourOutput, logSubHeader, enablePProf bool
 
 func main() {
@@ -120,6 +121,15 @@ func main() {
 		os.Exit(1)
 	}
 
+	if exportFormats != "" {
+		btCfg.Report.ExportFormats = strings.Split(exportFormats, ",")
+	}
+	err = btCfg.Report.ValidateExportFormats()
+	if err != nil {
+		fmt.Printf("Could not export results. Error: %v\n", err)
+		os.Exit(1)
+	}
+
 	if colourOutput {
 		common.SetColours(&btCfg.Colours)
 	} else {
@@ -174,19 +184,48 @@ func main() {
 			fmt.Printf("Could not read strategy config. Error: %v\n", err)
 			os.Exit(1)
 		}
-		var bt *backtest.BackTest
-		bt, err = backtest.NewBacktesterFromConfigs(cfg, &config.BacktesterConfig{
+		taskCfg := &config.BacktesterConfig{
 			Report: config.Report{
 				GenerateReport: generateReport,
 				TemplatePath:   btCfg.Report.TemplatePath,
 				OutputPath:     btCfg.Report.OutputPath,
 				DarkMode:       darkReport,
+				ExportFormats:  btCfg.Report.ExportFormats,
 			},
-		})
+		}
+		if cfg.OptimisationSettings != nil {
+			if cfg.OptimisationSettings.WalkForward != nil {
+				_, err = backtest.NewTaskManager().RunWalkForward(cfg, taskCfg)
+			} else {
+				_, err = backtest.NewTaskManager().RunOptimisation(cfg, taskCfg)
+			}
+			if err != nil {
+				fmt.Printf("Could not run optimisation. Error: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+		var bt *backtest.BackTest
+		bt, err = backtest.NewBacktesterFromConfigs(cfg, taskCfg)
 		if err != nil {
 			fmt.Printf("Could not execute strategy. Error: %v\n", err)
 			os.Exit(1)
 		}
+		var runHistory *backtest.RunHistory
+		runHistory, err = backtest.SetupRunHistory(&btCfg.RunHistory)
+		if err != nil {
+			fmt.Printf("Could not setup run history. Error: %v\n", err)
+			os.Exit(1)
+		}
+		if runHistory != nil {
+			bt.SetRunHistory(runHistory)
+			defer func() {
+				err = runHistory.Close()
+				if err != nil {
+					log.Errorln(common.Backtester, err)
+				}
+			}()
+		}
 		if bt.MetaData.LiveTesting {
 			err = bt.ExecuteStrategy(false)
 			if err != nil {
@@ -221,6 +260,10 @@ func main() {
 		log.Infoln(log.GRPCSys, "Starting RPC server")
 		var s *backtest.GRPCServer
 		s, err = backtest.SetupRPCServer(c, runManager)
+		if err != nil {
+			fmt.Printf("Could not setup RPC server. Error: %v\n", err)
+			os.Exit(1)
+		}
 		err = backtest.StartRPCServer(s)
 		if err != nil {
 			fmt.Printf("Could not start RPC server. Error: %v\n", err)
@@ -303,6 +346,11 @@ func parseFlags(wd string) map[string]bool {
 		"outputpath",
 		defaultReportOutput,
 		"the path where to output results")
+	flag.StringVar(
+		&exportFormats,
+		"exportformats",
+		"",
+		fmt.Sprintf("comma separated formats to export results as, alongside the report. eg '%v,%v'", config.ExportJSON, config.ExportCSV))
 	flag.BoolVar(
 		&darkReport,
 		"darkreport",
@@ -85,5 +85,10 @@ func (s *CustomStrategy) SetCustomSettings(map[string]interface{}) error {
 	return base.ErrCustomSettingsUnsupported
 }
 
+// CustomSettingsSchema describes the custom settings the strategy accepts
+func (s *CustomStrategy) CustomSettingsSchema() base.CustomSettingsSchema {
+	return base.CustomSettingsSchema{}
+}
+
 // SetDefaults sets default values for overridable custom settings
 func (s *CustomStrategy) SetDefaults() {}
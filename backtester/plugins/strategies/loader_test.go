@@ -63,3 +63,7 @@ func (s *CustomStrategy) SetCustomSettings(map[string]interface{}) error {
 }
 
 func (s *CustomStrategy) SetDefaults() {}
+
+func (s *CustomStrategy) CustomSettingsSchema() base.CustomSettingsSchema {
+	return base.CustomSettingsSchema{}
+}
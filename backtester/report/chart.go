@@ -2,6 +2,7 @@ package report
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
@@ -198,3 +199,156 @@ func createFuturesSpotDiffChart(items map[string]map[asset.Item]map[*currency.It
 	}
 	return response, nil
 }
+
+// createWalkForwardChart creates a chart of the stitched
+// out-of-sample equity curve of a walk-forward analysis
+func createWalkForwardChart(curve []statistics.ValueAtTime) *Chart {
+	if len(curve) == 0 {
+		return nil
+	}
+	plots := make([]LinePlot, len(curve))
+	for i := range curve {
+		plots[i] = LinePlot{
+			Value:     curve[i].Value.InexactFloat64(),
+			UnixMilli: curve[i].Time.UTC().UnixMilli(),
+		}
+	}
+	return &Chart{
+		AxisType: "linear",
+		Data: []ChartLine{
+			{
+				Name:      "Out-of-sample equity",
+				LinePlots: plots,
+			},
+		},
+	}
+}
+
+// createMonteCarloCharts creates histograms of the final returns
+// and max drawdowns of all monte carlo simulations
+func createMonteCarloCharts(m *statistics.MonteCarloResults) []*HistogramChart {
+	if m == nil {
+		return nil
+	}
+	return []*HistogramChart{
+		createHistogramChart("montecarloreturns", "Final Return %", m.FinalReturn.Histogram),
+		createHistogramChart("montecarlodrawdowns", "Max Drawdown %", m.MaxDrawdown.Histogram),
+	}
+}
+
+func createHistogramChart(id, name string, bins []statistics.HistogramBin) *HistogramChart {
+	resp := &HistogramChart{
+		ID:         id,
+		Name:       name,
+		Categories: make([]string, len(bins)),
+		Counts:     make([]int64, len(bins)),
+	}
+	for i := range bins {
+		resp.Categories[i] = bins[i].Minimum.StringFixed(2) + " to " + bins[i].Maximum.StringFixed(2)
+		resp.Counts[i] = bins[i].Count
+	}
+	return resp
+}
+
+// createOptimisationHeatmap creates a heatmap of the best score for each
+// combination of the first two optimisation parameters. Scores are coloured
+// from red for the worst to green for the best
+func createOptimisationHeatmap(o *OptimisationResults) *Heatmap {
+	if o == nil || len(o.Parameters) < 2 {
+		return nil
+	}
+	var xValues, yValues []interface{}
+	best := make(map[string]map[string]decimal.Decimal)
+	for i := range o.Runs {
+		if o.Runs[i].Error != "" || len(o.Runs[i].Parameters) < 2 {
+			continue
+		}
+		x := o.Runs[i].Parameters[0]
+		y := o.Runs[i].Parameters[1]
+		xValues = appendUniqueValue(xValues, x)
+		yValues = appendUniqueValue(yValues, y)
+		xKey, yKey := fmt.Sprint(x), fmt.Sprint(y)
+		if best[yKey] == nil {
+			best[yKey] = make(map[string]decimal.Decimal)
+		}
+		score, ok := best[yKey][xKey]
+		if !ok || o.Runs[i].Score.GreaterThan(score) {
+			best[yKey][xKey] = o.Runs[i].Score
+		}
+	}
+	if len(xValues) == 0 {
+		return nil
+	}
+	sortValues(xValues)
+	sortValues(yValues)
+
+	var lowest, highest decimal.Decimal
+	first := true
+	for _, row := range best {
+		for _, score := range row {
+			if first || score.LessThan(lowest) {
+				lowest = score
+			}
+			if first || score.GreaterThan(highest) {
+				highest = score
+			}
+			first = false
+		}
+	}
+	resp := &Heatmap{
+		XAxisName: o.Parameters[0],
+		YAxisName: o.Parameters[1],
+		XValues:   make([]string, len(xValues)),
+		Rows:      make([]HeatmapRow, len(yValues)),
+	}
+	for i := range xValues {
+		resp.XValues[i] = fmt.Sprint(xValues[i])
+	}
+	scoreRange := highest.Sub(lowest)
+	for i := range yValues {
+		yKey := fmt.Sprint(yValues[i])
+		row := HeatmapRow{
+			Value: yKey,
+			Cells: make([]HeatmapCell, len(xValues)),
+		}
+		for j := range resp.XValues {
+			score, ok := best[yKey][resp.XValues[j]]
+			if !ok {
+				continue
+			}
+			// hue 0 is red and 120 is green
+			hue := int64(120)
+			if !scoreRange.IsZero() {
+				hue = score.Sub(lowest).Div(scoreRange).Mul(decimal.NewFromInt(120)).IntPart()
+			}
+			row.Cells[j] = HeatmapCell{
+				HasScore: true,
+				Score:    score,
+				Colour:   fmt.Sprintf("hsl(%d, 70%%, 40%%)", hue),
+			}
+		}
+		resp.Rows[i] = row
+	}
+	return resp
+}
+
+func appendUniqueValue(values []interface{}, v interface{}) []interface{} {
+	for i := range values {
+		if fmt.Sprint(values[i]) == fmt.Sprint(v) {
+			return values
+		}
+	}
+	return append(values, v)
+}
+
+// sortValues sorts numbers numerically and everything else alphabetically
+func sortValues(values []interface{}) {
+	sort.SliceStable(values, func(i, j int) bool {
+		a, aOK := values[i].(float64)
+		b, bOK := values[j].(float64)
+		if aOK && bOK {
+			return a < b
+		}
+		return fmt.Sprint(values[i]) < fmt.Sprint(values[j])
+	})
+}
@@ -227,3 +227,93 @@ func TestCreateFuturesSpotDiffChart(t *testing.T) {
 		t.Error("expected data")
 	}
 }
+
+func TestCreateOptimisationHeatmap(t *testing.T) {
+	t.Parallel()
+	if createOptimisationHeatmap(nil) != nil {
+		t.Error("expected nil heatmap")
+	}
+	o := &OptimisationResults{
+		Parameters: []string{"rsi-period"},
+		Runs: []OptimisationRun{
+			{Parameters: []interface{}{7.0}, Score: decimal.NewFromInt(1)},
+		},
+	}
+	if createOptimisationHeatmap(o) != nil {
+		t.Error("expected nil heatmap for a single parameter")
+	}
+
+	o.Parameters = []string{"rsi-period", "rsi-low"}
+	o.Runs = []OptimisationRun{
+		{Parameters: []interface{}{14.0, 30.0}, Score: decimal.NewFromInt(3)},
+		{Parameters: []interface{}{7.0, 30.0}, Score: decimal.NewFromInt(1)},
+		{Parameters: []interface{}{14.0, 20.0}, Score: decimal.NewFromInt(2)},
+		{Parameters: []interface{}{7.0, 20.0}, Error: "bad"},
+	}
+	h := createOptimisationHeatmap(o)
+	if h == nil {
+		t.Fatal("expected heatmap")
+	}
+	if len(h.XValues) != 2 || h.XValues[0] != "7" || h.XValues[1] != "14" {
+		t.Errorf("received '%v' expected '[7 14]'", h.XValues)
+	}
+	if len(h.Rows) != 2 || h.Rows[0].Value != "20" {
+		t.Fatalf("received '%v' expected rows for 20 and 30", h.Rows)
+	}
+	if h.Rows[0].Cells[0].HasScore {
+		t.Error("expected failed run to have no score")
+	}
+	if !h.Rows[1].Cells[1].Score.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("received '%v' expected '%v'", h.Rows[1].Cells[1].Score, 3)
+	}
+	if h.Rows[1].Cells[1].Colour != "hsl(120, 70%, 40%)" {
+		t.Errorf("received '%v' expected best score to be green", h.Rows[1].Cells[1].Colour)
+	}
+	if h.Rows[1].Cells[0].Colour != "hsl(0, 70%, 40%)" {
+		t.Errorf("received '%v' expected worst score to be red", h.Rows[1].Cells[0].Colour)
+	}
+}
+
+func TestCreateWalkForwardChart(t *testing.T) {
+	t.Parallel()
+	if createWalkForwardChart(nil) != nil {
+		t.Error("expected nil chart")
+	}
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	c := createWalkForwardChart([]statistics.ValueAtTime{
+		{Time: tt, Value: decimal.NewFromInt(100)},
+		{Time: tt.Add(time.Hour), Value: decimal.NewFromInt(110)},
+	})
+	if c == nil {
+		t.Fatal("expected chart")
+	}
+	if len(c.Data) != 1 || len(c.Data[0].LinePlots) != 2 {
+		t.Fatalf("received '%v' expected one line of two plots", c.Data)
+	}
+	if c.Data[0].LinePlots[1].Value != 110 || c.Data[0].LinePlots[1].UnixMilli != tt.Add(time.Hour).UnixMilli() {
+		t.Errorf("received '%v' expected '%v' at '%v'", c.Data[0].LinePlots[1], 110, tt.Add(time.Hour).UnixMilli())
+	}
+}
+
+func TestCreateMonteCarloCharts(t *testing.T) {
+	t.Parallel()
+	if createMonteCarloCharts(nil) != nil {
+		t.Error("expected no charts")
+	}
+	charts := createMonteCarloCharts(&statistics.MonteCarloResults{
+		FinalReturn: statistics.MonteCarloDistribution{
+			Histogram: []statistics.HistogramBin{
+				{Minimum: decimal.NewFromInt(-5), Maximum: decimal.NewFromFloat(2.5), Count: 3},
+			},
+		},
+	})
+	if len(charts) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(charts), 2)
+	}
+	if len(charts[0].Categories) != 1 || charts[0].Categories[0] != "-5.00 to 2.50" || charts[0].Counts[0] != 3 {
+		t.Errorf("received '%v' '%v' expected '[-5.00 to 2.50]' '[3]'", charts[0].Categories, charts[0].Counts)
+	}
+	if len(charts[1].Categories) != 0 {
+		t.Errorf("received '%v' expected no categories", charts[1].Categories)
+	}
+}
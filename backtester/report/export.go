@@ -0,0 +1,457 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetExportFormats sets which machine-readable formats
+// the results are exported as
+func (d *Data) SetExportFormats(formats []string) {
+	d.ExportFormats = formats
+}
+
+// CreateExport gathers the config, events, orders, fills, holdings, funding,
+// PNL and final results of a run into a versioned export. Exchange asset
+// pairs are sorted so that exports of the same run are comparable
+func (d *Data) CreateExport() (*Export, error) {
+	if d.Statistics == nil {
+		return nil, errStatisticsUnset
+	}
+	resp := &Export{
+		Version:     ExportVersion,
+		GeneratedAt: time.Now().UTC(),
+		Strategy:    d.Statistics.StrategyName,
+		Seed:        d.Statistics.Seed,
+		Config:      d.Config,
+	}
+	if d.Config != nil {
+		resp.Nickname = d.Config.Nickname
+	}
+	stats := sortedCurrencyPairStatistics(d.Statistics)
+	for i := range stats {
+		resp.addCurrencyPairStatistic(stats[i])
+	}
+	if d.Statistics.FundingStatistics != nil {
+		for i := range d.Statistics.FundingStatistics.Items {
+			item := d.Statistics.FundingStatistics.Items[i].ReportItem
+			if item == nil {
+				continue
+			}
+			for j := range item.Snapshots {
+				resp.Funding = append(resp.Funding, ExportFunding{
+					Exchange:      item.Exchange,
+					Asset:         item.Asset,
+					Currency:      item.Currency,
+					Time:          item.Snapshots[j].Time,
+					Available:     item.Snapshots[j].Available,
+					USDClosePrice: item.Snapshots[j].USDClosePrice,
+					USDValue:      item.Snapshots[j].USDValue,
+				})
+			}
+		}
+		if usd := d.Statistics.FundingStatistics.TotalUSDStatistics; usd != nil {
+			resp.Total = &ExportResult{
+				TotalOrders:              d.Statistics.TotalOrders,
+				MarketMovement:           usd.BenchmarkMarketMovement,
+				StrategyMovement:         usd.HoldingValueDifference,
+				MaxDrawdownPercent:       usd.MaxDrawdown.DrawdownPercent,
+				CompoundAnnualGrowthRate: usd.CompoundAnnualGrowthRate,
+			}
+			resp.Total.setRatios(usd.ArithmeticRatios, usd.GeometricRatios)
+		}
+	}
+	return resp, nil
+}
+
+// sortedCurrencyPairStatistics flattens the exchange asset pair statistics
+// ordered by exchange, asset and pair
+func sortedCurrencyPairStatistics(s *statistics.Statistic) []*statistics.CurrencyPairStatistic {
+	var resp []*statistics.CurrencyPairStatistic
+	for _, assetMap := range s.ExchangeAssetPairStatistics {
+		for _, baseMap := range assetMap {
+			for _, quoteMap := range baseMap {
+				for _, stats := range quoteMap {
+					resp = append(resp, stats)
+				}
+			}
+		}
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		if resp[i].Exchange != resp[j].Exchange {
+			return resp[i].Exchange < resp[j].Exchange
+		}
+		if resp[i].Asset != resp[j].Asset {
+			return resp[i].Asset.String() < resp[j].Asset.String()
+		}
+		return resp[i].Currency.String() < resp[j].Currency.String()
+	})
+	return resp
+}
+
+// addCurrencyPairStatistic appends the events and results
+// of an exchange asset pair to the export
+func (e *Export) addCurrencyPairStatistic(c *statistics.CurrencyPairStatistic) {
+	for i := range c.Events {
+		ev := &c.Events[i]
+		row := ExportEvent{
+			Exchange: c.Exchange,
+			Asset:    c.Asset,
+			Pair:     c.Currency,
+			Offset:   ev.Offset,
+			Time:     ev.Time,
+			Close:    ev.ClosePrice,
+		}
+		var reasons []string
+		if ev.DataEvent != nil {
+			row.Open = ev.DataEvent.GetOpenPrice()
+			row.High = ev.DataEvent.GetHighPrice()
+			row.Low = ev.DataEvent.GetLowPrice()
+			row.Close = ev.DataEvent.GetClosePrice()
+			row.Volume = ev.DataEvent.GetVolume()
+		}
+		if ev.SignalEvent != nil {
+			row.Signal = ev.SignalEvent.GetDirection().String()
+			reasons = append(reasons, ev.SignalEvent.GetReasons()...)
+		}
+		if ev.OrderEvent != nil {
+			row.Order = ev.OrderEvent.GetDirection().String()
+		}
+		if ev.FillEvent != nil {
+			row.Fill = ev.FillEvent.GetDirection().String()
+			reasons = append(reasons, ev.FillEvent.GetReasons()...)
+			if common.CanTransact(ev.FillEvent.GetDirection()) {
+				e.Fills = append(e.Fills, ExportFill{
+					Exchange:            c.Exchange,
+					Asset:               c.Asset,
+					Pair:                c.Currency,
+					Offset:              ev.Offset,
+					Time:                ev.FillEvent.GetTime(),
+					Direction:           ev.FillEvent.GetDirection().String(),
+					Amount:              ev.FillEvent.GetAmount(),
+					ClosePrice:          ev.FillEvent.GetClosePrice(),
+					VolumeAdjustedPrice: ev.FillEvent.GetVolumeAdjustedPrice(),
+					PurchasePrice:       ev.FillEvent.GetPurchasePrice(),
+					SlippageRate:        ev.FillEvent.GetSlippageRate(),
+					Fee:                 ev.FillEvent.GetExchangeFee(),
+					Total:               ev.FillEvent.GetTotal(),
+					IsLiquidated:        ev.FillEvent.IsLiquidated(),
+					ExitRule:            ev.FillEvent.GetExitRule(),
+					Reasons:             ev.FillEvent.GetConcatReasons(),
+				})
+			}
+		}
+		row.Reasons = strings.Join(reasons, ". ")
+		e.Events = append(e.Events, row)
+
+		if !ev.Holdings.Timestamp.IsZero() {
+			e.Holdings = append(e.Holdings, ExportHolding{
+				Exchange:                  c.Exchange,
+				Asset:                     c.Asset,
+				Pair:                      c.Currency,
+				Offset:                    ev.Offset,
+				Time:                      ev.Holdings.Timestamp,
+				BaseSize:                  ev.Holdings.BaseSize,
+				BaseValue:                 ev.Holdings.BaseValue,
+				QuoteSize:                 ev.Holdings.QuoteSize,
+				CommittedFunds:            ev.Holdings.CommittedFunds,
+				TotalValue:                ev.Holdings.TotalValue,
+				TotalFees:                 ev.Holdings.TotalFees,
+				TotalValueLost:            ev.Holdings.TotalValueLost,
+				ChangeInTotalValuePercent: ev.Holdings.ChangeInTotalValuePercent,
+			})
+		}
+		if ev.PNL != nil {
+			e.PNL = append(e.PNL, ExportPNL{
+				Exchange:           c.Exchange,
+				Asset:              c.Asset,
+				Pair:               c.Currency,
+				Offset:             ev.Offset,
+				Time:               ev.Time,
+				CollateralCurrency: ev.PNL.GetCollateralCurrency(),
+				Direction:          ev.PNL.GetDirection().String(),
+				Status:             ev.PNL.GetPositionStatus().String(),
+				UnrealisedPNL:      ev.PNL.GetUnrealisedPNL().PNL,
+				RealisedPNL:        ev.PNL.GetRealisedPNL().PNL,
+				FundingPayments:    ev.PNL.GetFundingPayments().PNL,
+			})
+		}
+	}
+
+	for i := range c.FinalOrders.Orders {
+		o := c.FinalOrders.Orders[i]
+		if o.Order == nil {
+			continue
+		}
+		e.Orders = append(e.Orders, ExportOrder{
+			Exchange:            c.Exchange,
+			Asset:               c.Asset,
+			Pair:                c.Currency,
+			Time:                o.Order.Date,
+			ID:                  o.Order.OrderID,
+			Side:                o.Order.Side.String(),
+			Type:                o.Order.Type.String(),
+			Status:              o.Order.Status.String(),
+			Price:               decimal.NewFromFloat(o.Order.Price),
+			Amount:              decimal.NewFromFloat(o.Order.Amount),
+			Fee:                 decimal.NewFromFloat(o.Order.Fee),
+			ClosePrice:          o.ClosePrice,
+			VolumeAdjustedPrice: o.VolumeAdjustedPrice,
+			SlippageRate:        o.SlippageRate,
+			CostBasis:           o.CostBasis,
+		})
+	}
+	for i := range c.UnfilledOrders {
+		o := &c.UnfilledOrders[i]
+		price := o.LimitPrice
+		if price.IsZero() {
+			price = o.TriggerPrice
+		}
+		e.Orders = append(e.Orders, ExportOrder{
+			Exchange: c.Exchange,
+			Asset:    c.Asset,
+			Pair:     c.Currency,
+			Time:     o.Placed,
+			ID:       o.ID,
+			Side:     o.Side.String(),
+			Type:     o.Type.String(),
+			Status:   o.Status.String(),
+			Price:    price,
+			Amount:   o.Amount,
+			Reason:   o.Reason,
+		})
+	}
+
+	result := ExportResult{
+		Exchange:                 c.Exchange,
+		Asset:                    c.Asset,
+		Pair:                     c.Currency,
+		TotalOrders:              c.TotalOrders,
+		MarketMovement:           c.MarketMovement,
+		StrategyMovement:         c.StrategyMovement,
+		RealisedPNL:              c.RealisedPNL,
+		UnrealisedPNL:            c.UnrealisedPNL,
+		TotalFees:                c.TotalFees,
+		MaxDrawdownPercent:       c.MaxDrawdown.DrawdownPercent,
+		CompoundAnnualGrowthRate: c.CompoundAnnualGrowthRate,
+	}
+	result.setRatios(c.ArithmeticRatios, c.GeometricRatios)
+	e.Results = append(e.Results, result)
+}
+
+func (r *ExportResult) setRatios(arithmetic, geometric *statistics.Ratios) {
+	if arithmetic != nil {
+		r.ArithmeticSharpeRatio = arithmetic.SharpeRatio
+		r.ArithmeticSortinoRatio = arithmetic.SortinoRatio
+		r.ArithmeticInformationRatio = arithmetic.InformationRatio
+		r.ArithmeticCalmarRatio = arithmetic.CalmarRatio
+	}
+	if geometric != nil {
+		r.GeometricSharpeRatio = geometric.SharpeRatio
+		r.GeometricSortinoRatio = geometric.SortinoRatio
+		r.GeometricInformationRatio = geometric.InformationRatio
+		r.GeometricCalmarRatio = geometric.CalmarRatio
+	}
+}
+
+// exportResults saves the export in each of the export formats
+// to the output path using the report's file name
+func (d *Data) exportResults(fn string) error {
+	export, err := d.CreateExport()
+	if err != nil {
+		return err
+	}
+	for i := range d.ExportFormats {
+		switch strings.ToLower(d.ExportFormats[i]) {
+		case config.ExportJSON:
+			err = d.saveJSONExport(fn, export)
+		case config.ExportCSV:
+			err = d.saveCSVExport(fn, export)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// saveJSONExport saves the export as a single JSON file
+func (d *Data) saveJSONExport(fn string, export *Export) error {
+	fileName, err := common.GenerateFileName(fn+"-export", config.ExportJSON)
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(export, "", " ")
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filepath.Join(d.OutputPath, fileName), b, file.DefaultPermissionOctal)
+	if err != nil {
+		return err
+	}
+	log.Infof(common.Report, "Successfully exported results to %v", filepath.Join(d.OutputPath, fileName))
+	return nil
+}
+
+// saveCSVExport saves the export as a directory of CSV files,
+// one for each section of the export
+func (d *Data) saveCSVExport(fn string, export *Export) error {
+	fileName, err := common.GenerateFileName(fn+"-export", config.ExportCSV)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join(d.OutputPath, strings.TrimSuffix(fileName, "."+config.ExportCSV))
+	err = os.MkdirAll(dir, file.DefaultPermissionOctal)
+	if err != nil {
+		return err
+	}
+	tables, err := export.csvTables()
+	if err != nil {
+		return err
+	}
+	for name, records := range tables {
+		err = writeCSV(filepath.Join(dir, name+"."+config.ExportCSV), records)
+		if err != nil {
+			return err
+		}
+	}
+	log.Infof(common.Report, "Successfully exported results to %v", dir)
+	return nil
+}
+
+func writeCSV(path string, records [][]string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	w := csv.NewWriter(f)
+	err = w.WriteAll(records)
+	if err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// csvTables converts the export into CSV records keyed by file name.
+// The first record of each table is its header
+func (e *Export) csvTables() (map[string][][]string, error) {
+	cfg, err := json.Marshal(e.Config)
+	if err != nil {
+		return nil, err
+	}
+	tables := map[string][][]string{
+		"run": {
+			{"version", "generated-at", "nickname", "strategy", "config"},
+			{strconv.Itoa(e.Version), formatTime(e.GeneratedAt), e.Nickname, e.Strategy, string(cfg)},
+		},
+		"events": {
+			{"exchange", "asset", "pair", "offset", "time", "open", "high", "low", "close", "volume", "signal", "order", "fill", "reasons"},
+		},
+		"orders": {
+			{"exchange", "asset", "pair", "time", "id", "side", "type", "status", "price", "amount", "fee", "close-price", "volume-adjusted-price", "slippage-rate", "cost-basis", "reason"},
+		},
+		"fills": {
+			{"exchange", "asset", "pair", "offset", "time", "direction", "amount", "close-price", "volume-adjusted-price", "purchase-price", "slippage-rate", "fee", "total", "is-liquidated", "exit-rule", "reasons"},
+		},
+		"holdings": {
+			{"exchange", "asset", "pair", "offset", "time", "base-size", "base-value", "quote-size", "committed-funds", "total-value", "total-fees", "total-value-lost", "change-in-total-value-percent"},
+		},
+		"funding": {
+			{"exchange", "asset", "currency", "time", "available", "usd-close-price", "usd-value"},
+		},
+		"pnl": {
+			{"exchange", "asset", "pair", "offset", "time", "collateral-currency", "direction", "status", "unrealised-pnl", "realised-pnl", "funding-payments"},
+		},
+		"results": {
+			{"exchange", "asset", "pair", "total-orders", "market-movement", "strategy-movement", "realised-pnl", "unrealised-pnl", "total-fees", "max-drawdown-percent", "compound-annual-growth-rate", "arithmetic-sharpe-ratio", "arithmetic-sortino-ratio", "arithmetic-information-ratio", "arithmetic-calmar-ratio", "geometric-sharpe-ratio", "geometric-sortino-ratio", "geometric-information-ratio", "geometric-calmar-ratio"},
+		},
+	}
+	for i := range e.Events {
+		ev := &e.Events[i]
+		tables["events"] = append(tables["events"], []string{
+			ev.Exchange, ev.Asset.String(), ev.Pair.String(), strconv.FormatInt(ev.Offset, 10), formatTime(ev.Time),
+			ev.Open.String(), ev.High.String(), ev.Low.String(), ev.Close.String(), ev.Volume.String(),
+			ev.Signal, ev.Order, ev.Fill, ev.Reasons,
+		})
+	}
+	for i := range e.Orders {
+		o := &e.Orders[i]
+		tables["orders"] = append(tables["orders"], []string{
+			o.Exchange, o.Asset.String(), o.Pair.String(), formatTime(o.Time), o.ID, o.Side, o.Type, o.Status,
+			o.Price.String(), o.Amount.String(), o.Fee.String(), o.ClosePrice.String(), o.VolumeAdjustedPrice.String(),
+			o.SlippageRate.String(), o.CostBasis.String(), o.Reason,
+		})
+	}
+	for i := range e.Fills {
+		f := &e.Fills[i]
+		tables["fills"] = append(tables["fills"], []string{
+			f.Exchange, f.Asset.String(), f.Pair.String(), strconv.FormatInt(f.Offset, 10), formatTime(f.Time), f.Direction,
+			f.Amount.String(), f.ClosePrice.String(), f.VolumeAdjustedPrice.String(), f.PurchasePrice.String(),
+			f.SlippageRate.String(), f.Fee.String(), f.Total.String(), strconv.FormatBool(f.IsLiquidated), f.ExitRule, f.Reasons,
+		})
+	}
+	for i := range e.Holdings {
+		h := &e.Holdings[i]
+		tables["holdings"] = append(tables["holdings"], []string{
+			h.Exchange, h.Asset.String(), h.Pair.String(), strconv.FormatInt(h.Offset, 10), formatTime(h.Time),
+			h.BaseSize.String(), h.BaseValue.String(), h.QuoteSize.String(), h.CommittedFunds.String(),
+			h.TotalValue.String(), h.TotalFees.String(), h.TotalValueLost.String(), h.ChangeInTotalValuePercent.String(),
+		})
+	}
+	for i := range e.Funding {
+		f := &e.Funding[i]
+		tables["funding"] = append(tables["funding"], []string{
+			f.Exchange, f.Asset.String(), f.Currency.String(), formatTime(f.Time),
+			f.Available.String(), f.USDClosePrice.String(), f.USDValue.String(),
+		})
+	}
+	for i := range e.PNL {
+		p := &e.PNL[i]
+		tables["pnl"] = append(tables["pnl"], []string{
+			p.Exchange, p.Asset.String(), p.Pair.String(), strconv.FormatInt(p.Offset, 10), formatTime(p.Time),
+			p.CollateralCurrency.String(), p.Direction, p.Status,
+			p.UnrealisedPNL.String(), p.RealisedPNL.String(), p.FundingPayments.String(),
+		})
+	}
+	results := e.Results
+	if e.Total != nil {
+		results = append(results[:len(results):len(results)], *e.Total)
+	}
+	for i := range results {
+		r := &results[i]
+		var a, p string
+		if r.Exchange != "" {
+			a = r.Asset.String()
+			p = r.Pair.String()
+		}
+		tables["results"] = append(tables["results"], []string{
+			r.Exchange, a, p, strconv.FormatInt(r.TotalOrders, 10),
+			r.MarketMovement.String(), r.StrategyMovement.String(), r.RealisedPNL.String(), r.UnrealisedPNL.String(),
+			r.TotalFees.String(), r.MaxDrawdownPercent.String(), r.CompoundAnnualGrowthRate.String(),
+			r.ArithmeticSharpeRatio.String(), r.ArithmeticSortinoRatio.String(), r.ArithmeticInformationRatio.String(), r.ArithmeticCalmarRatio.String(),
+			r.GeometricSharpeRatio.String(), r.GeometricSortinoRatio.String(), r.GeometricInformationRatio.String(), r.GeometricCalmarRatio.String(),
+		})
+	}
+	return tables, nil
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
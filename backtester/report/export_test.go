@@ -0,0 +1,285 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/config"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/exchange"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/compliance"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/portfolio/holdings"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/statistics"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/event"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/fill"
+	"github.com/thrasher-corp/gocryptotrader/backtester/eventtypes/kline"
+	"github.com/thrasher-corp/gocryptotrader/backtester/funding"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func exportTestData(t *testing.T) *Data {
+	t.Helper()
+	tt := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	b := &event.Base{
+		Exchange:     testExchange,
+		Time:         tt,
+		CurrencyPair: p,
+		AssetType:    asset.Spot,
+	}
+	b2 := &event.Base{
+		Exchange:     testExchange,
+		Offset:       1,
+		Time:         tt.Add(time.Hour),
+		CurrencyPair: p,
+		AssetType:    asset.Spot,
+		Reasons:      []string{"bought"},
+	}
+	return &Data{
+		Config:     &config.Config{Nickname: "test"},
+		OutputPath: t.TempDir(),
+		Statistics: &statistics.Statistic{
+			StrategyName: "testStrat",
+			TotalOrders:  1,
+			ExchangeAssetPairStatistics: map[string]map[asset.Item]map[*currency.Item]map[*currency.Item]*statistics.CurrencyPairStatistic{
+				testExchange: {
+					asset.Spot: {
+						p.Base.Item: {
+							p.Quote.Item: &statistics.CurrencyPairStatistic{
+								Exchange:         testExchange,
+								Asset:            asset.Spot,
+								Currency:         p,
+								TotalOrders:      1,
+								MarketMovement:   decimal.NewFromInt(10),
+								StrategyMovement: decimal.NewFromInt(5),
+								ArithmeticRatios: &statistics.Ratios{SharpeRatio: decimal.NewFromInt(2)},
+								GeometricRatios:  &statistics.Ratios{SharpeRatio: decimal.NewFromInt(1)},
+								Events: []statistics.DataAtOffset{
+									{
+										Time:       tt,
+										ClosePrice: decimal.NewFromInt(100),
+										DataEvent: &kline.Kline{
+											Base:   b,
+											Open:   decimal.NewFromInt(99),
+											High:   decimal.NewFromInt(101),
+											Low:    decimal.NewFromInt(98),
+											Close:  decimal.NewFromInt(100),
+											Volume: decimal.NewFromInt(1337),
+										},
+									},
+									{
+										Offset:     1,
+										Time:       tt.Add(time.Hour),
+										ClosePrice: decimal.NewFromInt(110),
+										FillEvent: &fill.Fill{
+											Base:          b2,
+											Direction:     gctorder.Buy,
+											Amount:        decimal.NewFromInt(1),
+											ClosePrice:    decimal.NewFromInt(110),
+											PurchasePrice: decimal.NewFromInt(110),
+											Total:         decimal.NewFromInt(110),
+										},
+										Holdings: holdings.Holding{
+											Timestamp:  tt.Add(time.Hour),
+											BaseSize:   decimal.NewFromInt(1),
+											TotalValue: decimal.NewFromInt(1000),
+										},
+										PNL: &portfolio.PNLSummary{
+											Result: gctorder.PNLResult{
+												UnrealisedPNL: decimal.NewFromInt(10),
+												Direction:     gctorder.Long,
+											},
+										},
+									},
+								},
+								FinalOrders: compliance.Snapshot{
+									Orders: []compliance.SnapshotOrder{
+										{
+											ClosePrice: decimal.NewFromInt(110),
+											Order: &gctorder.Detail{
+												OrderID: "1",
+												Side:    gctorder.Buy,
+												Type:    gctorder.Market,
+												Price:   110,
+												Amount:  1,
+												Date:    tt.Add(time.Hour),
+											},
+										},
+									},
+								},
+								UnfilledOrders: []exchange.RestingOrder{
+									{
+										ID:         "2",
+										Type:       gctorder.Limit,
+										Side:       gctorder.Sell,
+										Status:     gctorder.Active,
+										LimitPrice: decimal.NewFromInt(200),
+										Amount:     decimal.NewFromInt(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			FundingStatistics: &statistics.FundingStatistics{
+				Items: []statistics.FundingItemStatistics{
+					{
+						ReportItem: &funding.ReportItem{
+							Exchange:  testExchange,
+							Asset:     asset.Spot,
+							Currency:  currency.USDT,
+							Snapshots: []funding.ItemSnapshot{{Time: tt, Available: decimal.NewFromInt(1000)}},
+						},
+					},
+				},
+				TotalUSDStatistics: &statistics.TotalFundingStatistics{
+					HoldingValueDifference: decimal.NewFromInt(5),
+					ArithmeticRatios:       &statistics.Ratios{},
+				},
+			},
+		},
+	}
+}
+
+func TestCreateExport(t *testing.T) {
+	t.Parallel()
+	d := &Data{}
+	_, err := d.CreateExport()
+	if !errors.Is(err, errStatisticsUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errStatisticsUnset)
+	}
+
+	d = exportTestData(t)
+	e, err := d.CreateExport()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if e.Version != ExportVersion {
+		t.Errorf("received '%v' expected '%v'", e.Version, ExportVersion)
+	}
+	if e.Nickname != "test" {
+		t.Errorf("received '%v' expected '%v'", e.Nickname, "test")
+	}
+	if len(e.Events) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(e.Events), 2)
+	}
+	if !e.Events[0].Volume.Equal(decimal.NewFromInt(1337)) {
+		t.Errorf("received '%v' expected '%v'", e.Events[0].Volume, 1337)
+	}
+	if e.Events[1].Fill != gctorder.Buy.String() {
+		t.Errorf("received '%v' expected '%v'", e.Events[1].Fill, gctorder.Buy)
+	}
+	if len(e.Fills) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(e.Fills), 1)
+	}
+	if len(e.Orders) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(e.Orders), 2)
+	}
+	if e.Orders[1].Status != gctorder.Active.String() {
+		t.Errorf("received '%v' expected '%v'", e.Orders[1].Status, gctorder.Active)
+	}
+	if !e.Orders[1].Price.Equal(decimal.NewFromInt(200)) {
+		t.Errorf("received '%v' expected '%v'", e.Orders[1].Price, 200)
+	}
+	if len(e.Holdings) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(e.Holdings), 1)
+	}
+	if len(e.PNL) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(e.PNL), 1)
+	}
+	if !e.PNL[0].UnrealisedPNL.Equal(decimal.NewFromInt(10)) {
+		t.Errorf("received '%v' expected '%v'", e.PNL[0].UnrealisedPNL, 10)
+	}
+	if len(e.Funding) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(e.Funding), 1)
+	}
+	if len(e.Results) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(e.Results), 1)
+	}
+	if !e.Results[0].ArithmeticSharpeRatio.Equal(decimal.NewFromInt(2)) {
+		t.Errorf("received '%v' expected '%v'", e.Results[0].ArithmeticSharpeRatio, 2)
+	}
+	if e.Total == nil || !e.Total.StrategyMovement.Equal(decimal.NewFromInt(5)) {
+		t.Errorf("received '%v' expected total strategy movement of 5", e.Total)
+	}
+}
+
+func TestExportResults(t *testing.T) {
+	t.Parallel()
+	d := exportTestData(t)
+	d.ExportFormats = []string{config.ExportJSON, "CSV"}
+	err := d.GenerateReport()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	jsonFiles, err := filepath.Glob(filepath.Join(d.OutputPath, "*-export.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jsonFiles) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(jsonFiles), 1)
+	}
+	b, err := os.ReadFile(jsonFiles[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var e Export
+	err = json.Unmarshal(b, &e)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if e.Version != ExportVersion {
+		t.Errorf("received '%v' expected '%v'", e.Version, ExportVersion)
+	}
+	if len(e.Events) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(e.Events), 2)
+	}
+
+	dir := filepath.Join(d.OutputPath, filepath.Base(jsonFiles[0][:len(jsonFiles[0])-len(".json")]))
+	expected := map[string]int{
+		"run":      2,
+		"events":   3,
+		"orders":   3,
+		"fills":    2,
+		"holdings": 2,
+		"funding":  2,
+		"pnl":      2,
+		"results":  3,
+	}
+	for name, rows := range expected {
+		f, err := os.Open(filepath.Join(dir, name+".csv"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		records, err := csv.NewReader(f).ReadAll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = f.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(records) != rows {
+			t.Errorf("%v received '%v' records expected '%v'", name, len(records), rows)
+		}
+	}
+
+	// the html report is not generated without a template
+	htmlFiles, err := filepath.Glob(filepath.Join(d.OutputPath, "*.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(htmlFiles) != 0 {
+		t.Errorf("received '%v' expected '%v'", len(htmlFiles), 0)
+	}
+}
@@ -1,6 +1,7 @@
 package report
 
 import (
+	"encoding/json"
 	"fmt"
 	"html/template"
 	"os"
@@ -10,15 +11,32 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
 // GenerateReport sends final data from statistics to a template
-// to create a lovely final report for someone to view
+// to create a lovely final report for someone to view.
+// Results are also exported in any of the export formats
 func (d *Data) GenerateReport() error {
-	if d.TemplatePath == "" || d.OutputPath == "" {
+	if d.OutputPath == "" || (d.TemplatePath == "" && len(d.ExportFormats) == 0) {
+		return nil
+	}
+	fn := d.Config.Nickname
+	if fn != "" {
+		fn += "-"
+	}
+	fn += d.Statistics.StrategyName + "-"
+	fn += time.Now().Format("2006-01-02-15-04-05")
+	if len(d.ExportFormats) > 0 {
+		err := d.exportResults(fn)
+		if err != nil {
+			return err
+		}
+	}
+	if d.TemplatePath == "" {
 		return nil
 	}
 	log.Infoln(common.Report, "Generating report")
@@ -69,16 +87,16 @@ func (d *Data) GenerateReport() error {
 			return err
 		}
 	}
+	if d.Optimisation != nil {
+		d.Optimisation.Heatmap = createOptimisationHeatmap(d.Optimisation)
+	}
+	if d.WalkForward != nil {
+		d.WalkForward.EquityChart = createWalkForwardChart(d.WalkForward.EquityCurve)
+	}
+	d.MonteCarloCharts = createMonteCarloCharts(d.Statistics.MonteCarlo)
 	tmpl := template.Must(
 		template.ParseFiles(d.TemplatePath),
 	)
-	fn := d.Config.Nickname
-	if fn != "" {
-		fn += "-"
-	}
-	fn += d.Statistics.StrategyName + "-"
-	fn += time.Now().Format("2006-01-02-15-04-05")
-
 	fileName, err := common.GenerateFileName(fn, "html")
 	if err != nil {
 		return err
@@ -104,6 +122,28 @@ func (d *Data) GenerateReport() error {
 		return err
 	}
 	log.Infof(common.Report, "Successfully saved report to %v", filepath.Join(d.OutputPath, fileName))
+	if d.Statistics.MonteCarlo != nil {
+		return d.saveMonteCarloResults(fn)
+	}
+	return nil
+}
+
+// saveMonteCarloResults exports the monte carlo results as JSON
+// alongside the report
+func (d *Data) saveMonteCarloResults(fn string) error {
+	fileName, err := common.GenerateFileName(fn+"-monte-carlo", "json")
+	if err != nil {
+		return err
+	}
+	b, err := json.MarshalIndent(d.Statistics.MonteCarlo, "", " ")
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(filepath.Join(d.OutputPath, fileName), b, file.DefaultPermissionOctal)
+	if err != nil {
+		return err
+	}
+	log.Infof(common.Report, "Successfully saved monte carlo results to %v", filepath.Join(d.OutputPath, fileName))
 	return nil
 }
 
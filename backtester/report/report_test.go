@@ -312,6 +312,90 @@ func TestGenerateReport(t *testing.T) {
 			},
 		},
 	}
+	d.Optimisation = &OptimisationResults{
+		Method:     "grid",
+		Metric:     "sharpe-ratio",
+		Parameters: []string{"rsi-period", "rsi-low"},
+		Runs: []OptimisationRun{
+			{
+				Rank:       1,
+				Parameters: []interface{}{14.0, 30.0},
+				Summary:    &statistics.PerformanceSummary{SharpeRatio: decimal.NewFromInt(2)},
+				Score:      decimal.NewFromInt(2),
+			},
+			{
+				Parameters: []interface{}{7.0, 30.0},
+				Error:      "no data",
+			},
+		},
+	}
+	d.Statistics.MonteCarlo = &statistics.MonteCarloResults{
+		Method:               "shuffle",
+		Sample:               "returns",
+		Simulations:          1000,
+		SampleSize:           2,
+		Seed:                 1337,
+		ConfidenceLevel:      decimal.NewFromInt(95),
+		RuinThresholdPercent: decimal.NewFromInt(50),
+		FinalReturn: statistics.MonteCarloDistribution{
+			Original:  decimal.NewFromInt(5),
+			Histogram: []statistics.HistogramBin{{Minimum: decimal.NewFromInt(4), Maximum: decimal.NewFromInt(6), Count: 1000}},
+		},
+		MaxDrawdown: statistics.MonteCarloDistribution{
+			Original:  decimal.NewFromInt(-2),
+			Histogram: []statistics.HistogramBin{{Minimum: decimal.NewFromInt(-3), Maximum: decimal.NewFromInt(-1), Count: 1000}},
+		},
+	}
+	d.Statistics.RiskMetrics = &statistics.RiskMetrics{
+		ConfidenceLevel:      decimal.NewFromInt(95),
+		ValueAtRisk:          decimal.NewFromInt(2),
+		OmegaRatio:           decimal.NewFromFloat(1.5),
+		LongestWinningStreak: 3,
+		AnnualReturns: []statistics.AnnualReturns{
+			{
+				Year:   2020,
+				Months: [12]statistics.PeriodReturn{{Return: decimal.NewFromInt(5), Set: true}},
+				Total:  decimal.NewFromInt(5),
+			},
+		},
+	}
+	d.Statistics.Benchmark = &statistics.BenchmarkResults{
+		Name:            "equal-weight basket",
+		StrategyReturn:  decimal.NewFromInt(5),
+		BenchmarkReturn: decimal.NewFromInt(3),
+		Beta:            decimal.NewFromFloat(0.5),
+		BeatBenchmark:   true,
+	}
+	d.WalkForward = &WalkForwardResults{
+		Method:      "grid",
+		Metric:      "sharpe-ratio",
+		Parameters:  []string{"rsi-period"},
+		TotalReturn: decimal.NewFromInt(5),
+		MaxDrawdown: decimal.NewFromInt(-2),
+		Windows: []WalkForwardWindow{
+			{
+				InSampleStart:    time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+				InSampleEnd:      time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+				OutOfSampleStart: time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+				OutOfSampleEnd:   time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC),
+				Parameters:       []interface{}{14.0},
+				InSampleScore:    decimal.NewFromInt(2),
+				OutOfSampleScore: decimal.NewFromInt(1),
+				OutOfSample:      &statistics.PerformanceSummary{SharpeRatio: decimal.NewFromInt(1)},
+			},
+			{
+				InSampleStart:    time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+				InSampleEnd:      time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC),
+				OutOfSampleStart: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC),
+				OutOfSampleEnd:   time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC),
+				Error:            "no data",
+			},
+		},
+		EquityCurve: []statistics.ValueAtTime{
+			{Time: time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC), Value: decimal.NewFromInt(100)},
+			{Time: time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC), Value: decimal.NewFromInt(105)},
+		},
+	}
 	if err := d.GenerateReport(); err != nil {
 		t.Error(err)
 	}
@@ -2,6 +2,7 @@ package report
 
 import (
 	"errors"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/thrasher-corp/gocryptotrader/backtester/config"
@@ -16,6 +17,11 @@ import (
 // lightweight charts can only render 1100 candles
 const maxChartLimit = 1100
 
+// ExportVersion is the version of the export schema. It is incremented
+// whenever a field is changed or removed so that consumers can detect
+// exports they do not support
+const ExportVersion = 1
+
 var (
 	errNoCandles       = errors.New("no candles to enhance")
 	errStatisticsUnset = errors.New("unable to proceed with unset Statistics property")
@@ -26,6 +32,8 @@ type Handler interface {
 	GenerateReport() error
 	SetKlineData(*kline.Item) error
 	UseDarkMode(bool)
+	SetExportFormats([]string)
+	CreateExport() (*Export, error)
 }
 
 // Data holds all statistical information required to output detailed backtesting results
@@ -42,9 +50,159 @@ type Data struct {
 	HoldingsOverTimeChart *Chart
 	PNLOverTimeChart      *Chart
 	FuturesSpotDiffChart  *Chart
+	Optimisation          *OptimisationResults
+	WalkForward           *WalkForwardResults
+	MonteCarloCharts      []*HistogramChart
+	ExportFormats         []string
 	Prettify              PrettyNumbers
 }
 
+// Export holds the full results of a strategy run in a stable,
+// versioned format for analysis outside of the backtester
+type Export struct {
+	Version     int             `json:"version"`
+	GeneratedAt time.Time       `json:"generated-at"`
+	Nickname    string          `json:"nickname"`
+	Strategy    string          `json:"strategy"`
+	Seed        int64           `json:"seed"`
+	Config      *config.Config  `json:"config"`
+	Events      []ExportEvent   `json:"events"`
+	Orders      []ExportOrder   `json:"orders"`
+	Fills       []ExportFill    `json:"fills"`
+	Holdings    []ExportHolding `json:"holdings"`
+	Funding     []ExportFunding `json:"funding"`
+	PNL         []ExportPNL     `json:"pnl"`
+	Results     []ExportResult  `json:"results"`
+	Total       *ExportResult   `json:"total,omitempty"`
+}
+
+// ExportEvent holds the data, signal, order and fill
+// of an exchange asset pair at a time
+type ExportEvent struct {
+	Exchange string          `json:"exchange"`
+	Asset    asset.Item      `json:"asset"`
+	Pair     currency.Pair   `json:"pair"`
+	Offset   int64           `json:"offset"`
+	Time     time.Time       `json:"time"`
+	Open     decimal.Decimal `json:"open"`
+	High     decimal.Decimal `json:"high"`
+	Low      decimal.Decimal `json:"low"`
+	Close    decimal.Decimal `json:"close"`
+	Volume   decimal.Decimal `json:"volume"`
+	Signal   string          `json:"signal"`
+	Order    string          `json:"order"`
+	Fill     string          `json:"fill"`
+	Reasons  string          `json:"reasons"`
+}
+
+// ExportOrder holds an order placed during the run. Orders which were
+// never filled have no fill details and a reason where one is known
+type ExportOrder struct {
+	Exchange            string          `json:"exchange"`
+	Asset               asset.Item      `json:"asset"`
+	Pair                currency.Pair   `json:"pair"`
+	Time                time.Time       `json:"time"`
+	ID                  string          `json:"id"`
+	Side                string          `json:"side"`
+	Type                string          `json:"type"`
+	Status              string          `json:"status"`
+	Price               decimal.Decimal `json:"price"`
+	Amount              decimal.Decimal `json:"amount"`
+	Fee                 decimal.Decimal `json:"fee"`
+	ClosePrice          decimal.Decimal `json:"close-price"`
+	VolumeAdjustedPrice decimal.Decimal `json:"volume-adjusted-price"`
+	SlippageRate        decimal.Decimal `json:"slippage-rate"`
+	CostBasis           decimal.Decimal `json:"cost-basis"`
+	Reason              string          `json:"reason,omitempty"`
+}
+
+// ExportFill holds the details of a filled order
+type ExportFill struct {
+	Exchange            string          `json:"exchange"`
+	Asset               asset.Item      `json:"asset"`
+	Pair                currency.Pair   `json:"pair"`
+	Offset              int64           `json:"offset"`
+	Time                time.Time       `json:"time"`
+	Direction           string          `json:"direction"`
+	Amount              decimal.Decimal `json:"amount"`
+	ClosePrice          decimal.Decimal `json:"close-price"`
+	VolumeAdjustedPrice decimal.Decimal `json:"volume-adjusted-price"`
+	PurchasePrice       decimal.Decimal `json:"purchase-price"`
+	SlippageRate        decimal.Decimal `json:"slippage-rate"`
+	Fee                 decimal.Decimal `json:"fee"`
+	Total               decimal.Decimal `json:"total"`
+	IsLiquidated        bool            `json:"is-liquidated"`
+	ExitRule            string          `json:"exit-rule,omitempty"`
+	Reasons             string          `json:"reasons"`
+}
+
+// ExportHolding holds the holdings of an exchange asset pair at a time
+type ExportHolding struct {
+	Exchange                  string          `json:"exchange"`
+	Asset                     asset.Item      `json:"asset"`
+	Pair                      currency.Pair   `json:"pair"`
+	Offset                    int64           `json:"offset"`
+	Time                      time.Time       `json:"time"`
+	BaseSize                  decimal.Decimal `json:"base-size"`
+	BaseValue                 decimal.Decimal `json:"base-value"`
+	QuoteSize                 decimal.Decimal `json:"quote-size"`
+	CommittedFunds            decimal.Decimal `json:"committed-funds"`
+	TotalValue                decimal.Decimal `json:"total-value"`
+	TotalFees                 decimal.Decimal `json:"total-fees"`
+	TotalValueLost            decimal.Decimal `json:"total-value-lost"`
+	ChangeInTotalValuePercent decimal.Decimal `json:"change-in-total-value-percent"`
+}
+
+// ExportFunding holds a snapshot of a funding item at a time
+type ExportFunding struct {
+	Exchange      string          `json:"exchange"`
+	Asset         asset.Item      `json:"asset"`
+	Currency      currency.Code   `json:"currency"`
+	Time          time.Time       `json:"time"`
+	Available     decimal.Decimal `json:"available"`
+	USDClosePrice decimal.Decimal `json:"usd-close-price"`
+	USDValue      decimal.Decimal `json:"usd-value"`
+}
+
+// ExportPNL holds the PNL of a position at a time
+type ExportPNL struct {
+	Exchange           string          `json:"exchange"`
+	Asset              asset.Item      `json:"asset"`
+	Pair               currency.Pair   `json:"pair"`
+	Offset             int64           `json:"offset"`
+	Time               time.Time       `json:"time"`
+	CollateralCurrency currency.Code   `json:"collateral-currency"`
+	Direction          string          `json:"direction"`
+	Status             string          `json:"status"`
+	UnrealisedPNL      decimal.Decimal `json:"unrealised-pnl"`
+	RealisedPNL        decimal.Decimal `json:"realised-pnl"`
+	FundingPayments    decimal.Decimal `json:"funding-payments"`
+}
+
+// ExportResult holds the final results and ratios of an exchange asset
+// pair. The total result of the run has no exchange, asset or pair
+type ExportResult struct {
+	Exchange                   string          `json:"exchange,omitempty"`
+	Asset                      asset.Item      `json:"asset,omitempty"`
+	Pair                       currency.Pair   `json:"pair"`
+	TotalOrders                int64           `json:"total-orders"`
+	MarketMovement             decimal.Decimal `json:"market-movement"`
+	StrategyMovement           decimal.Decimal `json:"strategy-movement"`
+	RealisedPNL                decimal.Decimal `json:"realised-pnl"`
+	UnrealisedPNL              decimal.Decimal `json:"unrealised-pnl"`
+	TotalFees                  decimal.Decimal `json:"total-fees"`
+	MaxDrawdownPercent         decimal.Decimal `json:"max-drawdown-percent"`
+	CompoundAnnualGrowthRate   decimal.Decimal `json:"compound-annual-growth-rate"`
+	ArithmeticSharpeRatio      decimal.Decimal `json:"arithmetic-sharpe-ratio"`
+	ArithmeticSortinoRatio     decimal.Decimal `json:"arithmetic-sortino-ratio"`
+	ArithmeticInformationRatio decimal.Decimal `json:"arithmetic-information-ratio"`
+	ArithmeticCalmarRatio      decimal.Decimal `json:"arithmetic-calmar-ratio"`
+	GeometricSharpeRatio       decimal.Decimal `json:"geometric-sharpe-ratio"`
+	GeometricSortinoRatio      decimal.Decimal `json:"geometric-sortino-ratio"`
+	GeometricInformationRatio  decimal.Decimal `json:"geometric-information-ratio"`
+	GeometricCalmarRatio       decimal.Decimal `json:"geometric-calmar-ratio"`
+}
+
 // Chart holds chart data along with an axis
 type Chart struct {
 	AxisType           string
@@ -59,6 +217,15 @@ type ChartLine struct {
 	LinePlots []LinePlot
 }
 
+// HistogramChart holds the count of values
+// in each bin of a distribution
+type HistogramChart struct {
+	ID         string
+	Name       string
+	Categories []string
+	Counts     []int64
+}
+
 // LinePlot holds value data
 // for a chart
 type LinePlot struct {
@@ -67,6 +234,74 @@ type LinePlot struct {
 	Flag      string
 }
 
+// OptimisationResults holds the ranked strategy runs of an optimisation
+type OptimisationResults struct {
+	Method     string
+	Metric     string
+	Parameters []string
+	Runs       []OptimisationRun
+	Heatmap    *Heatmap
+}
+
+// OptimisationRun holds the result of a strategy run in an optimisation.
+// Parameter values are in the order of the optimisation's parameters
+type OptimisationRun struct {
+	Rank       int
+	Parameters []interface{}
+	Summary    *statistics.PerformanceSummary
+	Score      decimal.Decimal
+	Error      string
+}
+
+// WalkForwardResults holds the out-of-sample results of a walk-forward
+// analysis, stitched together into one equity curve
+type WalkForwardResults struct {
+	Method      string
+	Metric      string
+	Parameters  []string
+	Windows     []WalkForwardWindow
+	TotalReturn decimal.Decimal
+	MaxDrawdown decimal.Decimal
+	EquityCurve []statistics.ValueAtTime
+	EquityChart *Chart
+}
+
+// WalkForwardWindow holds the optimised parameters of an in-sample window
+// and the results of running them over the out-of-sample window
+type WalkForwardWindow struct {
+	InSampleStart    time.Time
+	InSampleEnd      time.Time
+	OutOfSampleStart time.Time
+	OutOfSampleEnd   time.Time
+	Parameters       []interface{}
+	InSampleScore    decimal.Decimal
+	OutOfSampleScore decimal.Decimal
+	OutOfSample      *statistics.PerformanceSummary
+	Error            string
+}
+
+// Heatmap holds the best score for each combination
+// of the first two optimisation parameters
+type Heatmap struct {
+	XAxisName string
+	YAxisName string
+	XValues   []string
+	Rows      []HeatmapRow
+}
+
+// HeatmapRow holds the heatmap cells for a value of the y axis parameter
+type HeatmapRow struct {
+	Value string
+	Cells []HeatmapCell
+}
+
+// HeatmapCell holds the best score for a combination of parameter values
+type HeatmapCell struct {
+	HasScore bool
+	Score    decimal.Decimal
+	Colour   string
+}
+
 // Warning holds any candle warnings
 type Warning struct {
 	Exchange string
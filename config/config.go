@@ -1216,8 +1216,13 @@ func (c *Config) checkGCTScriptConfig() error {
 		c.GCTScript.MaxVirtualMachines = gctscript.DefaultMaxVirtualMachines
 	}
 
+	err := c.GCTScript.ValidateLimits()
+	if err != nil {
+		return err
+	}
+
 	scriptPath := c.GetDataPath("scripts")
-	err := common.CreateDir(scriptPath)
+	err = common.CreateDir(scriptPath)
 	if err != nil {
 		return err
 	}
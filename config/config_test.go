@@ -1988,6 +1988,11 @@ func TestCheckGCTScriptConfig(t *testing.T) {
 	if c.GCTScript.MaxVirtualMachines != gctscript.DefaultMaxVirtualMachines {
 		t.Fatal("unexpected value return")
 	}
+
+	c.GCTScript.DefaultLimits = &gctscript.Limits{Capabilities: []string{"teleport"}}
+	if err := c.checkGCTScriptConfig(); err == nil {
+		t.Error("expected error on unknown capability")
+	}
 }
 
 func TestCheckDatabaseConfig(t *testing.T) {
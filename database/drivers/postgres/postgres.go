@@ -13,6 +13,16 @@ import (
 
 // Connect opens a connection to Postgres database and returns a pointer to database.DB
 func Connect(cfg *database.Config) (*database.Instance, error) {
+	return ConnectInstance(database.DB, cfg)
+}
+
+// ConnectInstance opens a connection to Postgres database and assigns the
+// connection to the supplied instance. This allows for connections which are
+// independent of the global database.DB
+func ConnectInstance(instance *database.Instance, cfg *database.Config) (*database.Instance, error) {
+	if instance == nil {
+		return nil, database.ErrNilInstance
+	}
 	if cfg == nil {
 		return nil, database.ErrNilConfig
 	}
@@ -35,9 +45,9 @@ func Connect(cfg *database.Config) (*database.Instance, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = database.DB.SetPostgresConnection(db)
+	err = instance.SetPostgresConnection(db)
 	if err != nil {
 		return nil, err
 	}
-	return database.DB, nil
+	return instance, nil
 }
@@ -11,20 +11,30 @@ import (
 
 // Connect opens a connection to sqlite database and returns a pointer to database.DB
 func Connect(db string) (*database.Instance, error) {
+	return ConnectInstance(database.DB, db)
+}
+
+// ConnectInstance opens a connection to sqlite database using the supplied
+// instance's data path and assigns the connection to that instance. This allows
+// for connections which are independent of the global database.DB
+func ConnectInstance(instance *database.Instance, db string) (*database.Instance, error) {
+	if instance == nil {
+		return nil, database.ErrNilInstance
+	}
 	if db == "" {
 		return nil, database.ErrNoDatabaseProvided
 	}
 
-	databaseFullLocation := filepath.Join(database.DB.DataPath, db)
+	databaseFullLocation := filepath.Join(instance.DataPath, db)
 	dbConn, err := sql.Open("sqlite3", databaseFullLocation)
 	if err != nil {
 		return nil, err
 	}
 
-	err = database.DB.SetSQLiteConnection(dbConn)
+	err = instance.SetSQLiteConnection(dbConn)
 	if err != nil {
 		return nil, err
 	}
 
-	return database.DB, nil
+	return instance, nil
 }
@@ -0,0 +1,40 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS backtest_run
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    nickname varchar NOT NULL,
+    strategy varchar NOT NULL,
+    config_hash varchar(64) NOT NULL,
+    config TEXT NOT NULL,
+    parameters TEXT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    total_return DOUBLE PRECISION NOT NULL,
+    max_drawdown DOUBLE PRECISION NOT NULL,
+    sharpe_ratio DOUBLE PRECISION NOT NULL,
+    sortino_ratio DOUBLE PRECISION NOT NULL,
+    calmar_ratio DOUBLE PRECISION NOT NULL,
+    equity_curve TEXT NOT NULL,
+    report TEXT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE INDEX IF NOT EXISTS backtest_run_strategy ON backtest_run(strategy);
+CREATE INDEX IF NOT EXISTS backtest_run_config_hash ON backtest_run(config_hash);
+
+CREATE TABLE IF NOT EXISTS backtest_run_trade
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    backtest_run_id uuid NOT NULL REFERENCES backtest_run(id) ON DELETE CASCADE,
+    exchange varchar NOT NULL,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    side varchar NOT NULL,
+    price DOUBLE PRECISION NOT NULL,
+    amount DOUBLE PRECISION NOT NULL,
+    fee DOUBLE PRECISION NOT NULL,
+    timestamp TIMESTAMPTZ NOT NULL
+);
+-- +goose Down
+DROP TABLE backtest_run_trade;
+DROP TABLE backtest_run;
@@ -0,0 +1,44 @@
+-- +goose Up
+CREATE TABLE backtest_run
+(
+    id text NOT NULL primary key,
+    nickname text NOT NULL,
+    strategy text NOT NULL,
+    config_hash text NOT NULL,
+    config text NOT NULL,
+    parameters text NULL,
+    start_time timestamp NOT NULL,
+    end_time timestamp NOT NULL,
+    total_return real NOT NULL,
+    max_drawdown real NOT NULL,
+    sharpe_ratio real NOT NULL,
+    sortino_ratio real NOT NULL,
+    calmar_ratio real NOT NULL,
+    equity_curve text NOT NULL,
+    report text NULL,
+    created_at timestamp NOT NULL default CURRENT_TIMESTAMP,
+    UNIQUE(id) ON CONFLICT REPLACE
+);
+CREATE INDEX backtest_run_strategy ON backtest_run(strategy);
+CREATE INDEX backtest_run_config_hash ON backtest_run(config_hash);
+
+CREATE TABLE backtest_run_trade
+(
+    id text NOT NULL primary key,
+    backtest_run_id text NOT NULL,
+    exchange text NOT NULL,
+    asset text NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    side text NOT NULL,
+    price real NOT NULL,
+    amount real NOT NULL,
+    fee real NOT NULL,
+    timestamp timestamp NOT NULL,
+    UNIQUE(id) ON CONFLICT REPLACE,
+    FOREIGN KEY(backtest_run_id) REFERENCES backtest_run(id) ON DELETE CASCADE
+);
+
+-- +goose Down
+DROP TABLE backtest_run_trade;
+DROP TABLE backtest_run;
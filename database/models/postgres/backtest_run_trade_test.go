@@ -0,0 +1,841 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testBacktestRunTrades(t *testing.T) {
+	t.Parallel()
+
+	query := BacktestRunTrades()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testBacktestRunTradesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BacktestRunTrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBacktestRunTradesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := BacktestRunTrades().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BacktestRunTrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBacktestRunTradesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BacktestRunTradeSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BacktestRunTrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBacktestRunTradesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := BacktestRunTradeExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if BacktestRunTrade exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected BacktestRunTradeExists to return true, but got false.")
+	}
+}
+
+func testBacktestRunTradesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	backtestRunTradeFound, err := FindBacktestRunTrade(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if backtestRunTradeFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testBacktestRunTradesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = BacktestRunTrades().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBacktestRunTradesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := BacktestRunTrades().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testBacktestRunTradesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	backtestRunTradeOne := &BacktestRunTrade{}
+	backtestRunTradeTwo := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, backtestRunTradeOne, backtestRunTradeDBTypes, false, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+	if err = randomize.Struct(seed, backtestRunTradeTwo, backtestRunTradeDBTypes, false, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = backtestRunTradeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = backtestRunTradeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BacktestRunTrades().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testBacktestRunTradesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	backtestRunTradeOne := &BacktestRunTrade{}
+	backtestRunTradeTwo := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, backtestRunTradeOne, backtestRunTradeDBTypes, false, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+	if err = randomize.Struct(seed, backtestRunTradeTwo, backtestRunTradeDBTypes, false, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = backtestRunTradeOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = backtestRunTradeTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BacktestRunTrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func backtestRunTradeBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRunTrade) error {
+	*o = BacktestRunTrade{}
+	return nil
+}
+
+func backtestRunTradeAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRunTrade) error {
+	*o = BacktestRunTrade{}
+	return nil
+}
+
+func backtestRunTradeAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRunTrade) error {
+	*o = BacktestRunTrade{}
+	return nil
+}
+
+func backtestRunTradeBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRunTrade) error {
+	*o = BacktestRunTrade{}
+	return nil
+}
+
+func backtestRunTradeAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRunTrade) error {
+	*o = BacktestRunTrade{}
+	return nil
+}
+
+func backtestRunTradeBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRunTrade) error {
+	*o = BacktestRunTrade{}
+	return nil
+}
+
+func backtestRunTradeAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRunTrade) error {
+	*o = BacktestRunTrade{}
+	return nil
+}
+
+func backtestRunTradeBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRunTrade) error {
+	*o = BacktestRunTrade{}
+	return nil
+}
+
+func backtestRunTradeAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRunTrade) error {
+	*o = BacktestRunTrade{}
+	return nil
+}
+
+func testBacktestRunTradesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &BacktestRunTrade{}
+	o := &BacktestRunTrade{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade object: %s", err)
+	}
+
+	AddBacktestRunTradeHook(boil.BeforeInsertHook, backtestRunTradeBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	backtestRunTradeBeforeInsertHooks = []BacktestRunTradeHook{}
+
+	AddBacktestRunTradeHook(boil.AfterInsertHook, backtestRunTradeAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	backtestRunTradeAfterInsertHooks = []BacktestRunTradeHook{}
+
+	AddBacktestRunTradeHook(boil.AfterSelectHook, backtestRunTradeAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	backtestRunTradeAfterSelectHooks = []BacktestRunTradeHook{}
+
+	AddBacktestRunTradeHook(boil.BeforeUpdateHook, backtestRunTradeBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	backtestRunTradeBeforeUpdateHooks = []BacktestRunTradeHook{}
+
+	AddBacktestRunTradeHook(boil.AfterUpdateHook, backtestRunTradeAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	backtestRunTradeAfterUpdateHooks = []BacktestRunTradeHook{}
+
+	AddBacktestRunTradeHook(boil.BeforeDeleteHook, backtestRunTradeBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	backtestRunTradeBeforeDeleteHooks = []BacktestRunTradeHook{}
+
+	AddBacktestRunTradeHook(boil.AfterDeleteHook, backtestRunTradeAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	backtestRunTradeAfterDeleteHooks = []BacktestRunTradeHook{}
+
+	AddBacktestRunTradeHook(boil.BeforeUpsertHook, backtestRunTradeBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	backtestRunTradeBeforeUpsertHooks = []BacktestRunTradeHook{}
+
+	AddBacktestRunTradeHook(boil.AfterUpsertHook, backtestRunTradeAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	backtestRunTradeAfterUpsertHooks = []BacktestRunTradeHook{}
+}
+
+func testBacktestRunTradesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BacktestRunTrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBacktestRunTradesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(backtestRunTradeColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BacktestRunTrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBacktestRunTradeToOneBacktestRunUsingBacktestRun(t *testing.T) {
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var local BacktestRunTrade
+	var foreign BacktestRun
+
+	seed := randomize.NewSeed()
+	if err := randomize.Struct(seed, &local, backtestRunTradeDBTypes, false, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+	if err := randomize.Struct(seed, &foreign, backtestRunDBTypes, false, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	if err := foreign.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	local.BacktestRunID = foreign.ID
+	if err := local.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := local.BacktestRun().One(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if check.ID != foreign.ID {
+		t.Errorf("want: %v, got %v", foreign.ID, check.ID)
+	}
+
+	slice := BacktestRunTradeSlice{&local}
+	if err = local.L.LoadBacktestRun(ctx, tx, false, (*[]*BacktestRunTrade)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.BacktestRun == nil {
+		t.Error("struct should have been eager loaded")
+	}
+
+	local.R.BacktestRun = nil
+	if err = local.L.LoadBacktestRun(ctx, tx, true, &local, nil); err != nil {
+		t.Fatal(err)
+	}
+	if local.R.BacktestRun == nil {
+		t.Error("struct should have been eager loaded")
+	}
+}
+
+func testBacktestRunTradeToOneSetOpBacktestRunUsingBacktestRun(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BacktestRunTrade
+	var b, c BacktestRun
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, backtestRunTradeDBTypes, false, strmangle.SetComplement(backtestRunTradePrimaryKeyColumns, backtestRunTradeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &b, backtestRunDBTypes, false, strmangle.SetComplement(backtestRunPrimaryKeyColumns, backtestRunColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, backtestRunDBTypes, false, strmangle.SetComplement(backtestRunPrimaryKeyColumns, backtestRunColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	for i, x := range []*BacktestRun{&b, &c} {
+		err = a.SetBacktestRun(ctx, tx, i != 0, x)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if a.R.BacktestRun != x {
+			t.Error("relationship struct not set to correct value")
+		}
+
+		if x.R.BacktestRunTrades[0] != &a {
+			t.Error("failed to append to foreign relationship struct")
+		}
+		if a.BacktestRunID != x.ID {
+			t.Error("foreign key was wrong value", a.BacktestRunID)
+		}
+
+		zero := reflect.Zero(reflect.TypeOf(a.BacktestRunID))
+		reflect.Indirect(reflect.ValueOf(&a.BacktestRunID)).Set(zero)
+
+		if err = a.Reload(ctx, tx); err != nil {
+			t.Fatal("failed to reload", err)
+		}
+
+		if a.BacktestRunID != x.ID {
+			t.Error("foreign key was wrong value", a.BacktestRunID, x.ID)
+		}
+	}
+}
+
+func testBacktestRunTradesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBacktestRunTradesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BacktestRunTradeSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBacktestRunTradesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BacktestRunTrades().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	backtestRunTradeDBTypes = map[string]string{`ID`: `uuid`, `BacktestRunID`: `uuid`, `Exchange`: `character varying`, `Asset`: `character varying`, `Base`: `character varying`, `Quote`: `character varying`, `Side`: `character varying`, `Price`: `double precision`, `Amount`: `double precision`, `Fee`: `double precision`, `Timestamp`: `timestamp with time zone`}
+	_                       = bytes.MinRead
+)
+
+func testBacktestRunTradesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(backtestRunTradePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(backtestRunTradeAllColumns) == len(backtestRunTradePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BacktestRunTrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testBacktestRunTradesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(backtestRunTradeAllColumns) == len(backtestRunTradePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRunTrade{}
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BacktestRunTrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, backtestRunTradeDBTypes, true, backtestRunTradePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(backtestRunTradeAllColumns, backtestRunTradePrimaryKeyColumns) {
+		fields = backtestRunTradeAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			backtestRunTradeAllColumns,
+			backtestRunTradePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := BacktestRunTradeSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testBacktestRunTradesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(backtestRunTradeAllColumns) == len(backtestRunTradePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := BacktestRunTrade{}
+	if err = randomize.Struct(seed, &o, backtestRunTradeDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert BacktestRunTrade: %s", err)
+	}
+
+	count, err := BacktestRunTrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, backtestRunTradeDBTypes, false, backtestRunTradePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BacktestRunTrade struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert BacktestRunTrade: %s", err)
+	}
+
+	count, err = BacktestRunTrades().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
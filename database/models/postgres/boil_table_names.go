@@ -5,6 +5,8 @@ package postgres
 
 var TableNames = struct {
 	AuditEvent              string
+	BacktestRun             string
+	BacktestRunTrade        string
 	Candle                  string
 	Datahistoryjob          string
 	Datahistoryjobrelations string
@@ -18,6 +20,8 @@ var TableNames = struct {
 	WithdrawalHistory       string
 }{
 	AuditEvent:              "audit_event",
+	BacktestRun:             "backtest_run",
+	BacktestRunTrade:        "backtest_run_trade",
 	Candle:                  "candle",
 	Datahistoryjob:          "datahistoryjob",
 	Datahistoryjobrelations: "datahistoryjobrelations",
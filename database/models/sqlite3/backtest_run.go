@@ -0,0 +1,1009 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+// BacktestRun is an object representing the database table.
+type BacktestRun struct {
+	ID           string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Nickname     string      `boil:"nickname" json:"nickname" toml:"nickname" yaml:"nickname"`
+	Strategy     string      `boil:"strategy" json:"strategy" toml:"strategy" yaml:"strategy"`
+	ConfigHash   string      `boil:"config_hash" json:"config_hash" toml:"config_hash" yaml:"config_hash"`
+	Config       string      `boil:"config" json:"config" toml:"config" yaml:"config"`
+	Parameters   null.String `boil:"parameters" json:"parameters,omitempty" toml:"parameters" yaml:"parameters,omitempty"`
+	StartTime    string      `boil:"start_time" json:"start_time" toml:"start_time" yaml:"start_time"`
+	EndTime      string      `boil:"end_time" json:"end_time" toml:"end_time" yaml:"end_time"`
+	TotalReturn  float64     `boil:"total_return" json:"total_return" toml:"total_return" yaml:"total_return"`
+	MaxDrawdown  float64     `boil:"max_drawdown" json:"max_drawdown" toml:"max_drawdown" yaml:"max_drawdown"`
+	SharpeRatio  float64     `boil:"sharpe_ratio" json:"sharpe_ratio" toml:"sharpe_ratio" yaml:"sharpe_ratio"`
+	SortinoRatio float64     `boil:"sortino_ratio" json:"sortino_ratio" toml:"sortino_ratio" yaml:"sortino_ratio"`
+	CalmarRatio  float64     `boil:"calmar_ratio" json:"calmar_ratio" toml:"calmar_ratio" yaml:"calmar_ratio"`
+	EquityCurve  string      `boil:"equity_curve" json:"equity_curve" toml:"equity_curve" yaml:"equity_curve"`
+	Report       null.String `boil:"report" json:"report,omitempty" toml:"report" yaml:"report,omitempty"`
+	CreatedAt    string      `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *backtestRunR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L backtestRunL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BacktestRunColumns = struct {
+	ID           string
+	Nickname     string
+	Strategy     string
+	ConfigHash   string
+	Config       string
+	Parameters   string
+	StartTime    string
+	EndTime      string
+	TotalReturn  string
+	MaxDrawdown  string
+	SharpeRatio  string
+	SortinoRatio string
+	CalmarRatio  string
+	EquityCurve  string
+	Report       string
+	CreatedAt    string
+}{
+	ID:           "id",
+	Nickname:     "nickname",
+	Strategy:     "strategy",
+	ConfigHash:   "config_hash",
+	Config:       "config",
+	Parameters:   "parameters",
+	StartTime:    "start_time",
+	EndTime:      "end_time",
+	TotalReturn:  "total_return",
+	MaxDrawdown:  "max_drawdown",
+	SharpeRatio:  "sharpe_ratio",
+	SortinoRatio: "sortino_ratio",
+	CalmarRatio:  "calmar_ratio",
+	EquityCurve:  "equity_curve",
+	Report:       "report",
+	CreatedAt:    "created_at",
+}
+
+// Generated where
+
+var BacktestRunWhere = struct {
+	ID           whereHelperstring
+	Nickname     whereHelperstring
+	Strategy     whereHelperstring
+	ConfigHash   whereHelperstring
+	Config       whereHelperstring
+	Parameters   whereHelpernull_String
+	StartTime    whereHelperstring
+	EndTime      whereHelperstring
+	TotalReturn  whereHelperfloat64
+	MaxDrawdown  whereHelperfloat64
+	SharpeRatio  whereHelperfloat64
+	SortinoRatio whereHelperfloat64
+	CalmarRatio  whereHelperfloat64
+	EquityCurve  whereHelperstring
+	Report       whereHelpernull_String
+	CreatedAt    whereHelperstring
+}{
+	ID:           whereHelperstring{field: "\"backtest_run\".\"id\""},
+	Nickname:     whereHelperstring{field: "\"backtest_run\".\"nickname\""},
+	Strategy:     whereHelperstring{field: "\"backtest_run\".\"strategy\""},
+	ConfigHash:   whereHelperstring{field: "\"backtest_run\".\"config_hash\""},
+	Config:       whereHelperstring{field: "\"backtest_run\".\"config\""},
+	Parameters:   whereHelpernull_String{field: "\"backtest_run\".\"parameters\""},
+	StartTime:    whereHelperstring{field: "\"backtest_run\".\"start_time\""},
+	EndTime:      whereHelperstring{field: "\"backtest_run\".\"end_time\""},
+	TotalReturn:  whereHelperfloat64{field: "\"backtest_run\".\"total_return\""},
+	MaxDrawdown:  whereHelperfloat64{field: "\"backtest_run\".\"max_drawdown\""},
+	SharpeRatio:  whereHelperfloat64{field: "\"backtest_run\".\"sharpe_ratio\""},
+	SortinoRatio: whereHelperfloat64{field: "\"backtest_run\".\"sortino_ratio\""},
+	CalmarRatio:  whereHelperfloat64{field: "\"backtest_run\".\"calmar_ratio\""},
+	EquityCurve:  whereHelperstring{field: "\"backtest_run\".\"equity_curve\""},
+	Report:       whereHelpernull_String{field: "\"backtest_run\".\"report\""},
+	CreatedAt:    whereHelperstring{field: "\"backtest_run\".\"created_at\""},
+}
+
+// BacktestRunRels is where relationship names are stored.
+var BacktestRunRels = struct {
+	BacktestRunTrades string
+}{
+	BacktestRunTrades: "BacktestRunTrades",
+}
+
+// backtestRunR is where relationships are stored.
+type backtestRunR struct {
+	BacktestRunTrades BacktestRunTradeSlice
+}
+
+// NewStruct creates a new relationship struct
+func (*backtestRunR) NewStruct() *backtestRunR {
+	return &backtestRunR{}
+}
+
+// backtestRunL is where Load methods for each relationship are stored.
+type backtestRunL struct{}
+
+var (
+	backtestRunAllColumns            = []string{"id", "nickname", "strategy", "config_hash", "config", "parameters", "start_time", "end_time", "total_return", "max_drawdown", "sharpe_ratio", "sortino_ratio", "calmar_ratio", "equity_curve", "report", "created_at"}
+	backtestRunColumnsWithoutDefault = []string{"id", "nickname", "strategy", "config_hash", "config", "parameters", "start_time", "end_time", "total_return", "max_drawdown", "sharpe_ratio", "sortino_ratio", "calmar_ratio", "equity_curve", "report"}
+	backtestRunColumnsWithDefault    = []string{"created_at"}
+	backtestRunPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// BacktestRunSlice is an alias for a slice of pointers to BacktestRun.
+	// This should generally be used opposed to []BacktestRun.
+	BacktestRunSlice []*BacktestRun
+	// BacktestRunHook is the signature for custom BacktestRun hook methods
+	BacktestRunHook func(context.Context, boil.ContextExecutor, *BacktestRun) error
+
+	backtestRunQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	backtestRunType                 = reflect.TypeOf(&BacktestRun{})
+	backtestRunMapping              = queries.MakeStructMapping(backtestRunType)
+	backtestRunPrimaryKeyMapping, _ = queries.BindMapping(backtestRunType, backtestRunMapping, backtestRunPrimaryKeyColumns)
+	backtestRunInsertCacheMut       sync.RWMutex
+	backtestRunInsertCache          = make(map[string]insertCache)
+	backtestRunUpdateCacheMut       sync.RWMutex
+	backtestRunUpdateCache          = make(map[string]updateCache)
+	backtestRunUpsertCacheMut       sync.RWMutex
+	backtestRunUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var backtestRunBeforeInsertHooks []BacktestRunHook
+var backtestRunBeforeUpdateHooks []BacktestRunHook
+var backtestRunBeforeDeleteHooks []BacktestRunHook
+var backtestRunBeforeUpsertHooks []BacktestRunHook
+
+var backtestRunAfterInsertHooks []BacktestRunHook
+var backtestRunAfterSelectHooks []BacktestRunHook
+var backtestRunAfterUpdateHooks []BacktestRunHook
+var backtestRunAfterDeleteHooks []BacktestRunHook
+var backtestRunAfterUpsertHooks []BacktestRunHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BacktestRun) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BacktestRun) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BacktestRun) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BacktestRun) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BacktestRun) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BacktestRun) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BacktestRun) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BacktestRun) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BacktestRun) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBacktestRunHook registers your hook function for all future operations.
+func AddBacktestRunHook(hookPoint boil.HookPoint, backtestRunHook BacktestRunHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		backtestRunBeforeInsertHooks = append(backtestRunBeforeInsertHooks, backtestRunHook)
+	case boil.BeforeUpdateHook:
+		backtestRunBeforeUpdateHooks = append(backtestRunBeforeUpdateHooks, backtestRunHook)
+	case boil.BeforeDeleteHook:
+		backtestRunBeforeDeleteHooks = append(backtestRunBeforeDeleteHooks, backtestRunHook)
+	case boil.BeforeUpsertHook:
+		backtestRunBeforeUpsertHooks = append(backtestRunBeforeUpsertHooks, backtestRunHook)
+	case boil.AfterInsertHook:
+		backtestRunAfterInsertHooks = append(backtestRunAfterInsertHooks, backtestRunHook)
+	case boil.AfterSelectHook:
+		backtestRunAfterSelectHooks = append(backtestRunAfterSelectHooks, backtestRunHook)
+	case boil.AfterUpdateHook:
+		backtestRunAfterUpdateHooks = append(backtestRunAfterUpdateHooks, backtestRunHook)
+	case boil.AfterDeleteHook:
+		backtestRunAfterDeleteHooks = append(backtestRunAfterDeleteHooks, backtestRunHook)
+	case boil.AfterUpsertHook:
+		backtestRunAfterUpsertHooks = append(backtestRunAfterUpsertHooks, backtestRunHook)
+	}
+}
+
+// One returns a single backtest_run record from the query.
+func (q backtestRunQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BacktestRun, error) {
+	o := &BacktestRun{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for backtest_run")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BacktestRun records from the query.
+func (q backtestRunQuery) All(ctx context.Context, exec boil.ContextExecutor) (BacktestRunSlice, error) {
+	var o []*BacktestRun
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to BacktestRun slice")
+	}
+
+	if len(backtestRunAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BacktestRun records in the query.
+func (q backtestRunQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count backtest_run rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q backtestRunQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if backtest_run exists")
+	}
+
+	return count > 0, nil
+}
+
+// BacktestRunTrades retrieves all the backtest_run_trade's BacktestRunTrades with an executor.
+func (o *BacktestRun) BacktestRunTrades(mods ...qm.QueryMod) backtestRunTradeQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"backtest_run_trade\".\"backtest_run_id\"=?", o.ID),
+	)
+
+	query := BacktestRunTrades(queryMods...)
+	queries.SetFrom(query.Query, "\"backtest_run_trade\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"backtest_run_trade\".*"})
+	}
+
+	return query
+}
+
+// LoadBacktestRunTrades allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (backtestRunL) LoadBacktestRunTrades(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBacktestRun interface{}, mods queries.Applicator) error {
+	var slice []*BacktestRun
+	var object *BacktestRun
+
+	if singular {
+		object = maybeBacktestRun.(*BacktestRun)
+	} else {
+		slice = *maybeBacktestRun.(*[]*BacktestRun)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &backtestRunR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &backtestRunR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`backtest_run_trade`), qm.WhereIn(`backtest_run_trade.backtest_run_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load backtest_run_trade")
+	}
+
+	var resultSlice []*BacktestRunTrade
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice backtest_run_trade")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on backtest_run_trade")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for backtest_run_trade")
+	}
+
+	if len(backtestRunTradeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.BacktestRunTrades = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &backtestRunTradeR{}
+			}
+			foreign.R.BacktestRun = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.BacktestRunID {
+				local.R.BacktestRunTrades = append(local.R.BacktestRunTrades, foreign)
+				if foreign.R == nil {
+					foreign.R = &backtestRunTradeR{}
+				}
+				foreign.R.BacktestRun = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddBacktestRunTrades adds the given related objects to the existing relationships
+// of the backtest_run, optionally inserting them as new records.
+// Appends related to o.R.BacktestRunTrades.
+// Sets related.R.BacktestRun appropriately.
+func (o *BacktestRun) AddBacktestRunTrades(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*BacktestRunTrade) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.BacktestRunID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"backtest_run_trade\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"backtest_run_id"}),
+				strmangle.WhereClause("\"", "\"", 0, backtestRunTradePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.BacktestRunID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &backtestRunR{
+			BacktestRunTrades: related,
+		}
+	} else {
+		o.R.BacktestRunTrades = append(o.R.BacktestRunTrades, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &backtestRunTradeR{
+				BacktestRun: o,
+			}
+		} else {
+			rel.R.BacktestRun = o
+		}
+	}
+	return nil
+}
+
+// BacktestRuns retrieves all the records using an executor.
+func BacktestRuns(mods ...qm.QueryMod) backtestRunQuery {
+	mods = append(mods, qm.From("\"backtest_run\""))
+	return backtestRunQuery{NewQuery(mods...)}
+}
+
+// FindBacktestRun retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBacktestRun(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*BacktestRun, error) {
+	backtestRunObj := &BacktestRun{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"backtest_run\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, backtestRunObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from backtest_run")
+	}
+
+	return backtestRunObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BacktestRun) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no backtest_run provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(backtestRunColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	backtestRunInsertCacheMut.RLock()
+	cache, cached := backtestRunInsertCache[key]
+	backtestRunInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			backtestRunAllColumns,
+			backtestRunColumnsWithDefault,
+			backtestRunColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(backtestRunType, backtestRunMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(backtestRunType, backtestRunMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"backtest_run\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"backtest_run\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"backtest_run\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, backtestRunPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into backtest_run")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for backtest_run")
+	}
+
+CacheNoHooks:
+	if !cached {
+		backtestRunInsertCacheMut.Lock()
+		backtestRunInsertCache[key] = cache
+		backtestRunInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BacktestRun.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BacktestRun) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	backtestRunUpdateCacheMut.RLock()
+	cache, cached := backtestRunUpdateCache[key]
+	backtestRunUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			backtestRunAllColumns,
+			backtestRunPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update backtest_run, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"backtest_run\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, backtestRunPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(backtestRunType, backtestRunMapping, append(wl, backtestRunPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update backtest_run row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for backtest_run")
+	}
+
+	if !cached {
+		backtestRunUpdateCacheMut.Lock()
+		backtestRunUpdateCache[key] = cache
+		backtestRunUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q backtestRunQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for backtest_run")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for backtest_run")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BacktestRunSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), backtestRunPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"backtest_run\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, backtestRunPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in backtest_run slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all backtest_run")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single BacktestRun record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BacktestRun) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no BacktestRun provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), backtestRunPrimaryKeyMapping)
+	sql := "DELETE FROM \"backtest_run\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from backtest_run")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for backtest_run")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q backtestRunQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no backtestRunQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from backtest_run")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for backtest_run")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BacktestRunSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(backtestRunBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), backtestRunPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"backtest_run\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, backtestRunPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from backtest_run slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for backtest_run")
+	}
+
+	if len(backtestRunAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BacktestRun) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBacktestRun(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BacktestRunSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BacktestRunSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), backtestRunPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"backtest_run\".* FROM \"backtest_run\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, backtestRunPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in BacktestRunSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BacktestRunExists checks if the BacktestRun row exists.
+func BacktestRunExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"backtest_run\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if backtest_run exists")
+	}
+
+	return exists, nil
+}
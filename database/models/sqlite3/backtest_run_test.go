@@ -0,0 +1,838 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testBacktestRuns(t *testing.T) {
+	t.Parallel()
+
+	query := BacktestRuns()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testBacktestRunsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BacktestRuns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBacktestRunsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := BacktestRuns().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BacktestRuns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBacktestRunsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BacktestRunSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := BacktestRuns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testBacktestRunsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := BacktestRunExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if BacktestRun exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected BacktestRunExists to return true, but got false.")
+	}
+}
+
+func testBacktestRunsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	backtestRunFound, err := FindBacktestRun(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if backtestRunFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testBacktestRunsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = BacktestRuns().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBacktestRunsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := BacktestRuns().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testBacktestRunsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	backtestRunOne := &BacktestRun{}
+	backtestRunTwo := &BacktestRun{}
+	if err = randomize.Struct(seed, backtestRunOne, backtestRunDBTypes, false, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+	if err = randomize.Struct(seed, backtestRunTwo, backtestRunDBTypes, false, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = backtestRunOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = backtestRunTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BacktestRuns().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testBacktestRunsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	backtestRunOne := &BacktestRun{}
+	backtestRunTwo := &BacktestRun{}
+	if err = randomize.Struct(seed, backtestRunOne, backtestRunDBTypes, false, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+	if err = randomize.Struct(seed, backtestRunTwo, backtestRunDBTypes, false, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = backtestRunOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = backtestRunTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BacktestRuns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func backtestRunBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRun) error {
+	*o = BacktestRun{}
+	return nil
+}
+
+func backtestRunAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRun) error {
+	*o = BacktestRun{}
+	return nil
+}
+
+func backtestRunAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRun) error {
+	*o = BacktestRun{}
+	return nil
+}
+
+func backtestRunBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRun) error {
+	*o = BacktestRun{}
+	return nil
+}
+
+func backtestRunAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRun) error {
+	*o = BacktestRun{}
+	return nil
+}
+
+func backtestRunBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRun) error {
+	*o = BacktestRun{}
+	return nil
+}
+
+func backtestRunAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRun) error {
+	*o = BacktestRun{}
+	return nil
+}
+
+func backtestRunBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRun) error {
+	*o = BacktestRun{}
+	return nil
+}
+
+func backtestRunAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *BacktestRun) error {
+	*o = BacktestRun{}
+	return nil
+}
+
+func testBacktestRunsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &BacktestRun{}
+	o := &BacktestRun{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize BacktestRun object: %s", err)
+	}
+
+	AddBacktestRunHook(boil.BeforeInsertHook, backtestRunBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	backtestRunBeforeInsertHooks = []BacktestRunHook{}
+
+	AddBacktestRunHook(boil.AfterInsertHook, backtestRunAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	backtestRunAfterInsertHooks = []BacktestRunHook{}
+
+	AddBacktestRunHook(boil.AfterSelectHook, backtestRunAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	backtestRunAfterSelectHooks = []BacktestRunHook{}
+
+	AddBacktestRunHook(boil.BeforeUpdateHook, backtestRunBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	backtestRunBeforeUpdateHooks = []BacktestRunHook{}
+
+	AddBacktestRunHook(boil.AfterUpdateHook, backtestRunAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	backtestRunAfterUpdateHooks = []BacktestRunHook{}
+
+	AddBacktestRunHook(boil.BeforeDeleteHook, backtestRunBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	backtestRunBeforeDeleteHooks = []BacktestRunHook{}
+
+	AddBacktestRunHook(boil.AfterDeleteHook, backtestRunAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	backtestRunAfterDeleteHooks = []BacktestRunHook{}
+
+	AddBacktestRunHook(boil.BeforeUpsertHook, backtestRunBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	backtestRunBeforeUpsertHooks = []BacktestRunHook{}
+
+	AddBacktestRunHook(boil.AfterUpsertHook, backtestRunAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	backtestRunAfterUpsertHooks = []BacktestRunHook{}
+}
+
+func testBacktestRunsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BacktestRuns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBacktestRunsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(backtestRunColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BacktestRuns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testBacktestRunToManyBacktestRunTrades(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BacktestRun
+	var b, c BacktestRunTrade
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, backtestRunTradeDBTypes, false, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, backtestRunTradeDBTypes, false, backtestRunTradeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.BacktestRunID = a.ID
+	c.BacktestRunID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.BacktestRunTrades().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.BacktestRunID == b.BacktestRunID {
+			bFound = true
+		}
+		if v.BacktestRunID == c.BacktestRunID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := BacktestRunSlice{&a}
+	if err = a.L.LoadBacktestRunTrades(ctx, tx, false, (*[]*BacktestRun)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.BacktestRunTrades); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.BacktestRunTrades = nil
+	if err = a.L.LoadBacktestRunTrades(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.BacktestRunTrades); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testBacktestRunToManyAddOpBacktestRunTrades(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a BacktestRun
+	var b, c, d, e BacktestRunTrade
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, backtestRunDBTypes, false, strmangle.SetComplement(backtestRunPrimaryKeyColumns, backtestRunColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*BacktestRunTrade{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, backtestRunTradeDBTypes, false, strmangle.SetComplement(backtestRunTradePrimaryKeyColumns, backtestRunTradeColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*BacktestRunTrade{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddBacktestRunTrades(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.BacktestRunID {
+			t.Error("foreign key was wrong value", a.ID, first.BacktestRunID)
+		}
+		if a.ID != second.BacktestRunID {
+			t.Error("foreign key was wrong value", a.ID, second.BacktestRunID)
+		}
+
+		if first.R.BacktestRun != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.BacktestRun != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.BacktestRunTrades[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.BacktestRunTrades[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.BacktestRunTrades().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testBacktestRunsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBacktestRunsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := BacktestRunSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testBacktestRunsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := BacktestRuns().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	backtestRunDBTypes = map[string]string{`ID`: `TEXT`, `Nickname`: `TEXT`, `Strategy`: `TEXT`, `ConfigHash`: `TEXT`, `Config`: `TEXT`, `Parameters`: `TEXT`, `StartTime`: `TIMESTAMP`, `EndTime`: `TIMESTAMP`, `TotalReturn`: `REAL`, `MaxDrawdown`: `REAL`, `SharpeRatio`: `REAL`, `SortinoRatio`: `REAL`, `CalmarRatio`: `REAL`, `EquityCurve`: `TEXT`, `Report`: `TEXT`, `CreatedAt`: `TIMESTAMP`}
+	_                  = bytes.MinRead
+)
+
+func testBacktestRunsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(backtestRunPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(backtestRunAllColumns) == len(backtestRunPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BacktestRuns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testBacktestRunsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(backtestRunAllColumns) == len(backtestRunPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &BacktestRun{}
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := BacktestRuns().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, backtestRunDBTypes, true, backtestRunPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize BacktestRun struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(backtestRunAllColumns, backtestRunPrimaryKeyColumns) {
+		fields = backtestRunAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			backtestRunAllColumns,
+			backtestRunPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := BacktestRunSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
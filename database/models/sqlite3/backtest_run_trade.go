@@ -0,0 +1,976 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// BacktestRunTrade is an object representing the database table.
+type BacktestRunTrade struct {
+	ID            string  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	BacktestRunID string  `boil:"backtest_run_id" json:"backtest_run_id" toml:"backtest_run_id" yaml:"backtest_run_id"`
+	Exchange      string  `boil:"exchange" json:"exchange" toml:"exchange" yaml:"exchange"`
+	Asset         string  `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Base          string  `boil:"base" json:"base" toml:"base" yaml:"base"`
+	Quote         string  `boil:"quote" json:"quote" toml:"quote" yaml:"quote"`
+	Side          string  `boil:"side" json:"side" toml:"side" yaml:"side"`
+	Price         float64 `boil:"price" json:"price" toml:"price" yaml:"price"`
+	Amount        float64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Fee           float64 `boil:"fee" json:"fee" toml:"fee" yaml:"fee"`
+	Timestamp     string  `boil:"timestamp" json:"timestamp" toml:"timestamp" yaml:"timestamp"`
+
+	R *backtestRunTradeR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L backtestRunTradeL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var BacktestRunTradeColumns = struct {
+	ID            string
+	BacktestRunID string
+	Exchange      string
+	Asset         string
+	Base          string
+	Quote         string
+	Side          string
+	Price         string
+	Amount        string
+	Fee           string
+	Timestamp     string
+}{
+	ID:            "id",
+	BacktestRunID: "backtest_run_id",
+	Exchange:      "exchange",
+	Asset:         "asset",
+	Base:          "base",
+	Quote:         "quote",
+	Side:          "side",
+	Price:         "price",
+	Amount:        "amount",
+	Fee:           "fee",
+	Timestamp:     "timestamp",
+}
+
+// Generated where
+
+var BacktestRunTradeWhere = struct {
+	ID            whereHelperstring
+	BacktestRunID whereHelperstring
+	Exchange      whereHelperstring
+	Asset         whereHelperstring
+	Base          whereHelperstring
+	Quote         whereHelperstring
+	Side          whereHelperstring
+	Price         whereHelperfloat64
+	Amount        whereHelperfloat64
+	Fee           whereHelperfloat64
+	Timestamp     whereHelperstring
+}{
+	ID:            whereHelperstring{field: "\"backtest_run_trade\".\"id\""},
+	BacktestRunID: whereHelperstring{field: "\"backtest_run_trade\".\"backtest_run_id\""},
+	Exchange:      whereHelperstring{field: "\"backtest_run_trade\".\"exchange\""},
+	Asset:         whereHelperstring{field: "\"backtest_run_trade\".\"asset\""},
+	Base:          whereHelperstring{field: "\"backtest_run_trade\".\"base\""},
+	Quote:         whereHelperstring{field: "\"backtest_run_trade\".\"quote\""},
+	Side:          whereHelperstring{field: "\"backtest_run_trade\".\"side\""},
+	Price:         whereHelperfloat64{field: "\"backtest_run_trade\".\"price\""},
+	Amount:        whereHelperfloat64{field: "\"backtest_run_trade\".\"amount\""},
+	Fee:           whereHelperfloat64{field: "\"backtest_run_trade\".\"fee\""},
+	Timestamp:     whereHelperstring{field: "\"backtest_run_trade\".\"timestamp\""},
+}
+
+// BacktestRunTradeRels is where relationship names are stored.
+var BacktestRunTradeRels = struct {
+	BacktestRun string
+}{
+	BacktestRun: "BacktestRun",
+}
+
+// backtestRunTradeR is where relationships are stored.
+type backtestRunTradeR struct {
+	BacktestRun *BacktestRun
+}
+
+// NewStruct creates a new relationship struct
+func (*backtestRunTradeR) NewStruct() *backtestRunTradeR {
+	return &backtestRunTradeR{}
+}
+
+// backtestRunTradeL is where Load methods for each relationship are stored.
+type backtestRunTradeL struct{}
+
+var (
+	backtestRunTradeAllColumns            = []string{"id", "backtest_run_id", "exchange", "asset", "base", "quote", "side", "price", "amount", "fee", "timestamp"}
+	backtestRunTradeColumnsWithoutDefault = []string{"id", "backtest_run_id", "exchange", "asset", "base", "quote", "side", "price", "amount", "fee", "timestamp"}
+	backtestRunTradeColumnsWithDefault    = []string{}
+	backtestRunTradePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// BacktestRunTradeSlice is an alias for a slice of pointers to BacktestRunTrade.
+	// This should generally be used opposed to []BacktestRunTrade.
+	BacktestRunTradeSlice []*BacktestRunTrade
+	// BacktestRunTradeHook is the signature for custom BacktestRunTrade hook methods
+	BacktestRunTradeHook func(context.Context, boil.ContextExecutor, *BacktestRunTrade) error
+
+	backtestRunTradeQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	backtestRunTradeType                 = reflect.TypeOf(&BacktestRunTrade{})
+	backtestRunTradeMapping              = queries.MakeStructMapping(backtestRunTradeType)
+	backtestRunTradePrimaryKeyMapping, _ = queries.BindMapping(backtestRunTradeType, backtestRunTradeMapping, backtestRunTradePrimaryKeyColumns)
+	backtestRunTradeInsertCacheMut       sync.RWMutex
+	backtestRunTradeInsertCache          = make(map[string]insertCache)
+	backtestRunTradeUpdateCacheMut       sync.RWMutex
+	backtestRunTradeUpdateCache          = make(map[string]updateCache)
+	backtestRunTradeUpsertCacheMut       sync.RWMutex
+	backtestRunTradeUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var backtestRunTradeBeforeInsertHooks []BacktestRunTradeHook
+var backtestRunTradeBeforeUpdateHooks []BacktestRunTradeHook
+var backtestRunTradeBeforeDeleteHooks []BacktestRunTradeHook
+var backtestRunTradeBeforeUpsertHooks []BacktestRunTradeHook
+
+var backtestRunTradeAfterInsertHooks []BacktestRunTradeHook
+var backtestRunTradeAfterSelectHooks []BacktestRunTradeHook
+var backtestRunTradeAfterUpdateHooks []BacktestRunTradeHook
+var backtestRunTradeAfterDeleteHooks []BacktestRunTradeHook
+var backtestRunTradeAfterUpsertHooks []BacktestRunTradeHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *BacktestRunTrade) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunTradeBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *BacktestRunTrade) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunTradeBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *BacktestRunTrade) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunTradeBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *BacktestRunTrade) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunTradeBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *BacktestRunTrade) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunTradeAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *BacktestRunTrade) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunTradeAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *BacktestRunTrade) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunTradeAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *BacktestRunTrade) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunTradeAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *BacktestRunTrade) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range backtestRunTradeAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddBacktestRunTradeHook registers your hook function for all future operations.
+func AddBacktestRunTradeHook(hookPoint boil.HookPoint, backtestRunTradeHook BacktestRunTradeHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		backtestRunTradeBeforeInsertHooks = append(backtestRunTradeBeforeInsertHooks, backtestRunTradeHook)
+	case boil.BeforeUpdateHook:
+		backtestRunTradeBeforeUpdateHooks = append(backtestRunTradeBeforeUpdateHooks, backtestRunTradeHook)
+	case boil.BeforeDeleteHook:
+		backtestRunTradeBeforeDeleteHooks = append(backtestRunTradeBeforeDeleteHooks, backtestRunTradeHook)
+	case boil.BeforeUpsertHook:
+		backtestRunTradeBeforeUpsertHooks = append(backtestRunTradeBeforeUpsertHooks, backtestRunTradeHook)
+	case boil.AfterInsertHook:
+		backtestRunTradeAfterInsertHooks = append(backtestRunTradeAfterInsertHooks, backtestRunTradeHook)
+	case boil.AfterSelectHook:
+		backtestRunTradeAfterSelectHooks = append(backtestRunTradeAfterSelectHooks, backtestRunTradeHook)
+	case boil.AfterUpdateHook:
+		backtestRunTradeAfterUpdateHooks = append(backtestRunTradeAfterUpdateHooks, backtestRunTradeHook)
+	case boil.AfterDeleteHook:
+		backtestRunTradeAfterDeleteHooks = append(backtestRunTradeAfterDeleteHooks, backtestRunTradeHook)
+	case boil.AfterUpsertHook:
+		backtestRunTradeAfterUpsertHooks = append(backtestRunTradeAfterUpsertHooks, backtestRunTradeHook)
+	}
+}
+
+// One returns a single backtest_run_trade record from the query.
+func (q backtestRunTradeQuery) One(ctx context.Context, exec boil.ContextExecutor) (*BacktestRunTrade, error) {
+	o := &BacktestRunTrade{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for backtest_run_trade")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all BacktestRunTrade records from the query.
+func (q backtestRunTradeQuery) All(ctx context.Context, exec boil.ContextExecutor) (BacktestRunTradeSlice, error) {
+	var o []*BacktestRunTrade
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to BacktestRunTrade slice")
+	}
+
+	if len(backtestRunTradeAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all BacktestRunTrade records in the query.
+func (q backtestRunTradeQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count backtest_run_trade rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q backtestRunTradeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if backtest_run_trade exists")
+	}
+
+	return count > 0, nil
+}
+
+// BacktestRun pointed to by the foreign key.
+func (o *BacktestRunTrade) BacktestRun(mods ...qm.QueryMod) backtestRunQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"id\" = ?", o.BacktestRunID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := BacktestRuns(queryMods...)
+	queries.SetFrom(query.Query, "\"backtest_run\"")
+
+	return query
+}
+
+// LoadBacktestRun allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (backtestRunTradeL) LoadBacktestRun(ctx context.Context, e boil.ContextExecutor, singular bool, maybeBacktestRunTrade interface{}, mods queries.Applicator) error {
+	var slice []*BacktestRunTrade
+	var object *BacktestRunTrade
+
+	if singular {
+		object = maybeBacktestRunTrade.(*BacktestRunTrade)
+	} else {
+		slice = *maybeBacktestRunTrade.(*[]*BacktestRunTrade)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &backtestRunTradeR{}
+		}
+		args = append(args, object.BacktestRunID)
+
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &backtestRunTradeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.BacktestRunID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.BacktestRunID)
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`backtest_run`), qm.WhereIn(`backtest_run.id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load BacktestRun")
+	}
+
+	var resultSlice []*BacktestRun
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice BacktestRun")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for backtest_run")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for backtest_run")
+	}
+
+	if len(backtestRunTradeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.BacktestRun = foreign
+		if foreign.R == nil {
+			foreign.R = &backtestRunR{}
+		}
+		foreign.R.BacktestRunTrades = append(foreign.R.BacktestRunTrades, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.BacktestRunID == foreign.ID {
+				local.R.BacktestRun = foreign
+				if foreign.R == nil {
+					foreign.R = &backtestRunR{}
+				}
+				foreign.R.BacktestRunTrades = append(foreign.R.BacktestRunTrades, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// SetBacktestRun of the backtest_run_trade to the related item.
+// Sets o.R.BacktestRun to related.
+// Adds o to related.R.BacktestRunTrades.
+func (o *BacktestRunTrade) SetBacktestRun(ctx context.Context, exec boil.ContextExecutor, insert bool, related *BacktestRun) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE \"backtest_run_trade\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, []string{"backtest_run_id"}),
+		strmangle.WhereClause("\"", "\"", 0, backtestRunTradePrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, updateQuery)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	o.BacktestRunID = related.ID
+	if o.R == nil {
+		o.R = &backtestRunTradeR{
+			BacktestRun: related,
+		}
+	} else {
+		o.R.BacktestRun = related
+	}
+
+	if related.R == nil {
+		related.R = &backtestRunR{
+			BacktestRunTrades: BacktestRunTradeSlice{o},
+		}
+	} else {
+		related.R.BacktestRunTrades = append(related.R.BacktestRunTrades, o)
+	}
+
+	return nil
+}
+
+// BacktestRunTrades retrieves all the records using an executor.
+func BacktestRunTrades(mods ...qm.QueryMod) backtestRunTradeQuery {
+	mods = append(mods, qm.From("\"backtest_run_trade\""))
+	return backtestRunTradeQuery{NewQuery(mods...)}
+}
+
+// FindBacktestRunTrade retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindBacktestRunTrade(ctx context.Context, exec boil.ContextExecutor, iD string, selectCols ...string) (*BacktestRunTrade, error) {
+	backtestRunTradeObj := &BacktestRunTrade{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"backtest_run_trade\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, backtestRunTradeObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from backtest_run_trade")
+	}
+
+	return backtestRunTradeObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *BacktestRunTrade) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no backtest_run_trade provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(backtestRunTradeColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	backtestRunTradeInsertCacheMut.RLock()
+	cache, cached := backtestRunTradeInsertCache[key]
+	backtestRunTradeInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			backtestRunTradeAllColumns,
+			backtestRunTradeColumnsWithDefault,
+			backtestRunTradeColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(backtestRunTradeType, backtestRunTradeMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(backtestRunTradeType, backtestRunTradeMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"backtest_run_trade\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"backtest_run_trade\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"backtest_run_trade\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, backtestRunTradePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	_, err = exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into backtest_run_trade")
+	}
+
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for backtest_run_trade")
+	}
+
+CacheNoHooks:
+	if !cached {
+		backtestRunTradeInsertCacheMut.Lock()
+		backtestRunTradeInsertCache[key] = cache
+		backtestRunTradeInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the BacktestRunTrade.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *BacktestRunTrade) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	backtestRunTradeUpdateCacheMut.RLock()
+	cache, cached := backtestRunTradeUpdateCache[key]
+	backtestRunTradeUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			backtestRunTradeAllColumns,
+			backtestRunTradePrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update backtest_run_trade, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"backtest_run_trade\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, backtestRunTradePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(backtestRunTradeType, backtestRunTradeMapping, append(wl, backtestRunTradePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update backtest_run_trade row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for backtest_run_trade")
+	}
+
+	if !cached {
+		backtestRunTradeUpdateCacheMut.Lock()
+		backtestRunTradeUpdateCache[key] = cache
+		backtestRunTradeUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q backtestRunTradeQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for backtest_run_trade")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for backtest_run_trade")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o BacktestRunTradeSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), backtestRunTradePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"backtest_run_trade\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, backtestRunTradePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in backtest_run_trade slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all backtest_run_trade")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single BacktestRunTrade record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *BacktestRunTrade) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no BacktestRunTrade provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), backtestRunTradePrimaryKeyMapping)
+	sql := "DELETE FROM \"backtest_run_trade\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from backtest_run_trade")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for backtest_run_trade")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q backtestRunTradeQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no backtestRunTradeQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from backtest_run_trade")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for backtest_run_trade")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o BacktestRunTradeSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(backtestRunTradeBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), backtestRunTradePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"backtest_run_trade\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, backtestRunTradePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from backtest_run_trade slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for backtest_run_trade")
+	}
+
+	if len(backtestRunTradeAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *BacktestRunTrade) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindBacktestRunTrade(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *BacktestRunTradeSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := BacktestRunTradeSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), backtestRunTradePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"backtest_run_trade\".* FROM \"backtest_run_trade\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, backtestRunTradePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in BacktestRunTradeSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// BacktestRunTradeExists checks if the BacktestRunTrade row exists.
+func BacktestRunTradeExists(ctx context.Context, exec boil.ContextExecutor, iD string) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"backtest_run_trade\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if backtest_run_trade exists")
+	}
+
+	return exists, nil
+}
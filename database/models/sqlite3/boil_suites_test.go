@@ -13,6 +13,8 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
+	t.Run("BacktestRuns", testBacktestRuns)
+	t.Run("BacktestRunTrades", testBacktestRunTrades)
 	t.Run("Candles", testCandles)
 	t.Run("Datahistoryjobs", testDatahistoryjobs)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
@@ -27,6 +29,8 @@ func TestParent(t *testing.T) {
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("BacktestRuns", testBacktestRunsDelete)
+	t.Run("BacktestRunTrades", testBacktestRunTradesDelete)
 	t.Run("Candles", testCandlesDelete)
 	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
@@ -41,6 +45,8 @@ func TestDelete(t *testing.T) {
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("BacktestRuns", testBacktestRunsQueryDeleteAll)
+	t.Run("BacktestRunTrades", testBacktestRunTradesQueryDeleteAll)
 	t.Run("Candles", testCandlesQueryDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
@@ -55,6 +61,8 @@ func TestQueryDeleteAll(t *testing.T) {
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("BacktestRuns", testBacktestRunsSliceDeleteAll)
+	t.Run("BacktestRunTrades", testBacktestRunTradesSliceDeleteAll)
 	t.Run("Candles", testCandlesSliceDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
@@ -69,6 +77,8 @@ func TestSliceDeleteAll(t *testing.T) {
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("BacktestRuns", testBacktestRunsExists)
+	t.Run("BacktestRunTrades", testBacktestRunTradesExists)
 	t.Run("Candles", testCandlesExists)
 	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
@@ -83,6 +93,8 @@ func TestExists(t *testing.T) {
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("BacktestRuns", testBacktestRunsFind)
+	t.Run("BacktestRunTrades", testBacktestRunTradesFind)
 	t.Run("Candles", testCandlesFind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
@@ -97,6 +109,8 @@ func TestFind(t *testing.T) {
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("BacktestRuns", testBacktestRunsBind)
+	t.Run("BacktestRunTrades", testBacktestRunTradesBind)
 	t.Run("Candles", testCandlesBind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
@@ -111,6 +125,8 @@ func TestBind(t *testing.T) {
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("BacktestRuns", testBacktestRunsOne)
+	t.Run("BacktestRunTrades", testBacktestRunTradesOne)
 	t.Run("Candles", testCandlesOne)
 	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
@@ -125,6 +141,8 @@ func TestOne(t *testing.T) {
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("BacktestRuns", testBacktestRunsAll)
+	t.Run("BacktestRunTrades", testBacktestRunTradesAll)
 	t.Run("Candles", testCandlesAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
@@ -139,6 +157,8 @@ func TestAll(t *testing.T) {
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("BacktestRuns", testBacktestRunsCount)
+	t.Run("BacktestRunTrades", testBacktestRunTradesCount)
 	t.Run("Candles", testCandlesCount)
 	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
@@ -153,6 +173,8 @@ func TestCount(t *testing.T) {
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("BacktestRuns", testBacktestRunsHooks)
+	t.Run("BacktestRunTrades", testBacktestRunTradesHooks)
 	t.Run("Candles", testCandlesHooks)
 	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
@@ -168,6 +190,10 @@ func TestHooks(t *testing.T) {
 func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("BacktestRuns", testBacktestRunsInsert)
+	t.Run("BacktestRuns", testBacktestRunsInsertWhitelist)
+	t.Run("BacktestRunTrades", testBacktestRunTradesInsert)
+	t.Run("BacktestRunTrades", testBacktestRunTradesInsertWhitelist)
 	t.Run("Candles", testCandlesInsert)
 	t.Run("Candles", testCandlesInsertWhitelist)
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsert)
@@ -193,6 +219,7 @@ func TestInsert(t *testing.T) {
 // TestToOne tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOne(t *testing.T) {
+	t.Run("BacktestRunTradeToBacktestRunUsingBacktestRun", testBacktestRunTradeToOneBacktestRunUsingBacktestRun)
 	t.Run("CandleToDatahistoryjobUsingValidationJob", testCandleToOneDatahistoryjobUsingValidationJob)
 	t.Run("CandleToDatahistoryjobUsingSourceJob", testCandleToOneDatahistoryjobUsingSourceJob)
 	t.Run("CandleToExchangeUsingExchangeName", testCandleToOneExchangeUsingExchangeName)
@@ -216,6 +243,7 @@ func TestOneToOne(t *testing.T) {
 // TestToMany tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToMany(t *testing.T) {
+	t.Run("BacktestRunToBacktestRunTrades", testBacktestRunToManyBacktestRunTrades)
 	t.Run("DatahistoryjobToValidationJobCandles", testDatahistoryjobToManyValidationJobCandles)
 	t.Run("DatahistoryjobToSourceJobCandles", testDatahistoryjobToManySourceJobCandles)
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManyPrerequisiteJobDatahistoryjobs)
@@ -232,6 +260,7 @@ func TestToMany(t *testing.T) {
 // TestToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToOneSet(t *testing.T) {
+	t.Run("BacktestRunTradeToBacktestRunUsingBacktestRunTrades", testBacktestRunTradeToOneSetOpBacktestRunUsingBacktestRun)
 	t.Run("CandleToDatahistoryjobUsingValidationJobCandles", testCandleToOneSetOpDatahistoryjobUsingValidationJob)
 	t.Run("CandleToDatahistoryjobUsingSourceJobCandles", testCandleToOneSetOpDatahistoryjobUsingSourceJob)
 	t.Run("CandleToExchangeUsingExchangeNameCandle", testCandleToOneSetOpExchangeUsingExchangeName)
@@ -267,6 +296,7 @@ func TestOneToOneRemove(t *testing.T) {}
 // TestToManyAdd tests cannot be run in parallel
 // or deadlocks can occur.
 func TestToManyAdd(t *testing.T) {
+	t.Run("BacktestRunToBacktestRunTrades", testBacktestRunToManyAddOpBacktestRunTrades)
 	t.Run("DatahistoryjobToValidationJobCandles", testDatahistoryjobToManyAddOpValidationJobCandles)
 	t.Run("DatahistoryjobToSourceJobCandles", testDatahistoryjobToManyAddOpSourceJobCandles)
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManyAddOpPrerequisiteJobDatahistoryjobs)
@@ -302,6 +332,8 @@ func TestToManyRemove(t *testing.T) {
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("BacktestRuns", testBacktestRunsReload)
+	t.Run("BacktestRunTrades", testBacktestRunTradesReload)
 	t.Run("Candles", testCandlesReload)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
@@ -316,6 +348,8 @@ func TestReload(t *testing.T) {
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("BacktestRuns", testBacktestRunsReloadAll)
+	t.Run("BacktestRunTrades", testBacktestRunTradesReloadAll)
 	t.Run("Candles", testCandlesReloadAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
@@ -330,6 +364,8 @@ func TestReloadAll(t *testing.T) {
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("BacktestRuns", testBacktestRunsSelect)
+	t.Run("BacktestRunTrades", testBacktestRunTradesSelect)
 	t.Run("Candles", testCandlesSelect)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
@@ -344,6 +380,8 @@ func TestSelect(t *testing.T) {
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("BacktestRuns", testBacktestRunsUpdate)
+	t.Run("BacktestRunTrades", testBacktestRunTradesUpdate)
 	t.Run("Candles", testCandlesUpdate)
 	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
@@ -358,6 +396,8 @@ func TestUpdate(t *testing.T) {
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("BacktestRuns", testBacktestRunsSliceUpdateAll)
+	t.Run("BacktestRunTrades", testBacktestRunTradesSliceUpdateAll)
 	t.Run("Candles", testCandlesSliceUpdateAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
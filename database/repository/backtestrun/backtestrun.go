@@ -0,0 +1,497 @@
+package backtestrun
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// summaryColumns are returned when listing runs, leaving out the
+// potentially large config and report columns
+var summaryColumns = []string{
+	"id",
+	"nickname",
+	"strategy",
+	"config_hash",
+	"parameters",
+	"start_time",
+	"end_time",
+	"total_return",
+	"max_drawdown",
+	"sharpe_ratio",
+	"sortino_ratio",
+	"calmar_ratio",
+	"equity_curve",
+	"created_at",
+}
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Upsert inserts or updates backtest runs along with their trades.
+// Any trades previously stored against a run are replaced
+func (db *DBService) Upsert(runs ...*Run) error {
+	if len(runs) == 0 {
+		return nil
+	}
+	for i := range runs {
+		if runs[i] == nil {
+			return errNilRun
+		}
+	}
+	ctx := context.TODO()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = upsertSqlite(ctx, tx, runs...)
+	case database.DBPostgreSQL:
+		err = upsertPostgres(ctx, tx, runs...)
+	default:
+		err = database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetByID returns a run, its report and trades by its ID
+func (db *DBService) GetByID(id string) (*Run, error) {
+	if id == "" {
+		return nil, errRunIDNotSet
+	}
+	var err error
+	var run *Run
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		run, err = db.getByIDSQLite(id)
+	case database.DBPostgreSQL:
+		run, err = db.getByIDPostgres(id)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("%w %v", errRunNotFound, id)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// GetRuns returns a summary of all runs matching the filter, newest first.
+// The config, report and trades of each run are not populated
+func (db *DBService) GetRuns(filter *Filter) ([]Run, error) {
+	if filter == nil {
+		filter = &Filter{}
+	}
+	if filter.Limit < 0 {
+		return nil, fmt.Errorf("%w limit cannot be negative", errInvalidFilter)
+	}
+	if !filter.CreatedFrom.IsZero() && !filter.CreatedTo.IsZero() && filter.CreatedTo.Before(filter.CreatedFrom) {
+		return nil, fmt.Errorf("%w created to cannot be before created from", errInvalidFilter)
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getRunsSQLite(filter)
+	case database.DBPostgreSQL:
+		return db.getRunsPostgres(filter)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func filterMods(filter *Filter, formatTime func(time.Time) interface{}) []qm.QueryMod {
+	mods := []qm.QueryMod{qm.Select(summaryColumns...)}
+	if filter.Strategy != "" {
+		mods = append(mods, qm.Where("strategy = ?", filter.Strategy))
+	}
+	if filter.Nickname != "" {
+		mods = append(mods, qm.Where("nickname = ?", filter.Nickname))
+	}
+	if filter.ConfigHash != "" {
+		mods = append(mods, qm.Where("config_hash = ?", filter.ConfigHash))
+	}
+	if !filter.CreatedFrom.IsZero() {
+		mods = append(mods, qm.Where("created_at >= ?", formatTime(filter.CreatedFrom)))
+	}
+	if !filter.CreatedTo.IsZero() {
+		mods = append(mods, qm.Where("created_at <= ?", formatTime(filter.CreatedTo)))
+	}
+	mods = append(mods, qm.OrderBy("created_at DESC"))
+	if filter.Limit > 0 {
+		mods = append(mods, qm.Limit(filter.Limit))
+	}
+	return mods
+}
+
+func prepareRun(run *Run) (string, error) {
+	if run.ID == "" {
+		freshUUID, err := uuid.NewV4()
+		if err != nil {
+			return "", err
+		}
+		run.ID = freshUUID.String()
+	}
+	if run.CreatedAt.IsZero() {
+		run.CreatedAt = time.Now()
+	}
+	equityCurve, err := json.Marshal(run.EquityCurve)
+	if err != nil {
+		return "", err
+	}
+	return string(equityCurve), nil
+}
+
+func upsertSqlite(ctx context.Context, tx *sql.Tx, runs ...*Run) error {
+	for i := range runs {
+		equityCurve, err := prepareRun(runs[i])
+		if err != nil {
+			return err
+		}
+		var tempRun = sqlite3.BacktestRun{
+			ID:           runs[i].ID,
+			Nickname:     runs[i].Nickname,
+			Strategy:     runs[i].Strategy,
+			ConfigHash:   runs[i].ConfigHash,
+			Config:       runs[i].Config,
+			Parameters:   null.NewString(runs[i].Parameters, runs[i].Parameters != ""),
+			StartTime:    runs[i].StartDate.UTC().Format(time.RFC3339),
+			EndTime:      runs[i].EndDate.UTC().Format(time.RFC3339),
+			TotalReturn:  runs[i].TotalReturn,
+			MaxDrawdown:  runs[i].MaxDrawdown,
+			SharpeRatio:  runs[i].SharpeRatio,
+			SortinoRatio: runs[i].SortinoRatio,
+			CalmarRatio:  runs[i].CalmarRatio,
+			EquityCurve:  equityCurve,
+			Report:       null.NewString(runs[i].Report, runs[i].Report != ""),
+			CreatedAt:    runs[i].CreatedAt.UTC().Format(time.RFC3339),
+		}
+		err = tempRun.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+		_, err = sqlite3.BacktestRunTrades(qm.Where("backtest_run_id = ?", runs[i].ID)).DeleteAll(ctx, tx)
+		if err != nil {
+			return err
+		}
+		for j := range runs[i].Trades {
+			trade := &runs[i].Trades[j]
+			if trade.ID == "" {
+				var freshUUID uuid.UUID
+				freshUUID, err = uuid.NewV4()
+				if err != nil {
+					return err
+				}
+				trade.ID = freshUUID.String()
+			}
+			trade.RunID = runs[i].ID
+			var tempTrade = sqlite3.BacktestRunTrade{
+				ID:            trade.ID,
+				BacktestRunID: trade.RunID,
+				Exchange:      trade.Exchange,
+				Asset:         trade.Asset,
+				Base:          trade.Base,
+				Quote:         trade.Quote,
+				Side:          trade.Side,
+				Price:         trade.Price,
+				Amount:        trade.Amount,
+				Fee:           trade.Fee,
+				Timestamp:     trade.Timestamp.UTC().Format(time.RFC3339),
+			}
+			err = tempTrade.Insert(ctx, tx, boil.Infer())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func upsertPostgres(ctx context.Context, tx *sql.Tx, runs ...*Run) error {
+	for i := range runs {
+		equityCurve, err := prepareRun(runs[i])
+		if err != nil {
+			return err
+		}
+		var tempRun = postgres.BacktestRun{
+			ID:           runs[i].ID,
+			Nickname:     runs[i].Nickname,
+			Strategy:     runs[i].Strategy,
+			ConfigHash:   runs[i].ConfigHash,
+			Config:       runs[i].Config,
+			Parameters:   null.NewString(runs[i].Parameters, runs[i].Parameters != ""),
+			StartTime:    runs[i].StartDate.UTC(),
+			EndTime:      runs[i].EndDate.UTC(),
+			TotalReturn:  runs[i].TotalReturn,
+			MaxDrawdown:  runs[i].MaxDrawdown,
+			SharpeRatio:  runs[i].SharpeRatio,
+			SortinoRatio: runs[i].SortinoRatio,
+			CalmarRatio:  runs[i].CalmarRatio,
+			EquityCurve:  equityCurve,
+			Report:       null.NewString(runs[i].Report, runs[i].Report != ""),
+			CreatedAt:    runs[i].CreatedAt.UTC(),
+		}
+		err = tempRun.Upsert(ctx, tx, true, []string{"id"}, boil.Infer(), boil.Infer())
+		if err != nil {
+			return err
+		}
+		_, err = postgres.BacktestRunTrades(qm.Where("backtest_run_id = ?", runs[i].ID)).DeleteAll(ctx, tx)
+		if err != nil {
+			return err
+		}
+		for j := range runs[i].Trades {
+			trade := &runs[i].Trades[j]
+			if trade.ID == "" {
+				var freshUUID uuid.UUID
+				freshUUID, err = uuid.NewV4()
+				if err != nil {
+					return err
+				}
+				trade.ID = freshUUID.String()
+			}
+			trade.RunID = runs[i].ID
+			var tempTrade = postgres.BacktestRunTrade{
+				ID:            trade.ID,
+				BacktestRunID: trade.RunID,
+				Exchange:      trade.Exchange,
+				Asset:         trade.Asset,
+				Base:          trade.Base,
+				Quote:         trade.Quote,
+				Side:          trade.Side,
+				Price:         trade.Price,
+				Amount:        trade.Amount,
+				Fee:           trade.Fee,
+				Timestamp:     trade.Timestamp.UTC(),
+			}
+			err = tempTrade.Insert(ctx, tx, boil.Infer())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (db *DBService) getByIDSQLite(id string) (*Run, error) {
+	result, err := sqlite3.BacktestRuns(qm.Where("id = ?", id)).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	run, err := sqliteToRun(result)
+	if err != nil {
+		return nil, err
+	}
+	run.Config = result.Config
+	run.Report = result.Report.String
+
+	trades, err := sqlite3.BacktestRunTrades(
+		qm.Where("backtest_run_id = ?", id),
+		qm.OrderBy("timestamp")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	run.Trades = make([]Trade, len(trades))
+	for i := range trades {
+		var ts time.Time
+		ts, err = time.Parse(time.RFC3339, trades[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		run.Trades[i] = Trade{
+			ID:        trades[i].ID,
+			RunID:     trades[i].BacktestRunID,
+			Exchange:  trades[i].Exchange,
+			Asset:     trades[i].Asset,
+			Base:      trades[i].Base,
+			Quote:     trades[i].Quote,
+			Side:      trades[i].Side,
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+			Fee:       trades[i].Fee,
+			Timestamp: ts,
+		}
+	}
+	return run, nil
+}
+
+func (db *DBService) getByIDPostgres(id string) (*Run, error) {
+	result, err := postgres.BacktestRuns(qm.Where("id = ?", id)).One(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	run, err := postgresToRun(result)
+	if err != nil {
+		return nil, err
+	}
+	run.Config = result.Config
+	run.Report = result.Report.String
+
+	trades, err := postgres.BacktestRunTrades(
+		qm.Where("backtest_run_id = ?", id),
+		qm.OrderBy("timestamp")).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	run.Trades = make([]Trade, len(trades))
+	for i := range trades {
+		run.Trades[i] = Trade{
+			ID:        trades[i].ID,
+			RunID:     trades[i].BacktestRunID,
+			Exchange:  trades[i].Exchange,
+			Asset:     trades[i].Asset,
+			Base:      trades[i].Base,
+			Quote:     trades[i].Quote,
+			Side:      trades[i].Side,
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+			Fee:       trades[i].Fee,
+			Timestamp: trades[i].Timestamp,
+		}
+	}
+	return run, nil
+}
+
+func (db *DBService) getRunsSQLite(filter *Filter) ([]Run, error) {
+	results, err := sqlite3.BacktestRuns(filterMods(filter, func(t time.Time) interface{} {
+		return t.UTC().Format(time.RFC3339)
+	})...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	runs := make([]Run, len(results))
+	for i := range results {
+		var run *Run
+		run, err = sqliteToRun(results[i])
+		if err != nil {
+			return nil, err
+		}
+		runs[i] = *run
+	}
+	return runs, nil
+}
+
+func (db *DBService) getRunsPostgres(filter *Filter) ([]Run, error) {
+	results, err := postgres.BacktestRuns(filterMods(filter, func(t time.Time) interface{} {
+		return t.UTC()
+	})...).All(context.TODO(), db.sql)
+	if err != nil {
+		return nil, err
+	}
+	runs := make([]Run, len(results))
+	for i := range results {
+		var run *Run
+		run, err = postgresToRun(results[i])
+		if err != nil {
+			return nil, err
+		}
+		runs[i] = *run
+	}
+	return runs, nil
+}
+
+func sqliteToRun(result *sqlite3.BacktestRun) (*Run, error) {
+	start, err := time.Parse(time.RFC3339, result.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(time.RFC3339, result.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	created, err := time.Parse(time.RFC3339, result.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	run := &Run{
+		ID:           result.ID,
+		Nickname:     result.Nickname,
+		Strategy:     result.Strategy,
+		ConfigHash:   result.ConfigHash,
+		Parameters:   result.Parameters.String,
+		StartDate:    start,
+		EndDate:      end,
+		TotalReturn:  result.TotalReturn,
+		MaxDrawdown:  result.MaxDrawdown,
+		SharpeRatio:  result.SharpeRatio,
+		SortinoRatio: result.SortinoRatio,
+		CalmarRatio:  result.CalmarRatio,
+		CreatedAt:    created,
+	}
+	if result.EquityCurve != "" {
+		err = json.Unmarshal([]byte(result.EquityCurve), &run.EquityCurve)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return run, nil
+}
+
+func postgresToRun(result *postgres.BacktestRun) (*Run, error) {
+	run := &Run{
+		ID:           result.ID,
+		Nickname:     result.Nickname,
+		Strategy:     result.Strategy,
+		ConfigHash:   result.ConfigHash,
+		Parameters:   result.Parameters.String,
+		StartDate:    result.StartTime,
+		EndDate:      result.EndTime,
+		TotalReturn:  result.TotalReturn,
+		MaxDrawdown:  result.MaxDrawdown,
+		SharpeRatio:  result.SharpeRatio,
+		SortinoRatio: result.SortinoRatio,
+		CalmarRatio:  result.CalmarRatio,
+		CreatedAt:    result.CreatedAt,
+	}
+	if result.EquityCurve != "" {
+		err := json.Unmarshal([]byte(result.EquityCurve), &run.EquityCurve)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return run, nil
+}
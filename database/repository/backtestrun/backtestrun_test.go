@@ -0,0 +1,209 @@
+package backtestrun
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+)
+
+var verbose = false
+
+func TestMain(m *testing.M) {
+	if verbose {
+		err := testhelpers.EnableVerboseTestOutput()
+		if err != nil {
+			fmt.Printf("failed to enable verbose test output: %v", err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.TempDir, err = os.MkdirTemp("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	db, err := Setup(nil)
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if db != nil {
+		t.Errorf("received '%v' expected '%v'", db, nil)
+	}
+	db, err = Setup(&database.Instance{})
+	if !errors.Is(err, nil) {
+		t.Errorf("received '%v' expected '%v'", err, nil)
+	}
+	if db != nil {
+		t.Errorf("received '%v' expected '%v'", db, nil)
+	}
+}
+
+func TestBacktestRun(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			tt := time.Now().Truncate(time.Second).UTC()
+			var runs []*Run
+			for i := 0; i < 3; i++ {
+				runs = append(runs, &Run{
+					Nickname:    fmt.Sprintf("run%v", i),
+					Strategy:    "dollarcostaverage",
+					ConfigHash:  "abc",
+					Config:      "{}",
+					StartDate:   tt.Add(-time.Hour * 24),
+					EndDate:     tt,
+					TotalReturn: float64(i),
+					EquityCurve: []EquityPoint{{Time: tt, Value: 1337}},
+					Report:      `{"version":1}`,
+					CreatedAt:   tt.Add(time.Duration(i) * time.Minute),
+					Trades: []Trade{
+						{
+							Exchange:  "binance",
+							Asset:     "spot",
+							Base:      "BTC",
+							Quote:     "USDT",
+							Side:      "BUY",
+							Price:     1337,
+							Amount:    1,
+							Timestamp: tt,
+						},
+					},
+				})
+			}
+			runs[2].Strategy = "rsi"
+			err = db.Upsert(runs...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if runs[0].ID == "" {
+				t.Error("expected run id to be set")
+			}
+
+			// storing the same run again replaces its trades
+			runs[0].Trades = append(runs[0].Trades, Trade{Side: "SELL", Timestamp: tt.Add(time.Hour)})
+			err = db.Upsert(runs[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			run, err := db.GetByID(runs[0].ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if run.Report != `{"version":1}` {
+				t.Errorf("received '%v' expected '%v'", run.Report, `{"version":1}`)
+			}
+			if len(run.Trades) != 2 {
+				t.Errorf("received '%v' expected '%v'", len(run.Trades), 2)
+			}
+			if len(run.EquityCurve) != 1 || run.EquityCurve[0].Value != 1337 {
+				t.Errorf("received '%v' expected a single equity point of 1337", run.EquityCurve)
+			}
+			if !run.StartDate.Equal(tt.Add(-time.Hour * 24)) {
+				t.Errorf("received '%v' expected '%v'", run.StartDate, tt.Add(-time.Hour*24))
+			}
+
+			_, err = db.GetByID("")
+			if !errors.Is(err, errRunIDNotSet) {
+				t.Errorf("received '%v' expected '%v'", err, errRunIDNotSet)
+			}
+			_, err = db.GetByID("3b9f3c4e-2a6b-4c4e-9d0e-9c5c7a1f0b11")
+			if !errors.Is(err, errRunNotFound) {
+				t.Errorf("received '%v' expected '%v'", err, errRunNotFound)
+			}
+
+			results, err := db.GetRuns(nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(results) != 3 {
+				t.Fatalf("received '%v' expected '%v'", len(results), 3)
+			}
+			if results[0].ID != runs[2].ID {
+				t.Errorf("received '%v' expected newest run '%v'", results[0].ID, runs[2].ID)
+			}
+			if results[0].Report != "" || results[0].Config != "" {
+				t.Error("expected summary to exclude report and config")
+			}
+
+			results, err = db.GetRuns(&Filter{Strategy: "dollarcostaverage", Limit: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(results) != 1 || results[0].ID != runs[1].ID {
+				t.Errorf("received '%v' expected run '%v'", results, runs[1].ID)
+			}
+
+			results, err = db.GetRuns(&Filter{CreatedFrom: tt.Add(time.Minute), CreatedTo: tt.Add(time.Minute)})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(results) != 1 || results[0].ID != runs[1].ID {
+				t.Errorf("received '%v' expected run '%v'", results, runs[1].ID)
+			}
+
+			_, err = db.GetRuns(&Filter{Limit: -1})
+			if !errors.Is(err, errInvalidFilter) {
+				t.Errorf("received '%v' expected '%v'", err, errInvalidFilter)
+			}
+			_, err = db.GetRuns(&Filter{CreatedFrom: tt, CreatedTo: tt.Add(-time.Minute)})
+			if !errors.Is(err, errInvalidFilter) {
+				t.Errorf("received '%v' expected '%v'", err, errInvalidFilter)
+			}
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package backtestrun
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var (
+	errNilRun        = errors.New("backtest run is nil")
+	errRunIDNotSet   = errors.New("backtest run id not set")
+	errRunNotFound   = errors.New("backtest run not found")
+	errInvalidFilter = errors.New("invalid backtest run filter")
+)
+
+// Run is a DTO for a completed backtest run and its results
+type Run struct {
+	ID           string
+	Nickname     string
+	Strategy     string
+	ConfigHash   string
+	Config       string
+	Parameters   string
+	StartDate    time.Time
+	EndDate      time.Time
+	TotalReturn  float64
+	MaxDrawdown  float64
+	SharpeRatio  float64
+	SortinoRatio float64
+	CalmarRatio  float64
+	EquityCurve  []EquityPoint
+	Report       string
+	CreatedAt    time.Time
+	Trades       []Trade
+}
+
+// EquityPoint is a single value of a run's equity curve
+type EquityPoint struct {
+	Time  time.Time `json:"time"`
+	Value float64   `json:"value"`
+}
+
+// Trade is a DTO for an order filled during a backtest run
+type Trade struct {
+	ID        string
+	RunID     string
+	Exchange  string
+	Asset     string
+	Base      string
+	Quote     string
+	Side      string
+	Price     float64
+	Amount    float64
+	Fee       float64
+	Timestamp time.Time
+}
+
+// Filter narrows down which runs are returned from the database.
+// Empty fields are ignored
+type Filter struct {
+	Strategy    string
+	Nickname    string
+	ConfigHash  string
+	CreatedFrom time.Time
+	CreatedTo   time.Time
+	Limit       int
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using the backtest run database service
+// without needing to care about implementation
+type IDBService interface {
+	Upsert(runs ...*Run) error
+	GetByID(id string) (*Run, error)
+	GetRuns(filter *Filter) ([]Run, error)
+}
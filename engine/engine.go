@@ -727,6 +727,12 @@ func (bot *Engine) GetExchanges() []exchange.IBotExchange {
 	return exch
 }
 
+// GetDataHistoryManager returns the data history manager, which is nil when
+// the subsystem has not been setup
+func (bot *Engine) GetDataHistoryManager() *DataHistoryManager {
+	return bot.dataHistoryManager
+}
+
 // LoadExchange loads an exchange by name. Optional wait group can be added for
 // external synchronization.
 func (bot *Engine) LoadExchange(name string, wg *sync.WaitGroup) error {
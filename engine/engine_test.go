@@ -338,3 +338,15 @@ func TestSettingsPrint(t *testing.T) {
 	s = &Settings{}
 	s.PrintLoadedSettings()
 }
+
+func TestGetDataHistoryManager(t *testing.T) {
+	t.Parallel()
+	bot := &Engine{}
+	if bot.GetDataHistoryManager() != nil {
+		t.Error("expected nil data history manager")
+	}
+	bot.dataHistoryManager = &DataHistoryManager{}
+	if bot.GetDataHistoryManager() != bot.dataHistoryManager {
+		t.Error("expected data history manager to be returned")
+	}
+}
@@ -2578,11 +2578,12 @@ func (s *RPCServer) GCTScriptStatus(_ context.Context, _ *gctrpc.GCTScriptStatus
 			log.Errorf(log.GRPCSys, "%v", common.GetAssertError("*gctscript.VM", v))
 			return false
 		}
-		resp.Scripts = append(resp.Scripts, &gctrpc.GCTScript{
-			Uuid:    vm.ID.String(),
-			Name:    vm.ShortName(),
-			NextRun: vm.NextRun.String(),
-		})
+		script := &gctrpc.GCTScript{
+			Uuid: vm.ID.String(),
+			Name: vm.ShortName(),
+		}
+		setScriptScheduleStatus(script, vm)
+		resp.Scripts = append(resp.Scripts, script)
 
 		return true
 	})
@@ -2614,12 +2615,12 @@ func (s *RPCServer) GCTScriptQuery(_ context.Context, r *gctrpc.GCTScriptQueryRe
 	resp := &gctrpc.GCTScriptQueryResponse{
 		Status: MsgStatusOK,
 		Script: &gctrpc.GCTScript{
-			Name:    vm.ShortName(),
-			Uuid:    vm.ID.String(),
-			Path:    vm.Path,
-			NextRun: vm.NextRun.String(),
+			Name: vm.ShortName(),
+			Uuid: vm.ID.String(),
+			Path: vm.Path,
 		},
 	}
+	setScriptScheduleStatus(resp.Script, vm)
 	data, err := vm.Read()
 	if err != nil {
 		return nil, err
@@ -2815,11 +2816,21 @@ func (s *RPCServer) GCTScriptListAll(context.Context, *gctrpc.GCTScriptListAllRe
 			if err != nil {
 				return err
 			}
-			if filepath.Ext(path) == common.GctExt {
-				resp.Scripts = append(resp.Scripts, &gctrpc.GCTScript{
-					Name: path,
-				})
+			if filepath.Ext(path) != common.GctExt {
+				return nil
+			}
+			script := &gctrpc.GCTScript{
+				Name: path,
 			}
+			schedule, err := gctscript.ReadSchedule(path)
+			if err != nil {
+				log.Errorf(log.GRPCSys, "Unable to read schedule for %v: %v", path, err)
+			}
+			if schedule != nil {
+				script.NextRun = schedule.Next(time.Now()).String()
+				script.Schedule = scheduleToRPC(schedule, gctscript.LastScheduledRun(filepath.Base(path)))
+			}
+			resp.Scripts = append(resp.Scripts, script)
 			return nil
 		})
 	if err != nil {
@@ -2829,6 +2840,37 @@ func (s *RPCServer) GCTScriptListAll(context.Context, *gctrpc.GCTScriptListAllRe
 	return resp, nil
 }
 
+// setScriptScheduleStatus populates the next run and schedule details of a
+// running script. The next run of a scheduled script is updated by its
+// scheduler so it is only read via ScheduleStatus
+func setScriptScheduleStatus(script *gctrpc.GCTScript, vm *gctscript.VM) {
+	status := vm.ScheduleStatus()
+	if status == nil {
+		script.NextRun = vm.NextRun.String()
+		return
+	}
+	script.NextRun = status.NextRun.String()
+	script.Schedule = scheduleToRPC(&status.Schedule, status.LastRun)
+	script.Schedule.ActiveRuns = int64(status.ActiveRuns)
+	script.Schedule.Queued = status.Queued
+}
+
+// scheduleToRPC converts a script schedule to its RPC representation
+func scheduleToRPC(schedule *gctscript.Schedule, lastRun time.Time) *gctrpc.GCTScriptSchedule {
+	s := &gctrpc.GCTScriptSchedule{
+		Expression:     schedule.Expression,
+		Timezone:       schedule.Location.String(),
+		Jitter:         schedule.Jitter.String(),
+		MaxConcurrency: int64(schedule.MaxConcurrency),
+		Overlap:        schedule.Overlap,
+		MissedRun:      schedule.MissedRun,
+	}
+	if !lastRun.IsZero() {
+		s.LastRun = lastRun.String()
+	}
+	return s
+}
+
 // GCTScriptStopAll stops all running scripts
 func (s *RPCServer) GCTScriptStopAll(context.Context, *gctrpc.GCTScriptStopAllRequest) (*gctrpc.GenericResponse, error) {
 	if !s.gctScriptManager.IsRunning() {
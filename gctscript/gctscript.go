@@ -7,5 +7,7 @@ import (
 
 // Setup configures the wrapper interface to use
 func Setup() {
-	modules.SetModuleWrapper(gct.Setup())
+	wrapper := gct.Setup()
+	modules.SetModuleWrapper(wrapper)
+	modules.SetDatabaseWrapper(wrapper)
 }
@@ -0,0 +1,203 @@
+package gct
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+)
+
+const (
+	dbCandlesFunc = "candles"
+	dbTradesFunc  = "trades"
+	dbJobFunc     = "job"
+	dbJobsFunc    = "jobs"
+)
+
+var databaseModule = map[string]objects.Object{
+	dbCandlesFunc: &objects.UserFunction{Name: dbCandlesFunc, Value: DatabaseCandles},
+	dbTradesFunc:  &objects.UserFunction{Name: dbTradesFunc, Value: DatabaseTrades},
+	dbJobFunc:     &objects.UserFunction{Name: dbJobFunc, Value: DatabaseJob},
+	dbJobsFunc:    &objects.UserFunction{Name: dbJobsFunc, Value: DatabaseJobs},
+}
+
+// DatabaseCandles returns saved candles for the exchange, pair, asset and
+// interval within the requested range. The response can be passed directly to
+// the ta module
+func DatabaseCandles(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 7 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, errResp, err := parseDatabaseQuery(dbCandlesFunc, args[:4]...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	startTime, ok := objects.ToTime(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, dbCandlesFunc, "time.Time", args[4])
+	}
+	endTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, dbCandlesFunc, "time.Time", args[5])
+	}
+	intervalStr, ok := objects.ToString(args[6])
+	if !ok {
+		return nil, constructRuntimeError(7, dbCandlesFunc, "string", args[6])
+	}
+	interval, err := parseInterval(intervalStr)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	ret, err := wrappers.GetDatabaseWrapper().
+		Candles(exchangeName, pair, assetType, kline.Interval(interval), startTime, endTime)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	return klineToOHLCV(ret), nil
+}
+
+// DatabaseTrades returns saved trades for the exchange, pair and asset within
+// the requested range
+func DatabaseTrades(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, errResp, err := parseDatabaseQuery(dbTradesFunc, args[:4]...)
+	if errResp != nil || err != nil {
+		return errResp, err
+	}
+	startTime, ok := objects.ToTime(args[4])
+	if !ok {
+		return nil, constructRuntimeError(5, dbTradesFunc, "time.Time", args[4])
+	}
+	endTime, ok := objects.ToTime(args[5])
+	if !ok {
+		return nil, constructRuntimeError(6, dbTradesFunc, "time.Time", args[5])
+	}
+
+	trades, err := wrappers.GetDatabaseWrapper().
+		Trades(exchangeName, pair, assetType, startTime, endTime)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	r := objects.Array{Value: make([]objects.Object, len(trades))}
+	for x := range trades {
+		temp := make(map[string]objects.Object, 8)
+		temp["tid"] = &objects.String{Value: trades[x].TID}
+		temp["exchange"] = &objects.String{Value: trades[x].Exchange}
+		temp["pair"] = &objects.String{Value: trades[x].CurrencyPair.String()}
+		temp["asset"] = &objects.String{Value: trades[x].AssetType.String()}
+		temp["side"] = &objects.String{Value: trades[x].Side.String()}
+		temp["price"] = &objects.Float{Value: trades[x].Price}
+		temp["amount"] = &objects.Float{Value: trades[x].Amount}
+		temp["timestamp"] = &objects.Time{Value: trades[x].Timestamp}
+		r.Value[x] = &objects.Map{Value: temp}
+	}
+	return &r, nil
+}
+
+// DatabaseJob returns the details and status of a data history job by its
+// nickname
+func DatabaseJob(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 1 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	nickname, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, constructRuntimeError(1, dbJobFunc, "string", args[0])
+	}
+
+	job, err := wrappers.GetDatabaseWrapper().DataHistoryJob(nickname)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+	return dataHistoryJobToObject(job), nil
+}
+
+// DatabaseJobs returns the details and status of all data history jobs
+// created within the requested range
+func DatabaseJobs(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 2 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	startTime, ok := objects.ToTime(args[0])
+	if !ok {
+		return nil, constructRuntimeError(1, dbJobsFunc, "time.Time", args[0])
+	}
+	endTime, ok := objects.ToTime(args[1])
+	if !ok {
+		return nil, constructRuntimeError(2, dbJobsFunc, "time.Time", args[1])
+	}
+
+	jobs, err := wrappers.GetDatabaseWrapper().DataHistoryJobs(startTime, endTime)
+	if err != nil {
+		return errorResponsef(standardFormatting, err)
+	}
+
+	r := objects.Array{Value: make([]objects.Object, len(jobs))}
+	for x := range jobs {
+		r.Value[x] = dataHistoryJobToObject(&jobs[x])
+	}
+	return &r, nil
+}
+
+// parseDatabaseQuery parses the exchange, pair, delimiter and asset arguments
+// shared by database queries
+func parseDatabaseQuery(funcName string, args ...objects.Object) (exchangeName string, pair currency.Pair, assetType asset.Item, errResp objects.Object, err error) {
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		err = constructRuntimeError(1, funcName, "string", args[0])
+		return
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		err = constructRuntimeError(2, funcName, "string", args[1])
+		return
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		err = constructRuntimeError(3, funcName, "string", args[2])
+		return
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		err = constructRuntimeError(4, funcName, "string", args[3])
+		return
+	}
+
+	var parseErr error
+	pair, parseErr = currency.NewPairDelimiter(currencyPair, delimiter)
+	if parseErr != nil {
+		errResp, err = errorResponsef(standardFormatting, parseErr)
+		return
+	}
+	assetType, parseErr = asset.New(assetTypeParam)
+	if parseErr != nil {
+		errResp, err = errorResponsef(standardFormatting, parseErr)
+	}
+	return
+}
+
+func dataHistoryJobToObject(job *modules.DataHistoryJob) *objects.Map {
+	data := make(map[string]objects.Object, 10)
+	data["nickname"] = &objects.String{Value: job.Nickname}
+	data["exchange"] = &objects.String{Value: job.Exchange}
+	data["asset"] = &objects.String{Value: job.Asset.String()}
+	data["pair"] = &objects.String{Value: job.Pair.String()}
+	data["start"] = &objects.Time{Value: job.StartDate}
+	data["end"] = &objects.Time{Value: job.EndDate}
+	data["interval"] = &objects.String{Value: job.Interval.String()}
+	data["datatype"] = &objects.String{Value: job.DataType}
+	data["status"] = &objects.String{Value: job.Status}
+	data["created"] = &objects.Time{Value: job.CreatedDate}
+	return &objects.Map{Value: data}
+}
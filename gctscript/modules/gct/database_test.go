@@ -0,0 +1,136 @@
+package gct
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta/indicators"
+)
+
+var (
+	dbStart = &objects.Time{Value: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)}
+	dbEnd   = &objects.Time{Value: time.Date(2021, 1, 2, 0, 0, 0, 0, time.UTC)}
+)
+
+func TestDatabaseCandles(t *testing.T) {
+	t.Parallel()
+	_, err := DatabaseCandles()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received '%v' expected '%v'", err, objects.ErrWrongNumArguments)
+	}
+
+	_, err = DatabaseCandles(objects.UndefinedValue, currencyPair, delimiter, assetType, dbStart, dbEnd, &objects.String{Value: "1h"})
+	if err == nil {
+		t.Error("expected conversion error")
+	}
+
+	resp, err := DatabaseCandles(exch, currencyPair, delimiter, assetType, dbStart, dbEnd, &objects.String{Value: "bad"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resp.(*objects.Error); !ok {
+		t.Errorf("expected error response for invalid interval, received %v", resp)
+	}
+
+	resp, err = DatabaseCandles(exch, currencyPair, delimiter, assetType, dbStart, dbEnd, &objects.String{Value: "1h"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ohlcv, ok := resp.(*OHLCV)
+	if !ok {
+		t.Fatalf("received '%T' expected '%T'", resp, &OHLCV{})
+	}
+	if ohlcv.TypeName() != indicators.OHLCV {
+		t.Errorf("received '%v' expected '%v'", ohlcv.TypeName(), indicators.OHLCV)
+	}
+	candles, ok := ohlcv.Value["candles"].(*objects.Array)
+	if !ok || len(candles.Value) == 0 {
+		t.Error("expected candles to be returned")
+	}
+}
+
+func TestDatabaseTrades(t *testing.T) {
+	t.Parallel()
+	_, err := DatabaseTrades()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received '%v' expected '%v'", err, objects.ErrWrongNumArguments)
+	}
+
+	_, err = DatabaseTrades(exch, currencyPair, delimiter, assetType, objects.TrueValue, dbEnd)
+	if err == nil {
+		t.Error("expected conversion error")
+	}
+
+	resp, err := DatabaseTrades(exch, currencyPair, delimiter, &objects.String{Value: "bad"}, dbStart, dbEnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := resp.(*objects.Error); !ok {
+		t.Errorf("expected error response for invalid asset, received %v", resp)
+	}
+
+	resp, err = DatabaseTrades(exch, currencyPair, delimiter, assetType, dbStart, dbEnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trades, ok := resp.(*objects.Array)
+	if !ok || len(trades.Value) != 1 {
+		t.Fatalf("expected one trade, received %v", resp)
+	}
+	trade, ok := trades.Value[0].(*objects.Map)
+	if !ok {
+		t.Fatalf("received '%T' expected '%T'", trades.Value[0], &objects.Map{})
+	}
+	if tid, _ := objects.ToString(trade.Value["tid"]); tid != "1337" {
+		t.Errorf("received '%v' expected '%v'", tid, "1337")
+	}
+}
+
+func TestDatabaseJob(t *testing.T) {
+	t.Parallel()
+	_, err := DatabaseJob()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received '%v' expected '%v'", err, objects.ErrWrongNumArguments)
+	}
+
+	_, err = DatabaseJob(objects.UndefinedValue)
+	if err == nil {
+		t.Error("expected conversion error")
+	}
+
+	resp, err := DatabaseJob(&objects.String{Value: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	job, ok := resp.(*objects.Map)
+	if !ok {
+		t.Fatalf("received '%T' expected '%T'", resp, &objects.Map{})
+	}
+	if status, _ := objects.ToString(job.Value["status"]); status != "complete" {
+		t.Errorf("received '%v' expected '%v'", status, "complete")
+	}
+}
+
+func TestDatabaseJobs(t *testing.T) {
+	t.Parallel()
+	_, err := DatabaseJobs()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Errorf("received '%v' expected '%v'", err, objects.ErrWrongNumArguments)
+	}
+
+	_, err = DatabaseJobs(dbStart, objects.TrueValue)
+	if err == nil {
+		t.Error("expected conversion error")
+	}
+
+	resp, err := DatabaseJobs(dbStart, dbEnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jobs, ok := resp.(*objects.Array)
+	if !ok || len(jobs.Value) != 1 {
+		t.Errorf("expected one job, received %v", resp)
+	}
+}
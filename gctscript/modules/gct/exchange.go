@@ -83,7 +83,7 @@ func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	ob, err := wrappers.GetWrapper().Orderbook(ctx, exchangeName, pair, assetType)
+	ob, err := scriptCtx.getWrapper().Orderbook(ctx, exchangeName, pair, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -153,7 +153,7 @@ func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	tx, err := wrappers.GetWrapper().Ticker(ctx, exchangeName, pair, assetType)
+	tx, err := scriptCtx.getWrapper().Ticker(ctx, exchangeName, pair, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -263,7 +263,7 @@ func ExchangeAccountInfo(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	rtnValue, err := wrappers.GetWrapper().
+	rtnValue, err := scriptCtx.getWrapper().
 		AccountInformation(ctx, exchangeName, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
@@ -334,7 +334,7 @@ func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	orderDetails, err := wrappers.GetWrapper().
+	orderDetails, err := scriptCtx.getWrapper().
 		QueryOrder(ctx, exchangeName, orderID, pair, assetType)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
@@ -425,7 +425,7 @@ func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	isCancelled, err := wrappers.GetWrapper().
+	isCancelled, err := scriptCtx.getWrapper().
 		CancelOrder(ctx, exchangeName, orderID, cp, a)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
@@ -516,7 +516,7 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	rtn, err := wrappers.GetWrapper().SubmitOrder(ctx, tempSubmit)
+	rtn, err := scriptCtx.getWrapper().SubmitOrder(ctx, tempSubmit)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -612,7 +612,7 @@ func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	rtn, err := wrappers.GetWrapper().WithdrawalCryptoFunds(ctx, withdrawRequest)
+	rtn, err := scriptCtx.getWrapper().WithdrawalCryptoFunds(ctx, withdrawRequest)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
 	}
@@ -659,7 +659,7 @@ func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	rtn, err := wrappers.GetWrapper().
+	rtn, err := scriptCtx.getWrapper().
 		WithdrawalFiatFunds(ctx, bankAccountID, withdrawRequest)
 	if err != nil {
 		return errorResponsef(standardFormatting, err)
@@ -732,7 +732,7 @@ func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
 	}
 
 	ctx := processScriptContext(scriptCtx)
-	ret, err := wrappers.GetWrapper().
+	ret, err := scriptCtx.getWrapper().
 		OHLCV(ctx,
 			exchangeName,
 			pair,
@@ -744,6 +744,12 @@ func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
 		return errorResponsef(standardFormatting, err)
 	}
 
+	return klineToOHLCV(ret), nil
+}
+
+// klineToOHLCV converts candles to an OHLCV object which can be used by the
+// ta module
+func klineToOHLCV(ret *kline.Item) *OHLCV {
 	candles := objects.Array{Value: make([]objects.Object, len(ret.Candles))}
 	for x := range ret.Candles {
 		candles.Value[x] = &objects.Array{
@@ -767,7 +773,7 @@ func exchangeOHLCV(args ...objects.Object) (objects.Object, error) {
 
 	c := new(OHLCV)
 	c.Value = retValue
-	return c, nil
+	return c
 }
 
 // parseInterval will parse the interval param of indictors that have them and convert to time.Duration
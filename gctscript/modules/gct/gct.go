@@ -2,10 +2,13 @@ package gct
 
 import (
 	"context"
+	"fmt"
 
 	objects "github.com/d5/tengo/v2"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
 )
 
 const (
@@ -29,6 +32,35 @@ func AllModuleNames() []string {
 	return names
 }
 
+// LimitedModule returns a copy of the named module with each restricted
+// function checked against the limiter before it is called. A nil limiter
+// returns the unrestricted module
+func LimitedModule(name string, limiter *modules.Limiter) map[string]objects.Object {
+	mod := Modules[name]
+	if limiter == nil || mod == nil {
+		return mod
+	}
+	limited := make(map[string]objects.Object, len(mod))
+	for funcName, obj := range mod {
+		call, restricted := moduleCalls[name][funcName]
+		fn, ok := obj.(*objects.UserFunction)
+		if !restricted || !ok {
+			limited[funcName] = obj
+			continue
+		}
+		limited[funcName] = &objects.UserFunction{
+			Name: fn.Name,
+			Value: func(args ...objects.Object) (objects.Object, error) {
+				if err := limiter.Check(call); err != nil {
+					return nil, fmt.Errorf("%s.%s: %w", name, fn.Name, err)
+				}
+				return fn.Value(args...)
+			},
+		}
+	}
+	return limited
+}
+
 // setVerbose specifically sets verbosity for http rest requests for this script
 // Params: scriptCTX
 func setVerbose(args ...objects.Object) (objects.Object, error) {
@@ -208,3 +240,19 @@ func processScriptContext(scriptCtx *Context) context.Context {
 func (c *Context) TypeName() string {
 	return "scriptContext"
 }
+
+// SetWrapper overrides the exchange wrapper used by module functions called
+// with this context, allowing scripts to run against an alternative source
+// such as the backtester
+func (c *Context) SetWrapper(wrapper modules.GCTExchange) {
+	c.wrapper = wrapper
+}
+
+// getWrapper returns the context's exchange wrapper override if set,
+// otherwise the default wrapper
+func (c *Context) getWrapper() modules.GCTExchange {
+	if c != nil && c.wrapper != nil {
+		return c.wrapper
+	}
+	return wrappers.GetWrapper()
+}
@@ -1,16 +1,20 @@
 package gct
 
 import (
+	"context"
 	"errors"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	objects "github.com/d5/tengo/v2"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
 )
@@ -46,6 +50,7 @@ var (
 
 func TestMain(m *testing.M) {
 	modules.SetModuleWrapper(validator.Wrapper{})
+	modules.SetDatabaseWrapper(validator.Wrapper{})
 	os.Exit(m.Run())
 }
 
@@ -526,3 +531,90 @@ func TestScriptCredentialTypeName(t *testing.T) {
 		t.Fatal("unexpected value")
 	}
 }
+
+func TestLimitedModule(t *testing.T) {
+	t.Parallel()
+	if mod := LimitedModule("exchange", nil); !reflect.DeepEqual(mod, exchangeModule) {
+		t.Error("expected unrestricted module for nil limiter")
+	}
+	limiter, err := modules.NewLimiter([]string{modules.CapabilityMarketData}, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mod := LimitedModule("non-existent", limiter); mod != nil {
+		t.Error("expected nil module")
+	}
+	mod := LimitedModule("exchange", limiter)
+	if len(mod) != len(exchangeModule) {
+		t.Fatalf("received '%v' expected '%v'", len(mod), len(exchangeModule))
+	}
+
+	ticker, ok := mod[tickerFunc].(*objects.UserFunction)
+	if !ok {
+		t.Fatal("unable to type assert user function")
+	}
+	_, err = ticker.Value(ctx, exch, currencyPair, delimiter, assetType)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = ticker.Value(ctx, exch, currencyPair, delimiter, assetType)
+	if !errors.Is(err, modules.ErrQuotaExceeded) {
+		t.Errorf("received '%v' expected '%v'", err, modules.ErrQuotaExceeded)
+	}
+
+	exchanges, ok := mod[exchangesFunc].(*objects.UserFunction)
+	if !ok {
+		t.Fatal("unable to type assert user function")
+	}
+	_, err = exchanges.Value(tv)
+	if err != nil {
+		t.Error(err)
+	}
+
+	withdraw, ok := mod[withdrawCryptoFunc].(*objects.UserFunction)
+	if !ok {
+		t.Fatal("unable to type assert user function")
+	}
+	_, err = withdraw.Value()
+	if !errors.Is(err, modules.ErrCapabilityNotPermitted) {
+		t.Errorf("received '%v' expected '%v'", err, modules.ErrCapabilityNotPermitted)
+	}
+
+	global := LimitedModule("global", limiter)
+	if !reflect.DeepEqual(global, globalModules) {
+		t.Error("expected unrestricted functions to be unchanged")
+	}
+}
+
+// errTickerWrapper overrides the validator ticker to confirm the context
+// wrapper is used
+type errTickerWrapper struct {
+	validator.Wrapper
+}
+
+var errWrapperOverride = errors.New("wrapper override")
+
+// Ticker overrides default implementation
+func (e errTickerWrapper) Ticker(context.Context, string, currency.Pair, asset.Item) (*ticker.Price, error) {
+	return nil, errWrapperOverride
+}
+
+func TestContextWrapper(t *testing.T) {
+	t.Parallel()
+	var c *Context
+	if _, ok := c.getWrapper().(validator.Wrapper); !ok {
+		t.Error("expected default wrapper for nil context")
+	}
+	c = &Context{}
+	if _, ok := c.getWrapper().(validator.Wrapper); !ok {
+		t.Error("expected default wrapper when no override is set")
+	}
+	c.SetWrapper(errTickerWrapper{})
+	resp, err := ExchangeTicker(c, exch, currencyPair, delimiter, assetType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e, ok := resp.(*objects.Error); !ok || !strings.Contains(e.String(), errWrapperOverride.Error()) {
+		t.Errorf("received '%v' expected '%v'", resp, errWrapperOverride)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 const (
@@ -21,9 +22,39 @@ var Modules = map[string]map[string]objects.Object{
 	"exchange": exchangeModule,
 	"common":   commonModule,
 	"global":   globalModules,
+	"db":       databaseModule,
+}
+
+// moduleCalls defines the capability and quota restrictions of each module
+// function, functions not listed are always permitted
+var moduleCalls = map[string]map[string]modules.Call{
+	"exchange": {
+		orderbookFunc:      {Capability: modules.CapabilityMarketData, APICall: true},
+		tickerFunc:         {Capability: modules.CapabilityMarketData, APICall: true},
+		exchangesFunc:      {Capability: modules.CapabilityMarketData},
+		pairsFunc:          {Capability: modules.CapabilityMarketData},
+		ohlcvFunc:          {Capability: modules.CapabilityMarketData, APICall: true},
+		accountInfoFunc:    {Capability: modules.CapabilityAccount, APICall: true},
+		depositAddressFunc: {Capability: modules.CapabilityAccount},
+		orderQueryFunc:     {Capability: modules.CapabilityTrade, APICall: true},
+		orderCancelFunc:    {Capability: modules.CapabilityTrade, APICall: true},
+		orderSubmitFunc:    {Capability: modules.CapabilityTrade, APICall: true, OrderSubmission: true},
+		withdrawCryptoFunc: {Capability: modules.CapabilityWithdraw, APICall: true},
+		withdrawFiatFunc:   {Capability: modules.CapabilityWithdraw, APICall: true},
+	},
+	"common": {
+		writeAsCSVFunc: {Capability: modules.CapabilityFile},
+	},
+	"db": {
+		dbCandlesFunc: {Capability: modules.CapabilityMarketData},
+		dbTradesFunc:  {Capability: modules.CapabilityMarketData},
+		dbJobFunc:     {Capability: modules.CapabilityMarketData},
+		dbJobsFunc:    {Capability: modules.CapabilityMarketData},
+	},
 }
 
 // Context defines a juncture for script context to go context awareness
 type Context struct {
 	objects.Map
+	wrapper modules.GCTExchange
 }
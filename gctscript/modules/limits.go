@@ -0,0 +1,184 @@
+package modules
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// CapabilityMarketData allows reading exchanges, pairs, tickers,
+	// orderbooks and candles
+	CapabilityMarketData = "market_data"
+	// CapabilityAccount allows reading account balances and deposit addresses
+	CapabilityAccount = "account"
+	// CapabilityTrade allows submitting, cancelling and querying orders
+	CapabilityTrade = "trade"
+	// CapabilityWithdraw allows withdrawing crypto and fiat funds
+	CapabilityWithdraw = "withdraw"
+	// CapabilityFile allows access to the file system and running processes
+	CapabilityFile = "file"
+)
+
+var (
+	// ErrCapabilityNotPermitted is returned when a script calls a function
+	// outside of its capability allowlist
+	ErrCapabilityNotPermitted = errors.New("capability not permitted")
+	// ErrQuotaExceeded is returned when a script exceeds a call quota
+	ErrQuotaExceeded = errors.New("quota exceeded")
+
+	errUnknownCapability = errors.New("unknown capability")
+	errInvalidQuota      = errors.New("invalid quota")
+
+	// Capabilities is the list of all supported capabilities
+	Capabilities = []string{
+		CapabilityMarketData,
+		CapabilityAccount,
+		CapabilityTrade,
+		CapabilityWithdraw,
+		CapabilityFile,
+	}
+)
+
+// Call describes the restrictions applied when a script calls a module
+// function
+type Call struct {
+	Capability      string
+	APICall         bool
+	OrderSubmission bool
+}
+
+// Limiter enforces a capability allowlist and call quotas for a single
+// script, it is safe for concurrent use
+type Limiter struct {
+	capabilities        map[string]bool
+	maxAPICalls         int64
+	maxOrderSubmissions int64
+	interval            time.Duration
+
+	m                sync.Mutex
+	windowStart      time.Time
+	apiCalls         int64
+	orderSubmissions int64
+}
+
+// NewLimiter returns a limiter for the supplied allowlist and quotas. An empty
+// allowlist permits all capabilities and a zero quota is unlimited. When
+// interval is zero quotas apply per run and are reset via Reset
+func NewLimiter(capabilities []string, maxAPICalls, maxOrderSubmissions int64, interval time.Duration) (*Limiter, error) {
+	if err := ValidateCapabilities(capabilities); err != nil {
+		return nil, err
+	}
+	if maxAPICalls < 0 || maxOrderSubmissions < 0 || interval < 0 {
+		return nil, fmt.Errorf("%w: quotas and interval cannot be negative", errInvalidQuota)
+	}
+	l := &Limiter{
+		maxAPICalls:         maxAPICalls,
+		maxOrderSubmissions: maxOrderSubmissions,
+		interval:            interval,
+	}
+	if len(capabilities) > 0 {
+		l.capabilities = make(map[string]bool, len(capabilities))
+		for i := range capabilities {
+			l.capabilities[capabilities[i]] = true
+		}
+	}
+	return l, nil
+}
+
+// ValidateCapabilities ensures all capabilities are supported
+func ValidateCapabilities(capabilities []string) error {
+	for i := range capabilities {
+		var found bool
+		for j := range Capabilities {
+			if capabilities[i] == Capabilities[j] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%w: %q", errUnknownCapability, capabilities[i])
+		}
+	}
+	return nil
+}
+
+// Allowed returns whether the capability is in the allowlist, a nil limiter
+// allows everything
+func (l *Limiter) Allowed(capability string) bool {
+	if l == nil || l.capabilities == nil || capability == "" {
+		return true
+	}
+	return l.capabilities[capability]
+}
+
+// Check verifies the call is permitted and within quota then records it
+func (l *Limiter) Check(c Call) error {
+	if l == nil {
+		return nil
+	}
+	if !l.Allowed(c.Capability) {
+		return fmt.Errorf("%w: %s", ErrCapabilityNotPermitted, c.Capability)
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	if l.interval > 0 {
+		now := time.Now()
+		if now.Sub(l.windowStart) >= l.interval {
+			l.windowStart = now
+			l.apiCalls = 0
+			l.orderSubmissions = 0
+		}
+	}
+	if c.APICall && l.maxAPICalls > 0 && l.apiCalls >= l.maxAPICalls {
+		return fmt.Errorf("%w: %d exchange API calls allowed", ErrQuotaExceeded, l.maxAPICalls)
+	}
+	if c.OrderSubmission && l.maxOrderSubmissions > 0 && l.orderSubmissions >= l.maxOrderSubmissions {
+		return fmt.Errorf("%w: %d order submissions allowed", ErrQuotaExceeded, l.maxOrderSubmissions)
+	}
+	if c.APICall {
+		l.apiCalls++
+	}
+	if c.OrderSubmission {
+		l.orderSubmissions++
+	}
+	return nil
+}
+
+// ForRun returns the limiter to use for a single run of a script. Interval
+// based quotas span runs so the same limiter is returned, otherwise a new
+// limiter with the same allowlist and quotas is returned so concurrent runs
+// cannot use or reset each other's quotas
+func (l *Limiter) ForRun() *Limiter {
+	if l == nil || l.interval > 0 {
+		return l
+	}
+	return &Limiter{
+		capabilities:        l.capabilities,
+		maxAPICalls:         l.maxAPICalls,
+		maxOrderSubmissions: l.maxOrderSubmissions,
+	}
+}
+
+// Reset clears the per run counters when quotas are not interval based
+func (l *Limiter) Reset() {
+	if l == nil || l.interval > 0 {
+		return
+	}
+	l.m.Lock()
+	l.apiCalls = 0
+	l.orderSubmissions = 0
+	l.m.Unlock()
+}
+
+// Usage returns the number of API calls and order submissions made in the
+// current quota window
+func (l *Limiter) Usage() (apiCalls, orderSubmissions int64) {
+	if l == nil {
+		return 0, 0
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	return l.apiCalls, l.orderSubmissions
+}
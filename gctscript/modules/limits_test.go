@@ -0,0 +1,152 @@
+package modules
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestNewLimiter(t *testing.T) {
+	t.Parallel()
+	_, err := NewLimiter([]string{"teleport"}, 0, 0, 0)
+	if !errors.Is(err, errUnknownCapability) {
+		t.Errorf("received '%v' expected '%v'", err, errUnknownCapability)
+	}
+	_, err = NewLimiter(nil, -1, 0, 0)
+	if !errors.Is(err, errInvalidQuota) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidQuota)
+	}
+	l, err := NewLimiter(nil, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !l.Allowed(CapabilityWithdraw) {
+		t.Error("expected empty allowlist to permit all capabilities")
+	}
+}
+
+func TestLimiterAllowed(t *testing.T) {
+	t.Parallel()
+	var l *Limiter
+	if !l.Allowed(CapabilityTrade) {
+		t.Error("expected nil limiter to permit all capabilities")
+	}
+	l, err := NewLimiter([]string{CapabilityMarketData, CapabilityAccount}, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !l.Allowed(CapabilityAccount) {
+		t.Error("expected account capability to be permitted")
+	}
+	if l.Allowed(CapabilityWithdraw) {
+		t.Error("expected withdraw capability to be denied")
+	}
+	if !l.Allowed("") {
+		t.Error("expected unrestricted call to be permitted")
+	}
+}
+
+func TestLimiterCheck(t *testing.T) {
+	t.Parallel()
+	var l *Limiter
+	if err := l.Check(Call{Capability: CapabilityWithdraw}); err != nil {
+		t.Error(err)
+	}
+
+	l, err := NewLimiter([]string{CapabilityMarketData, CapabilityTrade}, 2, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = l.Check(Call{Capability: CapabilityWithdraw, APICall: true})
+	if !errors.Is(err, ErrCapabilityNotPermitted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrCapabilityNotPermitted)
+	}
+	order := Call{Capability: CapabilityTrade, APICall: true, OrderSubmission: true}
+	if err = l.Check(order); err != nil {
+		t.Fatal(err)
+	}
+	if err = l.Check(order); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("received '%v' expected '%v'", err, ErrQuotaExceeded)
+	}
+	ticker := Call{Capability: CapabilityMarketData, APICall: true}
+	if err = l.Check(ticker); err != nil {
+		t.Fatal(err)
+	}
+	if err = l.Check(ticker); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("received '%v' expected '%v'", err, ErrQuotaExceeded)
+	}
+	if apiCalls, orders := l.Usage(); apiCalls != 2 || orders != 1 {
+		t.Errorf("received '%v' '%v' expected '2' '1'", apiCalls, orders)
+	}
+
+	l.Reset()
+	if apiCalls, orders := l.Usage(); apiCalls != 0 || orders != 0 {
+		t.Errorf("received '%v' '%v' expected '0' '0'", apiCalls, orders)
+	}
+	if err = l.Check(order); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLimiterInterval(t *testing.T) {
+	t.Parallel()
+	l, err := NewLimiter(nil, 1, 0, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call := Call{Capability: CapabilityMarketData, APICall: true}
+	if err = l.Check(call); err != nil {
+		t.Fatal(err)
+	}
+	l.Reset()
+	if err = l.Check(call); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("received '%v' expected '%v'", err, ErrQuotaExceeded)
+	}
+	l.windowStart = time.Now().Add(-time.Hour)
+	if err = l.Check(call); err != nil {
+		t.Error(err)
+	}
+
+	var nilLimiter *Limiter
+	nilLimiter.Reset()
+	if apiCalls, orders := nilLimiter.Usage(); apiCalls != 0 || orders != 0 {
+		t.Error("expected zero usage for nil limiter")
+	}
+}
+
+func TestLimiterForRun(t *testing.T) {
+	t.Parallel()
+	var nilLimiter *Limiter
+	if nilLimiter.ForRun() != nil {
+		t.Error("expected nil limiter")
+	}
+	l, err := NewLimiter([]string{CapabilityMarketData}, 1, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	call := Call{Capability: CapabilityMarketData, APICall: true}
+	if err = l.Check(call); err != nil {
+		t.Fatal(err)
+	}
+	run := l.ForRun()
+	if run == l {
+		t.Fatal("expected a new limiter for per run quotas")
+	}
+	if err = run.Check(call); err != nil {
+		t.Error(err)
+	}
+	if err = run.Check(call); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("received '%v' expected '%v'", err, ErrQuotaExceeded)
+	}
+	if err = run.Check(Call{Capability: CapabilityTrade}); !errors.Is(err, ErrCapabilityNotPermitted) {
+		t.Errorf("received '%v' expected '%v'", err, ErrCapabilityNotPermitted)
+	}
+
+	l, err = NewLimiter(nil, 1, 0, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.ForRun() != l {
+		t.Error("expected interval quotas to be shared across runs")
+	}
+}
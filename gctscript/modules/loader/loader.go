@@ -3,39 +3,52 @@ package loader
 import (
 	"github.com/d5/tengo/v2"
 	"github.com/d5/tengo/v2/stdlib"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta"
 )
 
+const osModule = "os"
+
 // GetModuleMap returns the module map that includes all modules
 // for the given module names.
 func GetModuleMap() *tengo.ModuleMap {
-	modules := tengo.NewModuleMap()
+	return GetLimitedModuleMap(nil)
+}
+
+// GetLimitedModuleMap returns the module map with GCT module functions
+// restricted by the supplied limiter. The stdlib os module is excluded when
+// the limiter does not permit file access
+func GetLimitedModuleMap(limiter *modules.Limiter) *tengo.ModuleMap {
+	moduleMap := tengo.NewModuleMap()
 
 	gctModuleList := gct.AllModuleNames()
 	for _, name := range gctModuleList {
-		if mod := gct.Modules[name]; mod != nil {
-			modules.AddBuiltinModule(name, mod)
+		if mod := gct.LimitedModule(name, limiter); mod != nil {
+			moduleMap.AddBuiltinModule(name, mod)
 		}
 	}
 
 	taModuleList := ta.AllModuleNames()
 	for _, name := range taModuleList {
 		if mod := ta.Modules[name]; mod != nil {
-			modules.AddBuiltinModule(name, mod)
+			moduleMap.AddBuiltinModule(name, mod)
 		}
 	}
 
 	stdLib := stdlib.AllModuleNames()
 	for _, name := range stdLib {
+		if name == osModule && !limiter.Allowed(modules.CapabilityFile) {
+			continue
+		}
 		if mod := stdlib.BuiltinModules[name]; mod != nil {
-			modules.AddBuiltinModule(name, mod)
+			moduleMap.AddBuiltinModule(name, mod)
 		}
 		if mod := stdlib.SourceModules[name]; mod != "" {
-			modules.AddSourceModule(name, []byte(mod))
+			moduleMap.AddSourceModule(name, []byte(mod))
 		}
 	}
-	return modules
+	return moduleMap
 }
 
 // SetDefaultScriptOutput sets the output folder
@@ -3,6 +3,8 @@ package loader
 import (
 	"reflect"
 	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 func TestGetModuleMap(t *testing.T) {
@@ -16,3 +18,20 @@ func TestGetModuleMap(t *testing.T) {
 		t.Fatal("expected GetModuleMap() to contain module results instead received 0 value")
 	}
 }
+
+func TestGetLimitedModuleMap(t *testing.T) {
+	limiter, err := modules.NewLimiter([]string{modules.CapabilityMarketData}, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	x := GetLimitedModuleMap(limiter)
+	if x.Get(osModule) != nil {
+		t.Error("expected os module to be excluded without file capability")
+	}
+	if x.Get("exchange") == nil {
+		t.Error("expected exchange module to be loaded")
+	}
+	if GetModuleMap().Get(osModule) == nil {
+		t.Error("expected os module to be loaded without limiter")
+	}
+}
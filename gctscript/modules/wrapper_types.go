@@ -12,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -25,6 +26,9 @@ const (
 // Wrapper instance of GCT to use for modules
 var Wrapper GCTExchange
 
+// DatabaseWrapper instance of GCT to use for database modules
+var DatabaseWrapper GCTDatabase
+
 // GCTExchange interface requirements
 type GCTExchange interface {
 	Exchanges(enabledOnly bool) []string
@@ -46,3 +50,31 @@ type GCTExchange interface {
 func SetModuleWrapper(wrapper GCTExchange) {
 	Wrapper = wrapper
 }
+
+// GCTDatabase interface requirements for read only database access
+type GCTDatabase interface {
+	Candles(exch string, pair currency.Pair, item asset.Item, interval kline.Interval, start, end time.Time) (*kline.Item, error)
+	Trades(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]trade.Data, error)
+	DataHistoryJob(nickname string) (*DataHistoryJob, error)
+	DataHistoryJobs(start, end time.Time) ([]DataHistoryJob, error)
+}
+
+// DataHistoryJob holds the details and status of a data history job
+type DataHistoryJob struct {
+	Nickname    string
+	Exchange    string
+	Asset       asset.Item
+	Pair        currency.Pair
+	StartDate   time.Time
+	EndDate     time.Time
+	Interval    kline.Interval
+	DataType    string
+	Status      string
+	CreatedDate time.Time
+}
+
+// SetDatabaseWrapper link the database wrapper and interface to use for
+// modules
+func SetDatabaseWrapper(wrapper GCTDatabase) {
+	DatabaseWrapper = wrapper
+}
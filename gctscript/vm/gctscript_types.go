@@ -19,6 +19,23 @@ type Config struct {
 	AllowImports       bool          `json:"allow_imports"`
 	AutoLoad           []string      `json:"auto_load"`
 	Verbose            bool          `json:"verbose"`
+	// DefaultLimits applies to all scripts without an entry in ScriptLimits
+	DefaultLimits *Limits `json:"default_limits,omitempty"`
+	// ScriptLimits applies limits to a script by its file name
+	ScriptLimits map[string]*Limits `json:"script_limits,omitempty"`
+}
+
+// Limits defines the resource quotas and capability allowlist for a script,
+// zero values are unlimited and an empty allowlist permits all capabilities.
+// MaxWallTime is measured in wall clock time, so time spent waiting on
+// exchange APIs counts towards it
+type Limits struct {
+	MaxWallTime         time.Duration `json:"max_wall_time"`
+	MaxAllocs           int64         `json:"max_allocs"`
+	MaxAPICalls         int64         `json:"max_api_calls"`
+	MaxOrderSubmissions int64         `json:"max_order_submissions"`
+	QuotaInterval       time.Duration `json:"quota_interval"`
+	Capabilities        []string      `json:"capabilities"`
 }
 
 // Error interface to meet error requirements
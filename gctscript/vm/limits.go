@@ -0,0 +1,83 @@
+package vm
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+var errInvalidLimits = errors.New("invalid script limits")
+
+// Validate checks the limits are within bounds and only reference supported
+// capabilities
+func (l *Limits) Validate() error {
+	if l == nil {
+		return fmt.Errorf("%T %w", l, common.ErrNilPointer)
+	}
+	if l.MaxWallTime < 0 || l.MaxAllocs < 0 || l.MaxAPICalls < 0 || l.MaxOrderSubmissions < 0 || l.QuotaInterval < 0 {
+		return fmt.Errorf("%w: values cannot be negative", errInvalidLimits)
+	}
+	return modules.ValidateCapabilities(l.Capabilities)
+}
+
+// ValidateLimits validates the default and all per script limits
+func (c *Config) ValidateLimits() error {
+	if c.DefaultLimits != nil {
+		if err := c.DefaultLimits.Validate(); err != nil {
+			return fmt.Errorf("default limits: %w", err)
+		}
+	}
+	for name, limits := range c.ScriptLimits {
+		if err := limits.Validate(); err != nil {
+			return fmt.Errorf("script %s limits: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// GetLimits returns the limits for a script file name, falling back to the
+// default limits. Nil is returned when the script is unrestricted
+func (c *Config) GetLimits(script string) *Limits {
+	name := filepath.Base(script)
+	if l, ok := c.ScriptLimits[name]; ok {
+		return l
+	}
+	if filepath.Ext(name) == common.GctExt {
+		if l, ok := c.ScriptLimits[name[:len(name)-len(common.GctExt)]]; ok {
+			return l
+		}
+	}
+	return c.DefaultLimits
+}
+
+// runTimeout returns the maximum duration of a single run of the script
+func (vm *VM) runTimeout() time.Duration {
+	if vm.limits != nil && vm.limits.MaxWallTime > 0 &&
+		(vm.config.ScriptTimeout <= 0 || vm.limits.MaxWallTime < vm.config.ScriptTimeout) {
+		return vm.limits.MaxWallTime
+	}
+	return vm.config.ScriptTimeout
+}
+
+// applyLimits loads the VM's limits and creates the limiter its restricted
+// modules are checked against
+func (vm *VM) applyLimits() error {
+	vm.limits = vm.config.GetLimits(vm.File)
+	vm.limiter = nil
+	if vm.limits == nil {
+		return nil
+	}
+	if err := vm.limits.Validate(); err != nil {
+		return err
+	}
+	var err error
+	vm.limiter, err = modules.NewLimiter(vm.limits.Capabilities,
+		vm.limits.MaxAPICalls,
+		vm.limits.MaxOrderSubmissions,
+		vm.limits.QuotaInterval)
+	return err
+}
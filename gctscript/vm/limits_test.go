@@ -0,0 +1,149 @@
+package vm
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+func TestLimitsValidate(t *testing.T) {
+	t.Parallel()
+	var l *Limits
+	if err := l.Validate(); !errors.Is(err, common.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilPointer)
+	}
+	l = &Limits{MaxAPICalls: -1}
+	if err := l.Validate(); !errors.Is(err, errInvalidLimits) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidLimits)
+	}
+	l = &Limits{Capabilities: []string{"teleport"}}
+	if err := l.Validate(); err == nil {
+		t.Error("expected error on unknown capability")
+	}
+	l = &Limits{Capabilities: []string{modules.CapabilityMarketData}, MaxAPICalls: 10, QuotaInterval: time.Minute}
+	if err := l.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestConfigLimits(t *testing.T) {
+	t.Parallel()
+	scriptLimits := &Limits{MaxOrderSubmissions: 1}
+	c := &Config{
+		DefaultLimits: &Limits{MaxAPICalls: 5},
+		ScriptLimits: map[string]*Limits{
+			"rebalance": scriptLimits,
+		},
+	}
+	if err := c.ValidateLimits(); err != nil {
+		t.Fatal(err)
+	}
+	if l := c.GetLimits(filepath.Join("scripts", "rebalance.gct")); l != scriptLimits {
+		t.Error("expected script limits")
+	}
+	if l := c.GetLimits("other.gct"); l != c.DefaultLimits {
+		t.Error("expected default limits")
+	}
+
+	c.ScriptLimits["bad.gct"] = &Limits{MaxAllocs: -1}
+	if err := c.ValidateLimits(); !errors.Is(err, errInvalidLimits) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidLimits)
+	}
+	c.DefaultLimits.MaxWallTime = -1
+	if err := c.ValidateLimits(); !errors.Is(err, errInvalidLimits) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidLimits)
+	}
+	if l := (&Config{}).GetLimits("any.gct"); l != nil {
+		t.Error("expected nil limits")
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	t.Parallel()
+	testVM := &VM{config: &Config{ScriptTimeout: time.Minute}}
+	if timeout := testVM.runTimeout(); timeout != time.Minute {
+		t.Errorf("received '%v' expected '%v'", timeout, time.Minute)
+	}
+	testVM.limits = &Limits{MaxWallTime: time.Second}
+	if timeout := testVM.runTimeout(); timeout != time.Second {
+		t.Errorf("received '%v' expected '%v'", timeout, time.Second)
+	}
+	testVM.limits.MaxWallTime = time.Hour
+	if timeout := testVM.runTimeout(); timeout != time.Minute {
+		t.Errorf("received '%v' expected '%v'", timeout, time.Minute)
+	}
+}
+
+func TestVMLimits(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	write := func(name, code string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(code), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+	withdraw := write("withdraw.gct", `exch := import("exchange")
+exch.withdrawcrypto(ctx, "binance", "BTC", "address", "", 1.0, 0.0, "")
+`)
+	alloc := write("alloc.gct", `a := []
+for i := 0; i < 1000; i++ {
+	a = append(a, {"i": i})
+}
+`)
+	fileAccess := write("file.gct", `os := import("os")`)
+
+	manager := GctScriptManager{
+		config: &Config{
+			ScriptTimeout:      time.Minute,
+			MaxVirtualMachines: maxTestVirtualMachines,
+			DefaultLimits:      &Limits{Capabilities: []string{modules.CapabilityMarketData}},
+			ScriptLimits: map[string]*Limits{
+				"alloc.gct": {MaxAllocs: 100},
+			},
+		},
+		started: 1,
+	}
+
+	testVM := manager.NewVM()
+	if err := testVM.Load(withdraw); err != nil {
+		t.Fatal(err)
+	}
+	if err := testVM.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	if err := testVM.RunCtx(); !errors.Is(err, modules.ErrCapabilityNotPermitted) {
+		t.Errorf("received '%v' expected '%v'", err, modules.ErrCapabilityNotPermitted)
+	}
+
+	testVM = manager.NewVM()
+	if err := testVM.Load(alloc); err != nil {
+		t.Fatal(err)
+	}
+	if err := testVM.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	if err := testVM.RunCtx(); err == nil {
+		t.Error("expected allocation limit error")
+	}
+
+	testVM = manager.NewVM()
+	if err := testVM.Load(fileAccess); err != nil {
+		t.Fatal(err)
+	}
+	if err := testVM.Compile(); err == nil {
+		t.Error("expected os module to be unavailable")
+	}
+
+	manager.config.DefaultLimits = &Limits{Capabilities: []string{"teleport"}}
+	testVM = manager.NewVM()
+	if err := testVM.Load(fileAccess); err == nil {
+		t.Error("expected error on invalid limits")
+	}
+}
@@ -0,0 +1,533 @@
+package vm
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/d5/tengo/v2"
+	"github.com/d5/tengo/v2/parser"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	minuteField = cronField{min: 0, max: 59}
+	hourField   = cronField{min: 0, max: 23}
+	domField    = cronField{min: 1, max: 31}
+	monthField  = cronField{min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// day of week allows 7 as an alias for Sunday
+	dowField = cronField{min: 0, max: 7, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+
+	cronDescriptors = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+)
+
+// parseCron parses a standard five field cron expression
+// (minute hour day-of-month month day-of-week) or one of the supported
+// descriptors such as @daily
+func parseCron(expression string) (*cronSchedule, error) {
+	expression = strings.TrimSpace(expression)
+	if d, ok := cronDescriptors[strings.ToLower(expression)]; ok {
+		expression = d
+	}
+	fields := strings.Fields(expression)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("%w %q: expected 5 fields received %d", errInvalidCronExpression, expression, len(fields))
+	}
+	var err error
+	c := &cronSchedule{
+		domAny: fields[2] == "*",
+		dowAny: fields[4] == "*",
+	}
+	if c.minute, err = minuteField.parse(fields[0]); err != nil {
+		return nil, err
+	}
+	if c.hour, err = hourField.parse(fields[1]); err != nil {
+		return nil, err
+	}
+	if c.dom, err = domField.parse(fields[2]); err != nil {
+		return nil, err
+	}
+	if c.month, err = monthField.parse(fields[3]); err != nil {
+		return nil, err
+	}
+	if c.dow, err = dowField.parse(fields[4]); err != nil {
+		return nil, err
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	return c, nil
+}
+
+// parse converts a comma separated list of values, ranges and steps into a
+// bitset
+func (f cronField) parse(field string) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		step := 1
+		if parts := strings.SplitN(item, "/", 2); len(parts) == 2 {
+			s, err := strconv.Atoi(parts[1])
+			if err != nil || s < 1 {
+				return 0, fmt.Errorf("%w: invalid step %q", errInvalidCronExpression, item)
+			}
+			step = s
+			item = parts[0]
+		}
+		start, end := f.min, f.max
+		switch {
+		case item == "*":
+		case strings.Contains(item, "-"):
+			bounds := strings.SplitN(item, "-", 2)
+			var err error
+			if start, err = f.value(bounds[0]); err != nil {
+				return 0, err
+			}
+			if end, err = f.value(bounds[1]); err != nil {
+				return 0, err
+			}
+			if start > end {
+				return 0, fmt.Errorf("%w: range %q start exceeds end", errInvalidCronExpression, item)
+			}
+		default:
+			var err error
+			if start, err = f.value(item); err != nil {
+				return 0, err
+			}
+			if step == 1 {
+				end = start
+			}
+		}
+		for i := start; i <= end; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+	return bits, nil
+}
+
+func (f cronField) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q is not a valid value", errInvalidCronExpression, s)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%w: %d outside of range %d-%d", errInvalidCronExpression, v, f.min, f.max)
+	}
+	return v, nil
+}
+
+// next returns the first matching time strictly after t in t's location,
+// a zero time is returned if nothing matches within five years
+func (c *cronSchedule) next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// dayMatches follows cron semantics where a restricted day of month and day
+// of week match if either field matches
+func (c *cronSchedule) dayMatches(t time.Time) bool {
+	dom := c.dom&(1<<uint(t.Day())) != 0
+	dow := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domAny || c.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Next returns the next run time after t, excluding jitter
+func (s *Schedule) Next(t time.Time) time.Time {
+	if s == nil || s.cron == nil {
+		return time.Time{}
+	}
+	next := s.cron.next(t.In(s.Location))
+	if next.IsZero() {
+		return next
+	}
+	return next.UTC()
+}
+
+// Validate checks and defaults the schedule settings
+func (s *Schedule) Validate() error {
+	if s == nil {
+		return fmt.Errorf("%T %w", s, common.ErrNilPointer)
+	}
+	var err error
+	s.cron, err = parseCron(s.Expression)
+	if err != nil {
+		return err
+	}
+	if s.Location == nil {
+		s.Location = time.UTC
+	}
+	if s.Jitter < 0 {
+		return fmt.Errorf("%w: jitter cannot be negative", errInvalidScheduleValue)
+	}
+	if s.MaxConcurrency == 0 {
+		s.MaxConcurrency = DefaultMaxConcurrency
+	}
+	if s.MaxConcurrency < 0 {
+		return fmt.Errorf("%w: max_concurrency must be positive", errInvalidScheduleValue)
+	}
+	switch s.Overlap {
+	case "":
+		s.Overlap = OverlapSkip
+	case OverlapSkip, OverlapQueue:
+	default:
+		return fmt.Errorf("%w: unsupported overlap policy %q", errInvalidScheduleValue, s.Overlap)
+	}
+	switch s.MissedRun {
+	case "":
+		s.MissedRun = MissedRunSkip
+	case MissedRunSkip, MissedRunOnce:
+	default:
+		return fmt.Errorf("%w: unsupported missed_run policy %q", errInvalidScheduleValue, s.MissedRun)
+	}
+	if s.Next(time.Now()).IsZero() {
+		return fmt.Errorf("%w: %q", errNoNextRun, s.Expression)
+	}
+	return nil
+}
+
+// ReadSchedule parses a script file and returns its declared schedule, nil is
+// returned if the script does not declare one
+func ReadSchedule(path string) (*Schedule, error) {
+	if filepath.Ext(path) != common.GctExt {
+		path += common.GctExt
+	}
+	code, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseSchedule(filepath.Base(path), code)
+}
+
+// parseSchedule statically inspects the top level literal declarations of a
+// script so that the schedule is known before the script is first run
+func parseSchedule(name string, code []byte) (*Schedule, error) {
+	fileSet := parser.NewFileSet()
+	srcFile := fileSet.AddFile(name, -1, len(code))
+	f, err := parser.NewParser(srcFile, code, nil).ParseFile()
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]parser.Expr)
+	for i := range f.Stmts {
+		assign, ok := f.Stmts[i].(*parser.AssignStmt)
+		if !ok || len(assign.LHS) != 1 || len(assign.RHS) != 1 {
+			continue
+		}
+		ident, ok := assign.LHS[0].(*parser.Ident)
+		if !ok {
+			continue
+		}
+		values[ident.Name] = assign.RHS[0]
+	}
+	expr, ok := values[scheduleVar]
+	if !ok {
+		return nil, nil
+	}
+	if _, ok = values[timerVar]; ok {
+		return nil, errTimerAndSchedule
+	}
+	s := &Schedule{}
+	if s.Expression, err = stringLiteral(scheduleVar, expr); err != nil {
+		return nil, err
+	}
+	if expr, ok = values[timezoneVar]; ok {
+		var tz string
+		if tz, err = stringLiteral(timezoneVar, expr); err != nil {
+			return nil, err
+		}
+		if s.Location, err = time.LoadLocation(tz); err != nil {
+			return nil, err
+		}
+	}
+	if expr, ok = values[jitterVar]; ok {
+		var jitter string
+		if jitter, err = stringLiteral(jitterVar, expr); err != nil {
+			return nil, err
+		}
+		if s.Jitter, err = time.ParseDuration(jitter); err != nil {
+			return nil, err
+		}
+	}
+	if expr, ok = values[maxConcurrencyVar]; ok {
+		lit, isInt := expr.(*parser.IntLit)
+		if !isInt {
+			return nil, fmt.Errorf("%w: %s must be an integer literal", errInvalidScheduleValue, maxConcurrencyVar)
+		}
+		s.MaxConcurrency = int(lit.Value)
+	}
+	if expr, ok = values[overlapVar]; ok {
+		if s.Overlap, err = stringLiteral(overlapVar, expr); err != nil {
+			return nil, err
+		}
+	}
+	if expr, ok = values[missedRunVar]; ok {
+		if s.MissedRun, err = stringLiteral(missedRunVar, expr); err != nil {
+			return nil, err
+		}
+	}
+	return s, s.Validate()
+}
+
+func stringLiteral(name string, expr parser.Expr) (string, error) {
+	lit, ok := expr.(*parser.StringLit)
+	if !ok {
+		return "", fmt.Errorf("%w: %s must be a string literal", errInvalidScheduleValue, name)
+	}
+	return lit.Value, nil
+}
+
+// LastScheduledRun returns the last recorded scheduled run time of a script
+func LastScheduledRun(name string) time.Time {
+	scheduleStateMtx.Lock()
+	defer scheduleStateMtx.Unlock()
+	state, err := readScheduleState()
+	if err != nil {
+		log.Errorf(log.GCTScriptMgr, "Unable to read schedule state: %v", err)
+		return time.Time{}
+	}
+	return state[name]
+}
+
+func recordScheduledRun(name string, t time.Time) error {
+	scheduleStateMtx.Lock()
+	defer scheduleStateMtx.Unlock()
+	state, err := readScheduleState()
+	if err != nil {
+		return err
+	}
+	state[name] = t.UTC()
+	data, err := json.MarshalIndent(state, "", " ")
+	if err != nil {
+		return err
+	}
+	return file.Write(filepath.Join(ScriptPath, scheduleStateFile), data)
+}
+
+func readScheduleState() (map[string]time.Time, error) {
+	state := make(map[string]time.Time)
+	data, err := os.ReadFile(filepath.Join(ScriptPath, scheduleStateFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return state, nil
+		}
+		return nil, err
+	}
+	return state, json.Unmarshal(data, &state)
+}
+
+// ScheduleStatus returns the current schedule state of the VM, nil is returned
+// if the VM is not scheduled
+func (vm *VM) ScheduleStatus() *ScheduleStatus {
+	if vm == nil || vm.Schedule == nil || vm.sched == nil {
+		return nil
+	}
+	vm.sched.m.Lock()
+	defer vm.sched.m.Unlock()
+	return &ScheduleStatus{
+		Schedule:   *vm.Schedule,
+		NextRun:    vm.NextRun,
+		LastRun:    vm.sched.lastRun,
+		ActiveRuns: vm.sched.active,
+		Queued:     vm.sched.queued,
+	}
+}
+
+// scheduleRunner runs the compiled script at each scheduled time until the
+// VM is shutdown
+func (vm *VM) scheduleRunner() {
+	vm.S = make(chan struct{}, 1)
+	vm.sched = &scheduler{
+		slots:   make(chan struct{}, vm.Schedule.MaxConcurrency),
+		lastRun: LastScheduledRun(vm.ShortName()),
+	}
+
+	if vm.Schedule.MissedRun == MissedRunOnce && vm.missedRun(time.Now()) {
+		if vm.config.Verbose {
+			log.Debugf(log.GCTScriptMgr, "Script: %s ID: %v missed a scheduled run, running now", vm.ShortName(), vm.ID)
+		}
+		vm.dispatch()
+	}
+
+	// the runner is tracked so that runs cannot be dispatched after
+	// shutdown has finished waiting for active runs
+	vm.sched.wg.Add(1)
+	go func() {
+		defer vm.sched.wg.Done()
+		last := time.Now()
+		for {
+			next := vm.Schedule.Next(last)
+			if next.IsZero() {
+				log.Errorln(log.GCTScriptMgr, Error{Action: "Schedule", Script: vm.File, Cause: errNoNextRun})
+				return
+			}
+			runAt := next
+			if vm.Schedule.Jitter > 0 {
+				runAt = runAt.Add(time.Duration(rand.Int63n(int64(vm.Schedule.Jitter)))) //nolint:gosec // basic number generation required, no need for crypto/rand
+			}
+			vm.sched.m.Lock()
+			vm.NextRun = runAt
+			vm.sched.m.Unlock()
+
+			waitTime := time.NewTimer(time.Until(runAt))
+			select {
+			case <-waitTime.C:
+				vm.dispatch()
+				last = next
+				if now := time.Now(); now.After(last) {
+					last = now
+				}
+			case <-vm.S:
+				waitTime.Stop()
+				return
+			}
+		}
+	}()
+}
+
+// missedRun returns whether a scheduled run should have occurred between the
+// last recorded run and now
+func (vm *VM) missedRun(now time.Time) bool {
+	vm.sched.m.Lock()
+	lastRun := vm.sched.lastRun
+	vm.sched.m.Unlock()
+	if lastRun.IsZero() {
+		return false
+	}
+	next := vm.Schedule.Next(lastRun)
+	return !next.IsZero() && !next.After(now)
+}
+
+// dispatch starts a scheduled run applying the overlap policy when all
+// concurrency slots are in use
+func (vm *VM) dispatch() {
+	select {
+	case vm.sched.slots <- struct{}{}:
+		vm.sched.wg.Add(1)
+		go vm.scheduledRun()
+		return
+	default:
+	}
+
+	vm.sched.m.Lock()
+	defer vm.sched.m.Unlock()
+	if vm.Schedule.Overlap != OverlapQueue || vm.sched.queued {
+		log.Warnf(log.GCTScriptMgr, "Script: %s ID: %v skipping scheduled run, %d of %d runs active",
+			vm.ShortName(), vm.ID, vm.sched.active, vm.Schedule.MaxConcurrency)
+		return
+	}
+	vm.sched.queued = true
+	vm.sched.wg.Add(1)
+	go func() {
+		select {
+		case vm.sched.slots <- struct{}{}:
+			vm.sched.m.Lock()
+			vm.sched.queued = false
+			vm.sched.m.Unlock()
+			vm.scheduledRun()
+		case <-vm.S:
+			vm.sched.wg.Done()
+		}
+	}()
+}
+
+// compileForRun returns a copy of the compiled script for a run that can
+// overlap others. When quotas apply per run the script is compiled again with
+// its imports bound to a new limiter so concurrent runs do not share quotas
+func (vm *VM) compileForRun() (*tengo.Compiled, *modules.Limiter, error) {
+	limiter := vm.limiter.ForRun()
+	if limiter == vm.limiter {
+		return vm.Compiled.Clone(), limiter, nil
+	}
+	s, err := vm.newScript(limiter)
+	if err != nil {
+		return nil, nil, Error{Action: "Schedule: Compile", Script: vm.File, Cause: err}
+	}
+	compiled, err := s.Compile()
+	if err != nil {
+		return nil, nil, Error{Action: "Schedule: Compile", Script: vm.File, Cause: err}
+	}
+	return compiled, limiter, nil
+}
+
+// scheduledRun executes the script and releases its concurrency slot, a
+// separate copy of the compiled script is used when runs can overlap
+func (vm *VM) scheduledRun() {
+	defer func() {
+		<-vm.sched.slots
+		vm.sched.wg.Done()
+	}()
+	start := time.Now()
+	vm.sched.m.Lock()
+	vm.sched.active++
+	vm.sched.lastRun = start
+	vm.sched.m.Unlock()
+
+	compiled, limiter := vm.Compiled, vm.limiter
+	var err error
+	if vm.Schedule.MaxConcurrency > 1 {
+		compiled, limiter, err = vm.compileForRun()
+	}
+	if err == nil {
+		err = vm.runCompiled(compiled, limiter)
+	}
+	if err != nil {
+		log.Errorln(log.GCTScriptMgr, err)
+	}
+
+	vm.sched.m.Lock()
+	vm.sched.active--
+	vm.sched.m.Unlock()
+	if validator.IsTestExecution.Load() != true {
+		if err = recordScheduledRun(vm.ShortName(), start); err != nil {
+			log.Errorf(log.GCTScriptMgr, "Script: %s unable to record scheduled run: %v", vm.ShortName(), err)
+		}
+	}
+}
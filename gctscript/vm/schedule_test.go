@@ -0,0 +1,349 @@
+package vm
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+)
+
+var testScheduleScript = filepath.Join("..", "..", "testdata", "gctscript", "schedule.gct")
+
+func TestParseCron(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		expression string
+		err        error
+	}{
+		{"5 0 * * *", nil},
+		{"30 9 * * mon-fri", nil},
+		{"*/15 * * * *", nil},
+		{"0 0 1,15 jan-jun 7", nil},
+		{"@daily", nil},
+		{"@HOURLY", nil},
+		{"", errInvalidCronExpression},
+		{"* * * *", errInvalidCronExpression},
+		{"60 * * * *", errInvalidCronExpression},
+		{"* 24 * * *", errInvalidCronExpression},
+		{"* * 0 * *", errInvalidCronExpression},
+		{"* * * 13 *", errInvalidCronExpression},
+		{"* * * * 8", errInvalidCronExpression},
+		{"*/0 * * * *", errInvalidCronExpression},
+		{"5-1 * * * *", errInvalidCronExpression},
+		{"a * * * *", errInvalidCronExpression},
+	} {
+		_, err := parseCron(tc.expression)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("%q received '%v' expected '%v'", tc.expression, err, tc.err)
+		}
+	}
+}
+
+func TestCronNext(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2022, 3, 4, 10, 30, 15, 0, time.UTC) // Friday
+	for _, tc := range []struct {
+		expression string
+		expected   time.Time
+	}{
+		{"5 0 * * *", time.Date(2022, 3, 5, 0, 5, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2022, 3, 4, 10, 45, 0, 0, time.UTC)},
+		{"30 9 * * mon-fri", time.Date(2022, 3, 7, 9, 30, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 29 2 *", time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)},
+		{"0 0 1 * 0", time.Date(2022, 3, 6, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2022, 3, 6, 0, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+	} {
+		c, err := parseCron(tc.expression)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if next := c.next(from); !next.Equal(tc.expected) {
+			t.Errorf("%q received '%v' expected '%v'", tc.expression, next, tc.expected)
+		}
+	}
+
+	c, err := parseCron("0 0 31 2 *")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if next := c.next(from); !next.IsZero() {
+		t.Errorf("received '%v' expected zero time", next)
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	t.Parallel()
+	var s *Schedule
+	if next := s.Next(time.Now()); !next.IsZero() {
+		t.Error("expected zero time for nil schedule")
+	}
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	s = &Schedule{Expression: "30 9 * * *", Location: loc}
+	if err = s.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	next := s.Next(time.Date(2022, 7, 1, 0, 0, 0, 0, time.UTC))
+	if expected := time.Date(2022, 7, 1, 13, 30, 0, 0, time.UTC); !next.Equal(expected) {
+		t.Errorf("received '%v' expected '%v'", next, expected)
+	}
+	if next.Location() != time.UTC {
+		t.Error("expected next run to be returned in UTC")
+	}
+}
+
+func TestScheduleValidate(t *testing.T) {
+	t.Parallel()
+	var s *Schedule
+	if err := s.Validate(); !errors.Is(err, common.ErrNilPointer) {
+		t.Errorf("received '%v' expected '%v'", err, common.ErrNilPointer)
+	}
+
+	s = &Schedule{Expression: "@daily"}
+	if err := s.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if s.Location != time.UTC || s.MaxConcurrency != DefaultMaxConcurrency ||
+		s.Overlap != OverlapSkip || s.MissedRun != MissedRunSkip {
+		t.Errorf("defaults not applied: %+v", s)
+	}
+
+	for _, s = range []*Schedule{
+		{Expression: "@daily", Jitter: -time.Second},
+		{Expression: "@daily", MaxConcurrency: -1},
+		{Expression: "@daily", Overlap: "allow"},
+		{Expression: "@daily", MissedRun: "catch_up"},
+	} {
+		if err := s.Validate(); !errors.Is(err, errInvalidScheduleValue) {
+			t.Errorf("%+v received '%v' expected '%v'", s, err, errInvalidScheduleValue)
+		}
+	}
+
+	s = &Schedule{Expression: "0 0 30 2 *"}
+	if err := s.Validate(); !errors.Is(err, errNoNextRun) {
+		t.Errorf("received '%v' expected '%v'", err, errNoNextRun)
+	}
+}
+
+func TestParseSchedule(t *testing.T) {
+	t.Parallel()
+	s, err := parseSchedule("test", []byte(`fmt := import("fmt")`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != nil {
+		t.Error("expected nil schedule when none is declared")
+	}
+
+	_, err = parseSchedule("test", []byte("schedule := \"@daily\"\ntimer := \"5s\""))
+	if !errors.Is(err, errTimerAndSchedule) {
+		t.Errorf("received '%v' expected '%v'", err, errTimerAndSchedule)
+	}
+
+	_, err = parseSchedule("test", []byte("x := \"0 0 * * *\"\nschedule := x"))
+	if !errors.Is(err, errInvalidScheduleValue) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidScheduleValue)
+	}
+
+	_, err = parseSchedule("test", []byte("schedule := \"@daily\"\nmax_concurrency := \"2\""))
+	if !errors.Is(err, errInvalidScheduleValue) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidScheduleValue)
+	}
+
+	_, err = parseSchedule("test", []byte("schedule := \"@daily\"\njitter := \"soon\""))
+	if err == nil {
+		t.Error("expected error on invalid jitter")
+	}
+
+	_, err = parseSchedule("test", []byte("schedule := \"@daily\"\ntimezone := \"Mars/Olympus\""))
+	if err == nil {
+		t.Error("expected error on invalid timezone")
+	}
+
+	_, err = parseSchedule("test", []byte("schedule := "))
+	if err == nil {
+		t.Error("expected error on invalid script")
+	}
+}
+
+func TestReadSchedule(t *testing.T) {
+	t.Parallel()
+	s, err := ReadSchedule(testScheduleScript[:len(testScheduleScript)-4])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Expression != "5 0 * * *" ||
+		s.Location != time.UTC ||
+		s.Jitter != 30*time.Second ||
+		s.MaxConcurrency != 2 ||
+		s.Overlap != OverlapQueue ||
+		s.MissedRun != MissedRunOnce {
+		t.Errorf("unexpected schedule %+v", s)
+	}
+
+	s, err = ReadSchedule(testScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != nil {
+		t.Error("expected nil schedule for unscheduled script")
+	}
+
+	_, err = ReadSchedule("non-existent")
+	if err == nil {
+		t.Error("expected error on missing script")
+	}
+}
+
+func TestScheduledRunState(t *testing.T) {
+	ScriptPath = t.TempDir()
+	defer func() {
+		ScriptPath = filepath.Join("..", "..", "testdata", "gctscript")
+	}()
+	if last := LastScheduledRun("test.gct"); !last.IsZero() {
+		t.Errorf("received '%v' expected zero time", last)
+	}
+	ran := time.Date(2022, 3, 4, 0, 5, 0, 0, time.UTC)
+	if err := recordScheduledRun("test.gct", ran); err != nil {
+		t.Fatal(err)
+	}
+	if last := LastScheduledRun("test.gct"); !last.Equal(ran) {
+		t.Errorf("received '%v' expected '%v'", last, ran)
+	}
+}
+
+func TestVMSchedule(t *testing.T) {
+	ScriptPath = t.TempDir()
+	defer func() {
+		ScriptPath = filepath.Join("..", "..", "testdata", "gctscript")
+	}()
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	testVM := manager.New()
+	err := testVM.Load(testScheduleScript)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if testVM.Schedule == nil {
+		t.Fatal("expected schedule to be loaded")
+	}
+	if testVM.ScheduleStatus() != nil {
+		t.Error("expected nil status before schedule runner starts")
+	}
+
+	testVM.CompileAndRun()
+	status := testVM.ScheduleStatus()
+	if status == nil {
+		t.Fatal("expected schedule status")
+	}
+	if status.ActiveRuns != 0 {
+		t.Errorf("received '%v' expected '%v'", status.ActiveRuns, 0)
+	}
+
+	// shutdown waits for the dispatched run to finish
+	testVM.dispatch()
+	err = testVM.Shutdown()
+	if err != nil {
+		t.Fatal(err)
+	}
+	status = testVM.ScheduleStatus()
+	if status.LastRun.IsZero() {
+		t.Error("expected last run to be set")
+	}
+	if status.ActiveRuns != 0 {
+		t.Errorf("received '%v' expected '%v'", status.ActiveRuns, 0)
+	}
+}
+
+func TestScheduleDispatchOverlap(t *testing.T) {
+	t.Parallel()
+	for _, policy := range []string{OverlapSkip, OverlapQueue} {
+		testVM := &VM{
+			Schedule: &Schedule{MaxConcurrency: 1, Overlap: policy},
+			S:        make(chan struct{}),
+			sched:    &scheduler{slots: make(chan struct{}, 1)},
+		}
+		// occupy the only slot so that dispatch must apply the overlap policy
+		testVM.sched.slots <- struct{}{}
+		testVM.dispatch()
+		testVM.dispatch()
+		if queued := testVM.ScheduleStatus().Queued; queued != (policy == OverlapQueue) {
+			t.Errorf("%s received queued '%v'", policy, queued)
+		}
+		close(testVM.S)
+		testVM.sched.wg.Wait()
+	}
+}
+
+func TestMissedRun(t *testing.T) {
+	t.Parallel()
+	testVM := &VM{
+		Schedule: &Schedule{Expression: "0 * * * *"},
+		sched:    &scheduler{},
+	}
+	if err := testVM.Schedule.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2022, 3, 4, 10, 30, 0, 0, time.UTC)
+	if testVM.missedRun(now) {
+		t.Error("expected no missed run when never run")
+	}
+	testVM.sched.lastRun = now.Add(-20 * time.Minute)
+	if testVM.missedRun(now) {
+		t.Error("expected no missed run")
+	}
+	testVM.sched.lastRun = now.Add(-2 * time.Hour)
+	if !testVM.missedRun(now) {
+		t.Error("expected missed run")
+	}
+}
+
+func TestCompileForRun(t *testing.T) {
+	t.Parallel()
+	manager := GctScriptManager{
+		config:  configHelper(true, true, maxTestVirtualMachines),
+		started: 1,
+	}
+	manager.config.DefaultLimits = &Limits{MaxAPICalls: 1}
+	testVM := manager.New()
+	if err := testVM.Load(testScheduleScript); err != nil {
+		t.Fatal(err)
+	}
+	if err := testVM.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	compiled, limiter, err := testVM.compileForRun()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if compiled == testVM.Compiled {
+		t.Error("expected a separate copy of the compiled script")
+	}
+	if limiter == nil || limiter == testVM.limiter {
+		t.Error("expected a separate limiter for per run quotas")
+	}
+
+	manager.config.DefaultLimits = &Limits{MaxAPICalls: 1, QuotaInterval: time.Minute}
+	testVM = manager.New()
+	if err = testVM.Load(testScheduleScript); err != nil {
+		t.Fatal(err)
+	}
+	if err = testVM.Compile(); err != nil {
+		t.Fatal(err)
+	}
+	if _, limiter, err = testVM.compileForRun(); err != nil {
+		t.Fatal(err)
+	}
+	if limiter != testVM.limiter {
+		t.Error("expected interval quotas to be shared across runs")
+	}
+}
@@ -0,0 +1,86 @@
+package vm
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	// OverlapSkip drops a scheduled run when all concurrency slots are busy
+	OverlapSkip = "skip"
+	// OverlapQueue waits for a concurrency slot to free up before running,
+	// only one run can be queued at a time
+	OverlapQueue = "queue"
+
+	// MissedRunSkip ignores any runs missed while the bot was offline
+	MissedRunSkip = "skip"
+	// MissedRunOnce runs the script once on load if one or more scheduled
+	// runs were missed while the bot was offline
+	MissedRunOnce = "run_once"
+
+	// DefaultMaxConcurrency is the default number of simultaneous runs a
+	// scheduled script may have
+	DefaultMaxConcurrency = 1
+
+	scheduleStateFile = "schedule.json"
+
+	scheduleVar       = "schedule"
+	timezoneVar       = "timezone"
+	jitterVar         = "jitter"
+	maxConcurrencyVar = "max_concurrency"
+	overlapVar        = "overlap"
+	missedRunVar      = "missed_run"
+	timerVar          = "timer"
+)
+
+var (
+	errInvalidCronExpression = errors.New("invalid cron expression")
+	errInvalidScheduleValue  = errors.New("invalid schedule value")
+	errTimerAndSchedule      = errors.New("script cannot declare both a timer and a schedule")
+	errNoNextRun             = errors.New("schedule has no future run time")
+
+	scheduleStateMtx sync.Mutex
+)
+
+// Schedule holds the cron based execution settings declared by a script
+type Schedule struct {
+	Expression     string
+	Location       *time.Location
+	Jitter         time.Duration
+	MaxConcurrency int
+	Overlap        string
+	MissedRun      string
+	cron           *cronSchedule
+}
+
+// ScheduleStatus is a point in time view of a scheduled script
+type ScheduleStatus struct {
+	Schedule
+	NextRun    time.Time
+	LastRun    time.Time
+	ActiveRuns int
+	Queued     bool
+}
+
+// scheduler tracks the runtime state of a scheduled VM
+type scheduler struct {
+	m       sync.Mutex
+	slots   chan struct{}
+	queued  bool
+	active  int
+	lastRun time.Time
+	wg      sync.WaitGroup
+}
+
+// cronSchedule is a parsed five field cron expression, each field is stored
+// as a bitset of permitted values
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domAny, dowAny                bool
+}
+
+type cronField struct {
+	min, max int
+	names    map[string]int
+}
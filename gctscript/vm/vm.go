@@ -16,6 +16,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	scriptevent "github.com/thrasher-corp/gocryptotrader/database/repository/script"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/loader"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/validator"
@@ -85,31 +86,53 @@ func (vm *VM) Load(file string) error {
 
 	vm.File = file
 	vm.Path = filepath.Dir(file)
-	vm.Script = tengo.NewScript(code)
-
-	scriptCtx := &gct.Context{}
-	scriptCtx.Value = map[string]tengo.Object{
-		"script": &tengo.String{Value: vm.ShortName() + "-" + vm.ID.String()},
+	vm.Schedule, err = parseSchedule(vm.ShortName(), code)
+	if err != nil {
+		return &Error{Action: "Load: Schedule", Script: file, Cause: err}
 	}
+	vm.code = code
 
-	err = vm.Script.Add("ctx", scriptCtx)
+	err = vm.applyLimits()
+	if err != nil {
+		return &Error{Action: "Load: Limits", Script: file, Cause: err}
+	}
+	vm.Script, err = vm.newScript(vm.limiter)
 	if err != nil {
 		return err
 	}
-
-	vm.Script.SetImports(loader.GetModuleMap())
 	vm.Hash = vm.getHash()
 
-	if vm.config.AllowImports {
-		if vm.config.Verbose {
-			log.Debugf(log.GCTScriptMgr, "File imports enabled for vm: %v", vm.ID)
-		}
-		vm.Script.EnableFileImport(true)
+	if vm.config.AllowImports && vm.config.Verbose {
+		log.Debugf(log.GCTScriptMgr, "File imports enabled for vm: %v", vm.ID)
 	}
 	vm.event(StatusSuccess, TypeLoad)
 	return nil
 }
 
+// newScript creates a tengo script from the loaded code with its imports
+// bound to the supplied limiter
+func (vm *VM) newScript(limiter *modules.Limiter) (*tengo.Script, error) {
+	s := tengo.NewScript(vm.code)
+
+	scriptCtx := &gct.Context{}
+	scriptCtx.Value = map[string]tengo.Object{
+		"script": &tengo.String{Value: vm.ShortName() + "-" + vm.ID.String()},
+	}
+
+	err := s.Add("ctx", scriptCtx)
+	if err != nil {
+		return nil, err
+	}
+	s.SetImports(loader.GetLimitedModuleMap(limiter))
+	if vm.limits != nil && vm.limits.MaxAllocs > 0 {
+		s.SetMaxAllocs(vm.limits.MaxAllocs)
+	}
+	if vm.config.AllowImports {
+		s.EnableFileImport(true)
+	}
+	return s, nil
+}
+
 // Compile compiles to byte code loaded copy of vm script
 func (vm *VM) Compile() (err error) {
 	vm.Compiled, err = vm.Script.Compile()
@@ -117,9 +140,17 @@ func (vm *VM) Compile() (err error) {
 }
 
 // RunCtx runs compiled byte code with context.Context support.
-func (vm *VM) RunCtx() (err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), vm.config.ScriptTimeout)
+func (vm *VM) RunCtx() error {
+	return vm.runCompiled(vm.Compiled, vm.limiter)
+}
+
+// runCompiled runs the supplied compiled byte code, which is either the VM's
+// own copy or a copy for a concurrent scheduled run, along with the limiter
+// its imports are bound to
+func (vm *VM) runCompiled(compiled *tengo.Compiled, limiter *modules.Limiter) error {
+	ctx, cancel := context.WithTimeout(context.Background(), vm.runTimeout())
 	defer cancel()
+	limiter.Reset()
 
 	if vm.config.Verbose {
 		log.Debugf(log.GCTScriptMgr,
@@ -128,7 +159,7 @@ func (vm *VM) RunCtx() (err error) {
 			vm.ID)
 	}
 
-	err = vm.Compiled.RunContext(ctx)
+	err := compiled.RunContext(ctx)
 	if err != nil {
 		vm.event(StatusFailure, TypeExecute)
 		return Error{Action: "RunCtx", Cause: err}
@@ -152,6 +183,15 @@ func (vm *VM) CompileAndRun() {
 		return
 	}
 
+	if vm.Schedule != nil {
+		if vm.config.Verbose {
+			log.Debugf(log.GCTScriptMgr, "Scheduling script: %s ID: %v with schedule %q",
+				vm.ShortName(), vm.ID, vm.Schedule.Expression)
+		}
+		vm.scheduleRunner()
+		return
+	}
+
 	err = vm.RunCtx()
 	if err != nil {
 		log.Errorln(log.GCTScriptMgr, err)
@@ -194,6 +234,10 @@ func (vm *VM) Shutdown() error {
 	if vm.S != nil {
 		close(vm.S)
 	}
+	if vm.sched != nil {
+		// scheduled runs must finish before the script is released
+		vm.sched.wg.Wait()
+	}
 	if vm.config.Verbose {
 		log.Debugf(log.GCTScriptMgr, "Shutting down script: %s ID: %v", vm.ShortName(), vm.ID)
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/d5/tengo/v2"
 	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 )
 
 const (
@@ -52,6 +53,11 @@ type VM struct {
 	T          time.Duration
 	NextRun    time.Time
 	S          chan struct{}
+	Schedule   *Schedule
+	code       []byte
+	sched      *scheduler
+	limits     *Limits
+	limiter    *modules.Limiter
 	config     *Config
 	unregister func() error
 }
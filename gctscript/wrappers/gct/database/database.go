@@ -0,0 +1,75 @@
+package database
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
+)
+
+var errNilEngine = errors.New("engine has not been setup")
+
+// Database implements the read only database interface for scripts
+type Database struct{}
+
+// Candles returns saved candles for the exchange, pair, asset and interval
+// within the requested range
+func (d Database) Candles(exch string, pair currency.Pair, item asset.Item, interval kline.Interval, start, end time.Time) (*kline.Item, error) {
+	return kline.LoadFromDatabase(exch, pair, item, interval, start, end)
+}
+
+// Trades returns saved trades for the exchange, pair and asset within the
+// requested range
+func (d Database) Trades(exch string, pair currency.Pair, item asset.Item, start, end time.Time) ([]trade.Data, error) {
+	return trade.GetTradesInRange(exch, item.String(), pair.Base.String(), pair.Quote.String(), start, end)
+}
+
+// DataHistoryJob returns the data history job matching the nickname
+func (d Database) DataHistoryJob(nickname string) (*modules.DataHistoryJob, error) {
+	if engine.Bot == nil {
+		return nil, errNilEngine
+	}
+	job, err := engine.Bot.GetDataHistoryManager().GetByNickname(nickname, false)
+	if err != nil {
+		return nil, err
+	}
+	resp := convertJob(job)
+	return &resp, nil
+}
+
+// DataHistoryJobs returns all data history jobs created within the
+// requested range
+func (d Database) DataHistoryJobs(start, end time.Time) ([]modules.DataHistoryJob, error) {
+	if engine.Bot == nil {
+		return nil, errNilEngine
+	}
+	jobs, err := engine.Bot.GetDataHistoryManager().GetAllJobStatusBetween(start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]modules.DataHistoryJob, len(jobs))
+	for i := range jobs {
+		resp[i] = convertJob(jobs[i])
+	}
+	return resp, nil
+}
+
+func convertJob(job *engine.DataHistoryJob) modules.DataHistoryJob {
+	return modules.DataHistoryJob{
+		Nickname:    job.Nickname,
+		Exchange:    job.Exchange,
+		Asset:       job.Asset,
+		Pair:        job.Pair,
+		StartDate:   job.StartDate,
+		EndDate:     job.EndDate,
+		Interval:    job.Interval,
+		DataType:    job.DataType.String(),
+		Status:      job.Status.String(),
+		CreatedDate: job.CreatedDate,
+	}
+}
@@ -0,0 +1,70 @@
+package database
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func TestTrades(t *testing.T) {
+	t.Parallel()
+	end := time.Now()
+	_, err := Database{}.Trades("binance", currency.NewPair(currency.BTC, currency.USDT), asset.Spot, end.Add(-time.Hour), end)
+	if !errors.Is(err, database.ErrDatabaseNotConnected) {
+		t.Errorf("received '%v' expected '%v'", err, database.ErrDatabaseNotConnected)
+	}
+}
+
+func TestCandles(t *testing.T) {
+	t.Parallel()
+	end := time.Now()
+	_, err := Database{}.Candles("binance", currency.NewPair(currency.BTC, currency.USDT), asset.Spot, kline.OneHour, end.Add(-time.Hour), end)
+	if err == nil {
+		t.Error("expected error when database is not connected")
+	}
+}
+
+func TestDataHistoryJobs(t *testing.T) {
+	engine.Bot = nil
+	_, err := Database{}.DataHistoryJob("test")
+	if !errors.Is(err, errNilEngine) {
+		t.Errorf("received '%v' expected '%v'", err, errNilEngine)
+	}
+	_, err = Database{}.DataHistoryJobs(time.Now().Add(-time.Hour), time.Now())
+	if !errors.Is(err, errNilEngine) {
+		t.Errorf("received '%v' expected '%v'", err, errNilEngine)
+	}
+
+	engine.Bot = &engine.Engine{}
+	_, err = Database{}.DataHistoryJob("test")
+	if !errors.Is(err, engine.ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, engine.ErrNilSubsystem)
+	}
+	_, err = Database{}.DataHistoryJobs(time.Now().Add(-time.Hour), time.Now())
+	if !errors.Is(err, engine.ErrNilSubsystem) {
+		t.Errorf("received '%v' expected '%v'", err, engine.ErrNilSubsystem)
+	}
+	engine.Bot = nil
+}
+
+func TestConvertJob(t *testing.T) {
+	t.Parallel()
+	job := convertJob(&engine.DataHistoryJob{
+		Nickname: "test",
+		Exchange: "binance",
+		Asset:    asset.Spot,
+		Interval: kline.OneHour,
+	})
+	if job.Nickname != "test" || job.Exchange != "binance" || job.Asset != asset.Spot {
+		t.Errorf("unexpected job conversion %+v", job)
+	}
+	if job.Status != "active" || job.DataType != "candles" {
+		t.Errorf("received '%v' '%v' expected 'active' 'candles'", job.Status, job.DataType)
+	}
+}
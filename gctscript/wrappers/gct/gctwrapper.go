@@ -1,10 +1,14 @@
 package gct
 
-import "github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/exchange"
+import (
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/database"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers/gct/exchange"
+)
 
 // Setup returns a Wrapper
 func Setup() *Wrapper {
 	return &Wrapper{
 		&exchange.Exchange{},
+		&database.Database{},
 	}
 }
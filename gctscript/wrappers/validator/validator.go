@@ -14,6 +14,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/modules"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -283,3 +285,55 @@ func (w Wrapper) OHLCV(_ context.Context, exch string, p currency.Pair, a asset.
 		Candles:  candles,
 	}, nil
 }
+
+// Candles returns saved candles for requested exchange/pair/asset/start & end time
+func (w Wrapper) Candles(exch string, p currency.Pair, a asset.Item, i kline.Interval, start, end time.Time) (*kline.Item, error) {
+	return w.OHLCV(context.Background(), exch, p, a, start, end, i)
+}
+
+// Trades returns saved trades for requested exchange/pair/asset/start & end time
+func (w Wrapper) Trades(exch string, p currency.Pair, a asset.Item, start, _ time.Time) ([]trade.Data, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+	return []trade.Data{
+		{
+			TID:          "1337",
+			Exchange:     exch,
+			CurrencyPair: p,
+			AssetType:    a,
+			Side:         order.Buy,
+			Price:        validatorClose,
+			Amount:       validatorVol,
+			Timestamp:    start,
+		},
+	}, nil
+}
+
+// DataHistoryJob returns a data history job for requested nickname
+func (w Wrapper) DataHistoryJob(nickname string) (*modules.DataHistoryJob, error) {
+	if nickname == exchError.String() {
+		return nil, errTestFailed
+	}
+	return &modules.DataHistoryJob{
+		Nickname:    nickname,
+		Exchange:    "binance",
+		Asset:       asset.Spot,
+		Pair:        currency.NewPair(currency.BTC, currency.USDT),
+		StartDate:   time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2020, 2, 1, 0, 0, 0, 0, time.UTC),
+		Interval:    kline.OneHour,
+		DataType:    "candles",
+		Status:      "complete",
+		CreatedDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil
+}
+
+// DataHistoryJobs returns data history jobs created between start & end time
+func (w Wrapper) DataHistoryJobs(_, _ time.Time) ([]modules.DataHistoryJob, error) {
+	job, err := w.DataHistoryJob("validator")
+	if err != nil {
+		return nil, err
+	}
+	return []modules.DataHistoryJob{*job}, nil
+}
@@ -12,3 +12,11 @@ func GetWrapper() modules.GCTExchange {
 	}
 	return modules.Wrapper
 }
+
+// GetDatabaseWrapper returns the instance of the database wrapper to use
+func GetDatabaseWrapper() modules.GCTDatabase {
+	if validator.IsTestExecution.Load() == true {
+		return validator.Wrapper{}
+	}
+	return modules.DatabaseWrapper
+}